@@ -15,8 +15,13 @@ type BankKeeper interface {
 
 type DidKeeper interface {
 	GetParams(ctx sdk.Context) (params didtypes.FeeParams)
+	GetNamespaceFeeParams(ctx sdk.Context, namespace string) (params didtypes.FeeParams, found bool)
+	GetNamespaceResourceFeeParams(ctx sdk.Context, namespace string) (params didtypes.ResourceFeeParams, found bool)
+	FindDidByUniqueID(ctx *sdk.Context, uniqueID string) (did string, found bool)
 }
 
 type ResourceKeeper interface {
 	GetParams(ctx sdk.Context) (params resourcetypes.FeeParams)
+	GetUploadParams(ctx sdk.Context) (params resourcetypes.UploadParams)
+	GetUploadedResourceMediaType(ctx sdk.Context, collectionID string, id string) string
 }
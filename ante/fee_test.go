@@ -4,12 +4,15 @@ import (
 	cheqdante "github.com/canow-co/cheqd-node/ante"
 	cheqdpost "github.com/canow-co/cheqd-node/post"
 	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	resourcetypes "github.com/canow-co/cheqd-node/x/resource/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/google/uuid"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -269,6 +272,254 @@ var _ = Describe("Fee tests on DeliverTx", func() {
 		Expect(feeCollectorBalance.Amount).To(Equal(reward.AmountOf(didtypes.BaseMinimalDenom)), "Reward was not sent to the fee collector")
 	})
 
+	It("TaxableTx Lifecycle in a namespace with its own fees", func() {
+		// keys and addresses
+		priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+		// set fees of the namespace
+		namespaceFeeParams := didtypes.FeeParams{
+			CreateDid:     sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(10_000_000_000)),
+			UpdateDid:     sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(5_000_000_000)),
+			DeactivateDid: sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(2_000_000_000)),
+			BurnFactor:    sdk.MustNewDecFromStr("0.2"),
+		}
+		s.app.DidKeeper.SetNamespaceParams(s.ctx, didtypes.NamespaceParams{
+			Namespaces: []*didtypes.NamespaceConfig{
+				{Namespace: "partners", FeeParams: &namespaceFeeParams},
+			},
+		})
+
+		// msg and signatures
+		msg := SandboxDidDoc()
+		_, _, id := didutils.MustSplitDID(msg.Payload.Id)
+		msg.Payload.Id = didutils.JoinDID(didtypes.DidMethod, "partners", id)
+
+		feeAmount := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(5_000_000_000)))
+		gasLimit := testdata.NewTestGasLimit()
+		Expect(s.txBuilder.SetMsgs(msg)).To(BeNil())
+		s.txBuilder.SetFeeAmount(feeAmount)
+		s.txBuilder.SetGasLimit(gasLimit)
+		s.txBuilder.SetFeePayer(addr1)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		Expect(err).To(BeNil())
+
+		// set account with sufficient funds
+		acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+		s.app.AccountKeeper.SetAccount(s.ctx, acc)
+		amount := sdk.NewInt(100_000_000_000)
+		err = testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, amount)))
+		Expect(err).To(BeNil())
+
+		taxDecorator := cheqdpost.NewTaxDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.DidKeeper, s.app.ResourceKeeper)
+		posthandler := sdk.ChainAnteDecorators(taxDecorator)
+
+		// get supply before tx
+		supplyBeforeDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		_, err = posthandler(s.ctx, tx, false)
+		Expect(err).To(BeNil(), "Tx errored when fee payer had sufficient funds while subtracting tax of the namespace on deliverTx")
+
+		// check that the fee of the namespace was charged
+		balance := s.app.BankKeeper.GetBalance(s.ctx, addr1, didtypes.BaseMinimalDenom)
+		Expect(amount.Sub(namespaceFeeParams.CreateDid.Amount)).To(Equal(balance.Amount), "Tax of the namespace was not subtracted from the fee payer")
+
+		// check that supply was deflated by the burn factor of the namespace
+		supplyAfterDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		burnt := cheqdante.GetBurnFeePortion(namespaceFeeParams.BurnFactor, sdk.NewCoins(namespaceFeeParams.CreateDid))
+		Expect(supplyBeforeDeflation.Sub(supplyAfterDeflation...)).To(Equal(burnt), "Supply was not deflated")
+	})
+
+	It("TaxableTx Lifecycle of a resource in a namespace with its own fees", func() {
+		// keys and addresses
+		priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+		// set resource fees of the namespace
+		namespaceResourceFeeParams := didtypes.ResourceFeeParams{
+			Image:      sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(4_000_000_000)),
+			Json:       sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(1_000_000_000)),
+			Default:    sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(2_000_000_000)),
+			Chunk:      sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(500_000_000)),
+			BurnFactor: sdk.MustNewDecFromStr("0.2"),
+		}
+		s.app.DidKeeper.SetNamespaceParams(s.ctx, didtypes.NamespaceParams{
+			Namespaces: []*didtypes.NamespaceConfig{
+				{Namespace: "partners", ResourceFeeParams: &namespaceResourceFeeParams},
+			},
+		})
+
+		// collection DID in the namespace
+		collectionID := uuid.NewString()
+		s.app.DidKeeper.SetDidUniqueID(&s.ctx, didutils.JoinDID(didtypes.DidMethod, "partners", collectionID))
+
+		// msg and signatures
+		msg := SandboxResource()
+		msg.Payload.CollectionId = collectionID
+
+		feeAmount := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(5_000_000_000)))
+		gasLimit := testdata.NewTestGasLimit()
+		Expect(s.txBuilder.SetMsgs(msg)).To(BeNil())
+		s.txBuilder.SetFeeAmount(feeAmount)
+		s.txBuilder.SetGasLimit(gasLimit)
+		s.txBuilder.SetFeePayer(addr1)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		Expect(err).To(BeNil())
+
+		// set account with sufficient funds
+		acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+		s.app.AccountKeeper.SetAccount(s.ctx, acc)
+		amount := sdk.NewInt(100_000_000_000)
+		err = testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, amount)))
+		Expect(err).To(BeNil())
+
+		taxDecorator := cheqdpost.NewTaxDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.DidKeeper, s.app.ResourceKeeper)
+		posthandler := sdk.ChainAnteDecorators(taxDecorator)
+
+		// get supply before tx
+		supplyBeforeDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		_, err = posthandler(s.ctx, tx, false)
+		Expect(err).To(BeNil(), "Tx errored when fee payer had sufficient funds while subtracting resource tax of the namespace on deliverTx")
+
+		// check that the json resource fee of the namespace was charged
+		balance := s.app.BankKeeper.GetBalance(s.ctx, addr1, didtypes.BaseMinimalDenom)
+		Expect(amount.Sub(namespaceResourceFeeParams.Json.Amount)).To(Equal(balance.Amount), "Resource tax of the namespace was not subtracted from the fee payer")
+
+		// check that supply was deflated by the burn factor of the namespace
+		supplyAfterDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		burnt := cheqdante.GetBurnFeePortion(namespaceResourceFeeParams.BurnFactor, sdk.NewCoins(namespaceResourceFeeParams.Json))
+		Expect(supplyBeforeDeflation.Sub(supplyAfterDeflation...)).To(Equal(burnt), "Supply was not deflated")
+	})
+
+	It("TaxableTx Lifecycle of a resource upload chunk", func() {
+		// keys and addresses
+		priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+		// msg and signatures
+		msg := &resourcetypes.MsgAppendResourceChunk{
+			Payload: &resourcetypes.MsgAppendResourceChunkPayload{
+				CollectionId: SandboxResource().Payload.CollectionId,
+				Id:           uuid.NewString(),
+				Data:         []byte(`{"message": "test"}`),
+			},
+		}
+
+		feeAmount := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(5_000_000_000)))
+		gasLimit := testdata.NewTestGasLimit()
+		Expect(s.txBuilder.SetMsgs(msg)).To(BeNil())
+		s.txBuilder.SetFeeAmount(feeAmount)
+		s.txBuilder.SetGasLimit(gasLimit)
+		s.txBuilder.SetFeePayer(addr1)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		Expect(err).To(BeNil())
+
+		// set account with sufficient funds
+		acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+		s.app.AccountKeeper.SetAccount(s.ctx, acc)
+		amount := sdk.NewInt(100_000_000_000)
+		err = testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, amount)))
+		Expect(err).To(BeNil())
+
+		taxDecorator := cheqdpost.NewTaxDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.DidKeeper, s.app.ResourceKeeper)
+		posthandler := sdk.ChainAnteDecorators(taxDecorator)
+
+		// get supply before tx
+		supplyBeforeDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		_, err = posthandler(s.ctx, tx, false)
+		Expect(err).To(BeNil(), "Tx errored when fee payer had sufficient funds while subtracting the chunk tax on deliverTx")
+
+		// check that the chunk fee was charged
+		uploadParams := s.app.ResourceKeeper.GetUploadParams(s.ctx)
+		balance := s.app.BankKeeper.GetBalance(s.ctx, addr1, didtypes.BaseMinimalDenom)
+		Expect(amount.Sub(uploadParams.Chunk.Amount)).To(Equal(balance.Amount), "Chunk tax was not subtracted from the fee payer")
+
+		// check that supply was deflated by the resource burn factor
+		supplyAfterDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		burnt := cheqdante.GetBurnFeePortion(s.app.ResourceKeeper.GetParams(s.ctx).BurnFactor, sdk.NewCoins(uploadParams.Chunk))
+		Expect(supplyBeforeDeflation.Sub(supplyAfterDeflation...)).To(Equal(burnt), "Supply was not deflated")
+	})
+
+	It("TaxableTx Lifecycle of a resource upload finalization", func() {
+		// keys and addresses
+		priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+		// staged upload of an image
+		image := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, resourcetypes.MaxCreateResourceDataSize)...)
+		upload := resourcetypes.ResourceUpload{
+			CollectionId: SandboxResource().Payload.CollectionId,
+			Id:           uuid.NewString(),
+			Name:         "Logo",
+			ResourceType: "Image",
+			TotalSize:    uint64(len(image)),
+			ExpiryHeight: s.ctx.BlockHeight() + 1,
+		}
+		s.app.ResourceKeeper.SetResourceUpload(&s.ctx, &upload)
+		s.app.ResourceKeeper.AppendResourceUploadChunk(&s.ctx, &upload, image)
+
+		// msg and signatures
+		msg := &resourcetypes.MsgFinalizeResource{
+			Payload: &resourcetypes.MsgFinalizeResourcePayload{
+				CollectionId: upload.CollectionId,
+				Id:           upload.Id,
+			},
+		}
+
+		feeAmount := sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, sdk.NewInt(10_000_000_000)))
+		gasLimit := testdata.NewTestGasLimit()
+		Expect(s.txBuilder.SetMsgs(msg)).To(BeNil())
+		s.txBuilder.SetFeeAmount(feeAmount)
+		s.txBuilder.SetGasLimit(gasLimit)
+		s.txBuilder.SetFeePayer(addr1)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		Expect(err).To(BeNil())
+
+		// set account with sufficient funds
+		acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+		s.app.AccountKeeper.SetAccount(s.ctx, acc)
+		amount := sdk.NewInt(100_000_000_000)
+		err = testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewCoin(didtypes.BaseMinimalDenom, amount)))
+		Expect(err).To(BeNil())
+
+		taxDecorator := cheqdpost.NewTaxDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.DidKeeper, s.app.ResourceKeeper)
+		posthandler := sdk.ChainAnteDecorators(taxDecorator)
+
+		// get supply before tx
+		supplyBeforeDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		_, err = posthandler(s.ctx, tx, false)
+		Expect(err).To(BeNil(), "Tx errored when fee payer had sufficient funds while subtracting the resource tax on deliverTx")
+
+		// check that the image fee was charged
+		feeParams := s.app.ResourceKeeper.GetParams(s.ctx)
+		balance := s.app.BankKeeper.GetBalance(s.ctx, addr1, didtypes.BaseMinimalDenom)
+		Expect(amount.Sub(feeParams.Image.Amount)).To(Equal(balance.Amount), "Image tax was not subtracted from the fee payer")
+
+		// check that supply was deflated by the resource burn factor
+		supplyAfterDeflation, _, err := s.app.BankKeeper.GetPaginatedTotalSupply(s.ctx, &query.PageRequest{})
+		Expect(err).To(BeNil())
+
+		burnt := cheqdante.GetBurnFeePortion(feeParams.BurnFactor, sdk.NewCoins(feeParams.Image))
+		Expect(supplyBeforeDeflation.Sub(supplyAfterDeflation...)).To(Equal(burnt), "Supply was not deflated")
+	})
+
 	It("Non TaxableTx Lifecycle", func() {
 		// keys and addresses
 		priv1, _, addr1 := testdata.KeyTestPubAddr()
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
 	resourcetypes "github.com/canow-co/cheqd-node/x/resource/types"
 	resourceutils "github.com/canow-co/cheqd-node/x/resource/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,7 @@ const (
 	MsgCreateResourceDefault
 	MsgCreateResourceImage
 	MsgCreateResourceJSON
+	MsgAppendResourceChunk
 
 	TaxableMsgFeeCount
 )
@@ -38,6 +40,7 @@ var TaxableMsgFees = TaxableMsgFee{
 	MsgCreateResourceDefault: (sdk.Coins)(nil),
 	MsgCreateResourceImage:   (sdk.Coins)(nil),
 	MsgCreateResourceJSON:    (sdk.Coins)(nil),
+	MsgAppendResourceChunk:   (sdk.Coins)(nil),
 }
 
 var BurnFactors = BurnFactor{
@@ -51,33 +54,77 @@ func GetTaxableMsg(msg interface{}) bool {
 		return true
 	case *didtypes.MsgUpdateDidDoc:
 		return true
+	case *didtypes.MsgPatchDidDoc:
+		return true
 	case *didtypes.MsgDeactivateDidDoc:
 		return true
 	case *resourcetypes.MsgCreateResource:
 		return true
+	case *resourcetypes.MsgAppendResourceChunk:
+		return true
+	case *resourcetypes.MsgFinalizeResource:
+		return true
 	default:
 		return false
 	}
 }
 
-func GetTaxableMsgFeeWithBurnPortion(ctx sdk.Context, msg interface{}) (sdk.Coins, sdk.Coins, bool) {
+func GetTaxableMsgFeeWithBurnPortion(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKeeper, msg interface{}) (sdk.Coins, sdk.Coins, bool) {
 	switch msg := msg.(type) {
 	case *didtypes.MsgCreateDidDoc:
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorDid], TaxableMsgFees[MsgCreateDidDoc])
-		return GetRewardPortion(TaxableMsgFees[MsgCreateDidDoc], burnPortion), burnPortion, true
+		return GetDidTaxableMsgFee(ctx, didKeeper, msg.GetPayload().GetId(), MsgCreateDidDoc)
 	case *didtypes.MsgUpdateDidDoc:
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorDid], TaxableMsgFees[MsgUpdateDidDoc])
-		return GetRewardPortion(TaxableMsgFees[MsgUpdateDidDoc], burnPortion), burnPortion, true
+		return GetDidTaxableMsgFee(ctx, didKeeper, msg.GetPayload().GetId(), MsgUpdateDidDoc)
+	case *didtypes.MsgPatchDidDoc:
+		// Patch is a partial update, so it costs the same as a full update
+		return GetDidTaxableMsgFee(ctx, didKeeper, msg.GetPayload().GetId(), MsgUpdateDidDoc)
 	case *didtypes.MsgDeactivateDidDoc:
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorDid], TaxableMsgFees[MsgDeactivateDidDoc])
-		return GetRewardPortion(TaxableMsgFees[MsgDeactivateDidDoc], burnPortion), burnPortion, true
+		return GetDidTaxableMsgFee(ctx, didKeeper, msg.GetPayload().GetId(), MsgDeactivateDidDoc)
 	case *resourcetypes.MsgCreateResource:
-		return GetResourceTaxableMsgFee(ctx, msg)
+		payload := msg.GetPayload()
+		mediaType := resourceutils.DetectMediaType(payload.ToResource().Resource.Data)
+		return GetResourceTaxableMsgFee(ctx, didKeeper, payload.GetCollectionId(), GetResourceMediaTypeMsgFee(mediaType))
+	case *resourcetypes.MsgAppendResourceChunk:
+		// Chunks are charged regardless of the media type, on top of the resource fee charged on finalization
+		return GetResourceTaxableMsgFee(ctx, didKeeper, msg.GetPayload().GetCollectionId(), MsgAppendResourceChunk)
+	case *resourcetypes.MsgFinalizeResource:
+		// Resources uploaded in chunks cost the same as created ones of the media type
+		payload := msg.GetPayload()
+		mediaType := resourceKeeper.GetUploadedResourceMediaType(ctx, didutils.NormalizeID(payload.GetCollectionId()), didutils.NormalizeUUID(payload.GetId()))
+		return GetResourceTaxableMsgFee(ctx, didKeeper, payload.GetCollectionId(), GetResourceMediaTypeMsgFee(mediaType))
 	default:
 		return nil, nil, false
 	}
 }
 
+// GetDidTaxableMsgFee returns the fee of the DID msg. Namespaces with their own fee params override the chain-wide ones.
+func GetDidTaxableMsgFee(ctx sdk.Context, didKeeper DidKeeper, did string, msgFee int) (sdk.Coins, sdk.Coins, bool) {
+	fee, burnFactor := TaxableMsgFees[msgFee], BurnFactors[BurnFactorDid]
+
+	_, namespace, _, err := didutils.TrySplitDID(did)
+	if err == nil {
+		if feeParams, found := didKeeper.GetNamespaceFeeParams(ctx, namespace); found {
+			fee, burnFactor = getDidMsgFee(feeParams, msgFee), feeParams.BurnFactor
+		}
+	}
+
+	burnPortion := GetBurnFeePortion(burnFactor, fee)
+	return GetRewardPortion(fee, burnPortion), burnPortion, true
+}
+
+func getDidMsgFee(feeParams didtypes.FeeParams, msgFee int) sdk.Coins {
+	switch msgFee {
+	case MsgCreateDidDoc:
+		return sdk.NewCoins(feeParams.CreateDid)
+	case MsgUpdateDidDoc:
+		return sdk.NewCoins(feeParams.UpdateDid)
+	case MsgDeactivateDidDoc:
+		return sdk.NewCoins(feeParams.DeactivateDid)
+	default:
+		return nil
+	}
+}
+
 func GetRewardPortion(total sdk.Coins, burnPortion sdk.Coins) sdk.Coins {
 	if burnPortion.IsZero() {
 		return total
@@ -86,24 +133,54 @@ func GetRewardPortion(total sdk.Coins, burnPortion sdk.Coins) sdk.Coins {
 	return total.Sub(burnPortion...)
 }
 
-func GetResourceTaxableMsgFee(ctx sdk.Context, msg *resourcetypes.MsgCreateResource) (sdk.Coins, sdk.Coins, bool) {
-	mediaType := resourceutils.DetectMediaType(msg.GetPayload().ToResource().Resource.Data)
+// GetResourceTaxableMsgFee returns the fee of the resource msg. Namespaces of collection DIDs with their own
+// resource fee params override the chain-wide ones.
+func GetResourceTaxableMsgFee(ctx sdk.Context, didKeeper DidKeeper, collectionID string, msgFee int) (sdk.Coins, sdk.Coins, bool) {
+	fee, burnFactor := TaxableMsgFees[msgFee], BurnFactors[BurnFactorResource]
+
+	did, found := didKeeper.FindDidByUniqueID(&ctx, didutils.NormalizeID(collectionID))
+	if found {
+		_, namespace, _, err := didutils.TrySplitDID(did)
+		if err == nil {
+			if feeParams, found := didKeeper.GetNamespaceResourceFeeParams(ctx, namespace); found {
+				fee, burnFactor = getResourceMsgFee(feeParams, msgFee), feeParams.BurnFactor
+			}
+		}
+	}
+
+	burnPortion := GetBurnFeePortion(burnFactor, fee)
+	return GetRewardPortion(fee, burnPortion), burnPortion, true
+}
 
+func getResourceMsgFee(feeParams didtypes.ResourceFeeParams, msgFee int) sdk.Coins {
+	switch msgFee {
+	case MsgCreateResourceImage:
+		return sdk.NewCoins(feeParams.Image)
+	case MsgCreateResourceJSON:
+		return sdk.NewCoins(feeParams.Json)
+	case MsgCreateResourceDefault:
+		return sdk.NewCoins(feeParams.Default)
+	case MsgAppendResourceChunk:
+		return sdk.NewCoins(feeParams.Chunk)
+	default:
+		return nil
+	}
+}
+
+// GetResourceMediaTypeMsgFee returns the resource creation fee matching the media type
+func GetResourceMediaTypeMsgFee(mediaType string) int {
 	// Mime type image
 	if strings.HasPrefix(mediaType, "image/") {
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], TaxableMsgFees[MsgCreateResourceImage])
-		return GetRewardPortion(TaxableMsgFees[MsgCreateResourceImage], burnPortion), burnPortion, true
+		return MsgCreateResourceImage
 	}
 
 	// Mime type json
 	if strings.HasPrefix(mediaType, "application/json") {
-		burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], TaxableMsgFees[MsgCreateResourceJSON])
-		return GetRewardPortion(TaxableMsgFees[MsgCreateResourceJSON], burnPortion), burnPortion, true
+		return MsgCreateResourceJSON
 	}
 
 	// Default mime type
-	burnPortion := GetBurnFeePortion(BurnFactors[BurnFactorResource], TaxableMsgFees[MsgCreateResourceDefault])
-	return GetRewardPortion(TaxableMsgFees[MsgCreateResourceDefault], burnPortion), burnPortion, true
+	return MsgCreateResourceDefault
 }
 
 func checkFeeParamsFromSubspace(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKeeper) bool {
@@ -117,6 +194,9 @@ func checkFeeParamsFromSubspace(ctx sdk.Context, didKeeper DidKeeper, resourceKe
 	TaxableMsgFees[MsgCreateResourceJSON] = sdk.NewCoins(resourceParams.Json)
 	TaxableMsgFees[MsgCreateResourceDefault] = sdk.NewCoins(resourceParams.Default)
 
+	uploadParams := resourceKeeper.GetUploadParams(ctx)
+	TaxableMsgFees[MsgAppendResourceChunk] = sdk.NewCoins(uploadParams.Chunk)
+
 	BurnFactors[BurnFactorDid] = didParams.BurnFactor
 	BurnFactors[BurnFactorResource] = resourceParams.BurnFactor
 
@@ -129,7 +209,7 @@ func IsTaxableTx(ctx sdk.Context, didKeeper DidKeeper, resourceKeeper ResourceKe
 	burn := (sdk.Coins)(nil)
 	msgs := tx.GetMsgs()
 	for _, msg := range msgs {
-		rewardPortion, burnPortion, isIdentityMsg := GetTaxableMsgFeeWithBurnPortion(ctx, msg)
+		rewardPortion, burnPortion, isIdentityMsg := GetTaxableMsgFeeWithBurnPortion(ctx, didKeeper, resourceKeeper, msg)
 		if !isIdentityMsg {
 			continue
 		}
@@ -522,6 +522,7 @@ var (
 	fd_DidDoc_key_agreement         protoreflect.FieldDescriptor
 	fd_DidDoc_service               protoreflect.FieldDescriptor
 	fd_DidDoc_also_known_as         protoreflect.FieldDescriptor
+	fd_DidDoc_controller_threshold  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -538,6 +539,7 @@ func init() {
 	fd_DidDoc_key_agreement = md_DidDoc.Fields().ByName("key_agreement")
 	fd_DidDoc_service = md_DidDoc.Fields().ByName("service")
 	fd_DidDoc_also_known_as = md_DidDoc.Fields().ByName("also_known_as")
+	fd_DidDoc_controller_threshold = md_DidDoc.Fields().ByName("controller_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_DidDoc)(nil)
@@ -671,6 +673,12 @@ func (x *fastReflection_DidDoc) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ControllerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ControllerThreshold)
+		if !f(fd_DidDoc_controller_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -708,6 +716,8 @@ func (x *fastReflection_DidDoc) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Service) != 0
 	case "cheqd.did.v2.DidDoc.also_known_as":
 		return len(x.AlsoKnownAs) != 0
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		return x.ControllerThreshold != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -746,6 +756,8 @@ func (x *fastReflection_DidDoc) Clear(fd protoreflect.FieldDescriptor) {
 		x.Service = nil
 	case "cheqd.did.v2.DidDoc.also_known_as":
 		x.AlsoKnownAs = nil
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		x.ControllerThreshold = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -825,6 +837,9 @@ func (x *fastReflection_DidDoc) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_DidDoc_11_list{list: &x.AlsoKnownAs}
 		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		value := x.ControllerThreshold
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -887,6 +902,8 @@ func (x *fastReflection_DidDoc) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_DidDoc_11_list)
 		x.AlsoKnownAs = *clv.list
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		x.ControllerThreshold = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -969,6 +986,8 @@ func (x *fastReflection_DidDoc) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(value)
 	case "cheqd.did.v2.DidDoc.id":
 		panic(fmt.Errorf("field id of message cheqd.did.v2.DidDoc is not mutable"))
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		panic(fmt.Errorf("field controller_threshold of message cheqd.did.v2.DidDoc is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -1014,6 +1033,8 @@ func (x *fastReflection_DidDoc) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cheqd.did.v2.DidDoc.also_known_as":
 		list := []string{}
 		return protoreflect.ValueOfList(&_DidDoc_11_list{list: &list})
+	case "cheqd.did.v2.DidDoc.controller_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDoc"))
@@ -1147,6 +1168,9 @@ func (x *fastReflection_DidDoc) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.ControllerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ControllerThreshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1176,6 +1200,11 @@ func (x *fastReflection_DidDoc) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ControllerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ControllerThreshold))
+			i--
+			dAtA[i] = 0x60
+		}
 		if len(x.AlsoKnownAs) > 0 {
 			for iNdEx := len(x.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.AlsoKnownAs[iNdEx])
@@ -1737,6 +1766,25 @@ func (x *fastReflection_DidDoc) ProtoMethods() *protoiface.Methods {
 				}
 				x.AlsoKnownAs = append(x.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+				}
+				x.ControllerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ControllerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3028,6 +3076,7 @@ var (
 	fd_Service_service_endpoint protoreflect.FieldDescriptor
 	fd_Service_accept           protoreflect.FieldDescriptor
 	fd_Service_routing_keys     protoreflect.FieldDescriptor
+	fd_Service_endpoint         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3038,6 +3087,7 @@ func init() {
 	fd_Service_service_endpoint = md_Service.Fields().ByName("service_endpoint")
 	fd_Service_accept = md_Service.Fields().ByName("accept")
 	fd_Service_routing_keys = md_Service.Fields().ByName("routing_keys")
+	fd_Service_endpoint = md_Service.Fields().ByName("endpoint")
 }
 
 var _ protoreflect.Message = (*fastReflection_Service)(nil)
@@ -3135,6 +3185,12 @@ func (x *fastReflection_Service) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.Endpoint != nil {
+		value := protoreflect.ValueOfMessage(x.Endpoint.ProtoReflect())
+		if !f(fd_Service_endpoint, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3160,6 +3216,8 @@ func (x *fastReflection_Service) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Accept) != 0
 	case "cheqd.did.v2.Service.routing_keys":
 		return len(x.RoutingKeys) != 0
+	case "cheqd.did.v2.Service.endpoint":
+		return x.Endpoint != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -3186,6 +3244,8 @@ func (x *fastReflection_Service) Clear(fd protoreflect.FieldDescriptor) {
 		x.Accept = nil
 	case "cheqd.did.v2.Service.routing_keys":
 		x.RoutingKeys = nil
+	case "cheqd.did.v2.Service.endpoint":
+		x.Endpoint = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -3226,6 +3286,9 @@ func (x *fastReflection_Service) Get(descriptor protoreflect.FieldDescriptor) pr
 		}
 		listValue := &_Service_5_list{list: &x.RoutingKeys}
 		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.Service.endpoint":
+		value := x.Endpoint
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -3262,6 +3325,8 @@ func (x *fastReflection_Service) Set(fd protoreflect.FieldDescriptor, value prot
 		lv := value.List()
 		clv := lv.(*_Service_5_list)
 		x.RoutingKeys = *clv.list
+	case "cheqd.did.v2.Service.endpoint":
+		x.Endpoint = value.Message().Interface().(*ServiceEndpoint)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -3300,6 +3365,11 @@ func (x *fastReflection_Service) Mutable(fd protoreflect.FieldDescriptor) protor
 		}
 		value := &_Service_5_list{list: &x.RoutingKeys}
 		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.Service.endpoint":
+		if x.Endpoint == nil {
+			x.Endpoint = new(ServiceEndpoint)
+		}
+		return protoreflect.ValueOfMessage(x.Endpoint.ProtoReflect())
 	case "cheqd.did.v2.Service.id":
 		panic(fmt.Errorf("field id of message cheqd.did.v2.Service is not mutable"))
 	case "cheqd.did.v2.Service.service_type":
@@ -3330,6 +3400,9 @@ func (x *fastReflection_Service) NewField(fd protoreflect.FieldDescriptor) proto
 	case "cheqd.did.v2.Service.routing_keys":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Service_5_list{list: &list})
+	case "cheqd.did.v2.Service.endpoint":
+		m := new(ServiceEndpoint)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Service"))
@@ -3425,6 +3498,10 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Endpoint != nil {
+			l = options.Size(x.Endpoint)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3454,6 +3531,20 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Endpoint != nil {
+			encoded, err := options.Marshal(x.Endpoint)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.RoutingKeys) > 0 {
 			for iNdEx := len(x.RoutingKeys) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.RoutingKeys[iNdEx])
@@ -3704,6 +3795,42 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 				}
 				x.RoutingKeys = append(x.RoutingKeys, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Endpoint == nil {
+					x.Endpoint = &ServiceEndpoint{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Endpoint); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3739,28 +3866,81 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ServiceEndpoint_3_list)(nil)
+
+type _ServiceEndpoint_3_list struct {
+	list *[]*ServiceEndpointItem
+}
+
+func (x *_ServiceEndpoint_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceEndpoint_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ServiceEndpoint_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceEndpointItem)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceEndpoint_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceEndpointItem)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceEndpoint_3_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceEndpointItem)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ServiceEndpoint_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceEndpoint_3_list) NewElement() protoreflect.Value {
+	v := new(ServiceEndpointItem)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ServiceEndpoint_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_DidDocWithMetadata          protoreflect.MessageDescriptor
-	fd_DidDocWithMetadata_did_doc  protoreflect.FieldDescriptor
-	fd_DidDocWithMetadata_metadata protoreflect.FieldDescriptor
+	md_ServiceEndpoint       protoreflect.MessageDescriptor
+	fd_ServiceEndpoint_uri   protoreflect.FieldDescriptor
+	fd_ServiceEndpoint_map   protoreflect.FieldDescriptor
+	fd_ServiceEndpoint_items protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_diddoc_proto_init()
-	md_DidDocWithMetadata = File_cheqd_did_v2_diddoc_proto.Messages().ByName("DidDocWithMetadata")
-	fd_DidDocWithMetadata_did_doc = md_DidDocWithMetadata.Fields().ByName("did_doc")
-	fd_DidDocWithMetadata_metadata = md_DidDocWithMetadata.Fields().ByName("metadata")
+	md_ServiceEndpoint = File_cheqd_did_v2_diddoc_proto.Messages().ByName("ServiceEndpoint")
+	fd_ServiceEndpoint_uri = md_ServiceEndpoint.Fields().ByName("uri")
+	fd_ServiceEndpoint_map = md_ServiceEndpoint.Fields().ByName("map")
+	fd_ServiceEndpoint_items = md_ServiceEndpoint.Fields().ByName("items")
 }
 
-var _ protoreflect.Message = (*fastReflection_DidDocWithMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_ServiceEndpoint)(nil)
 
-type fastReflection_DidDocWithMetadata DidDocWithMetadata
+type fastReflection_ServiceEndpoint ServiceEndpoint
 
-func (x *DidDocWithMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DidDocWithMetadata)(x)
+func (x *ServiceEndpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceEndpoint)(x)
 }
 
-func (x *DidDocWithMetadata) slowProtoReflect() protoreflect.Message {
+func (x *ServiceEndpoint) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3772,43 +3952,43 @@ func (x *DidDocWithMetadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DidDocWithMetadata_messageType fastReflection_DidDocWithMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_DidDocWithMetadata_messageType{}
+var _fastReflection_ServiceEndpoint_messageType fastReflection_ServiceEndpoint_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceEndpoint_messageType{}
 
-type fastReflection_DidDocWithMetadata_messageType struct{}
+type fastReflection_ServiceEndpoint_messageType struct{}
 
-func (x fastReflection_DidDocWithMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DidDocWithMetadata)(nil)
+func (x fastReflection_ServiceEndpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceEndpoint)(nil)
 }
-func (x fastReflection_DidDocWithMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_DidDocWithMetadata)
+func (x fastReflection_ServiceEndpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpoint)
 }
-func (x fastReflection_DidDocWithMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DidDocWithMetadata
+func (x fastReflection_ServiceEndpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpoint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DidDocWithMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_DidDocWithMetadata
+func (x *fastReflection_ServiceEndpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpoint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DidDocWithMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_DidDocWithMetadata_messageType
+func (x *fastReflection_ServiceEndpoint) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceEndpoint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DidDocWithMetadata) New() protoreflect.Message {
-	return new(fastReflection_DidDocWithMetadata)
+func (x *fastReflection_ServiceEndpoint) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpoint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DidDocWithMetadata) Interface() protoreflect.ProtoMessage {
-	return (*DidDocWithMetadata)(x)
+func (x *fastReflection_ServiceEndpoint) Interface() protoreflect.ProtoMessage {
+	return (*ServiceEndpoint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3816,16 +3996,22 @@ func (x *fastReflection_DidDocWithMetadata) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DidDocWithMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DidDoc != nil {
-		value := protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
-		if !f(fd_DidDocWithMetadata_did_doc, value) {
+func (x *fastReflection_ServiceEndpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_ServiceEndpoint_uri, value) {
 			return
 		}
 	}
-	if x.Metadata != nil {
-		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-		if !f(fd_DidDocWithMetadata_metadata, value) {
+	if x.Map != "" {
+		value := protoreflect.ValueOfString(x.Map)
+		if !f(fd_ServiceEndpoint_map, value) {
+			return
+		}
+	}
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceEndpoint_3_list{list: &x.Items})
+		if !f(fd_ServiceEndpoint_items, value) {
 			return
 		}
 	}
@@ -3842,17 +4028,19 @@ func (x *fastReflection_DidDocWithMetadata) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DidDocWithMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ServiceEndpoint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		return x.DidDoc != nil
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		return x.Metadata != nil
+	case "cheqd.did.v2.ServiceEndpoint.uri":
+		return x.Uri != ""
+	case "cheqd.did.v2.ServiceEndpoint.map":
+		return x.Map != ""
+	case "cheqd.did.v2.ServiceEndpoint.items":
+		return len(x.Items) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3862,17 +4050,19 @@ func (x *fastReflection_DidDocWithMetadata) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ServiceEndpoint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		x.DidDoc = nil
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		x.Metadata = nil
+	case "cheqd.did.v2.ServiceEndpoint.uri":
+		x.Uri = ""
+	case "cheqd.did.v2.ServiceEndpoint.map":
+		x.Map = ""
+	case "cheqd.did.v2.ServiceEndpoint.items":
+		x.Items = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3882,19 +4072,25 @@ func (x *fastReflection_DidDocWithMetadata) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DidDocWithMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ServiceEndpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		value := x.DidDoc
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.ServiceEndpoint.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.ServiceEndpoint.map":
+		value := x.Map
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.ServiceEndpoint.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_ServiceEndpoint_3_list{})
+		}
+		listValue := &_ServiceEndpoint_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpoint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3908,17 +4104,21 @@ func (x *fastReflection_DidDocWithMetadata) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ServiceEndpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		x.DidDoc = value.Message().Interface().(*DidDoc)
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		x.Metadata = value.Message().Interface().(*Metadata)
+	case "cheqd.did.v2.ServiceEndpoint.uri":
+		x.Uri = value.Interface().(string)
+	case "cheqd.did.v2.ServiceEndpoint.map":
+		x.Map = value.Interface().(string)
+	case "cheqd.did.v2.ServiceEndpoint.items":
+		lv := value.List()
+		clv := lv.(*_ServiceEndpoint_3_list)
+		x.Items = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3932,52 +4132,53 @@ func (x *fastReflection_DidDocWithMetadata) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ServiceEndpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		if x.DidDoc == nil {
-			x.DidDoc = new(DidDoc)
-		}
-		return protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		if x.Metadata == nil {
-			x.Metadata = new(Metadata)
+	case "cheqd.did.v2.ServiceEndpoint.items":
+		if x.Items == nil {
+			x.Items = []*ServiceEndpointItem{}
 		}
-		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		value := &_ServiceEndpoint_3_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.ServiceEndpoint.uri":
+		panic(fmt.Errorf("field uri of message cheqd.did.v2.ServiceEndpoint is not mutable"))
+	case "cheqd.did.v2.ServiceEndpoint.map":
+		panic(fmt.Errorf("field map of message cheqd.did.v2.ServiceEndpoint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DidDocWithMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ServiceEndpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
-		m := new(DidDoc)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cheqd.did.v2.DidDocWithMetadata.metadata":
-		m := new(Metadata)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.ServiceEndpoint.uri":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.ServiceEndpoint.map":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.ServiceEndpoint.items":
+		list := []*ServiceEndpointItem{}
+		return protoreflect.ValueOfList(&_ServiceEndpoint_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpoint"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DidDocWithMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ServiceEndpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.DidDocWithMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.ServiceEndpoint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3985,7 +4186,7 @@ func (x *fastReflection_DidDocWithMetadata) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DidDocWithMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ServiceEndpoint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3996,7 +4197,7 @@ func (x *fastReflection_DidDocWithMetadata) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DidDocWithMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ServiceEndpoint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4008,7 +4209,7 @@ func (x *fastReflection_DidDocWithMetadata) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DidDocWithMetadata) IsValid() bool {
+func (x *fastReflection_ServiceEndpoint) IsValid() bool {
 	return x != nil
 }
 
@@ -4018,9 +4219,9 @@ func (x *fastReflection_DidDocWithMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ServiceEndpoint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DidDocWithMetadata)
+		x := input.Message.Interface().(*ServiceEndpoint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4032,14 +4233,20 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DidDoc != nil {
-			l = options.Size(x.DidDoc)
+		l = len(x.Uri)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Metadata != nil {
-			l = options.Size(x.Metadata)
+		l = len(x.Map)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4050,7 +4257,7 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DidDocWithMetadata)
+		x := input.Message.Interface().(*ServiceEndpoint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4069,30 +4276,1056 @@ func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Metadata != nil {
-			encoded, err := options.Marshal(x.Metadata)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.Map) > 0 {
+			i -= len(x.Map)
+			copy(dAtA[i:], x.Map)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Map)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.DidDoc != nil {
-			encoded, err := options.Marshal(x.DidDoc)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Map = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &ServiceEndpointItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ServiceEndpointItem     protoreflect.MessageDescriptor
+	fd_ServiceEndpointItem_uri protoreflect.FieldDescriptor
+	fd_ServiceEndpointItem_map protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_ServiceEndpointItem = File_cheqd_did_v2_diddoc_proto.Messages().ByName("ServiceEndpointItem")
+	fd_ServiceEndpointItem_uri = md_ServiceEndpointItem.Fields().ByName("uri")
+	fd_ServiceEndpointItem_map = md_ServiceEndpointItem.Fields().ByName("map")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceEndpointItem)(nil)
+
+type fastReflection_ServiceEndpointItem ServiceEndpointItem
+
+func (x *ServiceEndpointItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceEndpointItem)(x)
+}
+
+func (x *ServiceEndpointItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceEndpointItem_messageType fastReflection_ServiceEndpointItem_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceEndpointItem_messageType{}
+
+type fastReflection_ServiceEndpointItem_messageType struct{}
+
+func (x fastReflection_ServiceEndpointItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceEndpointItem)(nil)
+}
+func (x fastReflection_ServiceEndpointItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpointItem)
+}
+func (x fastReflection_ServiceEndpointItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpointItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceEndpointItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceEndpointItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceEndpointItem) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceEndpointItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceEndpointItem) New() protoreflect.Message {
+	return new(fastReflection_ServiceEndpointItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceEndpointItem) Interface() protoreflect.ProtoMessage {
+	return (*ServiceEndpointItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceEndpointItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_ServiceEndpointItem_uri, value) {
+			return
+		}
+	}
+	if x.Map != "" {
+		value := protoreflect.ValueOfString(x.Map)
+		if !f(fd_ServiceEndpointItem_map, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceEndpointItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointItem.uri":
+		return x.Uri != ""
+	case "cheqd.did.v2.ServiceEndpointItem.map":
+		return x.Map != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointItem"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointItem.uri":
+		x.Uri = ""
+	case "cheqd.did.v2.ServiceEndpointItem.map":
+		x.Map = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointItem"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceEndpointItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.ServiceEndpointItem.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.ServiceEndpointItem.map":
+		value := x.Map
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointItem"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointItem.uri":
+		x.Uri = value.Interface().(string)
+	case "cheqd.did.v2.ServiceEndpointItem.map":
+		x.Map = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointItem"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointItem.uri":
+		panic(fmt.Errorf("field uri of message cheqd.did.v2.ServiceEndpointItem is not mutable"))
+	case "cheqd.did.v2.ServiceEndpointItem.map":
+		panic(fmt.Errorf("field map of message cheqd.did.v2.ServiceEndpointItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointItem"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceEndpointItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.ServiceEndpointItem.uri":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.ServiceEndpointItem.map":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.ServiceEndpointItem"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.ServiceEndpointItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceEndpointItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.ServiceEndpointItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceEndpointItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceEndpointItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceEndpointItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceEndpointItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceEndpointItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Uri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Map)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpointItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Map) > 0 {
+			i -= len(x.Map)
+			copy(dAtA[i:], x.Map)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Map)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceEndpointItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpointItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceEndpointItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Map = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DidDocWithMetadata          protoreflect.MessageDescriptor
+	fd_DidDocWithMetadata_did_doc  protoreflect.FieldDescriptor
+	fd_DidDocWithMetadata_metadata protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_diddoc_proto_init()
+	md_DidDocWithMetadata = File_cheqd_did_v2_diddoc_proto.Messages().ByName("DidDocWithMetadata")
+	fd_DidDocWithMetadata_did_doc = md_DidDocWithMetadata.Fields().ByName("did_doc")
+	fd_DidDocWithMetadata_metadata = md_DidDocWithMetadata.Fields().ByName("metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_DidDocWithMetadata)(nil)
+
+type fastReflection_DidDocWithMetadata DidDocWithMetadata
+
+func (x *DidDocWithMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DidDocWithMetadata)(x)
+}
+
+func (x *DidDocWithMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DidDocWithMetadata_messageType fastReflection_DidDocWithMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_DidDocWithMetadata_messageType{}
+
+type fastReflection_DidDocWithMetadata_messageType struct{}
+
+func (x fastReflection_DidDocWithMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DidDocWithMetadata)(nil)
+}
+func (x fastReflection_DidDocWithMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_DidDocWithMetadata)
+}
+func (x fastReflection_DidDocWithMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocWithMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DidDocWithMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocWithMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DidDocWithMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_DidDocWithMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DidDocWithMetadata) New() protoreflect.Message {
+	return new(fastReflection_DidDocWithMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DidDocWithMetadata) Interface() protoreflect.ProtoMessage {
+	return (*DidDocWithMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DidDocWithMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DidDoc != nil {
+		value := protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
+		if !f(fd_DidDocWithMetadata_did_doc, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_DidDocWithMetadata_metadata, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DidDocWithMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		return x.DidDoc != nil
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		return x.Metadata != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		x.DidDoc = nil
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		x.Metadata = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DidDocWithMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		value := x.DidDoc
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		x.DidDoc = value.Message().Interface().(*DidDoc)
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		x.Metadata = value.Message().Interface().(*Metadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		if x.DidDoc == nil {
+			x.DidDoc = new(DidDoc)
+		}
+		return protoreflect.ValueOfMessage(x.DidDoc.ProtoReflect())
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(Metadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DidDocWithMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocWithMetadata.did_doc":
+		m := new(DidDoc)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.DidDocWithMetadata.metadata":
+		m := new(Metadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocWithMetadata"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocWithMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DidDocWithMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.DidDocWithMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DidDocWithMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocWithMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DidDocWithMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DidDocWithMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DidDocWithMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DidDoc != nil {
+			l = options.Size(x.DidDoc)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DidDocWithMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DidDoc != nil {
+			encoded, err := options.Marshal(x.DidDoc)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
@@ -4261,6 +5494,7 @@ var (
 	fd_Metadata_version_id          protoreflect.FieldDescriptor
 	fd_Metadata_next_version_id     protoreflect.FieldDescriptor
 	fd_Metadata_previous_version_id protoreflect.FieldDescriptor
+	fd_Metadata_next_key_commitment protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4272,6 +5506,7 @@ func init() {
 	fd_Metadata_version_id = md_Metadata.Fields().ByName("version_id")
 	fd_Metadata_next_version_id = md_Metadata.Fields().ByName("next_version_id")
 	fd_Metadata_previous_version_id = md_Metadata.Fields().ByName("previous_version_id")
+	fd_Metadata_next_key_commitment = md_Metadata.Fields().ByName("next_key_commitment")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -4283,7 +5518,7 @@ func (x *Metadata) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Metadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[5]
+	mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4375,6 +5610,12 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.NextKeyCommitment != "" {
+		value := protoreflect.ValueOfString(x.NextKeyCommitment)
+		if !f(fd_Metadata_next_key_commitment, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4402,6 +5643,8 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.NextVersionId != ""
 	case "cheqd.did.v2.Metadata.previous_version_id":
 		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.Metadata.next_key_commitment":
+		return x.NextKeyCommitment != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
@@ -4430,6 +5673,8 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 		x.NextVersionId = ""
 	case "cheqd.did.v2.Metadata.previous_version_id":
 		x.PreviousVersionId = ""
+	case "cheqd.did.v2.Metadata.next_key_commitment":
+		x.NextKeyCommitment = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
@@ -4464,6 +5709,9 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 	case "cheqd.did.v2.Metadata.previous_version_id":
 		value := x.PreviousVersionId
 		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.Metadata.next_key_commitment":
+		value := x.NextKeyCommitment
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
@@ -4496,6 +5744,8 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		x.NextVersionId = value.Interface().(string)
 	case "cheqd.did.v2.Metadata.previous_version_id":
 		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.Metadata.next_key_commitment":
+		x.NextKeyCommitment = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
@@ -4534,6 +5784,8 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field next_version_id of message cheqd.did.v2.Metadata is not mutable"))
 	case "cheqd.did.v2.Metadata.previous_version_id":
 		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.Metadata is not mutable"))
+	case "cheqd.did.v2.Metadata.next_key_commitment":
+		panic(fmt.Errorf("field next_key_commitment of message cheqd.did.v2.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
@@ -4561,6 +5813,8 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "cheqd.did.v2.Metadata.previous_version_id":
 		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.Metadata.next_key_commitment":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.Metadata"))
@@ -4653,6 +5907,10 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.NextKeyCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4682,6 +5940,13 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NextKeyCommitment) > 0 {
+			i -= len(x.NextKeyCommitment)
+			copy(dAtA[i:], x.NextKeyCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextKeyCommitment)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.PreviousVersionId) > 0 {
 			i -= len(x.PreviousVersionId)
 			copy(dAtA[i:], x.PreviousVersionId)
@@ -4978,6 +6243,38 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				}
 				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5040,6 +6337,8 @@ type DidDoc struct {
 	// Format: did:canow:<namespace>:<unique-identifier>
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// controller is a list of DIDs that are allowed to control the DID document.
+	// Besides did:canow DIDs of the ledger, did:key and did:jwk DIDs are allowed. Their DID documents
+	// are derived from the DIDs and aren't written to the ledger.
 	Controller []string `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
 	// verificationMethod is a list of verification methods that can be used to
 	// verify a digital signature or cryptographic proof.
@@ -5063,6 +6362,10 @@ type DidDoc struct {
 	Service []*Service `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
 	// alsoKnownAs is a list of DIDs that are known to refer to the same DID subject.
 	AlsoKnownAs []string `protobuf:"bytes,11,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// controllerThreshold is the minimal number of controllers (m-of-n) that have to sign
+	// creation, update and deactivation of the DID document.
+	// Default: 0, which means that all controllers have to sign.
+	ControllerThreshold uint32 `protobuf:"varint,12,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (x *DidDoc) Reset() {
@@ -5162,6 +6465,13 @@ func (x *DidDoc) GetAlsoKnownAs() []string {
 	return nil
 }
 
+func (x *DidDoc) GetControllerThreshold() uint32 {
+	if x != nil {
+		return x.ControllerThreshold
+	}
+	return 0
+}
+
 // VerificationMethod defines a verification method, as defined in the DID Core specification.
 // Documentation: https://www.w3.org/TR/did-core/#verification-methods
 type VerificationMethod struct {
@@ -5176,7 +6486,7 @@ type VerificationMethod struct {
 	// Example: Ed25519VerificationKey2020
 	VerificationMethodType string `protobuf:"bytes,2,opt,name=verification_method_type,json=verificationMethodType,proto3" json:"verification_method_type,omitempty"`
 	// controller is the DID of the controller of the verification method.
-	// Format: did:canow:<namespace>:<unique-identifier>
+	// Format: did:canow:<namespace>:<unique-identifier>, did:key:<multibase-key> or did:jwk:<base64url-jwk>
 	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
 	// verification_material is the public key of the verification method.
 	// Commonly used verification material types: publicJwk, publicKeyBase58, publicKeyMultibase
@@ -5276,6 +6586,12 @@ func (x *VerificationRelationship) GetVerificationMethod() *VerificationMethod {
 
 // Service defines a service, as defined in the DID Core specification.
 // Documentation: https://www.w3.org/TR/did-core/#services
+//
+// The DID Core form of the endpoint is added to the v2 message as the endpoint field instead of a new v3 Service.
+// A v3 Service would need v3 versions of every message and query embedding DID Documents. Fields 1-5 keep
+// their meaning, so v2 clients keep decoding services. Services with the endpoint set are rendered in the DID Core form.
+// Services without it, including all services stored before it was introduced, are rendered from service_endpoint,
+// accept and routing_keys exactly as before.
 type Service struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5287,11 +6603,19 @@ type Service struct {
 	// type is the type of the service.
 	// Example: LinkedResource
 	ServiceType string `protobuf:"bytes,2,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
-	// serviceEndpoint is the endpoint of the service.
+	// serviceEndpoint is the legacy representation of the endpoint of the service: a list of URIs.
+	// It's derived from the endpoint field if the endpoint can be represented this way and kept for existing clients.
 	// Example: https://example.com/endpoint
 	ServiceEndpoint []string `protobuf:"bytes,3,rep,name=service_endpoint,json=serviceEndpoint,proto3" json:"service_endpoint,omitempty"`
-	Accept          []string `protobuf:"bytes,4,rep,name=accept,proto3" json:"accept,omitempty"`
-	RoutingKeys     []string `protobuf:"bytes,5,rep,name=routing_keys,json=routingKeys,proto3" json:"routing_keys,omitempty"`
+	// accept is the legacy list of DIDComm media types accepted by all the service endpoints.
+	// It's derived from the endpoint field together with service_endpoint.
+	Accept []string `protobuf:"bytes,4,rep,name=accept,proto3" json:"accept,omitempty"`
+	// routing_keys is the legacy list of DIDComm routing keys of all the service endpoints.
+	// It's derived from the endpoint field together with service_endpoint.
+	RoutingKeys []string `protobuf:"bytes,5,rep,name=routing_keys,json=routingKeys,proto3" json:"routing_keys,omitempty"`
+	// endpoint is the endpoint of the service in the DID Core form: a URI, a map or an ordered set of URIs and maps.
+	// Services with only the legacy fields set aren't converted into this form.
+	Endpoint *ServiceEndpoint `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
 func (x *Service) Reset() {
@@ -5349,6 +6673,119 @@ func (x *Service) GetRoutingKeys() []string {
 	return nil
 }
 
+func (x *Service) GetEndpoint() *ServiceEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// ServiceEndpoint defines a service endpoint, as defined in the DID Core specification.
+// Exactly one of the fields is set.
+// Documentation: https://www.w3.org/TR/did-core/#dfn-serviceendpoint
+type ServiceEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uri is the endpoint represented by a single URI.
+	// Example: https://example.com/endpoint
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// map is the endpoint represented by a JSON object, e.g. a DIDComm v2 endpoint.
+	// Stored in the canonical form: keys are sorted, no insignificant whitespace.
+	// Example: {"accept":["didcomm/v2"],"routingKeys":["did:example:HPXoCUSjrSvWC54SLWQjsm#key-1"],"uri":"https://example.com/didcomm"}
+	Map string `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+	// items is the endpoint represented by an ordered set of URIs and maps.
+	Items []*ServiceEndpointItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ServiceEndpoint) Reset() {
+	*x = ServiceEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpoint) ProtoMessage() {}
+
+// Deprecated: Use ServiceEndpoint.ProtoReflect.Descriptor instead.
+func (*ServiceEndpoint) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServiceEndpoint) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetMap() string {
+	if x != nil {
+		return x.Map
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetItems() []*ServiceEndpointItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ServiceEndpointItem defines an item of a service endpoint ordered set: either a URI or a map.
+type ServiceEndpointItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uri is the item represented by a single URI.
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// map is the item represented by a JSON object in the canonical form.
+	Map string `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+}
+
+func (x *ServiceEndpointItem) Reset() {
+	*x = ServiceEndpointItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceEndpointItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpointItem) ProtoMessage() {}
+
+// Deprecated: Use ServiceEndpointItem.ProtoReflect.Descriptor instead.
+func (*ServiceEndpointItem) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServiceEndpointItem) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ServiceEndpointItem) GetMap() string {
+	if x != nil {
+		return x.Map
+	}
+	return ""
+}
+
 // DidDocWithMetadata defines a DID Document with metadata, as defined in the DID Core specification.
 // Contains the DID Document, as well as DID Document metadata.
 type DidDocWithMetadata struct {
@@ -5365,7 +6802,7 @@ type DidDocWithMetadata struct {
 func (x *DidDocWithMetadata) Reset() {
 	*x = DidDocWithMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[4]
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5379,7 +6816,7 @@ func (*DidDocWithMetadata) ProtoMessage() {}
 
 // Deprecated: Use DidDocWithMetadata.ProtoReflect.Descriptor instead.
 func (*DidDocWithMetadata) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{4}
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DidDocWithMetadata) GetDidDoc() *DidDoc {
@@ -5426,12 +6863,17 @@ type Metadata struct {
 	// Format: UUID
 	// Example: 123e4567-e89b-12d3-a456-426655440000
 	PreviousVersionId string `protobuf:"bytes,6,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// next_key_commitment is the pre-rotation commitment to the next authentication key set of the DID Document.
+	// Changes of the authentication keys are accepted only if the new keys match the commitment.
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	// Example: zQmdcSjGo5hMSaRsru16U4mgRaPiMZRkgw5nTY6bZdDd8K4
+	NextKeyCommitment string `protobuf:"bytes,7,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
 }
 
 func (x *Metadata) Reset() {
 	*x = Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[5]
+		mi := &file_cheqd_did_v2_diddoc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5445,7 +6887,7 @@ func (*Metadata) ProtoMessage() {}
 
 // Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{5}
+	return file_cheqd_did_v2_diddoc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Metadata) GetCreated() *timestamppb.Timestamp {
@@ -5490,6 +6932,13 @@ func (x *Metadata) GetPreviousVersionId() string {
 	return ""
 }
 
+func (x *Metadata) GetNextKeyCommitment() string {
+	if x != nil {
+		return x.NextKeyCommitment
+	}
+	return ""
+}
+
 var File_cheqd_did_v2_diddoc_proto protoreflect.FileDescriptor
 
 var file_cheqd_did_v2_diddoc_proto_rawDesc = []byte{
@@ -5499,7 +6948,7 @@ var file_cheqd_did_v2_diddoc_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xa4, 0x05, 0x0a, 0x06, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x22, 0xd7, 0x05, 0x0a, 0x06, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x63,
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
 	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
@@ -5541,88 +6990,103 @@ var file_cheqd_did_v2_diddoc_proto_rawDesc = []byte{
 	0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77,
 	0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4c,
-	0x0a, 0x18, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x34,
-	0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x74,
-	0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73,
-	0x22, 0xa1, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x64,
-	0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x42, 0x0f,
-	0xea, 0xde, 0x1f, 0x0b, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x06, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x3e, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x01, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x34, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x01, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0xa8, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x44, 0x69, 0x64,
-	0x64, 0x6f, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76,
-	0x32, 0x3b, 0x64, 0x69, 0x64, 0x76,
-	0x32, 0xa2, 0x02, 0x03, 0x43, 0x44, 0x58, 0xaa, 0x02, 0x0c,
-	0x43, 0x68, 0x65, 0x71, 0x64, 0x2e,
-	0x44, 0x69, 0x64, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x43,
-	0x68, 0x65, 0x71, 0x64, 0x5c, 0x44,
-	0x69, 0x64, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x18, 0x43, 0x68,
-	0x65, 0x71, 0x64, 0x5c, 0x44, 0x69,
-	0x64, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0e, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a,
-	0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56,
-	0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x4c, 0x0a, 0x18, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x33, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde,
+	0x1f, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x6e,
+	0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x39,
+	0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x3e, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x64, 0x69, 0x64,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63,
+	0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13,
+	0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x02,
+	0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x13, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65,
+	0x78, 0x74, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0xab, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x44, 0x69, 0x64, 0x64, 0x6f, 0x63, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x32, 0xa2, 0x02, 0x03,
+	0x43, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x44, 0x69, 0x64, 0x2e,
+	0x56, 0x32, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56,
+	0x32, 0xe2, 0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43,
+	0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5637,15 +7101,17 @@ func file_cheqd_did_v2_diddoc_proto_rawDescGZIP() []byte {
 	return file_cheqd_did_v2_diddoc_proto_rawDescData
 }
 
-var file_cheqd_did_v2_diddoc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cheqd_did_v2_diddoc_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_cheqd_did_v2_diddoc_proto_goTypes = []interface{}{
 	(*DidDoc)(nil),                   // 0: cheqd.did.v2.DidDoc
 	(*VerificationMethod)(nil),       // 1: cheqd.did.v2.VerificationMethod
 	(*VerificationRelationship)(nil), // 2: cheqd.did.v2.VerificationRelationship
 	(*Service)(nil),                  // 3: cheqd.did.v2.Service
-	(*DidDocWithMetadata)(nil),       // 4: cheqd.did.v2.DidDocWithMetadata
-	(*Metadata)(nil),                 // 5: cheqd.did.v2.Metadata
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*ServiceEndpoint)(nil),          // 4: cheqd.did.v2.ServiceEndpoint
+	(*ServiceEndpointItem)(nil),      // 5: cheqd.did.v2.ServiceEndpointItem
+	(*DidDocWithMetadata)(nil),       // 6: cheqd.did.v2.DidDocWithMetadata
+	(*Metadata)(nil),                 // 7: cheqd.did.v2.Metadata
+	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
 }
 var file_cheqd_did_v2_diddoc_proto_depIdxs = []int32{
 	1,  // 0: cheqd.did.v2.DidDoc.verification_method:type_name -> cheqd.did.v2.VerificationMethod
@@ -5656,15 +7122,17 @@ var file_cheqd_did_v2_diddoc_proto_depIdxs = []int32{
 	2,  // 5: cheqd.did.v2.DidDoc.key_agreement:type_name -> cheqd.did.v2.VerificationRelationship
 	3,  // 6: cheqd.did.v2.DidDoc.service:type_name -> cheqd.did.v2.Service
 	1,  // 7: cheqd.did.v2.VerificationRelationship.verification_method:type_name -> cheqd.did.v2.VerificationMethod
-	0,  // 8: cheqd.did.v2.DidDocWithMetadata.did_doc:type_name -> cheqd.did.v2.DidDoc
-	5,  // 9: cheqd.did.v2.DidDocWithMetadata.metadata:type_name -> cheqd.did.v2.Metadata
-	6,  // 10: cheqd.did.v2.Metadata.created:type_name -> google.protobuf.Timestamp
-	6,  // 11: cheqd.did.v2.Metadata.updated:type_name -> google.protobuf.Timestamp
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	4,  // 8: cheqd.did.v2.Service.endpoint:type_name -> cheqd.did.v2.ServiceEndpoint
+	5,  // 9: cheqd.did.v2.ServiceEndpoint.items:type_name -> cheqd.did.v2.ServiceEndpointItem
+	0,  // 10: cheqd.did.v2.DidDocWithMetadata.did_doc:type_name -> cheqd.did.v2.DidDoc
+	7,  // 11: cheqd.did.v2.DidDocWithMetadata.metadata:type_name -> cheqd.did.v2.Metadata
+	8,  // 12: cheqd.did.v2.Metadata.created:type_name -> google.protobuf.Timestamp
+	8,  // 13: cheqd.did.v2.Metadata.updated:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_did_v2_diddoc_proto_init() }
@@ -5722,7 +7190,7 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_diddoc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DidDocWithMetadata); i {
+			switch v := v.(*ServiceEndpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5734,6 +7202,30 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_diddoc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceEndpointItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_diddoc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DidDocWithMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_diddoc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
@@ -5752,7 +7244,7 @@ func file_cheqd_did_v2_diddoc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_did_v2_diddoc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,3703 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package didv2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_EventDidDocCreated_3_list)(nil)
+
+type _EventDidDocCreated_3_list struct {
+	list *[]string
+}
+
+func (x *_EventDidDocCreated_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventDidDocCreated_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventDidDocCreated_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventDidDocCreated_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventDidDocCreated_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventDidDocCreated at list field Signers as it is not of Message kind"))
+}
+
+func (x *_EventDidDocCreated_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventDidDocCreated_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventDidDocCreated_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventDidDocCreated            protoreflect.MessageDescriptor
+	fd_EventDidDocCreated_id         protoreflect.FieldDescriptor
+	fd_EventDidDocCreated_version_id protoreflect.FieldDescriptor
+	fd_EventDidDocCreated_signers    protoreflect.FieldDescriptor
+	fd_EventDidDocCreated_diff       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_events_proto_init()
+	md_EventDidDocCreated = File_cheqd_did_v2_events_proto.Messages().ByName("EventDidDocCreated")
+	fd_EventDidDocCreated_id = md_EventDidDocCreated.Fields().ByName("id")
+	fd_EventDidDocCreated_version_id = md_EventDidDocCreated.Fields().ByName("version_id")
+	fd_EventDidDocCreated_signers = md_EventDidDocCreated.Fields().ByName("signers")
+	fd_EventDidDocCreated_diff = md_EventDidDocCreated.Fields().ByName("diff")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDidDocCreated)(nil)
+
+type fastReflection_EventDidDocCreated EventDidDocCreated
+
+func (x *EventDidDocCreated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDidDocCreated)(x)
+}
+
+func (x *EventDidDocCreated) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDidDocCreated_messageType fastReflection_EventDidDocCreated_messageType
+var _ protoreflect.MessageType = fastReflection_EventDidDocCreated_messageType{}
+
+type fastReflection_EventDidDocCreated_messageType struct{}
+
+func (x fastReflection_EventDidDocCreated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDidDocCreated)(nil)
+}
+func (x fastReflection_EventDidDocCreated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDidDocCreated)
+}
+func (x fastReflection_EventDidDocCreated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDidDocCreated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDidDocCreated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDidDocCreated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDidDocCreated) Type() protoreflect.MessageType {
+	return _fastReflection_EventDidDocCreated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDidDocCreated) New() protoreflect.Message {
+	return new(fastReflection_EventDidDocCreated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDidDocCreated) Interface() protoreflect.ProtoMessage {
+	return (*EventDidDocCreated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDidDocCreated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_EventDidDocCreated_id, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_EventDidDocCreated_version_id, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_EventDidDocCreated_3_list{list: &x.Signers})
+		if !f(fd_EventDidDocCreated_signers, value) {
+			return
+		}
+	}
+	if x.Diff != nil {
+		value := protoreflect.ValueOfMessage(x.Diff.ProtoReflect())
+		if !f(fd_EventDidDocCreated_diff, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDidDocCreated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocCreated.id":
+		return x.Id != ""
+	case "cheqd.did.v2.EventDidDocCreated.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.EventDidDocCreated.signers":
+		return len(x.Signers) != 0
+	case "cheqd.did.v2.EventDidDocCreated.diff":
+		return x.Diff != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocCreated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocCreated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocCreated.id":
+		x.Id = ""
+	case "cheqd.did.v2.EventDidDocCreated.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.EventDidDocCreated.signers":
+		x.Signers = nil
+	case "cheqd.did.v2.EventDidDocCreated.diff":
+		x.Diff = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocCreated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDidDocCreated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.EventDidDocCreated.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocCreated.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocCreated.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_EventDidDocCreated_3_list{})
+		}
+		listValue := &_EventDidDocCreated_3_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.EventDidDocCreated.diff":
+		value := x.Diff
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocCreated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocCreated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocCreated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocCreated.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocCreated.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocCreated.signers":
+		lv := value.List()
+		clv := lv.(*_EventDidDocCreated_3_list)
+		x.Signers = *clv.list
+	case "cheqd.did.v2.EventDidDocCreated.diff":
+		x.Diff = value.Message().Interface().(*DidDocDiff)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocCreated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocCreated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocCreated.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_EventDidDocCreated_3_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.EventDidDocCreated.diff":
+		if x.Diff == nil {
+			x.Diff = new(DidDocDiff)
+		}
+		return protoreflect.ValueOfMessage(x.Diff.ProtoReflect())
+	case "cheqd.did.v2.EventDidDocCreated.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.EventDidDocCreated is not mutable"))
+	case "cheqd.did.v2.EventDidDocCreated.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.EventDidDocCreated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocCreated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDidDocCreated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocCreated.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocCreated.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocCreated.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventDidDocCreated_3_list{list: &list})
+	case "cheqd.did.v2.EventDidDocCreated.diff":
+		m := new(DidDocDiff)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocCreated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDidDocCreated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.EventDidDocCreated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDidDocCreated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocCreated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDidDocCreated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDidDocCreated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDidDocCreated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Diff != nil {
+			l = options.Size(x.Diff)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDidDocCreated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Diff != nil {
+			encoded, err := options.Marshal(x.Diff)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDidDocCreated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDidDocCreated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDidDocCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Diff == nil {
+					x.Diff = &DidDocDiff{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Diff); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventDidDocUpdated_4_list)(nil)
+
+type _EventDidDocUpdated_4_list struct {
+	list *[]string
+}
+
+func (x *_EventDidDocUpdated_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventDidDocUpdated_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventDidDocUpdated_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventDidDocUpdated_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventDidDocUpdated_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventDidDocUpdated at list field Signers as it is not of Message kind"))
+}
+
+func (x *_EventDidDocUpdated_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventDidDocUpdated_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventDidDocUpdated_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventDidDocUpdated                     protoreflect.MessageDescriptor
+	fd_EventDidDocUpdated_id                  protoreflect.FieldDescriptor
+	fd_EventDidDocUpdated_version_id          protoreflect.FieldDescriptor
+	fd_EventDidDocUpdated_previous_version_id protoreflect.FieldDescriptor
+	fd_EventDidDocUpdated_signers             protoreflect.FieldDescriptor
+	fd_EventDidDocUpdated_diff                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_events_proto_init()
+	md_EventDidDocUpdated = File_cheqd_did_v2_events_proto.Messages().ByName("EventDidDocUpdated")
+	fd_EventDidDocUpdated_id = md_EventDidDocUpdated.Fields().ByName("id")
+	fd_EventDidDocUpdated_version_id = md_EventDidDocUpdated.Fields().ByName("version_id")
+	fd_EventDidDocUpdated_previous_version_id = md_EventDidDocUpdated.Fields().ByName("previous_version_id")
+	fd_EventDidDocUpdated_signers = md_EventDidDocUpdated.Fields().ByName("signers")
+	fd_EventDidDocUpdated_diff = md_EventDidDocUpdated.Fields().ByName("diff")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDidDocUpdated)(nil)
+
+type fastReflection_EventDidDocUpdated EventDidDocUpdated
+
+func (x *EventDidDocUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDidDocUpdated)(x)
+}
+
+func (x *EventDidDocUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDidDocUpdated_messageType fastReflection_EventDidDocUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventDidDocUpdated_messageType{}
+
+type fastReflection_EventDidDocUpdated_messageType struct{}
+
+func (x fastReflection_EventDidDocUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDidDocUpdated)(nil)
+}
+func (x fastReflection_EventDidDocUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDidDocUpdated)
+}
+func (x fastReflection_EventDidDocUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDidDocUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDidDocUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDidDocUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDidDocUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventDidDocUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDidDocUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventDidDocUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDidDocUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventDidDocUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDidDocUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_EventDidDocUpdated_id, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_EventDidDocUpdated_version_id, value) {
+			return
+		}
+	}
+	if x.PreviousVersionId != "" {
+		value := protoreflect.ValueOfString(x.PreviousVersionId)
+		if !f(fd_EventDidDocUpdated_previous_version_id, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_EventDidDocUpdated_4_list{list: &x.Signers})
+		if !f(fd_EventDidDocUpdated_signers, value) {
+			return
+		}
+	}
+	if x.Diff != nil {
+		value := protoreflect.ValueOfMessage(x.Diff.ProtoReflect())
+		if !f(fd_EventDidDocUpdated_diff, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDidDocUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocUpdated.id":
+		return x.Id != ""
+	case "cheqd.did.v2.EventDidDocUpdated.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.EventDidDocUpdated.previous_version_id":
+		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.EventDidDocUpdated.signers":
+		return len(x.Signers) != 0
+	case "cheqd.did.v2.EventDidDocUpdated.diff":
+		return x.Diff != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocUpdated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocUpdated.id":
+		x.Id = ""
+	case "cheqd.did.v2.EventDidDocUpdated.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.EventDidDocUpdated.previous_version_id":
+		x.PreviousVersionId = ""
+	case "cheqd.did.v2.EventDidDocUpdated.signers":
+		x.Signers = nil
+	case "cheqd.did.v2.EventDidDocUpdated.diff":
+		x.Diff = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocUpdated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDidDocUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.EventDidDocUpdated.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocUpdated.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocUpdated.previous_version_id":
+		value := x.PreviousVersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocUpdated.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_EventDidDocUpdated_4_list{})
+		}
+		listValue := &_EventDidDocUpdated_4_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.EventDidDocUpdated.diff":
+		value := x.Diff
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocUpdated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocUpdated.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocUpdated.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocUpdated.previous_version_id":
+		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocUpdated.signers":
+		lv := value.List()
+		clv := lv.(*_EventDidDocUpdated_4_list)
+		x.Signers = *clv.list
+	case "cheqd.did.v2.EventDidDocUpdated.diff":
+		x.Diff = value.Message().Interface().(*DidDocDiff)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocUpdated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocUpdated.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_EventDidDocUpdated_4_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.EventDidDocUpdated.diff":
+		if x.Diff == nil {
+			x.Diff = new(DidDocDiff)
+		}
+		return protoreflect.ValueOfMessage(x.Diff.ProtoReflect())
+	case "cheqd.did.v2.EventDidDocUpdated.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.EventDidDocUpdated is not mutable"))
+	case "cheqd.did.v2.EventDidDocUpdated.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.EventDidDocUpdated is not mutable"))
+	case "cheqd.did.v2.EventDidDocUpdated.previous_version_id":
+		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.EventDidDocUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocUpdated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDidDocUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocUpdated.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocUpdated.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocUpdated.previous_version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocUpdated.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventDidDocUpdated_4_list{list: &list})
+	case "cheqd.did.v2.EventDidDocUpdated.diff":
+		m := new(DidDocDiff)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocUpdated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDidDocUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.EventDidDocUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDidDocUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDidDocUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDidDocUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDidDocUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Diff != nil {
+			l = options.Size(x.Diff)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDidDocUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Diff != nil {
+			encoded, err := options.Marshal(x.Diff)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PreviousVersionId) > 0 {
+			i -= len(x.PreviousVersionId)
+			copy(dAtA[i:], x.PreviousVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDidDocUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDidDocUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDidDocUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Diff == nil {
+					x.Diff = &DidDocDiff{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Diff); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventDidDocDeactivated_4_list)(nil)
+
+type _EventDidDocDeactivated_4_list struct {
+	list *[]string
+}
+
+func (x *_EventDidDocDeactivated_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventDidDocDeactivated_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventDidDocDeactivated_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventDidDocDeactivated_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventDidDocDeactivated_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventDidDocDeactivated at list field Signers as it is not of Message kind"))
+}
+
+func (x *_EventDidDocDeactivated_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventDidDocDeactivated_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventDidDocDeactivated_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventDidDocDeactivated                     protoreflect.MessageDescriptor
+	fd_EventDidDocDeactivated_id                  protoreflect.FieldDescriptor
+	fd_EventDidDocDeactivated_version_id          protoreflect.FieldDescriptor
+	fd_EventDidDocDeactivated_previous_version_id protoreflect.FieldDescriptor
+	fd_EventDidDocDeactivated_signers             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_events_proto_init()
+	md_EventDidDocDeactivated = File_cheqd_did_v2_events_proto.Messages().ByName("EventDidDocDeactivated")
+	fd_EventDidDocDeactivated_id = md_EventDidDocDeactivated.Fields().ByName("id")
+	fd_EventDidDocDeactivated_version_id = md_EventDidDocDeactivated.Fields().ByName("version_id")
+	fd_EventDidDocDeactivated_previous_version_id = md_EventDidDocDeactivated.Fields().ByName("previous_version_id")
+	fd_EventDidDocDeactivated_signers = md_EventDidDocDeactivated.Fields().ByName("signers")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDidDocDeactivated)(nil)
+
+type fastReflection_EventDidDocDeactivated EventDidDocDeactivated
+
+func (x *EventDidDocDeactivated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDidDocDeactivated)(x)
+}
+
+func (x *EventDidDocDeactivated) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDidDocDeactivated_messageType fastReflection_EventDidDocDeactivated_messageType
+var _ protoreflect.MessageType = fastReflection_EventDidDocDeactivated_messageType{}
+
+type fastReflection_EventDidDocDeactivated_messageType struct{}
+
+func (x fastReflection_EventDidDocDeactivated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDidDocDeactivated)(nil)
+}
+func (x fastReflection_EventDidDocDeactivated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDidDocDeactivated)
+}
+func (x fastReflection_EventDidDocDeactivated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDidDocDeactivated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDidDocDeactivated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDidDocDeactivated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDidDocDeactivated) Type() protoreflect.MessageType {
+	return _fastReflection_EventDidDocDeactivated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDidDocDeactivated) New() protoreflect.Message {
+	return new(fastReflection_EventDidDocDeactivated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDidDocDeactivated) Interface() protoreflect.ProtoMessage {
+	return (*EventDidDocDeactivated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDidDocDeactivated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_EventDidDocDeactivated_id, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_EventDidDocDeactivated_version_id, value) {
+			return
+		}
+	}
+	if x.PreviousVersionId != "" {
+		value := protoreflect.ValueOfString(x.PreviousVersionId)
+		if !f(fd_EventDidDocDeactivated_previous_version_id, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_EventDidDocDeactivated_4_list{list: &x.Signers})
+		if !f(fd_EventDidDocDeactivated_signers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDidDocDeactivated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocDeactivated.id":
+		return x.Id != ""
+	case "cheqd.did.v2.EventDidDocDeactivated.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.EventDidDocDeactivated.previous_version_id":
+		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.EventDidDocDeactivated.signers":
+		return len(x.Signers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocDeactivated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocDeactivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocDeactivated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocDeactivated.id":
+		x.Id = ""
+	case "cheqd.did.v2.EventDidDocDeactivated.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.EventDidDocDeactivated.previous_version_id":
+		x.PreviousVersionId = ""
+	case "cheqd.did.v2.EventDidDocDeactivated.signers":
+		x.Signers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocDeactivated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocDeactivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDidDocDeactivated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.EventDidDocDeactivated.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocDeactivated.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocDeactivated.previous_version_id":
+		value := x.PreviousVersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.EventDidDocDeactivated.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_EventDidDocDeactivated_4_list{})
+		}
+		listValue := &_EventDidDocDeactivated_4_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocDeactivated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocDeactivated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocDeactivated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocDeactivated.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocDeactivated.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocDeactivated.previous_version_id":
+		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.EventDidDocDeactivated.signers":
+		lv := value.List()
+		clv := lv.(*_EventDidDocDeactivated_4_list)
+		x.Signers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocDeactivated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocDeactivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocDeactivated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocDeactivated.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_EventDidDocDeactivated_4_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.EventDidDocDeactivated.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.EventDidDocDeactivated is not mutable"))
+	case "cheqd.did.v2.EventDidDocDeactivated.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.EventDidDocDeactivated is not mutable"))
+	case "cheqd.did.v2.EventDidDocDeactivated.previous_version_id":
+		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.EventDidDocDeactivated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocDeactivated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocDeactivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDidDocDeactivated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.EventDidDocDeactivated.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocDeactivated.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocDeactivated.previous_version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.EventDidDocDeactivated.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventDidDocDeactivated_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.EventDidDocDeactivated"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.EventDidDocDeactivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDidDocDeactivated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.EventDidDocDeactivated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDidDocDeactivated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDidDocDeactivated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDidDocDeactivated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDidDocDeactivated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDidDocDeactivated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDidDocDeactivated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PreviousVersionId) > 0 {
+			i -= len(x.PreviousVersionId)
+			copy(dAtA[i:], x.PreviousVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDidDocDeactivated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDidDocDeactivated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDidDocDeactivated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DidDocDiff_1_list)(nil)
+
+type _DidDocDiff_1_list struct {
+	list *[]string
+}
+
+func (x *_DidDocDiff_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocDiff_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DidDocDiff_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocDiff_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocDiff_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DidDocDiff at list field AddedVerificationMethods as it is not of Message kind"))
+}
+
+func (x *_DidDocDiff_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocDiff_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DidDocDiff_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DidDocDiff_2_list)(nil)
+
+type _DidDocDiff_2_list struct {
+	list *[]string
+}
+
+func (x *_DidDocDiff_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocDiff_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DidDocDiff_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocDiff_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocDiff_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DidDocDiff at list field RemovedVerificationMethods as it is not of Message kind"))
+}
+
+func (x *_DidDocDiff_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocDiff_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DidDocDiff_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DidDocDiff_3_list)(nil)
+
+type _DidDocDiff_3_list struct {
+	list *[]string
+}
+
+func (x *_DidDocDiff_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocDiff_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DidDocDiff_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocDiff_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocDiff_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DidDocDiff at list field ChangedVerificationMethods as it is not of Message kind"))
+}
+
+func (x *_DidDocDiff_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocDiff_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DidDocDiff_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DidDocDiff_4_list)(nil)
+
+type _DidDocDiff_4_list struct {
+	list *[]string
+}
+
+func (x *_DidDocDiff_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocDiff_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DidDocDiff_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocDiff_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocDiff_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DidDocDiff at list field AddedServices as it is not of Message kind"))
+}
+
+func (x *_DidDocDiff_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocDiff_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DidDocDiff_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DidDocDiff_5_list)(nil)
+
+type _DidDocDiff_5_list struct {
+	list *[]string
+}
+
+func (x *_DidDocDiff_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocDiff_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DidDocDiff_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocDiff_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocDiff_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DidDocDiff at list field RemovedServices as it is not of Message kind"))
+}
+
+func (x *_DidDocDiff_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocDiff_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DidDocDiff_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DidDocDiff_6_list)(nil)
+
+type _DidDocDiff_6_list struct {
+	list *[]string
+}
+
+func (x *_DidDocDiff_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DidDocDiff_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DidDocDiff_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DidDocDiff_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DidDocDiff_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DidDocDiff at list field ChangedServices as it is not of Message kind"))
+}
+
+func (x *_DidDocDiff_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DidDocDiff_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DidDocDiff_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DidDocDiff                              protoreflect.MessageDescriptor
+	fd_DidDocDiff_added_verification_methods   protoreflect.FieldDescriptor
+	fd_DidDocDiff_removed_verification_methods protoreflect.FieldDescriptor
+	fd_DidDocDiff_changed_verification_methods protoreflect.FieldDescriptor
+	fd_DidDocDiff_added_services               protoreflect.FieldDescriptor
+	fd_DidDocDiff_removed_services             protoreflect.FieldDescriptor
+	fd_DidDocDiff_changed_services             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_events_proto_init()
+	md_DidDocDiff = File_cheqd_did_v2_events_proto.Messages().ByName("DidDocDiff")
+	fd_DidDocDiff_added_verification_methods = md_DidDocDiff.Fields().ByName("added_verification_methods")
+	fd_DidDocDiff_removed_verification_methods = md_DidDocDiff.Fields().ByName("removed_verification_methods")
+	fd_DidDocDiff_changed_verification_methods = md_DidDocDiff.Fields().ByName("changed_verification_methods")
+	fd_DidDocDiff_added_services = md_DidDocDiff.Fields().ByName("added_services")
+	fd_DidDocDiff_removed_services = md_DidDocDiff.Fields().ByName("removed_services")
+	fd_DidDocDiff_changed_services = md_DidDocDiff.Fields().ByName("changed_services")
+}
+
+var _ protoreflect.Message = (*fastReflection_DidDocDiff)(nil)
+
+type fastReflection_DidDocDiff DidDocDiff
+
+func (x *DidDocDiff) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DidDocDiff)(x)
+}
+
+func (x *DidDocDiff) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DidDocDiff_messageType fastReflection_DidDocDiff_messageType
+var _ protoreflect.MessageType = fastReflection_DidDocDiff_messageType{}
+
+type fastReflection_DidDocDiff_messageType struct{}
+
+func (x fastReflection_DidDocDiff_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DidDocDiff)(nil)
+}
+func (x fastReflection_DidDocDiff_messageType) New() protoreflect.Message {
+	return new(fastReflection_DidDocDiff)
+}
+func (x fastReflection_DidDocDiff_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocDiff
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DidDocDiff) Descriptor() protoreflect.MessageDescriptor {
+	return md_DidDocDiff
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DidDocDiff) Type() protoreflect.MessageType {
+	return _fastReflection_DidDocDiff_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DidDocDiff) New() protoreflect.Message {
+	return new(fastReflection_DidDocDiff)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DidDocDiff) Interface() protoreflect.ProtoMessage {
+	return (*DidDocDiff)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DidDocDiff) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AddedVerificationMethods) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocDiff_1_list{list: &x.AddedVerificationMethods})
+		if !f(fd_DidDocDiff_added_verification_methods, value) {
+			return
+		}
+	}
+	if len(x.RemovedVerificationMethods) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocDiff_2_list{list: &x.RemovedVerificationMethods})
+		if !f(fd_DidDocDiff_removed_verification_methods, value) {
+			return
+		}
+	}
+	if len(x.ChangedVerificationMethods) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocDiff_3_list{list: &x.ChangedVerificationMethods})
+		if !f(fd_DidDocDiff_changed_verification_methods, value) {
+			return
+		}
+	}
+	if len(x.AddedServices) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocDiff_4_list{list: &x.AddedServices})
+		if !f(fd_DidDocDiff_added_services, value) {
+			return
+		}
+	}
+	if len(x.RemovedServices) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocDiff_5_list{list: &x.RemovedServices})
+		if !f(fd_DidDocDiff_removed_services, value) {
+			return
+		}
+	}
+	if len(x.ChangedServices) != 0 {
+		value := protoreflect.ValueOfList(&_DidDocDiff_6_list{list: &x.ChangedServices})
+		if !f(fd_DidDocDiff_changed_services, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DidDocDiff) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocDiff.added_verification_methods":
+		return len(x.AddedVerificationMethods) != 0
+	case "cheqd.did.v2.DidDocDiff.removed_verification_methods":
+		return len(x.RemovedVerificationMethods) != 0
+	case "cheqd.did.v2.DidDocDiff.changed_verification_methods":
+		return len(x.ChangedVerificationMethods) != 0
+	case "cheqd.did.v2.DidDocDiff.added_services":
+		return len(x.AddedServices) != 0
+	case "cheqd.did.v2.DidDocDiff.removed_services":
+		return len(x.RemovedServices) != 0
+	case "cheqd.did.v2.DidDocDiff.changed_services":
+		return len(x.ChangedServices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocDiff"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocDiff does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocDiff) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocDiff.added_verification_methods":
+		x.AddedVerificationMethods = nil
+	case "cheqd.did.v2.DidDocDiff.removed_verification_methods":
+		x.RemovedVerificationMethods = nil
+	case "cheqd.did.v2.DidDocDiff.changed_verification_methods":
+		x.ChangedVerificationMethods = nil
+	case "cheqd.did.v2.DidDocDiff.added_services":
+		x.AddedServices = nil
+	case "cheqd.did.v2.DidDocDiff.removed_services":
+		x.RemovedServices = nil
+	case "cheqd.did.v2.DidDocDiff.changed_services":
+		x.ChangedServices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocDiff"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocDiff does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DidDocDiff) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.DidDocDiff.added_verification_methods":
+		if len(x.AddedVerificationMethods) == 0 {
+			return protoreflect.ValueOfList(&_DidDocDiff_1_list{})
+		}
+		listValue := &_DidDocDiff_1_list{list: &x.AddedVerificationMethods}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDocDiff.removed_verification_methods":
+		if len(x.RemovedVerificationMethods) == 0 {
+			return protoreflect.ValueOfList(&_DidDocDiff_2_list{})
+		}
+		listValue := &_DidDocDiff_2_list{list: &x.RemovedVerificationMethods}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDocDiff.changed_verification_methods":
+		if len(x.ChangedVerificationMethods) == 0 {
+			return protoreflect.ValueOfList(&_DidDocDiff_3_list{})
+		}
+		listValue := &_DidDocDiff_3_list{list: &x.ChangedVerificationMethods}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDocDiff.added_services":
+		if len(x.AddedServices) == 0 {
+			return protoreflect.ValueOfList(&_DidDocDiff_4_list{})
+		}
+		listValue := &_DidDocDiff_4_list{list: &x.AddedServices}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDocDiff.removed_services":
+		if len(x.RemovedServices) == 0 {
+			return protoreflect.ValueOfList(&_DidDocDiff_5_list{})
+		}
+		listValue := &_DidDocDiff_5_list{list: &x.RemovedServices}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.DidDocDiff.changed_services":
+		if len(x.ChangedServices) == 0 {
+			return protoreflect.ValueOfList(&_DidDocDiff_6_list{})
+		}
+		listValue := &_DidDocDiff_6_list{list: &x.ChangedServices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocDiff"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocDiff does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocDiff) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocDiff.added_verification_methods":
+		lv := value.List()
+		clv := lv.(*_DidDocDiff_1_list)
+		x.AddedVerificationMethods = *clv.list
+	case "cheqd.did.v2.DidDocDiff.removed_verification_methods":
+		lv := value.List()
+		clv := lv.(*_DidDocDiff_2_list)
+		x.RemovedVerificationMethods = *clv.list
+	case "cheqd.did.v2.DidDocDiff.changed_verification_methods":
+		lv := value.List()
+		clv := lv.(*_DidDocDiff_3_list)
+		x.ChangedVerificationMethods = *clv.list
+	case "cheqd.did.v2.DidDocDiff.added_services":
+		lv := value.List()
+		clv := lv.(*_DidDocDiff_4_list)
+		x.AddedServices = *clv.list
+	case "cheqd.did.v2.DidDocDiff.removed_services":
+		lv := value.List()
+		clv := lv.(*_DidDocDiff_5_list)
+		x.RemovedServices = *clv.list
+	case "cheqd.did.v2.DidDocDiff.changed_services":
+		lv := value.List()
+		clv := lv.(*_DidDocDiff_6_list)
+		x.ChangedServices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocDiff"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocDiff does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocDiff) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocDiff.added_verification_methods":
+		if x.AddedVerificationMethods == nil {
+			x.AddedVerificationMethods = []string{}
+		}
+		value := &_DidDocDiff_1_list{list: &x.AddedVerificationMethods}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.DidDocDiff.removed_verification_methods":
+		if x.RemovedVerificationMethods == nil {
+			x.RemovedVerificationMethods = []string{}
+		}
+		value := &_DidDocDiff_2_list{list: &x.RemovedVerificationMethods}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.DidDocDiff.changed_verification_methods":
+		if x.ChangedVerificationMethods == nil {
+			x.ChangedVerificationMethods = []string{}
+		}
+		value := &_DidDocDiff_3_list{list: &x.ChangedVerificationMethods}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.DidDocDiff.added_services":
+		if x.AddedServices == nil {
+			x.AddedServices = []string{}
+		}
+		value := &_DidDocDiff_4_list{list: &x.AddedServices}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.DidDocDiff.removed_services":
+		if x.RemovedServices == nil {
+			x.RemovedServices = []string{}
+		}
+		value := &_DidDocDiff_5_list{list: &x.RemovedServices}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.DidDocDiff.changed_services":
+		if x.ChangedServices == nil {
+			x.ChangedServices = []string{}
+		}
+		value := &_DidDocDiff_6_list{list: &x.ChangedServices}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocDiff"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocDiff does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DidDocDiff) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.DidDocDiff.added_verification_methods":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DidDocDiff_1_list{list: &list})
+	case "cheqd.did.v2.DidDocDiff.removed_verification_methods":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DidDocDiff_2_list{list: &list})
+	case "cheqd.did.v2.DidDocDiff.changed_verification_methods":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DidDocDiff_3_list{list: &list})
+	case "cheqd.did.v2.DidDocDiff.added_services":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DidDocDiff_4_list{list: &list})
+	case "cheqd.did.v2.DidDocDiff.removed_services":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DidDocDiff_5_list{list: &list})
+	case "cheqd.did.v2.DidDocDiff.changed_services":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DidDocDiff_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.DidDocDiff"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.DidDocDiff does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DidDocDiff) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.DidDocDiff", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DidDocDiff) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DidDocDiff) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DidDocDiff) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DidDocDiff) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DidDocDiff)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AddedVerificationMethods) > 0 {
+			for _, s := range x.AddedVerificationMethods {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemovedVerificationMethods) > 0 {
+			for _, s := range x.RemovedVerificationMethods {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChangedVerificationMethods) > 0 {
+			for _, s := range x.ChangedVerificationMethods {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AddedServices) > 0 {
+			for _, s := range x.AddedServices {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemovedServices) > 0 {
+			for _, s := range x.RemovedServices {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChangedServices) > 0 {
+			for _, s := range x.ChangedServices {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DidDocDiff)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChangedServices) > 0 {
+			for iNdEx := len(x.ChangedServices) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ChangedServices[iNdEx])
+				copy(dAtA[i:], x.ChangedServices[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChangedServices[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.RemovedServices) > 0 {
+			for iNdEx := len(x.RemovedServices) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedServices[iNdEx])
+				copy(dAtA[i:], x.RemovedServices[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedServices[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AddedServices) > 0 {
+			for iNdEx := len(x.AddedServices) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AddedServices[iNdEx])
+				copy(dAtA[i:], x.AddedServices[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddedServices[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ChangedVerificationMethods) > 0 {
+			for iNdEx := len(x.ChangedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ChangedVerificationMethods[iNdEx])
+				copy(dAtA[i:], x.ChangedVerificationMethods[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChangedVerificationMethods[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.RemovedVerificationMethods) > 0 {
+			for iNdEx := len(x.RemovedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedVerificationMethods[iNdEx])
+				copy(dAtA[i:], x.RemovedVerificationMethods[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedVerificationMethods[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.AddedVerificationMethods) > 0 {
+			for iNdEx := len(x.AddedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AddedVerificationMethods[iNdEx])
+				copy(dAtA[i:], x.AddedVerificationMethods[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddedVerificationMethods[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DidDocDiff)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocDiff: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DidDocDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedVerificationMethods", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddedVerificationMethods = append(x.AddedVerificationMethods, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedVerificationMethods", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedVerificationMethods = append(x.RemovedVerificationMethods, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangedVerificationMethods", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChangedVerificationMethods = append(x.ChangedVerificationMethods, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedServices", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddedServices = append(x.AddedServices, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedServices", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedServices = append(x.RemovedServices, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangedServices", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChangedServices = append(x.ChangedServices, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cheqd/did/v2/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventDidDocCreated is emitted when a new DID Document is created
+type EventDidDocCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID of the created DID Document.
+	// Format: did:canow:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version_id is the version identifier of the first version of the DID Document.
+	// Format: UUID
+	VersionId string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// signers is the list of DIDs whose signatures were required to create the DID Document
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// diff lists verification methods and services of the created DID Document as added
+	Diff *DidDocDiff `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *EventDidDocCreated) Reset() {
+	*x = EventDidDocCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDidDocCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDidDocCreated) ProtoMessage() {}
+
+// Deprecated: Use EventDidDocCreated.ProtoReflect.Descriptor instead.
+func (*EventDidDocCreated) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventDidDocCreated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventDidDocCreated) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *EventDidDocCreated) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *EventDidDocCreated) GetDiff() *DidDocDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// EventDidDocUpdated is emitted when a DID Document is updated
+type EventDidDocUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID of the updated DID Document.
+	// Format: did:canow:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version_id is the version identifier of the new version of the DID Document.
+	// Format: UUID
+	VersionId string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// previous_version_id is the version identifier of the replaced version of the DID Document.
+	// Format: UUID
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// signers is the list of DIDs whose signatures were required to update the DID Document
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	// diff summarizes changes of verification methods and services made by the update
+	Diff *DidDocDiff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *EventDidDocUpdated) Reset() {
+	*x = EventDidDocUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDidDocUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDidDocUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventDidDocUpdated.ProtoReflect.Descriptor instead.
+func (*EventDidDocUpdated) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventDidDocUpdated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventDidDocUpdated) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *EventDidDocUpdated) GetPreviousVersionId() string {
+	if x != nil {
+		return x.PreviousVersionId
+	}
+	return ""
+}
+
+func (x *EventDidDocUpdated) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *EventDidDocUpdated) GetDiff() *DidDocDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// EventDidDocDeactivated is emitted when a DID Document is deactivated
+type EventDidDocDeactivated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID of the deactivated DID Document.
+	// Format: did:canow:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version_id is the version identifier of the deactivated version of the DID Document.
+	// Format: UUID
+	VersionId string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// previous_version_id is the version identifier of the last active version of the DID Document.
+	// Format: UUID
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// signers is the list of DIDs whose signatures were required to deactivate the DID Document
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (x *EventDidDocDeactivated) Reset() {
+	*x = EventDidDocDeactivated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDidDocDeactivated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDidDocDeactivated) ProtoMessage() {}
+
+// Deprecated: Use EventDidDocDeactivated.ProtoReflect.Descriptor instead.
+func (*EventDidDocDeactivated) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventDidDocDeactivated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventDidDocDeactivated) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *EventDidDocDeactivated) GetPreviousVersionId() string {
+	if x != nil {
+		return x.PreviousVersionId
+	}
+	return ""
+}
+
+func (x *EventDidDocDeactivated) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+// DidDocDiff summarizes changes between two versions of a DID Document.
+// Verification methods embedded into verification relationships are considered.
+// Items are identified by their ids.
+type DidDocDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// added_verification_methods is the list of ids of verification methods that were added
+	AddedVerificationMethods []string `protobuf:"bytes,1,rep,name=added_verification_methods,json=addedVerificationMethods,proto3" json:"added_verification_methods,omitempty"`
+	// removed_verification_methods is the list of ids of verification methods that were removed
+	RemovedVerificationMethods []string `protobuf:"bytes,2,rep,name=removed_verification_methods,json=removedVerificationMethods,proto3" json:"removed_verification_methods,omitempty"`
+	// changed_verification_methods is the list of ids of verification methods that were modified
+	ChangedVerificationMethods []string `protobuf:"bytes,3,rep,name=changed_verification_methods,json=changedVerificationMethods,proto3" json:"changed_verification_methods,omitempty"`
+	// added_services is the list of ids of services that were added
+	AddedServices []string `protobuf:"bytes,4,rep,name=added_services,json=addedServices,proto3" json:"added_services,omitempty"`
+	// removed_services is the list of ids of services that were removed
+	RemovedServices []string `protobuf:"bytes,5,rep,name=removed_services,json=removedServices,proto3" json:"removed_services,omitempty"`
+	// changed_services is the list of ids of services that were modified
+	ChangedServices []string `protobuf:"bytes,6,rep,name=changed_services,json=changedServices,proto3" json:"changed_services,omitempty"`
+}
+
+func (x *DidDocDiff) Reset() {
+	*x = DidDocDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DidDocDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DidDocDiff) ProtoMessage() {}
+
+// Deprecated: Use DidDocDiff.ProtoReflect.Descriptor instead.
+func (*DidDocDiff) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DidDocDiff) GetAddedVerificationMethods() []string {
+	if x != nil {
+		return x.AddedVerificationMethods
+	}
+	return nil
+}
+
+func (x *DidDocDiff) GetRemovedVerificationMethods() []string {
+	if x != nil {
+		return x.RemovedVerificationMethods
+	}
+	return nil
+}
+
+func (x *DidDocDiff) GetChangedVerificationMethods() []string {
+	if x != nil {
+		return x.ChangedVerificationMethods
+	}
+	return nil
+}
+
+func (x *DidDocDiff) GetAddedServices() []string {
+	if x != nil {
+		return x.AddedServices
+	}
+	return nil
+}
+
+func (x *DidDocDiff) GetRemovedServices() []string {
+	if x != nil {
+		return x.RemovedServices
+	}
+	return nil
+}
+
+func (x *DidDocDiff) GetChangedServices() []string {
+	if x != nil {
+		return x.ChangedServices
+	}
+	return nil
+}
+
+var File_cheqd_did_v2_events_proto protoreflect.FileDescriptor
+
+var file_cheqd_did_v2_events_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63, 0x68, 0x65,
+	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0xbb, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44,
+	0x69, 0x64, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xcb, 0x02, 0x0a, 0x0a, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x44, 0x69, 0x66, 0x66, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1a, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0xab, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f,
+	0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b,
+	0x64, 0x69, 0x64, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68,
+	0x65, 0x71, 0x64, 0x2e, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x65,
+	0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x18, 0x43, 0x68, 0x65, 0x71,
+	0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69,
+	0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cheqd_did_v2_events_proto_rawDescOnce sync.Once
+	file_cheqd_did_v2_events_proto_rawDescData = file_cheqd_did_v2_events_proto_rawDesc
+)
+
+func file_cheqd_did_v2_events_proto_rawDescGZIP() []byte {
+	file_cheqd_did_v2_events_proto_rawDescOnce.Do(func() {
+		file_cheqd_did_v2_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_cheqd_did_v2_events_proto_rawDescData)
+	})
+	return file_cheqd_did_v2_events_proto_rawDescData
+}
+
+var file_cheqd_did_v2_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cheqd_did_v2_events_proto_goTypes = []interface{}{
+	(*EventDidDocCreated)(nil),     // 0: cheqd.did.v2.EventDidDocCreated
+	(*EventDidDocUpdated)(nil),     // 1: cheqd.did.v2.EventDidDocUpdated
+	(*EventDidDocDeactivated)(nil), // 2: cheqd.did.v2.EventDidDocDeactivated
+	(*DidDocDiff)(nil),             // 3: cheqd.did.v2.DidDocDiff
+}
+var file_cheqd_did_v2_events_proto_depIdxs = []int32{
+	3, // 0: cheqd.did.v2.EventDidDocCreated.diff:type_name -> cheqd.did.v2.DidDocDiff
+	3, // 1: cheqd.did.v2.EventDidDocUpdated.diff:type_name -> cheqd.did.v2.DidDocDiff
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cheqd_did_v2_events_proto_init() }
+func file_cheqd_did_v2_events_proto_init() {
+	if File_cheqd_did_v2_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cheqd_did_v2_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDidDocCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDidDocUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDidDocDeactivated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DidDocDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cheqd_did_v2_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cheqd_did_v2_events_proto_goTypes,
+		DependencyIndexes: file_cheqd_did_v2_events_proto_depIdxs,
+		MessageInfos:      file_cheqd_did_v2_events_proto_msgTypes,
+	}.Build()
+	File_cheqd_did_v2_events_proto = out.File
+	file_cheqd_did_v2_events_proto_rawDesc = nil
+	file_cheqd_did_v2_events_proto_goTypes = nil
+	file_cheqd_did_v2_events_proto_depIdxs = nil
+}
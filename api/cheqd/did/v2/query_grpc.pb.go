@@ -22,6 +22,11 @@ const (
 	Query_DidDoc_FullMethodName                    = "/cheqd.did.v2.Query/DidDoc"
 	Query_DidDocVersion_FullMethodName             = "/cheqd.did.v2.Query/DidDocVersion"
 	Query_AllDidDocVersionsMetadata_FullMethodName = "/cheqd.did.v2.Query/AllDidDocVersionsMetadata"
+	Query_Resolve_FullMethodName                   = "/cheqd.did.v2.Query/Resolve"
+	Query_DidDocAtTime_FullMethodName              = "/cheqd.did.v2.Query/DidDocAtTime"
+	Query_DidDocsByController_FullMethodName       = "/cheqd.did.v2.Query/DidDocsByController"
+	Query_DidDocsByPublicKey_FullMethodName        = "/cheqd.did.v2.Query/DidDocsByPublicKey"
+	Query_AllDidDocs_FullMethodName                = "/cheqd.did.v2.Query/AllDidDocs"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +39,16 @@ type QueryClient interface {
 	DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error)
 	// Fetch list of all versions of DID Documents for a given DID
 	AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Resolve a DID according to the W3C DID Resolution specification
+	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
+	// Fetch the version of a DID Document that was active at a given moment
+	DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error)
+	// Fetch latest versions of DID Documents controlled by a given DID
+	DidDocsByController(ctx context.Context, in *QueryDidDocsByControllerRequest, opts ...grpc.CallOption) (*QueryDidDocsByControllerResponse, error)
+	// Fetch latest versions of active DID Documents having a verification method with a given public key
+	DidDocsByPublicKey(ctx context.Context, in *QueryDidDocsByPublicKeyRequest, opts ...grpc.CallOption) (*QueryDidDocsByPublicKeyResponse, error)
+	// Fetch latest versions of all DID Documents matching the given filters
+	AllDidDocs(ctx context.Context, in *QueryAllDidDocsRequest, opts ...grpc.CallOption) (*QueryAllDidDocsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +86,51 @@ func (c *queryClient) AllDidDocVersionsMetadata(ctx context.Context, in *QueryAl
 	return out, nil
 }
 
+func (c *queryClient) Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error) {
+	out := new(QueryResolveResponse)
+	err := c.cc.Invoke(ctx, Query_Resolve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error) {
+	out := new(QueryDidDocAtTimeResponse)
+	err := c.cc.Invoke(ctx, Query_DidDocAtTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocsByController(ctx context.Context, in *QueryDidDocsByControllerRequest, opts ...grpc.CallOption) (*QueryDidDocsByControllerResponse, error) {
+	out := new(QueryDidDocsByControllerResponse)
+	err := c.cc.Invoke(ctx, Query_DidDocsByController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocsByPublicKey(ctx context.Context, in *QueryDidDocsByPublicKeyRequest, opts ...grpc.CallOption) (*QueryDidDocsByPublicKeyResponse, error) {
+	out := new(QueryDidDocsByPublicKeyResponse)
+	err := c.cc.Invoke(ctx, Query_DidDocsByPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDidDocs(ctx context.Context, in *QueryAllDidDocsRequest, opts ...grpc.CallOption) (*QueryAllDidDocsResponse, error) {
+	out := new(QueryAllDidDocsResponse)
+	err := c.cc.Invoke(ctx, Query_AllDidDocs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +141,16 @@ type QueryServer interface {
 	DidDocVersion(context.Context, *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error)
 	// Fetch list of all versions of DID Documents for a given DID
 	AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Resolve a DID according to the W3C DID Resolution specification
+	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
+	// Fetch the version of a DID Document that was active at a given moment
+	DidDocAtTime(context.Context, *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error)
+	// Fetch latest versions of DID Documents controlled by a given DID
+	DidDocsByController(context.Context, *QueryDidDocsByControllerRequest) (*QueryDidDocsByControllerResponse, error)
+	// Fetch latest versions of active DID Documents having a verification method with a given public key
+	DidDocsByPublicKey(context.Context, *QueryDidDocsByPublicKeyRequest) (*QueryDidDocsByPublicKeyResponse, error)
+	// Fetch latest versions of all DID Documents matching the given filters
+	AllDidDocs(context.Context, *QueryAllDidDocsRequest) (*QueryAllDidDocsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +167,21 @@ func (UnimplementedQueryServer) DidDocVersion(context.Context, *QueryDidDocVersi
 func (UnimplementedQueryServer) AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocVersionsMetadata not implemented")
 }
+func (UnimplementedQueryServer) Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+func (UnimplementedQueryServer) DidDocAtTime(context.Context, *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocAtTime not implemented")
+}
+func (UnimplementedQueryServer) DidDocsByController(context.Context, *QueryDidDocsByControllerRequest) (*QueryDidDocsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocsByController not implemented")
+}
+func (UnimplementedQueryServer) DidDocsByPublicKey(context.Context, *QueryDidDocsByPublicKeyRequest) (*QueryDidDocsByPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocsByPublicKey not implemented")
+}
+func (UnimplementedQueryServer) AllDidDocs(context.Context, *QueryAllDidDocsRequest) (*QueryAllDidDocsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocs not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +249,96 @@ func _Query_AllDidDocVersionsMetadata_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Resolve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Resolve(ctx, req.(*QueryResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidDocAtTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocAtTime(ctx, req.(*QueryDidDocAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocsByController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidDocsByController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocsByController(ctx, req.(*QueryDidDocsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocsByPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocsByPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocsByPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidDocsByPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocsByPublicKey(ctx, req.(*QueryDidDocsByPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDidDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidDocsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDidDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllDidDocs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDidDocs(ctx, req.(*QueryAllDidDocsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +358,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllDidDocVersionsMetadata",
 			Handler:    _Query_AllDidDocVersionsMetadata_Handler,
 		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Query_Resolve_Handler,
+		},
+		{
+			MethodName: "DidDocAtTime",
+			Handler:    _Query_DidDocAtTime_Handler,
+		},
+		{
+			MethodName: "DidDocsByController",
+			Handler:    _Query_DidDocsByController_Handler,
+		},
+		{
+			MethodName: "DidDocsByPublicKey",
+			Handler:    _Query_DidDocsByPublicKey_Handler,
+		},
+		{
+			MethodName: "AllDidDocs",
+			Handler:    _Query_AllDidDocs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/did/v2/query.proto",
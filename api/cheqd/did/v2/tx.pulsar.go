@@ -1731,28 +1731,79 @@ func (x *fastReflection_MsgDeactivateDidDoc) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_MsgPatchDidDoc_2_list)(nil)
+
+type _MsgPatchDidDoc_2_list struct {
+	list *[]*SignInfo
+}
+
+func (x *_MsgPatchDidDoc_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgPatchDidDoc_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgPatchDidDoc_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgPatchDidDoc_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgPatchDidDoc_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPatchDidDoc_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgPatchDidDoc_2_list) NewElement() protoreflect.Value {
+	v := new(SignInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPatchDidDoc_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SignInfo                        protoreflect.MessageDescriptor
-	fd_SignInfo_verification_method_id protoreflect.FieldDescriptor
-	fd_SignInfo_signature              protoreflect.FieldDescriptor
+	md_MsgPatchDidDoc            protoreflect.MessageDescriptor
+	fd_MsgPatchDidDoc_payload    protoreflect.FieldDescriptor
+	fd_MsgPatchDidDoc_signatures protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_SignInfo = File_cheqd_did_v2_tx_proto.Messages().ByName("SignInfo")
-	fd_SignInfo_verification_method_id = md_SignInfo.Fields().ByName("verification_method_id")
-	fd_SignInfo_signature = md_SignInfo.Fields().ByName("signature")
+	md_MsgPatchDidDoc = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgPatchDidDoc")
+	fd_MsgPatchDidDoc_payload = md_MsgPatchDidDoc.Fields().ByName("payload")
+	fd_MsgPatchDidDoc_signatures = md_MsgPatchDidDoc.Fields().ByName("signatures")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgPatchDidDoc)(nil)
 
-type fastReflection_SignInfo SignInfo
+type fastReflection_MsgPatchDidDoc MsgPatchDidDoc
 
-func (x *SignInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignInfo)(x)
+func (x *MsgPatchDidDoc) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPatchDidDoc)(x)
 }
 
-func (x *SignInfo) slowProtoReflect() protoreflect.Message {
+func (x *MsgPatchDidDoc) slowProtoReflect() protoreflect.Message {
 	mi := &file_cheqd_did_v2_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1764,43 +1815,43 @@ func (x *SignInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignInfo_messageType fastReflection_SignInfo_messageType
-var _ protoreflect.MessageType = fastReflection_SignInfo_messageType{}
+var _fastReflection_MsgPatchDidDoc_messageType fastReflection_MsgPatchDidDoc_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPatchDidDoc_messageType{}
 
-type fastReflection_SignInfo_messageType struct{}
+type fastReflection_MsgPatchDidDoc_messageType struct{}
 
-func (x fastReflection_SignInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignInfo)(nil)
+func (x fastReflection_MsgPatchDidDoc_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPatchDidDoc)(nil)
 }
-func (x fastReflection_SignInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignInfo)
+func (x fastReflection_MsgPatchDidDoc_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPatchDidDoc)
 }
-func (x fastReflection_SignInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignInfo
+func (x fastReflection_MsgPatchDidDoc_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPatchDidDoc
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SignInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignInfo
+func (x *fastReflection_MsgPatchDidDoc) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPatchDidDoc
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignInfo) Type() protoreflect.MessageType {
-	return _fastReflection_SignInfo_messageType
+func (x *fastReflection_MsgPatchDidDoc) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPatchDidDoc_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignInfo) New() protoreflect.Message {
-	return new(fastReflection_SignInfo)
+func (x *fastReflection_MsgPatchDidDoc) New() protoreflect.Message {
+	return new(fastReflection_MsgPatchDidDoc)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignInfo) Interface() protoreflect.ProtoMessage {
-	return (*SignInfo)(x)
+func (x *fastReflection_MsgPatchDidDoc) Interface() protoreflect.ProtoMessage {
+	return (*MsgPatchDidDoc)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1808,16 +1859,16 @@ func (x *fastReflection_SignInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VerificationMethodId != "" {
-		value := protoreflect.ValueOfString(x.VerificationMethodId)
-		if !f(fd_SignInfo_verification_method_id, value) {
+func (x *fastReflection_MsgPatchDidDoc) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Payload != nil {
+		value := protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+		if !f(fd_MsgPatchDidDoc_payload, value) {
 			return
 		}
 	}
-	if len(x.Signature) != 0 {
-		value := protoreflect.ValueOfBytes(x.Signature)
-		if !f(fd_SignInfo_signature, value) {
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgPatchDidDoc_2_list{list: &x.Signatures})
+		if !f(fd_MsgPatchDidDoc_signatures, value) {
 			return
 		}
 	}
@@ -1834,17 +1885,17 @@ func (x *fastReflection_SignInfo) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgPatchDidDoc) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.SignInfo.verification_method_id":
-		return x.VerificationMethodId != ""
-	case "cheqd.did.v2.SignInfo.signature":
-		return len(x.Signature) != 0
+	case "cheqd.did.v2.MsgPatchDidDoc.payload":
+		return x.Payload != nil
+	case "cheqd.did.v2.MsgPatchDidDoc.signatures":
+		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1854,17 +1905,17 @@ func (x *fastReflection_SignInfo) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgPatchDidDoc) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.SignInfo.verification_method_id":
-		x.VerificationMethodId = ""
-	case "cheqd.did.v2.SignInfo.signature":
-		x.Signature = nil
+	case "cheqd.did.v2.MsgPatchDidDoc.payload":
+		x.Payload = nil
+	case "cheqd.did.v2.MsgPatchDidDoc.signatures":
+		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1874,19 +1925,22 @@ func (x *fastReflection_SignInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDoc) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.SignInfo.verification_method_id":
-		value := x.VerificationMethodId
-		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.SignInfo.signature":
-		value := x.Signature
-		return protoreflect.ValueOfBytes(value)
+	case "cheqd.did.v2.MsgPatchDidDoc.payload":
+		value := x.Payload
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.MsgPatchDidDoc.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgPatchDidDoc_2_list{})
+		}
+		listValue := &_MsgPatchDidDoc_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDoc does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1900,17 +1954,19 @@ func (x *fastReflection_SignInfo) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgPatchDidDoc) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.SignInfo.verification_method_id":
-		x.VerificationMethodId = value.Interface().(string)
-	case "cheqd.did.v2.SignInfo.signature":
-		x.Signature = value.Bytes()
+	case "cheqd.did.v2.MsgPatchDidDoc.payload":
+		x.Payload = value.Message().Interface().(*MsgPatchDidDocPayload)
+	case "cheqd.did.v2.MsgPatchDidDoc.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgPatchDidDoc_2_list)
+		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1924,44 +1980,53 @@ func (x *fastReflection_SignInfo) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDoc) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.SignInfo.verification_method_id":
-		panic(fmt.Errorf("field verification_method_id of message cheqd.did.v2.SignInfo is not mutable"))
-	case "cheqd.did.v2.SignInfo.signature":
-		panic(fmt.Errorf("field signature of message cheqd.did.v2.SignInfo is not mutable"))
+	case "cheqd.did.v2.MsgPatchDidDoc.payload":
+		if x.Payload == nil {
+			x.Payload = new(MsgPatchDidDocPayload)
+		}
+		return protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+	case "cheqd.did.v2.MsgPatchDidDoc.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*SignInfo{}
+		}
+		value := &_MsgPatchDidDoc_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDoc) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.SignInfo.verification_method_id":
-		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.SignInfo.signature":
-		return protoreflect.ValueOfBytes(nil)
+	case "cheqd.did.v2.MsgPatchDidDoc.payload":
+		m := new(MsgPatchDidDocPayload)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.MsgPatchDidDoc.signatures":
+		list := []*SignInfo{}
+		return protoreflect.ValueOfList(&_MsgPatchDidDoc_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDoc"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDoc does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgPatchDidDoc) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.SignInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgPatchDidDoc", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1969,7 +2034,7 @@ func (x *fastReflection_SignInfo) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgPatchDidDoc) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1980,7 +2045,7 @@ func (x *fastReflection_SignInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgPatchDidDoc) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1992,7 +2057,7 @@ func (x *fastReflection_SignInfo) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignInfo) IsValid() bool {
+func (x *fastReflection_MsgPatchDidDoc) IsValid() bool {
 	return x != nil
 }
 
@@ -2002,9 +2067,9 @@ func (x *fastReflection_SignInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgPatchDidDoc) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignInfo)
+		x := input.Message.Interface().(*MsgPatchDidDoc)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2016,13 +2081,15 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.VerificationMethodId)
-		if l > 0 {
+		if x.Payload != nil {
+			l = options.Size(x.Payload)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2034,7 +2101,7 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignInfo)
+		x := input.Message.Interface().(*MsgPatchDidDoc)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2053,17 +2120,33 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.VerificationMethodId) > 0 {
-			i -= len(x.VerificationMethodId)
-			copy(dAtA[i:], x.VerificationMethodId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
+		if x.Payload != nil {
+			encoded, err := options.Marshal(x.Payload)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2078,7 +2161,7 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignInfo)
+		x := input.Message.Interface().(*MsgPatchDidDoc)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2110,17 +2193,17 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPatchDidDoc: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPatchDidDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2130,29 +2213,33 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				if x.Payload == nil {
+					x.Payload = &MsgPatchDidDocPayload{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payload); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2162,24 +2249,24 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
-				if x.Signature == nil {
-					x.Signature = []byte{}
+				x.Signatures = append(x.Signatures, &SignInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -2217,1516 +2304,2127 @@ func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_1_list)(nil)
+var (
+	md_SignInfo                        protoreflect.MessageDescriptor
+	fd_SignInfo_verification_method_id protoreflect.FieldDescriptor
+	fd_SignInfo_signature              protoreflect.FieldDescriptor
+	fd_SignInfo_version_id             protoreflect.FieldDescriptor
+)
 
-type _MsgCreateDidDocPayload_1_list struct {
-	list *[]string
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_SignInfo = File_cheqd_did_v2_tx_proto.Messages().ByName("SignInfo")
+	fd_SignInfo_verification_method_id = md_SignInfo.Fields().ByName("verification_method_id")
+	fd_SignInfo_signature = md_SignInfo.Fields().ByName("signature")
+	fd_SignInfo_version_id = md_SignInfo.Fields().ByName("version_id")
 }
 
-func (x *_MsgCreateDidDocPayload_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_SignInfo)(nil)
 
-func (x *_MsgCreateDidDocPayload_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_SignInfo SignInfo
 
-func (x *_MsgCreateDidDocPayload_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *SignInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignInfo)(x)
 }
 
-func (x *_MsgCreateDidDocPayload_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *SignInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_MsgCreateDidDocPayload_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateDidDocPayload at list field Context as it is not of Message kind"))
-}
+var _fastReflection_SignInfo_messageType fastReflection_SignInfo_messageType
+var _ protoreflect.MessageType = fastReflection_SignInfo_messageType{}
 
-func (x *_MsgCreateDidDocPayload_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_SignInfo_messageType struct{}
 
-func (x *_MsgCreateDidDocPayload_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x fastReflection_SignInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignInfo)(nil)
 }
-
-func (x *_MsgCreateDidDocPayload_1_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_SignInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignInfo)
 }
-
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_3_list)(nil)
-
-type _MsgCreateDidDocPayload_3_list struct {
-	list *[]string
+func (x fastReflection_SignInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignInfo
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignInfo
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignInfo) Type() protoreflect.MessageType {
+	return _fastReflection_SignInfo_messageType
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignInfo) New() protoreflect.Message {
+	return new(fastReflection_SignInfo)
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignInfo) Interface() protoreflect.ProtoMessage {
+	return (*SignInfo)(x)
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateDidDocPayload at list field Controller as it is not of Message kind"))
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VerificationMethodId != "" {
+		value := protoreflect.ValueOfString(x.VerificationMethodId)
+		if !f(fd_SignInfo_verification_method_id, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_SignInfo_signature, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_SignInfo_version_id, value) {
+			return
+		}
+	}
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.SignInfo.verification_method_id":
+		return x.VerificationMethodId != ""
+	case "cheqd.did.v2.SignInfo.signature":
+		return len(x.Signature) != 0
+	case "cheqd.did.v2.SignInfo.version_id":
+		return x.VersionId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.SignInfo.verification_method_id":
+		x.VerificationMethodId = ""
+	case "cheqd.did.v2.SignInfo.signature":
+		x.Signature = nil
+	case "cheqd.did.v2.SignInfo.version_id":
+		x.VersionId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_MsgCreateDidDocPayload_3_list) IsValid() bool {
-	return x.list != nil
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.SignInfo.verification_method_id":
+		value := x.VerificationMethodId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.SignInfo.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	case "cheqd.did.v2.SignInfo.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", descriptor.FullName()))
+	}
 }
 
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_4_list)(nil)
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.SignInfo.verification_method_id":
+		x.VerificationMethodId = value.Interface().(string)
+	case "cheqd.did.v2.SignInfo.signature":
+		x.Signature = value.Bytes()
+	case "cheqd.did.v2.SignInfo.version_id":
+		x.VersionId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+	}
+}
 
-type _MsgCreateDidDocPayload_4_list struct {
-	list *[]*VerificationMethod
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.SignInfo.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message cheqd.did.v2.SignInfo is not mutable"))
+	case "cheqd.did.v2.SignInfo.signature":
+		panic(fmt.Errorf("field signature of message cheqd.did.v2.SignInfo is not mutable"))
+	case "cheqd.did.v2.SignInfo.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.SignInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_MsgCreateDidDocPayload_4_list) Len() int {
-	if x.list == nil {
-		return 0
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.SignInfo.verification_method_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.SignInfo.signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "cheqd.did.v2.SignInfo.version_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.SignInfo"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.SignInfo does not contain field %s", fd.FullName()))
 	}
-	return len(*x.list)
 }
 
-func (x *_MsgCreateDidDocPayload_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.SignInfo", d.FullName()))
+	}
+	panic("unreachable")
 }
 
-func (x *_MsgCreateDidDocPayload_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationMethod)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgCreateDidDocPayload_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationMethod)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgCreateDidDocPayload_4_list) AppendMutable() protoreflect.Value {
-	v := new(VerificationMethod)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgCreateDidDocPayload_4_list) NewElement() protoreflect.Value {
-	v := new(VerificationMethod)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_5_list)(nil)
-
-type _MsgCreateDidDocPayload_5_list struct {
-	list *[]*VerificationRelationship
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) AppendMutable() protoreflect.Value {
-	v := new(VerificationRelationship)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) NewElement() protoreflect.Value {
-	v := new(VerificationRelationship)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_5_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_6_list)(nil)
-
-type _MsgCreateDidDocPayload_6_list struct {
-	list *[]*VerificationRelationship
-}
-
-func (x *_MsgCreateDidDocPayload_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgCreateDidDocPayload_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
-	(*x.list)[i] = concreteValue
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
 }
 
-func (x *_MsgCreateDidDocPayload_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
-	*x.list = append(*x.list, concreteValue)
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
 }
 
-func (x *_MsgCreateDidDocPayload_6_list) AppendMutable() protoreflect.Value {
-	v := new(VerificationRelationship)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignInfo) IsValid() bool {
+	return x != nil
 }
 
-func (x *_MsgCreateDidDocPayload_6_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VerificationMethodId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgCreateDidDocPayload_6_list) NewElement() protoreflect.Value {
-	v := new(VerificationRelationship)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_6_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_7_list)(nil)
-
-type _MsgCreateDidDocPayload_7_list struct {
-	list *[]*VerificationRelationship
-}
 
-func (x *_MsgCreateDidDocPayload_7_list) Len() int {
-	if x.list == nil {
-		return 0
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VerificationMethodId) > 0 {
+			i -= len(x.VerificationMethodId)
+			copy(dAtA[i:], x.VerificationMethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
 	}
-	return len(*x.list)
-}
-
-func (x *_MsgCreateDidDocPayload_7_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_7_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgCreateDidDocPayload_7_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgCreateDidDocPayload_7_list) AppendMutable() protoreflect.Value {
-	v := new(VerificationRelationship)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x *_MsgCreateDidDocPayload_7_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgCreateDidDocPayload_7_list) NewElement() protoreflect.Value {
-	v := new(VerificationRelationship)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDidDocPayload_7_list) IsValid() bool {
-	return x.list != nil
 }
 
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_8_list)(nil)
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_1_list)(nil)
 
-type _MsgCreateDidDocPayload_8_list struct {
-	list *[]*VerificationRelationship
+type _MsgCreateDidDocPayload_1_list struct {
+	list *[]string
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) Len() int {
+func (x *_MsgCreateDidDocPayload_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_MsgCreateDidDocPayload_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+func (x *_MsgCreateDidDocPayload_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+func (x *_MsgCreateDidDocPayload_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) AppendMutable() protoreflect.Value {
-	v := new(VerificationRelationship)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_MsgCreateDidDocPayload_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateDidDocPayload at list field Context as it is not of Message kind"))
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_MsgCreateDidDocPayload_1_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) NewElement() protoreflect.Value {
-	v := new(VerificationRelationship)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_MsgCreateDidDocPayload_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_MsgCreateDidDocPayload_8_list) IsValid() bool {
+func (x *_MsgCreateDidDocPayload_1_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_9_list)(nil)
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_3_list)(nil)
 
-type _MsgCreateDidDocPayload_9_list struct {
-	list *[]*VerificationRelationship
+type _MsgCreateDidDocPayload_3_list struct {
+	list *[]string
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) Len() int {
+func (x *_MsgCreateDidDocPayload_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_MsgCreateDidDocPayload_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+func (x *_MsgCreateDidDocPayload_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) Append(value protoreflect.Value) {
+func (x *_MsgCreateDidDocPayload_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateDidDocPayload_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateDidDocPayload at list field Controller as it is not of Message kind"))
+}
+
+func (x *_MsgCreateDidDocPayload_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateDidDocPayload_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCreateDidDocPayload_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_4_list)(nil)
+
+type _MsgCreateDidDocPayload_4_list struct {
+	list *[]*VerificationMethod
+}
+
+func (x *_MsgCreateDidDocPayload_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateDidDocPayload_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	concreteValue := valueUnwrapped.Interface().(*VerificationMethod)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateDidDocPayload_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationMethod)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) AppendMutable() protoreflect.Value {
-	v := new(VerificationRelationship)
+func (x *_MsgCreateDidDocPayload_4_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationMethod)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) Truncate(n int) {
+func (x *_MsgCreateDidDocPayload_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) NewElement() protoreflect.Value {
-	v := new(VerificationRelationship)
+func (x *_MsgCreateDidDocPayload_4_list) NewElement() protoreflect.Value {
+	v := new(VerificationMethod)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_9_list) IsValid() bool {
+func (x *_MsgCreateDidDocPayload_4_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_10_list)(nil)
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_5_list)(nil)
 
-type _MsgCreateDidDocPayload_10_list struct {
-	list *[]*Service
+type _MsgCreateDidDocPayload_5_list struct {
+	list *[]*VerificationRelationship
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) Len() int {
+func (x *_MsgCreateDidDocPayload_5_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) Get(i int) protoreflect.Value {
+func (x *_MsgCreateDidDocPayload_5_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgCreateDidDocPayload_5_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Service)
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) Append(value protoreflect.Value) {
+func (x *_MsgCreateDidDocPayload_5_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Service)
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) AppendMutable() protoreflect.Value {
-	v := new(Service)
+func (x *_MsgCreateDidDocPayload_5_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationRelationship)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) Truncate(n int) {
+func (x *_MsgCreateDidDocPayload_5_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) NewElement() protoreflect.Value {
-	v := new(Service)
+func (x *_MsgCreateDidDocPayload_5_list) NewElement() protoreflect.Value {
+	v := new(VerificationRelationship)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_10_list) IsValid() bool {
+func (x *_MsgCreateDidDocPayload_5_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_MsgCreateDidDocPayload_11_list)(nil)
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_6_list)(nil)
 
-type _MsgCreateDidDocPayload_11_list struct {
-	list *[]string
+type _MsgCreateDidDocPayload_6_list struct {
+	list *[]*VerificationRelationship
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) Len() int {
+func (x *_MsgCreateDidDocPayload_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_MsgCreateDidDocPayload_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgCreateDidDocPayload_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgCreateDidDocPayload_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateDidDocPayload at list field AlsoKnownAs as it is not of Message kind"))
+func (x *_MsgCreateDidDocPayload_6_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationRelationship)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) Truncate(n int) {
+func (x *_MsgCreateDidDocPayload_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_MsgCreateDidDocPayload_6_list) NewElement() protoreflect.Value {
+	v := new(VerificationRelationship)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgCreateDidDocPayload_11_list) IsValid() bool {
+func (x *_MsgCreateDidDocPayload_6_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_MsgCreateDidDocPayload                       protoreflect.MessageDescriptor
-	fd_MsgCreateDidDocPayload_context               protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_id                    protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_controller            protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_verification_method   protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_authentication        protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_assertion_method      protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_capability_invocation protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_capability_delegation protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_key_agreement         protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_service               protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_also_known_as         protoreflect.FieldDescriptor
-	fd_MsgCreateDidDocPayload_version_id            protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_7_list)(nil)
 
-func init() {
-	file_cheqd_did_v2_tx_proto_init()
-	md_MsgCreateDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgCreateDidDocPayload")
-	fd_MsgCreateDidDocPayload_context = md_MsgCreateDidDocPayload.Fields().ByName("context")
-	fd_MsgCreateDidDocPayload_id = md_MsgCreateDidDocPayload.Fields().ByName("id")
-	fd_MsgCreateDidDocPayload_controller = md_MsgCreateDidDocPayload.Fields().ByName("controller")
-	fd_MsgCreateDidDocPayload_verification_method = md_MsgCreateDidDocPayload.Fields().ByName("verification_method")
-	fd_MsgCreateDidDocPayload_authentication = md_MsgCreateDidDocPayload.Fields().ByName("authentication")
-	fd_MsgCreateDidDocPayload_assertion_method = md_MsgCreateDidDocPayload.Fields().ByName("assertion_method")
-	fd_MsgCreateDidDocPayload_capability_invocation = md_MsgCreateDidDocPayload.Fields().ByName("capability_invocation")
-	fd_MsgCreateDidDocPayload_capability_delegation = md_MsgCreateDidDocPayload.Fields().ByName("capability_delegation")
-	fd_MsgCreateDidDocPayload_key_agreement = md_MsgCreateDidDocPayload.Fields().ByName("key_agreement")
-	fd_MsgCreateDidDocPayload_service = md_MsgCreateDidDocPayload.Fields().ByName("service")
-	fd_MsgCreateDidDocPayload_also_known_as = md_MsgCreateDidDocPayload.Fields().ByName("also_known_as")
-	fd_MsgCreateDidDocPayload_version_id = md_MsgCreateDidDocPayload.Fields().ByName("version_id")
+type _MsgCreateDidDocPayload_7_list struct {
+	list *[]*VerificationRelationship
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateDidDocPayload)(nil)
-
-type fastReflection_MsgCreateDidDocPayload MsgCreateDidDocPayload
-
-func (x *MsgCreateDidDocPayload) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateDidDocPayload)(x)
+func (x *_MsgCreateDidDocPayload_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *MsgCreateDidDocPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_MsgCreateDidDocPayload_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-var _fastReflection_MsgCreateDidDocPayload_messageType fastReflection_MsgCreateDidDocPayload_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateDidDocPayload_messageType{}
+func (x *_MsgCreateDidDocPayload_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	(*x.list)[i] = concreteValue
+}
 
-type fastReflection_MsgCreateDidDocPayload_messageType struct{}
+func (x *_MsgCreateDidDocPayload_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	*x.list = append(*x.list, concreteValue)
+}
 
-func (x fastReflection_MsgCreateDidDocPayload_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateDidDocPayload)(nil)
+func (x *_MsgCreateDidDocPayload_7_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationRelationship)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_MsgCreateDidDocPayload_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateDidDocPayload)
+
+func (x *_MsgCreateDidDocPayload_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_MsgCreateDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateDidDocPayload
+
+func (x *_MsgCreateDidDocPayload_7_list) NewElement() protoreflect.Value {
+	v := new(VerificationRelationship)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgCreateDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateDidDocPayload
+func (x *_MsgCreateDidDocPayload_7_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateDidDocPayload) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateDidDocPayload_messageType
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_8_list)(nil)
+
+type _MsgCreateDidDocPayload_8_list struct {
+	list *[]*VerificationRelationship
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateDidDocPayload) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateDidDocPayload)
+func (x *_MsgCreateDidDocPayload_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateDidDocPayload) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateDidDocPayload)(x)
+func (x *_MsgCreateDidDocPayload_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_MsgCreateDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Context) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_1_list{list: &x.Context})
-		if !f(fd_MsgCreateDidDocPayload_context, value) {
-			return
-		}
-	}
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_MsgCreateDidDocPayload_id, value) {
-			return
-		}
-	}
-	if len(x.Controller) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_3_list{list: &x.Controller})
-		if !f(fd_MsgCreateDidDocPayload_controller, value) {
-			return
-		}
-	}
-	if len(x.VerificationMethod) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_4_list{list: &x.VerificationMethod})
-		if !f(fd_MsgCreateDidDocPayload_verification_method, value) {
-			return
-		}
-	}
-	if len(x.Authentication) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_5_list{list: &x.Authentication})
-		if !f(fd_MsgCreateDidDocPayload_authentication, value) {
-			return
-		}
-	}
-	if len(x.AssertionMethod) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_6_list{list: &x.AssertionMethod})
-		if !f(fd_MsgCreateDidDocPayload_assertion_method, value) {
-			return
-		}
-	}
-	if len(x.CapabilityInvocation) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_7_list{list: &x.CapabilityInvocation})
-		if !f(fd_MsgCreateDidDocPayload_capability_invocation, value) {
-			return
-		}
-	}
-	if len(x.CapabilityDelegation) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_8_list{list: &x.CapabilityDelegation})
-		if !f(fd_MsgCreateDidDocPayload_capability_delegation, value) {
-			return
-		}
-	}
-	if len(x.KeyAgreement) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_9_list{list: &x.KeyAgreement})
-		if !f(fd_MsgCreateDidDocPayload_key_agreement, value) {
-			return
-		}
-	}
-	if len(x.Service) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_10_list{list: &x.Service})
-		if !f(fd_MsgCreateDidDocPayload_service, value) {
-			return
-		}
-	}
-	if len(x.AlsoKnownAs) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{list: &x.AlsoKnownAs})
-		if !f(fd_MsgCreateDidDocPayload_also_known_as, value) {
-			return
-		}
+func (x *_MsgCreateDidDocPayload_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateDidDocPayload_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateDidDocPayload_8_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationRelationship)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if x.VersionId != "" {
-		value := protoreflect.ValueOfString(x.VersionId)
-		if !f(fd_MsgCreateDidDocPayload_version_id, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateDidDocPayload_8_list) NewElement() protoreflect.Value {
+	v := new(VerificationRelationship)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_9_list)(nil)
+
+type _MsgCreateDidDocPayload_9_list struct {
+	list *[]*VerificationRelationship
+}
+
+func (x *_MsgCreateDidDocPayload_9_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
-		return len(x.Context) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
-		return x.Id != ""
-	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
-		return len(x.Controller) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
-		return len(x.VerificationMethod) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
-		return len(x.Authentication) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
-		return len(x.AssertionMethod) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
-		return len(x.CapabilityInvocation) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
-		return len(x.CapabilityDelegation) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
-		return len(x.KeyAgreement) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
-		return len(x.Service) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
-		return len(x.AlsoKnownAs) != 0
-	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
-		return x.VersionId != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
-		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+func (x *_MsgCreateDidDocPayload_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateDidDocPayload_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationRelationship)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateDidDocPayload_9_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationRelationship)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
-		x.Context = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
-		x.Id = ""
-	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
-		x.Controller = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
-		x.VerificationMethod = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
-		x.Authentication = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
-		x.AssertionMethod = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
-		x.CapabilityInvocation = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
-		x.CapabilityDelegation = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
-		x.KeyAgreement = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
-		x.Service = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
-		x.AlsoKnownAs = nil
-	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
-		x.VersionId = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
-		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+func (x *_MsgCreateDidDocPayload_9_list) NewElement() protoreflect.Value {
+	v := new(VerificationRelationship)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_10_list)(nil)
+
+type _MsgCreateDidDocPayload_10_list struct {
+	list *[]*Service
+}
+
+func (x *_MsgCreateDidDocPayload_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
-		if len(x.Context) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_1_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_1_list{list: &x.Context}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
-		value := x.Id
-		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
-		if len(x.Controller) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_3_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_3_list{list: &x.Controller}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
-		if len(x.VerificationMethod) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_4_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_4_list{list: &x.VerificationMethod}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
-		if len(x.Authentication) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_5_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_5_list{list: &x.Authentication}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
-		if len(x.AssertionMethod) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_6_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_6_list{list: &x.AssertionMethod}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
-		if len(x.CapabilityInvocation) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_7_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_7_list{list: &x.CapabilityInvocation}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
-		if len(x.CapabilityDelegation) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_8_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_8_list{list: &x.CapabilityDelegation}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
-		if len(x.KeyAgreement) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_9_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_9_list{list: &x.KeyAgreement}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
-		if len(x.Service) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_10_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_10_list{list: &x.Service}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
-		if len(x.AlsoKnownAs) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{})
-		}
-		listValue := &_MsgCreateDidDocPayload_11_list{list: &x.AlsoKnownAs}
-		return protoreflect.ValueOfList(listValue)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
-		value := x.VersionId
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
-		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", descriptor.FullName()))
-	}
+func (x *_MsgCreateDidDocPayload_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_1_list)
-		x.Context = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
-		x.Id = value.Interface().(string)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_3_list)
-		x.Controller = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_4_list)
-		x.VerificationMethod = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_5_list)
-		x.Authentication = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_6_list)
-		x.AssertionMethod = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_7_list)
-		x.CapabilityInvocation = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_8_list)
-		x.CapabilityDelegation = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_9_list)
-		x.KeyAgreement = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_10_list)
-		x.Service = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDidDocPayload_11_list)
-		x.AlsoKnownAs = *clv.list
-	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
-		x.VersionId = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
-		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+func (x *_MsgCreateDidDocPayload_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Service)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateDidDocPayload_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Service)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateDidDocPayload_10_list) AppendMutable() protoreflect.Value {
+	v := new(Service)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
-		if x.Context == nil {
-			x.Context = []string{}
-		}
-		value := &_MsgCreateDidDocPayload_1_list{list: &x.Context}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
-		if x.Controller == nil {
-			x.Controller = []string{}
-		}
-		value := &_MsgCreateDidDocPayload_3_list{list: &x.Controller}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
-		if x.VerificationMethod == nil {
-			x.VerificationMethod = []*VerificationMethod{}
-		}
-		value := &_MsgCreateDidDocPayload_4_list{list: &x.VerificationMethod}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
-		if x.Authentication == nil {
-			x.Authentication = []*VerificationRelationship{}
-		}
-		value := &_MsgCreateDidDocPayload_5_list{list: &x.Authentication}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
-		if x.AssertionMethod == nil {
-			x.AssertionMethod = []*VerificationRelationship{}
-		}
-		value := &_MsgCreateDidDocPayload_6_list{list: &x.AssertionMethod}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
-		if x.CapabilityInvocation == nil {
-			x.CapabilityInvocation = []*VerificationRelationship{}
-		}
-		value := &_MsgCreateDidDocPayload_7_list{list: &x.CapabilityInvocation}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
-		if x.CapabilityDelegation == nil {
-			x.CapabilityDelegation = []*VerificationRelationship{}
-		}
-		value := &_MsgCreateDidDocPayload_8_list{list: &x.CapabilityDelegation}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
-		if x.KeyAgreement == nil {
-			x.KeyAgreement = []*VerificationRelationship{}
-		}
-		value := &_MsgCreateDidDocPayload_9_list{list: &x.KeyAgreement}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
-		if x.Service == nil {
-			x.Service = []*Service{}
-		}
-		value := &_MsgCreateDidDocPayload_10_list{list: &x.Service}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
-		if x.AlsoKnownAs == nil {
-			x.AlsoKnownAs = []string{}
-		}
-		value := &_MsgCreateDidDocPayload_11_list{list: &x.AlsoKnownAs}
-		return protoreflect.ValueOfList(value)
-	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
-		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
-	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
-		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
-		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+func (x *_MsgCreateDidDocPayload_10_list) NewElement() protoreflect.Value {
+	v := new(Service)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateDidDocPayload_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateDidDocPayload_11_list)(nil)
+
+type _MsgCreateDidDocPayload_11_list struct {
+	list *[]string
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_1_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
-		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_3_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
-		list := []*VerificationMethod{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_4_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
-		list := []*VerificationRelationship{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_5_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
-		list := []*VerificationRelationship{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_6_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
-		list := []*VerificationRelationship{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_7_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
-		list := []*VerificationRelationship{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_8_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
-		list := []*VerificationRelationship{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_9_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
-		list := []*Service{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_10_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{list: &list})
-	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
+func (x *_MsgCreateDidDocPayload_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateDidDocPayload at list field AlsoKnownAs as it is not of Message kind"))
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCreateDidDocPayload_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCreateDidDocPayload                       protoreflect.MessageDescriptor
+	fd_MsgCreateDidDocPayload_context               protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_id                    protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_controller            protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_verification_method   protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_authentication        protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_assertion_method      protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_capability_invocation protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_capability_delegation protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_key_agreement         protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_service               protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_also_known_as         protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_version_id            protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_controller_threshold  protoreflect.FieldDescriptor
+	fd_MsgCreateDidDocPayload_next_key_commitment   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgCreateDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgCreateDidDocPayload")
+	fd_MsgCreateDidDocPayload_context = md_MsgCreateDidDocPayload.Fields().ByName("context")
+	fd_MsgCreateDidDocPayload_id = md_MsgCreateDidDocPayload.Fields().ByName("id")
+	fd_MsgCreateDidDocPayload_controller = md_MsgCreateDidDocPayload.Fields().ByName("controller")
+	fd_MsgCreateDidDocPayload_verification_method = md_MsgCreateDidDocPayload.Fields().ByName("verification_method")
+	fd_MsgCreateDidDocPayload_authentication = md_MsgCreateDidDocPayload.Fields().ByName("authentication")
+	fd_MsgCreateDidDocPayload_assertion_method = md_MsgCreateDidDocPayload.Fields().ByName("assertion_method")
+	fd_MsgCreateDidDocPayload_capability_invocation = md_MsgCreateDidDocPayload.Fields().ByName("capability_invocation")
+	fd_MsgCreateDidDocPayload_capability_delegation = md_MsgCreateDidDocPayload.Fields().ByName("capability_delegation")
+	fd_MsgCreateDidDocPayload_key_agreement = md_MsgCreateDidDocPayload.Fields().ByName("key_agreement")
+	fd_MsgCreateDidDocPayload_service = md_MsgCreateDidDocPayload.Fields().ByName("service")
+	fd_MsgCreateDidDocPayload_also_known_as = md_MsgCreateDidDocPayload.Fields().ByName("also_known_as")
+	fd_MsgCreateDidDocPayload_version_id = md_MsgCreateDidDocPayload.Fields().ByName("version_id")
+	fd_MsgCreateDidDocPayload_controller_threshold = md_MsgCreateDidDocPayload.Fields().ByName("controller_threshold")
+	fd_MsgCreateDidDocPayload_next_key_commitment = md_MsgCreateDidDocPayload.Fields().ByName("next_key_commitment")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateDidDocPayload)(nil)
+
+type fastReflection_MsgCreateDidDocPayload MsgCreateDidDocPayload
+
+func (x *MsgCreateDidDocPayload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateDidDocPayload)(x)
+}
+
+func (x *MsgCreateDidDocPayload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgCreateDidDocPayload", d.FullName()))
-	}
-	panic("unreachable")
+var _fastReflection_MsgCreateDidDocPayload_messageType fastReflection_MsgCreateDidDocPayload_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateDidDocPayload_messageType{}
+
+type fastReflection_MsgCreateDidDocPayload_messageType struct{}
+
+func (x fastReflection_MsgCreateDidDocPayload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateDidDocPayload)(nil)
+}
+func (x fastReflection_MsgCreateDidDocPayload_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateDidDocPayload)
+}
+func (x fastReflection_MsgCreateDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateDidDocPayload
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateDidDocPayload) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateDidDocPayload
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateDidDocPayload) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateDidDocPayload_messageType
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateDidDocPayload) IsValid() bool {
-	return x != nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateDidDocPayload) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateDidDocPayload)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateDidDocPayload) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateDidDocPayload)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateDidDocPayload) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateDidDocPayload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Context) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_1_list{list: &x.Context})
+		if !f(fd_MsgCreateDidDocPayload_context, value) {
+			return
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.Context) > 0 {
-			for _, s := range x.Context {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgCreateDidDocPayload_id, value) {
+			return
 		}
-		l = len(x.Id)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Controller) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_3_list{list: &x.Controller})
+		if !f(fd_MsgCreateDidDocPayload_controller, value) {
+			return
 		}
-		if len(x.Controller) > 0 {
-			for _, s := range x.Controller {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.VerificationMethod) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_4_list{list: &x.VerificationMethod})
+		if !f(fd_MsgCreateDidDocPayload_verification_method, value) {
+			return
 		}
-		if len(x.VerificationMethod) > 0 {
-			for _, e := range x.VerificationMethod {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.Authentication) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_5_list{list: &x.Authentication})
+		if !f(fd_MsgCreateDidDocPayload_authentication, value) {
+			return
 		}
-		if len(x.Authentication) > 0 {
-			for _, e := range x.Authentication {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.AssertionMethod) > 0 {
-			for _, e := range x.AssertionMethod {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.AssertionMethod) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_6_list{list: &x.AssertionMethod})
+		if !f(fd_MsgCreateDidDocPayload_assertion_method, value) {
+			return
 		}
-		if len(x.CapabilityInvocation) > 0 {
-			for _, e := range x.CapabilityInvocation {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.CapabilityInvocation) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_7_list{list: &x.CapabilityInvocation})
+		if !f(fd_MsgCreateDidDocPayload_capability_invocation, value) {
+			return
 		}
-		if len(x.CapabilityDelegation) > 0 {
-			for _, e := range x.CapabilityDelegation {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.CapabilityDelegation) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_8_list{list: &x.CapabilityDelegation})
+		if !f(fd_MsgCreateDidDocPayload_capability_delegation, value) {
+			return
 		}
-		if len(x.KeyAgreement) > 0 {
-			for _, e := range x.KeyAgreement {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.KeyAgreement) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_9_list{list: &x.KeyAgreement})
+		if !f(fd_MsgCreateDidDocPayload_key_agreement, value) {
+			return
 		}
-		if len(x.Service) > 0 {
-			for _, e := range x.Service {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.Service) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_10_list{list: &x.Service})
+		if !f(fd_MsgCreateDidDocPayload_service, value) {
+			return
 		}
-		if len(x.AlsoKnownAs) > 0 {
-			for _, s := range x.AlsoKnownAs {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.AlsoKnownAs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{list: &x.AlsoKnownAs})
+		if !f(fd_MsgCreateDidDocPayload_also_known_as, value) {
+			return
 		}
-		l = len(x.VersionId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_MsgCreateDidDocPayload_version_id, value) {
+			return
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+	}
+	if x.ControllerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ControllerThreshold)
+		if !f(fd_MsgCreateDidDocPayload_controller_threshold, value) {
+			return
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+	}
+	if x.NextKeyCommitment != "" {
+		value := protoreflect.ValueOfString(x.NextKeyCommitment)
+		if !f(fd_MsgCreateDidDocPayload_next_key_commitment, value) {
+			return
 		}
 	}
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateDidDocPayload)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
+		return len(x.Context) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
+		return x.Id != ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
+		return len(x.Controller) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
+		return len(x.VerificationMethod) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
+		return len(x.Authentication) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
+		return len(x.AssertionMethod) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
+		return len(x.CapabilityInvocation) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
+		return len(x.CapabilityDelegation) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
+		return len(x.KeyAgreement) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
+		return len(x.Service) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
+		return len(x.AlsoKnownAs) != 0
+	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		return x.ControllerThreshold != uint32(0)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.next_key_commitment":
+		return x.NextKeyCommitment != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
+		x.Context = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
+		x.Id = ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
+		x.Controller = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
+		x.VerificationMethod = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
+		x.Authentication = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
+		x.AssertionMethod = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
+		x.CapabilityInvocation = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
+		x.CapabilityDelegation = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
+		x.KeyAgreement = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
+		x.Service = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
+		x.AlsoKnownAs = nil
+	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(0)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.next_key_commitment":
+		x.NextKeyCommitment = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
 		}
-		if len(x.VersionId) > 0 {
-			i -= len(x.VersionId)
-			copy(dAtA[i:], x.VersionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
-			i--
-			dAtA[i] = 0x62
+		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
+		if len(x.Context) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_1_list{})
 		}
-		if len(x.AlsoKnownAs) > 0 {
-			for iNdEx := len(x.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AlsoKnownAs[iNdEx])
-				copy(dAtA[i:], x.AlsoKnownAs[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlsoKnownAs[iNdEx])))
-				i--
-				dAtA[i] = 0x5a
-			}
+		listValue := &_MsgCreateDidDocPayload_1_list{list: &x.Context}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
+		if len(x.Controller) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_3_list{})
 		}
-		if len(x.Service) > 0 {
-			for iNdEx := len(x.Service) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Service[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x52
-			}
+		listValue := &_MsgCreateDidDocPayload_3_list{list: &x.Controller}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
+		if len(x.VerificationMethod) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_4_list{})
 		}
-		if len(x.KeyAgreement) > 0 {
-			for iNdEx := len(x.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.KeyAgreement[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4a
-			}
+		listValue := &_MsgCreateDidDocPayload_4_list{list: &x.VerificationMethod}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
+		if len(x.Authentication) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_5_list{})
 		}
-		if len(x.CapabilityDelegation) > 0 {
-			for iNdEx := len(x.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CapabilityDelegation[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.CapabilityInvocation) > 0 {
-			for iNdEx := len(x.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CapabilityInvocation[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
+		listValue := &_MsgCreateDidDocPayload_5_list{list: &x.Authentication}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
+		if len(x.AssertionMethod) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_6_list{})
 		}
-		if len(x.AssertionMethod) > 0 {
-			for iNdEx := len(x.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AssertionMethod[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
+		listValue := &_MsgCreateDidDocPayload_6_list{list: &x.AssertionMethod}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
+		if len(x.CapabilityInvocation) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_7_list{})
 		}
-		if len(x.Authentication) > 0 {
-			for iNdEx := len(x.Authentication) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Authentication[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
+		listValue := &_MsgCreateDidDocPayload_7_list{list: &x.CapabilityInvocation}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
+		if len(x.CapabilityDelegation) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_8_list{})
 		}
-		if len(x.VerificationMethod) > 0 {
-			for iNdEx := len(x.VerificationMethod) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.VerificationMethod[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
+		listValue := &_MsgCreateDidDocPayload_8_list{list: &x.CapabilityDelegation}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
+		if len(x.KeyAgreement) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_9_list{})
 		}
-		if len(x.Controller) > 0 {
-			for iNdEx := len(x.Controller) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Controller[iNdEx])
-				copy(dAtA[i:], x.Controller[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
+		listValue := &_MsgCreateDidDocPayload_9_list{list: &x.KeyAgreement}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
+		if len(x.Service) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_10_list{})
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
-			i--
-			dAtA[i] = 0x12
+		listValue := &_MsgCreateDidDocPayload_10_list{list: &x.Service}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
+		if len(x.AlsoKnownAs) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{})
 		}
-		if len(x.Context) > 0 {
-			for iNdEx := len(x.Context) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Context[iNdEx])
-				copy(dAtA[i:], x.Context[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Context[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		listValue := &_MsgCreateDidDocPayload_11_list{list: &x.AlsoKnownAs}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		value := x.ControllerThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.next_key_commitment":
+		value := x.NextKeyCommitment
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_1_list)
+		x.Context = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_3_list)
+		x.Controller = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_4_list)
+		x.VerificationMethod = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_5_list)
+		x.Authentication = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_6_list)
+		x.AssertionMethod = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_7_list)
+		x.CapabilityInvocation = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_8_list)
+		x.CapabilityDelegation = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_9_list)
+		x.KeyAgreement = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_10_list)
+		x.Service = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
+		lv := value.List()
+		clv := lv.(*_MsgCreateDidDocPayload_11_list)
+		x.AlsoKnownAs = *clv.list
+	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(value.Uint())
+	case "cheqd.did.v2.MsgCreateDidDocPayload.next_key_commitment":
+		x.NextKeyCommitment = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateDidDocPayload)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
+		if x.Context == nil {
+			x.Context = []string{}
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDidDocPayload: wiretype end group for non-group")
+		value := &_MsgCreateDidDocPayload_1_list{list: &x.Context}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
+		if x.Controller == nil {
+			x.Controller = []string{}
+		}
+		value := &_MsgCreateDidDocPayload_3_list{list: &x.Controller}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
+		if x.VerificationMethod == nil {
+			x.VerificationMethod = []*VerificationMethod{}
+		}
+		value := &_MsgCreateDidDocPayload_4_list{list: &x.VerificationMethod}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
+		if x.Authentication == nil {
+			x.Authentication = []*VerificationRelationship{}
+		}
+		value := &_MsgCreateDidDocPayload_5_list{list: &x.Authentication}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
+		if x.AssertionMethod == nil {
+			x.AssertionMethod = []*VerificationRelationship{}
+		}
+		value := &_MsgCreateDidDocPayload_6_list{list: &x.AssertionMethod}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
+		if x.CapabilityInvocation == nil {
+			x.CapabilityInvocation = []*VerificationRelationship{}
+		}
+		value := &_MsgCreateDidDocPayload_7_list{list: &x.CapabilityInvocation}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
+		if x.CapabilityDelegation == nil {
+			x.CapabilityDelegation = []*VerificationRelationship{}
+		}
+		value := &_MsgCreateDidDocPayload_8_list{list: &x.CapabilityDelegation}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
+		if x.KeyAgreement == nil {
+			x.KeyAgreement = []*VerificationRelationship{}
+		}
+		value := &_MsgCreateDidDocPayload_9_list{list: &x.KeyAgreement}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
+		if x.Service == nil {
+			x.Service = []*Service{}
+		}
+		value := &_MsgCreateDidDocPayload_10_list{list: &x.Service}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
+		if x.AlsoKnownAs == nil {
+			x.AlsoKnownAs = []string{}
+		}
+		value := &_MsgCreateDidDocPayload_11_list{list: &x.AlsoKnownAs}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		panic(fmt.Errorf("field controller_threshold of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgCreateDidDocPayload.next_key_commitment":
+		panic(fmt.Errorf("field next_key_commitment of message cheqd.did.v2.MsgCreateDidDocPayload is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgCreateDidDocPayload.context":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_1_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_3_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.verification_method":
+		list := []*VerificationMethod{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_4_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.authentication":
+		list := []*VerificationRelationship{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_5_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.assertion_method":
+		list := []*VerificationRelationship{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_6_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation":
+		list := []*VerificationRelationship{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_7_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation":
+		list := []*VerificationRelationship{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_8_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.key_agreement":
+		list := []*VerificationRelationship{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_9_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.service":
+		list := []*Service{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_10_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.also_known_as":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCreateDidDocPayload_11_list{list: &list})
+	case "cheqd.did.v2.MsgCreateDidDocPayload.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgCreateDidDocPayload.controller_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.did.v2.MsgCreateDidDocPayload.next_key_commitment":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgCreateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgCreateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgCreateDidDocPayload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateDidDocPayload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateDidDocPayload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateDidDocPayload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateDidDocPayload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Context) > 0 {
+			for _, s := range x.Context {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Context = append(x.Context, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Id = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		}
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Controller) > 0 {
+			for _, s := range x.Controller {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerificationMethod) > 0 {
+			for _, e := range x.VerificationMethod {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Authentication) > 0 {
+			for _, e := range x.Authentication {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AssertionMethod) > 0 {
+			for _, e := range x.AssertionMethod {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CapabilityInvocation) > 0 {
+			for _, e := range x.CapabilityInvocation {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CapabilityDelegation) > 0 {
+			for _, e := range x.CapabilityDelegation {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyAgreement) > 0 {
+			for _, e := range x.KeyAgreement {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Service) > 0 {
+			for _, e := range x.Service {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			for _, s := range x.AlsoKnownAs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ControllerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ControllerThreshold))
+		}
+		l = len(x.NextKeyCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateDidDocPayload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NextKeyCommitment) > 0 {
+			i -= len(x.NextKeyCommitment)
+			copy(dAtA[i:], x.NextKeyCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextKeyCommitment)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.ControllerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ControllerThreshold))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			for iNdEx := len(x.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AlsoKnownAs[iNdEx])
+				copy(dAtA[i:], x.AlsoKnownAs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlsoKnownAs[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Service) > 0 {
+			for iNdEx := len(x.Service) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Service[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.KeyAgreement) > 0 {
+			for iNdEx := len(x.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyAgreement[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.CapabilityDelegation) > 0 {
+			for iNdEx := len(x.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CapabilityDelegation[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.CapabilityInvocation) > 0 {
+			for iNdEx := len(x.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CapabilityInvocation[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				x.Controller = append(x.Controller, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.AssertionMethod) > 0 {
+			for iNdEx := len(x.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AssertionMethod[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Authentication) > 0 {
+			for iNdEx := len(x.Authentication) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Authentication[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.VerificationMethod) > 0 {
+			for iNdEx := len(x.VerificationMethod) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VerificationMethod[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Controller) > 0 {
+			for iNdEx := len(x.Controller) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Controller[iNdEx])
+				copy(dAtA[i:], x.Controller[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Context) > 0 {
+			for iNdEx := len(x.Context) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Context[iNdEx])
+				copy(dAtA[i:], x.Context[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Context[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateDidDocPayload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDidDocPayload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Context = append(x.Context, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = append(x.Controller, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -4004,6 +4702,57 @@ func (x *fastReflection_MsgCreateDidDocPayload) ProtoMethods() *protoiface.Metho
 				}
 				x.VersionId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+				}
+				x.ControllerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ControllerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4059,7 +4808,7 @@ func (x *MsgCreateDidDocResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgCreateDidDocResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[5]
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4983,6 +5732,9 @@ var (
 	fd_MsgUpdateDidDocPayload_service               protoreflect.FieldDescriptor
 	fd_MsgUpdateDidDocPayload_also_known_as         protoreflect.FieldDescriptor
 	fd_MsgUpdateDidDocPayload_version_id            protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_previous_version_id   protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_controller_threshold  protoreflect.FieldDescriptor
+	fd_MsgUpdateDidDocPayload_next_key_commitment   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5000,6 +5752,9 @@ func init() {
 	fd_MsgUpdateDidDocPayload_service = md_MsgUpdateDidDocPayload.Fields().ByName("service")
 	fd_MsgUpdateDidDocPayload_also_known_as = md_MsgUpdateDidDocPayload.Fields().ByName("also_known_as")
 	fd_MsgUpdateDidDocPayload_version_id = md_MsgUpdateDidDocPayload.Fields().ByName("version_id")
+	fd_MsgUpdateDidDocPayload_previous_version_id = md_MsgUpdateDidDocPayload.Fields().ByName("previous_version_id")
+	fd_MsgUpdateDidDocPayload_controller_threshold = md_MsgUpdateDidDocPayload.Fields().ByName("controller_threshold")
+	fd_MsgUpdateDidDocPayload_next_key_commitment = md_MsgUpdateDidDocPayload.Fields().ByName("next_key_commitment")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateDidDocPayload)(nil)
@@ -5011,7 +5766,7 @@ func (x *MsgUpdateDidDocPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgUpdateDidDocPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[6]
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5139,13 +5894,31 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
+	if x.PreviousVersionId != "" {
+		value := protoreflect.ValueOfString(x.PreviousVersionId)
+		if !f(fd_MsgUpdateDidDocPayload_previous_version_id, value) {
+			return
+		}
+	}
+	if x.ControllerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ControllerThreshold)
+		if !f(fd_MsgUpdateDidDocPayload_controller_threshold, value) {
+			return
+		}
+	}
+	if x.NextKeyCommitment != "" {
+		value := protoreflect.ValueOfString(x.NextKeyCommitment)
+		if !f(fd_MsgUpdateDidDocPayload_next_key_commitment, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
 // member fields of a oneof, and proto2 scalar fields are nullable. Such
 // fields are populated only if explicitly set.
 //
@@ -5178,6 +5951,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Has(fd protoreflect.FieldDescrip
 		return len(x.AlsoKnownAs) != 0
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		return x.VersionId != ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.previous_version_id":
+		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		return x.ControllerThreshold != uint32(0)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.next_key_commitment":
+		return x.NextKeyCommitment != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5218,6 +5997,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Clear(fd protoreflect.FieldDescr
 		x.AlsoKnownAs = nil
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		x.VersionId = ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.previous_version_id":
+		x.PreviousVersionId = ""
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(0)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.next_key_commitment":
+		x.NextKeyCommitment = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5300,6 +6085,15 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Get(descriptor protoreflect.Fiel
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		value := x.VersionId
 		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.previous_version_id":
+		value := x.PreviousVersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		value := x.ControllerThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.next_key_commitment":
+		value := x.NextKeyCommitment
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5364,6 +6158,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Set(fd protoreflect.FieldDescrip
 		x.AlsoKnownAs = *clv.list
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.previous_version_id":
+		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		x.ControllerThreshold = uint32(value.Uint())
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.next_key_commitment":
+		x.NextKeyCommitment = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5448,6 +6248,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.previous_version_id":
+		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		panic(fmt.Errorf("field controller_threshold of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.next_key_commitment":
+		panic(fmt.Errorf("field next_key_commitment of message cheqd.did.v2.MsgUpdateDidDocPayload is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5495,6 +6301,12 @@ func (x *fastReflection_MsgUpdateDidDocPayload) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfList(&_MsgUpdateDidDocPayload_11_list{list: &list})
 	case "cheqd.did.v2.MsgUpdateDidDocPayload.version_id":
 		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.previous_version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.controller_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cheqd.did.v2.MsgUpdateDidDocPayload.next_key_commitment":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocPayload"))
@@ -5632,6 +6444,17 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.PreviousVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ControllerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ControllerThreshold))
+		}
+		l = len(x.NextKeyCommitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5661,6 +6484,25 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NextKeyCommitment) > 0 {
+			i -= len(x.NextKeyCommitment)
+			copy(dAtA[i:], x.NextKeyCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextKeyCommitment)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.ControllerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ControllerThreshold))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.PreviousVersionId) > 0 {
+			i -= len(x.PreviousVersionId)
+			copy(dAtA[i:], x.PreviousVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if len(x.VersionId) > 0 {
 			i -= len(x.VersionId)
 			copy(dAtA[i:], x.VersionId)
@@ -6121,17 +6963,1614 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CapabilityDelegation = append(x.CapabilityDelegation, &VerificationRelationship{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CapabilityDelegation[len(x.CapabilityDelegation)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyAgreement = append(x.KeyAgreement, &VerificationRelationship{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyAgreement[len(x.KeyAgreement)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = append(x.Service, &Service{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service[len(x.Service)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlsoKnownAs = append(x.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+				}
+				x.ControllerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ControllerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateDidDocResponse       protoreflect.MessageDescriptor
+	fd_MsgUpdateDidDocResponse_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgUpdateDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgUpdateDidDocResponse")
+	fd_MsgUpdateDidDocResponse_value = md_MsgUpdateDidDocResponse.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateDidDocResponse)(nil)
+
+type fastReflection_MsgUpdateDidDocResponse MsgUpdateDidDocResponse
+
+func (x *MsgUpdateDidDocResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDidDocResponse)(x)
+}
+
+func (x *MsgUpdateDidDocResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateDidDocResponse_messageType fastReflection_MsgUpdateDidDocResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateDidDocResponse_messageType{}
+
+type fastReflection_MsgUpdateDidDocResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateDidDocResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDidDocResponse)(nil)
+}
+func (x fastReflection_MsgUpdateDidDocResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDidDocResponse)
+}
+func (x fastReflection_MsgUpdateDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDidDocResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDidDocResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateDidDocResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateDidDocResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateDidDocResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDidDocResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateDidDocResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateDidDocResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != nil {
+		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+		if !f(fd_MsgUpdateDidDocResponse_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
+		return x.Value != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
+		value := x.Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
+		x.Value = value.Message().Interface().(*DidDocWithMetadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
+		if x.Value == nil {
+			x.Value = new(DidDocWithMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
+		m := new(DidDocWithMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgUpdateDidDocResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateDidDocResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateDidDocResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateDidDocResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != nil {
+			l = options.Size(x.Value)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateDidDocResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Value != nil {
+			encoded, err := options.Marshal(x.Value)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateDidDocResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDidDocResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Value == nil {
+					x.Value = &DidDocWithMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDeactivateDidDocPayload                     protoreflect.MessageDescriptor
+	fd_MsgDeactivateDidDocPayload_id                  protoreflect.FieldDescriptor
+	fd_MsgDeactivateDidDocPayload_version_id          protoreflect.FieldDescriptor
+	fd_MsgDeactivateDidDocPayload_previous_version_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgDeactivateDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocPayload")
+	fd_MsgDeactivateDidDocPayload_id = md_MsgDeactivateDidDocPayload.Fields().ByName("id")
+	fd_MsgDeactivateDidDocPayload_version_id = md_MsgDeactivateDidDocPayload.Fields().ByName("version_id")
+	fd_MsgDeactivateDidDocPayload_previous_version_id = md_MsgDeactivateDidDocPayload.Fields().ByName("previous_version_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+
+type fastReflection_MsgDeactivateDidDocPayload MsgDeactivateDidDocPayload
+
+func (x *MsgDeactivateDidDocPayload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocPayload)(x)
+}
+
+func (x *MsgDeactivateDidDocPayload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeactivateDidDocPayload_messageType fastReflection_MsgDeactivateDidDocPayload_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocPayload_messageType{}
+
+type fastReflection_MsgDeactivateDidDocPayload_messageType struct{}
+
+func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+}
+func (x fastReflection_MsgDeactivateDidDocPayload_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocPayload)
+}
+func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocPayload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocPayload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeactivateDidDocPayload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeactivateDidDocPayload) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocPayload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeactivateDidDocPayload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgDeactivateDidDocPayload_id, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_MsgDeactivateDidDocPayload_version_id, value) {
+			return
+		}
+	}
+	if x.PreviousVersionId != "" {
+		value := protoreflect.ValueOfString(x.PreviousVersionId)
+		if !f(fd_MsgDeactivateDidDocPayload_previous_version_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		return x.Id != ""
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.previous_version_id":
+		return x.PreviousVersionId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		x.Id = ""
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.previous_version_id":
+		x.PreviousVersionId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.previous_version_id":
+		value := x.PreviousVersionId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.previous_version_id":
+		x.PreviousVersionId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.previous_version_id":
+		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeactivateDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgDeactivateDidDocPayload.previous_version_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeactivateDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocPayload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeactivateDidDocPayload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeactivateDidDocPayload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PreviousVersionId) > 0 {
+			i -= len(x.PreviousVersionId)
+			copy(dAtA[i:], x.PreviousVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDeactivateDidDocResponse       protoreflect.MessageDescriptor
+	fd_MsgDeactivateDidDocResponse_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_did_v2_tx_proto_init()
+	md_MsgDeactivateDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocResponse")
+	fd_MsgDeactivateDidDocResponse_value = md_MsgDeactivateDidDocResponse.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+
+type fastReflection_MsgDeactivateDidDocResponse MsgDeactivateDidDocResponse
+
+func (x *MsgDeactivateDidDocResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocResponse)(x)
+}
+
+func (x *MsgDeactivateDidDocResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeactivateDidDocResponse_messageType fastReflection_MsgDeactivateDidDocResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocResponse_messageType{}
+
+type fastReflection_MsgDeactivateDidDocResponse_messageType struct{}
+
+func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+}
+func (x fastReflection_MsgDeactivateDidDocResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocResponse)
+}
+func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeactivateDidDocResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeactivateDidDocResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeactivateDidDocResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDeactivateDidDocResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeactivateDidDocResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != nil {
+		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+		if !f(fd_MsgDeactivateDidDocResponse_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		return x.Value != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		value := x.Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		x.Value = value.Message().Interface().(*DidDocWithMetadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		if x.Value == nil {
+			x.Value = new(DidDocWithMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeactivateDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+		m := new(DidDocWithMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+		}
+		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeactivateDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeactivateDidDocResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeactivateDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeactivateDidDocResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != nil {
+			l = options.Size(x.Value)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Value != nil {
+			encoded, err := options.Marshal(x.Value)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CapabilityDelegation = append(x.CapabilityDelegation, &VerificationRelationship{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CapabilityDelegation[len(x.CapabilityDelegation)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 9:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6158,109 +8597,13 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.KeyAgreement = append(x.KeyAgreement, &VerificationRelationship{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyAgreement[len(x.KeyAgreement)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.Value == nil {
+					x.Value = &DidDocWithMetadata{}
 				}
-				x.Service = append(x.Service, &Service{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service[len(x.Service)-1]); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AlsoKnownAs = append(x.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VersionId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6296,27 +8639,86 @@ func (x *fastReflection_MsgUpdateDidDocPayload) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_MsgPatchDidDocPayload_2_list)(nil)
+
+type _MsgPatchDidDocPayload_2_list struct {
+	list *[]*PatchOperation
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PatchOperation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PatchOperation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) AppendMutable() protoreflect.Value {
+	v := new(PatchOperation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) NewElement() protoreflect.Value {
+	v := new(PatchOperation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPatchDidDocPayload_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateDidDocResponse       protoreflect.MessageDescriptor
-	fd_MsgUpdateDidDocResponse_value protoreflect.FieldDescriptor
+	md_MsgPatchDidDocPayload                     protoreflect.MessageDescriptor
+	fd_MsgPatchDidDocPayload_id                  protoreflect.FieldDescriptor
+	fd_MsgPatchDidDocPayload_operations          protoreflect.FieldDescriptor
+	fd_MsgPatchDidDocPayload_previous_version_id protoreflect.FieldDescriptor
+	fd_MsgPatchDidDocPayload_version_id          protoreflect.FieldDescriptor
+	fd_MsgPatchDidDocPayload_next_key_commitment protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgUpdateDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgUpdateDidDocResponse")
-	fd_MsgUpdateDidDocResponse_value = md_MsgUpdateDidDocResponse.Fields().ByName("value")
+	md_MsgPatchDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgPatchDidDocPayload")
+	fd_MsgPatchDidDocPayload_id = md_MsgPatchDidDocPayload.Fields().ByName("id")
+	fd_MsgPatchDidDocPayload_operations = md_MsgPatchDidDocPayload.Fields().ByName("operations")
+	fd_MsgPatchDidDocPayload_previous_version_id = md_MsgPatchDidDocPayload.Fields().ByName("previous_version_id")
+	fd_MsgPatchDidDocPayload_version_id = md_MsgPatchDidDocPayload.Fields().ByName("version_id")
+	fd_MsgPatchDidDocPayload_next_key_commitment = md_MsgPatchDidDocPayload.Fields().ByName("next_key_commitment")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateDidDocResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgPatchDidDocPayload)(nil)
 
-type fastReflection_MsgUpdateDidDocResponse MsgUpdateDidDocResponse
+type fastReflection_MsgPatchDidDocPayload MsgPatchDidDocPayload
 
-func (x *MsgUpdateDidDocResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateDidDocResponse)(x)
+func (x *MsgPatchDidDocPayload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPatchDidDocPayload)(x)
 }
 
-func (x *MsgUpdateDidDocResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[7]
+func (x *MsgPatchDidDocPayload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6327,43 +8729,43 @@ func (x *MsgUpdateDidDocResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateDidDocResponse_messageType fastReflection_MsgUpdateDidDocResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateDidDocResponse_messageType{}
+var _fastReflection_MsgPatchDidDocPayload_messageType fastReflection_MsgPatchDidDocPayload_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPatchDidDocPayload_messageType{}
 
-type fastReflection_MsgUpdateDidDocResponse_messageType struct{}
+type fastReflection_MsgPatchDidDocPayload_messageType struct{}
 
-func (x fastReflection_MsgUpdateDidDocResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateDidDocResponse)(nil)
+func (x fastReflection_MsgPatchDidDocPayload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPatchDidDocPayload)(nil)
 }
-func (x fastReflection_MsgUpdateDidDocResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateDidDocResponse)
+func (x fastReflection_MsgPatchDidDocPayload_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPatchDidDocPayload)
 }
-func (x fastReflection_MsgUpdateDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateDidDocResponse
+func (x fastReflection_MsgPatchDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPatchDidDocPayload
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateDidDocResponse
+func (x *fastReflection_MsgPatchDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPatchDidDocPayload
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateDidDocResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateDidDocResponse_messageType
+func (x *fastReflection_MsgPatchDidDocPayload) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPatchDidDocPayload_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateDidDocResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateDidDocResponse)
+func (x *fastReflection_MsgPatchDidDocPayload) New() protoreflect.Message {
+	return new(fastReflection_MsgPatchDidDocPayload)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateDidDocResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateDidDocResponse)(x)
+func (x *fastReflection_MsgPatchDidDocPayload) Interface() protoreflect.ProtoMessage {
+	return (*MsgPatchDidDocPayload)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6371,10 +8773,34 @@ func (x *fastReflection_MsgUpdateDidDocResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Value != nil {
-		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
-		if !f(fd_MsgUpdateDidDocResponse_value, value) {
+func (x *fastReflection_MsgPatchDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgPatchDidDocPayload_id, value) {
+			return
+		}
+	}
+	if len(x.Operations) != 0 {
+		value := protoreflect.ValueOfList(&_MsgPatchDidDocPayload_2_list{list: &x.Operations})
+		if !f(fd_MsgPatchDidDocPayload_operations, value) {
+			return
+		}
+	}
+	if x.PreviousVersionId != "" {
+		value := protoreflect.ValueOfString(x.PreviousVersionId)
+		if !f(fd_MsgPatchDidDocPayload_previous_version_id, value) {
+			return
+		}
+	}
+	if x.VersionId != "" {
+		value := protoreflect.ValueOfString(x.VersionId)
+		if !f(fd_MsgPatchDidDocPayload_version_id, value) {
+			return
+		}
+	}
+	if x.NextKeyCommitment != "" {
+		value := protoreflect.ValueOfString(x.NextKeyCommitment)
+		if !f(fd_MsgPatchDidDocPayload_next_key_commitment, value) {
 			return
 		}
 	}
@@ -6391,15 +8817,23 @@ func (x *fastReflection_MsgUpdateDidDocResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgPatchDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
-		return x.Value != nil
+	case "cheqd.did.v2.MsgPatchDidDocPayload.id":
+		return x.Id != ""
+	case "cheqd.did.v2.MsgPatchDidDocPayload.operations":
+		return len(x.Operations) != 0
+	case "cheqd.did.v2.MsgPatchDidDocPayload.previous_version_id":
+		return x.PreviousVersionId != ""
+	case "cheqd.did.v2.MsgPatchDidDocPayload.version_id":
+		return x.VersionId != ""
+	case "cheqd.did.v2.MsgPatchDidDocPayload.next_key_commitment":
+		return x.NextKeyCommitment != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocPayload"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocPayload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6409,15 +8843,23 @@ func (x *fastReflection_MsgUpdateDidDocResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgPatchDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
-		x.Value = nil
+	case "cheqd.did.v2.MsgPatchDidDocPayload.id":
+		x.Id = ""
+	case "cheqd.did.v2.MsgPatchDidDocPayload.operations":
+		x.Operations = nil
+	case "cheqd.did.v2.MsgPatchDidDocPayload.previous_version_id":
+		x.PreviousVersionId = ""
+	case "cheqd.did.v2.MsgPatchDidDocPayload.version_id":
+		x.VersionId = ""
+	case "cheqd.did.v2.MsgPatchDidDocPayload.next_key_commitment":
+		x.NextKeyCommitment = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocPayload"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocPayload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6427,16 +8869,31 @@ func (x *fastReflection_MsgUpdateDidDocResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
-		value := x.Value
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.MsgPatchDidDocPayload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.operations":
+		if len(x.Operations) == 0 {
+			return protoreflect.ValueOfList(&_MsgPatchDidDocPayload_2_list{})
+		}
+		listValue := &_MsgPatchDidDocPayload_2_list{list: &x.Operations}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.previous_version_id":
+		value := x.PreviousVersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.next_key_commitment":
+		value := x.NextKeyCommitment
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocPayload"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocPayload does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6450,15 +8907,25 @@ func (x *fastReflection_MsgUpdateDidDocResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgPatchDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
-		x.Value = value.Message().Interface().(*DidDocWithMetadata)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.operations":
+		lv := value.List()
+		clv := lv.(*_MsgPatchDidDocPayload_2_list)
+		x.Operations = *clv.list
+	case "cheqd.did.v2.MsgPatchDidDocPayload.previous_version_id":
+		x.PreviousVersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.version_id":
+		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.next_key_commitment":
+		x.NextKeyCommitment = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocPayload"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocPayload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6472,44 +8939,61 @@ func (x *fastReflection_MsgUpdateDidDocResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
-		if x.Value == nil {
-			x.Value = new(DidDocWithMetadata)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.operations":
+		if x.Operations == nil {
+			x.Operations = []*PatchOperation{}
 		}
-		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+		value := &_MsgPatchDidDocPayload_2_list{list: &x.Operations}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.did.v2.MsgPatchDidDocPayload.id":
+		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgPatchDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgPatchDidDocPayload.previous_version_id":
+		panic(fmt.Errorf("field previous_version_id of message cheqd.did.v2.MsgPatchDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgPatchDidDocPayload.version_id":
+		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgPatchDidDocPayload is not mutable"))
+	case "cheqd.did.v2.MsgPatchDidDocPayload.next_key_commitment":
+		panic(fmt.Errorf("field next_key_commitment of message cheqd.did.v2.MsgPatchDidDocPayload is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocPayload"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocPayload does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgUpdateDidDocResponse.value":
-		m := new(DidDocWithMetadata)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.MsgPatchDidDocPayload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgPatchDidDocPayload.operations":
+		list := []*PatchOperation{}
+		return protoreflect.ValueOfList(&_MsgPatchDidDocPayload_2_list{list: &list})
+	case "cheqd.did.v2.MsgPatchDidDocPayload.previous_version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgPatchDidDocPayload.version_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.MsgPatchDidDocPayload.next_key_commitment":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgUpdateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocPayload"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgUpdateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocPayload does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgPatchDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgUpdateDidDocResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgPatchDidDocPayload", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6517,7 +9001,7 @@ func (x *fastReflection_MsgUpdateDidDocResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateDidDocResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgPatchDidDocPayload) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6528,7 +9012,7 @@ func (x *fastReflection_MsgUpdateDidDocResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgPatchDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6540,7 +9024,7 @@ func (x *fastReflection_MsgUpdateDidDocResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateDidDocResponse) IsValid() bool {
+func (x *fastReflection_MsgPatchDidDocPayload) IsValid() bool {
 	return x != nil
 }
 
@@ -6550,9 +9034,9 @@ func (x *fastReflection_MsgUpdateDidDocResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgPatchDidDocPayload) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateDidDocResponse)
+		x := input.Message.Interface().(*MsgPatchDidDocPayload)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6564,8 +9048,26 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Value != nil {
-			l = options.Size(x.Value)
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Operations) > 0 {
+			for _, e := range x.Operations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PreviousVersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VersionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NextKeyCommitment)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6578,7 +9080,7 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateDidDocResponse)
+		x := input.Message.Interface().(*MsgPatchDidDocPayload)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6597,17 +9099,47 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Value != nil {
-			encoded, err := options.Marshal(x.Value)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.NextKeyCommitment) > 0 {
+			i -= len(x.NextKeyCommitment)
+			copy(dAtA[i:], x.NextKeyCommitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextKeyCommitment)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.VersionId) > 0 {
+			i -= len(x.VersionId)
+			copy(dAtA[i:], x.VersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PreviousVersionId) > 0 {
+			i -= len(x.PreviousVersionId)
+			copy(dAtA[i:], x.PreviousVersionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousVersionId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Operations) > 0 {
+			for iNdEx := len(x.Operations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Operations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6622,7 +9154,7 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateDidDocResponse)
+		x := input.Message.Interface().(*MsgPatchDidDocPayload)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6654,15 +9186,47 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDidDocResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPatchDidDocPayload: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPatchDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6674,27 +9238,121 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operations = append(x.Operations, &PatchOperation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Operations[len(x.Operations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Value == nil {
-					x.Value = &DidDocWithMetadata{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6732,28 +9390,38 @@ func (x *fastReflection_MsgUpdateDidDocResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_MsgDeactivateDidDocPayload            protoreflect.MessageDescriptor
-	fd_MsgDeactivateDidDocPayload_id         protoreflect.FieldDescriptor
-	fd_MsgDeactivateDidDocPayload_version_id protoreflect.FieldDescriptor
+	md_PatchOperation                           protoreflect.MessageDescriptor
+	fd_PatchOperation_op                        protoreflect.FieldDescriptor
+	fd_PatchOperation_path                      protoreflect.FieldDescriptor
+	fd_PatchOperation_verification_method       protoreflect.FieldDescriptor
+	fd_PatchOperation_verification_relationship protoreflect.FieldDescriptor
+	fd_PatchOperation_service                   protoreflect.FieldDescriptor
+	fd_PatchOperation_also_known_as             protoreflect.FieldDescriptor
+	fd_PatchOperation_context                   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgDeactivateDidDocPayload = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocPayload")
-	fd_MsgDeactivateDidDocPayload_id = md_MsgDeactivateDidDocPayload.Fields().ByName("id")
-	fd_MsgDeactivateDidDocPayload_version_id = md_MsgDeactivateDidDocPayload.Fields().ByName("version_id")
+	md_PatchOperation = File_cheqd_did_v2_tx_proto.Messages().ByName("PatchOperation")
+	fd_PatchOperation_op = md_PatchOperation.Fields().ByName("op")
+	fd_PatchOperation_path = md_PatchOperation.Fields().ByName("path")
+	fd_PatchOperation_verification_method = md_PatchOperation.Fields().ByName("verification_method")
+	fd_PatchOperation_verification_relationship = md_PatchOperation.Fields().ByName("verification_relationship")
+	fd_PatchOperation_service = md_PatchOperation.Fields().ByName("service")
+	fd_PatchOperation_also_known_as = md_PatchOperation.Fields().ByName("also_known_as")
+	fd_PatchOperation_context = md_PatchOperation.Fields().ByName("context")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+var _ protoreflect.Message = (*fastReflection_PatchOperation)(nil)
 
-type fastReflection_MsgDeactivateDidDocPayload MsgDeactivateDidDocPayload
+type fastReflection_PatchOperation PatchOperation
 
-func (x *MsgDeactivateDidDocPayload) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocPayload)(x)
+func (x *PatchOperation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PatchOperation)(x)
 }
 
-func (x *MsgDeactivateDidDocPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
+func (x *PatchOperation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6764,43 +9432,43 @@ func (x *MsgDeactivateDidDocPayload) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeactivateDidDocPayload_messageType fastReflection_MsgDeactivateDidDocPayload_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocPayload_messageType{}
+var _fastReflection_PatchOperation_messageType fastReflection_PatchOperation_messageType
+var _ protoreflect.MessageType = fastReflection_PatchOperation_messageType{}
 
-type fastReflection_MsgDeactivateDidDocPayload_messageType struct{}
+type fastReflection_PatchOperation_messageType struct{}
 
-func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocPayload)(nil)
+func (x fastReflection_PatchOperation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PatchOperation)(nil)
 }
-func (x fastReflection_MsgDeactivateDidDocPayload_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocPayload)
+func (x fastReflection_PatchOperation_messageType) New() protoreflect.Message {
+	return new(fastReflection_PatchOperation)
 }
-func (x fastReflection_MsgDeactivateDidDocPayload_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocPayload
+func (x fastReflection_PatchOperation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PatchOperation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocPayload
+func (x *fastReflection_PatchOperation) Descriptor() protoreflect.MessageDescriptor {
+	return md_PatchOperation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeactivateDidDocPayload_messageType
+func (x *fastReflection_PatchOperation) Type() protoreflect.MessageType {
+	return _fastReflection_PatchOperation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeactivateDidDocPayload) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocPayload)
+func (x *fastReflection_PatchOperation) New() protoreflect.Message {
+	return new(fastReflection_PatchOperation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeactivateDidDocPayload)(x)
+func (x *fastReflection_PatchOperation) Interface() protoreflect.ProtoMessage {
+	return (*PatchOperation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6808,16 +9476,46 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_MsgDeactivateDidDocPayload_id, value) {
+func (x *fastReflection_PatchOperation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Op != "" {
+		value := protoreflect.ValueOfString(x.Op)
+		if !f(fd_PatchOperation_op, value) {
 			return
 		}
 	}
-	if x.VersionId != "" {
-		value := protoreflect.ValueOfString(x.VersionId)
-		if !f(fd_MsgDeactivateDidDocPayload_version_id, value) {
+	if x.Path != "" {
+		value := protoreflect.ValueOfString(x.Path)
+		if !f(fd_PatchOperation_path, value) {
+			return
+		}
+	}
+	if x.VerificationMethod != nil {
+		value := protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
+		if !f(fd_PatchOperation_verification_method, value) {
+			return
+		}
+	}
+	if x.VerificationRelationship != nil {
+		value := protoreflect.ValueOfMessage(x.VerificationRelationship.ProtoReflect())
+		if !f(fd_PatchOperation_verification_relationship, value) {
+			return
+		}
+	}
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_PatchOperation_service, value) {
+			return
+		}
+	}
+	if x.AlsoKnownAs != "" {
+		value := protoreflect.ValueOfString(x.AlsoKnownAs)
+		if !f(fd_PatchOperation_also_known_as, value) {
+			return
+		}
+	}
+	if x.Context != "" {
+		value := protoreflect.ValueOfString(x.Context)
+		if !f(fd_PatchOperation_context, value) {
 			return
 		}
 	}
@@ -6834,17 +9532,27 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PatchOperation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		return x.Id != ""
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		return x.VersionId != ""
+	case "cheqd.did.v2.PatchOperation.op":
+		return x.Op != ""
+	case "cheqd.did.v2.PatchOperation.path":
+		return x.Path != ""
+	case "cheqd.did.v2.PatchOperation.verification_method":
+		return x.VerificationMethod != nil
+	case "cheqd.did.v2.PatchOperation.verification_relationship":
+		return x.VerificationRelationship != nil
+	case "cheqd.did.v2.PatchOperation.service":
+		return x.Service != nil
+	case "cheqd.did.v2.PatchOperation.also_known_as":
+		return x.AlsoKnownAs != ""
+	case "cheqd.did.v2.PatchOperation.context":
+		return x.Context != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PatchOperation"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PatchOperation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6854,17 +9562,27 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PatchOperation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		x.Id = ""
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		x.VersionId = ""
+	case "cheqd.did.v2.PatchOperation.op":
+		x.Op = ""
+	case "cheqd.did.v2.PatchOperation.path":
+		x.Path = ""
+	case "cheqd.did.v2.PatchOperation.verification_method":
+		x.VerificationMethod = nil
+	case "cheqd.did.v2.PatchOperation.verification_relationship":
+		x.VerificationRelationship = nil
+	case "cheqd.did.v2.PatchOperation.service":
+		x.Service = nil
+	case "cheqd.did.v2.PatchOperation.also_known_as":
+		x.AlsoKnownAs = ""
+	case "cheqd.did.v2.PatchOperation.context":
+		x.Context = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PatchOperation"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PatchOperation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6874,19 +9592,34 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PatchOperation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		value := x.Id
+	case "cheqd.did.v2.PatchOperation.op":
+		value := x.Op
 		return protoreflect.ValueOfString(value)
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		value := x.VersionId
+	case "cheqd.did.v2.PatchOperation.path":
+		value := x.Path
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.PatchOperation.verification_method":
+		value := x.VerificationMethod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.verification_relationship":
+		value := x.VerificationRelationship
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.also_known_as":
+		value := x.AlsoKnownAs
+		return protoreflect.ValueOfString(value)
+	case "cheqd.did.v2.PatchOperation.context":
+		value := x.Context
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PatchOperation"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PatchOperation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6900,17 +9633,27 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PatchOperation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		x.Id = value.Interface().(string)
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		x.VersionId = value.Interface().(string)
+	case "cheqd.did.v2.PatchOperation.op":
+		x.Op = value.Interface().(string)
+	case "cheqd.did.v2.PatchOperation.path":
+		x.Path = value.Interface().(string)
+	case "cheqd.did.v2.PatchOperation.verification_method":
+		x.VerificationMethod = value.Message().Interface().(*VerificationMethod)
+	case "cheqd.did.v2.PatchOperation.verification_relationship":
+		x.VerificationRelationship = value.Message().Interface().(*VerificationRelationship)
+	case "cheqd.did.v2.PatchOperation.service":
+		x.Service = value.Message().Interface().(*Service)
+	case "cheqd.did.v2.PatchOperation.also_known_as":
+		x.AlsoKnownAs = value.Interface().(string)
+	case "cheqd.did.v2.PatchOperation.context":
+		x.Context = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PatchOperation"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PatchOperation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6924,44 +9667,76 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PatchOperation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
-		panic(fmt.Errorf("field id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
-		panic(fmt.Errorf("field version_id of message cheqd.did.v2.MsgDeactivateDidDocPayload is not mutable"))
+	case "cheqd.did.v2.PatchOperation.verification_method":
+		if x.VerificationMethod == nil {
+			x.VerificationMethod = new(VerificationMethod)
+		}
+		return protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.verification_relationship":
+		if x.VerificationRelationship == nil {
+			x.VerificationRelationship = new(VerificationRelationship)
+		}
+		return protoreflect.ValueOfMessage(x.VerificationRelationship.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.service":
+		if x.Service == nil {
+			x.Service = new(Service)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.op":
+		panic(fmt.Errorf("field op of message cheqd.did.v2.PatchOperation is not mutable"))
+	case "cheqd.did.v2.PatchOperation.path":
+		panic(fmt.Errorf("field path of message cheqd.did.v2.PatchOperation is not mutable"))
+	case "cheqd.did.v2.PatchOperation.also_known_as":
+		panic(fmt.Errorf("field also_known_as of message cheqd.did.v2.PatchOperation is not mutable"))
+	case "cheqd.did.v2.PatchOperation.context":
+		panic(fmt.Errorf("field context of message cheqd.did.v2.PatchOperation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PatchOperation"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PatchOperation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeactivateDidDocPayload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PatchOperation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.id":
+	case "cheqd.did.v2.PatchOperation.op":
 		return protoreflect.ValueOfString("")
-	case "cheqd.did.v2.MsgDeactivateDidDocPayload.version_id":
+	case "cheqd.did.v2.PatchOperation.path":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.PatchOperation.verification_method":
+		m := new(VerificationMethod)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.verification_relationship":
+		m := new(VerificationRelationship)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.service":
+		m := new(Service)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.did.v2.PatchOperation.also_known_as":
+		return protoreflect.ValueOfString("")
+	case "cheqd.did.v2.PatchOperation.context":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocPayload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.PatchOperation"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocPayload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.PatchOperation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeactivateDidDocPayload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PatchOperation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocPayload", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.PatchOperation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6969,7 +9744,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeactivateDidDocPayload) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PatchOperation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6980,7 +9755,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocPayload) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PatchOperation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6992,7 +9767,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeactivateDidDocPayload) IsValid() bool {
+func (x *fastReflection_PatchOperation) IsValid() bool {
 	return x != nil
 }
 
@@ -7002,9 +9777,9 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PatchOperation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		x := input.Message.Interface().(*PatchOperation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7016,11 +9791,31 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
+		l = len(x.Op)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VersionId)
+		l = len(x.Path)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VerificationMethod != nil {
+			l = options.Size(x.VerificationMethod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VerificationRelationship != nil {
+			l = options.Size(x.VerificationRelationship)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Service != nil {
+			l = options.Size(x.Service)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AlsoKnownAs)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Context)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7034,7 +9829,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		x := input.Message.Interface().(*PatchOperation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7053,17 +9848,73 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VersionId) > 0 {
-			i -= len(x.VersionId)
-			copy(dAtA[i:], x.VersionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionId)))
+		if len(x.Context) > 0 {
+			i -= len(x.Context)
+			copy(dAtA[i:], x.Context)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Context)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			i -= len(x.AlsoKnownAs)
+			copy(dAtA[i:], x.AlsoKnownAs)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlsoKnownAs)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.VerificationRelationship != nil {
+			encoded, err := options.Marshal(x.VerificationRelationship)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.VerificationMethod != nil {
+			encoded, err := options.Marshal(x.VerificationMethod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Path) > 0 {
+			i -= len(x.Path)
+			copy(dAtA[i:], x.Path)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Path)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if len(x.Op) > 0 {
+			i -= len(x.Op)
+			copy(dAtA[i:], x.Op)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Op)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7078,7 +9929,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocPayload)
+		x := input.Message.Interface().(*PatchOperation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7097,28 +9948,200 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PatchOperation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PatchOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Op = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Path = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VerificationMethod == nil {
+					x.VerificationMethod = &VerificationMethod{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationMethod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationRelationship", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VerificationRelationship == nil {
+					x.VerificationRelationship = &VerificationRelationship{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationRelationship); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Service == nil {
+					x.Service = &Service{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7146,11 +10169,11 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				x.AlsoKnownAs = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7178,7 +10201,7 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VersionId = string(dAtA[iNdEx:postIndex])
+				x.Context = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7216,26 +10239,26 @@ func (x *fastReflection_MsgDeactivateDidDocPayload) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgDeactivateDidDocResponse       protoreflect.MessageDescriptor
-	fd_MsgDeactivateDidDocResponse_value protoreflect.FieldDescriptor
+	md_MsgPatchDidDocResponse       protoreflect.MessageDescriptor
+	fd_MsgPatchDidDocResponse_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cheqd_did_v2_tx_proto_init()
-	md_MsgDeactivateDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgDeactivateDidDocResponse")
-	fd_MsgDeactivateDidDocResponse_value = md_MsgDeactivateDidDocResponse.Fields().ByName("value")
+	md_MsgPatchDidDocResponse = File_cheqd_did_v2_tx_proto.Messages().ByName("MsgPatchDidDocResponse")
+	fd_MsgPatchDidDocResponse_value = md_MsgPatchDidDocResponse.Fields().ByName("value")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgPatchDidDocResponse)(nil)
 
-type fastReflection_MsgDeactivateDidDocResponse MsgDeactivateDidDocResponse
+type fastReflection_MsgPatchDidDocResponse MsgPatchDidDocResponse
 
-func (x *MsgDeactivateDidDocResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocResponse)(x)
+func (x *MsgPatchDidDocResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPatchDidDocResponse)(x)
 }
 
-func (x *MsgDeactivateDidDocResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
+func (x *MsgPatchDidDocResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_did_v2_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7246,43 +10269,43 @@ func (x *MsgDeactivateDidDocResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeactivateDidDocResponse_messageType fastReflection_MsgDeactivateDidDocResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeactivateDidDocResponse_messageType{}
+var _fastReflection_MsgPatchDidDocResponse_messageType fastReflection_MsgPatchDidDocResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPatchDidDocResponse_messageType{}
 
-type fastReflection_MsgDeactivateDidDocResponse_messageType struct{}
+type fastReflection_MsgPatchDidDocResponse_messageType struct{}
 
-func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeactivateDidDocResponse)(nil)
+func (x fastReflection_MsgPatchDidDocResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPatchDidDocResponse)(nil)
 }
-func (x fastReflection_MsgDeactivateDidDocResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocResponse)
+func (x fastReflection_MsgPatchDidDocResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPatchDidDocResponse)
 }
-func (x fastReflection_MsgDeactivateDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocResponse
+func (x fastReflection_MsgPatchDidDocResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPatchDidDocResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeactivateDidDocResponse
+func (x *fastReflection_MsgPatchDidDocResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPatchDidDocResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeactivateDidDocResponse_messageType
+func (x *fastReflection_MsgPatchDidDocResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPatchDidDocResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeactivateDidDocResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDeactivateDidDocResponse)
+func (x *fastReflection_MsgPatchDidDocResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPatchDidDocResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeactivateDidDocResponse)(x)
+func (x *fastReflection_MsgPatchDidDocResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPatchDidDocResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7290,10 +10313,10 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgPatchDidDocResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Value != nil {
 		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
-		if !f(fd_MsgDeactivateDidDocResponse_value, value) {
+		if !f(fd_MsgPatchDidDocResponse_value, value) {
 			return
 		}
 	}
@@ -7310,15 +10333,15 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgPatchDidDocResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+	case "cheqd.did.v2.MsgPatchDidDocResponse.value":
 		return x.Value != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7328,15 +10351,15 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgPatchDidDocResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+	case "cheqd.did.v2.MsgPatchDidDocResponse.value":
 		x.Value = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7346,16 +10369,16 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDocResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+	case "cheqd.did.v2.MsgPatchDidDocResponse.value":
 		value := x.Value
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7369,15 +10392,15 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgPatchDidDocResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+	case "cheqd.did.v2.MsgPatchDidDocResponse.value":
 		x.Value = value.Message().Interface().(*DidDocWithMetadata)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7391,44 +10414,44 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDocResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+	case "cheqd.did.v2.MsgPatchDidDocResponse.value":
 		if x.Value == nil {
 			x.Value = new(DidDocWithMetadata)
 		}
 		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeactivateDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgPatchDidDocResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cheqd.did.v2.MsgDeactivateDidDocResponse.value":
+	case "cheqd.did.v2.MsgPatchDidDocResponse.value":
 		m := new(DidDocWithMetadata)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgDeactivateDidDocResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.did.v2.MsgPatchDidDocResponse"))
 		}
-		panic(fmt.Errorf("message cheqd.did.v2.MsgDeactivateDidDocResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cheqd.did.v2.MsgPatchDidDocResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeactivateDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgPatchDidDocResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgDeactivateDidDocResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.did.v2.MsgPatchDidDocResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7436,7 +10459,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeactivateDidDocResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgPatchDidDocResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7447,7 +10470,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeactivateDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgPatchDidDocResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7459,7 +10482,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeactivateDidDocResponse) IsValid() bool {
+func (x *fastReflection_MsgPatchDidDocResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7469,9 +10492,9 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgPatchDidDocResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		x := input.Message.Interface().(*MsgPatchDidDocResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7497,7 +10520,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		x := input.Message.Interface().(*MsgPatchDidDocResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7541,7 +10564,7 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeactivateDidDocResponse)
+		x := input.Message.Interface().(*MsgPatchDidDocResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7573,10 +10596,10 @@ func (x *fastReflection_MsgDeactivateDidDocResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPatchDidDocResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeactivateDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPatchDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7804,6 +10827,53 @@ func (x *MsgDeactivateDidDoc) GetSignatures() []*SignInfo {
 	return nil
 }
 
+// MsgPatchDidDoc defines the Msg/PatchDidDoc request type.
+// It describes the parameters of a request for partially updating an existing DID document.
+type MsgPatchDidDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payload containing the patch to be applied to the DID Document
+	Payload *MsgPatchDidDocPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the DID Document's controller(s)
+	Signatures []*SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgPatchDidDoc) Reset() {
+	*x = MsgPatchDidDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPatchDidDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPatchDidDoc) ProtoMessage() {}
+
+// Deprecated: Use MsgPatchDidDoc.ProtoReflect.Descriptor instead.
+func (*MsgPatchDidDoc) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgPatchDidDoc) GetPayload() *MsgPatchDidDocPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MsgPatchDidDoc) GetSignatures() []*SignInfo {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 // SignInfo defines the structure of a DID Document controller's signature
 type SignInfo struct {
 	state         protoimpl.MessageState
@@ -7814,12 +10884,16 @@ type SignInfo struct {
 	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 	// Signature of the DID Document controller
 	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Version ID of the DID Document the verification method is taken from (optional).
+	// Tells the existing and the updated versions of a DID Document apart during update.
+	// If not set, the signature is checked against each version the signer is required for.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 }
 
 func (x *SignInfo) Reset() {
 	*x = SignInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[3]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7833,7 +10907,7 @@ func (*SignInfo) ProtoMessage() {}
 
 // Deprecated: Use SignInfo.ProtoReflect.Descriptor instead.
 func (*SignInfo) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{3}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SignInfo) GetVerificationMethodId() string {
@@ -7850,6 +10924,13 @@ func (x *SignInfo) GetSignature() []byte {
 	return nil
 }
 
+func (x *SignInfo) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
 // MsgCreateDidDocPayload defines the structure of the payload for creating a new DID document
 type MsgCreateDidDocPayload struct {
 	state         protoimpl.MessageState
@@ -7863,6 +10944,8 @@ type MsgCreateDidDocPayload struct {
 	// Format: did:canow:<namespace>:<unique-identifier>
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// controller is a list of DIDs that are allowed to control the DID document.
+	// Besides did:canow DIDs of the ledger, did:key and did:jwk DIDs are allowed. Their DID documents
+	// are derived from the DIDs and aren't written to the ledger.
 	Controller []string `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
 	// verificationMethod is a list of verification methods that can be used to
 	// verify a digital signature or cryptographic proof.
@@ -7903,12 +10986,20 @@ type MsgCreateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// controllerThreshold is the minimal number of controllers that have to sign changes of the DID Document. OPTIONAL.
+	// Default: 0, which means that all controllers have to sign.
+	ControllerThreshold uint32 `protobuf:"varint,13,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
+	// nextKeyCommitment is the pre-rotation commitment to the next authentication key set. OPTIONAL.
+	// Can be generated with `generate-next-key-commitment` command.
+	//
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	NextKeyCommitment string `protobuf:"bytes,14,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
 }
 
 func (x *MsgCreateDidDocPayload) Reset() {
 	*x = MsgCreateDidDocPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[4]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7922,7 +11013,7 @@ func (*MsgCreateDidDocPayload) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateDidDocPayload.ProtoReflect.Descriptor instead.
 func (*MsgCreateDidDocPayload) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{4}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MsgCreateDidDocPayload) GetContext() []string {
@@ -7992,19 +11083,33 @@ func (x *MsgCreateDidDocPayload) GetService() []*Service {
 	if x != nil {
 		return x.Service
 	}
-	return nil
+	return nil
+}
+
+func (x *MsgCreateDidDocPayload) GetAlsoKnownAs() []string {
+	if x != nil {
+		return x.AlsoKnownAs
+	}
+	return nil
+}
+
+func (x *MsgCreateDidDocPayload) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
 }
 
-func (x *MsgCreateDidDocPayload) GetAlsoKnownAs() []string {
+func (x *MsgCreateDidDocPayload) GetControllerThreshold() uint32 {
 	if x != nil {
-		return x.AlsoKnownAs
+		return x.ControllerThreshold
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgCreateDidDocPayload) GetVersionId() string {
+func (x *MsgCreateDidDocPayload) GetNextKeyCommitment() string {
 	if x != nil {
-		return x.VersionId
+		return x.NextKeyCommitment
 	}
 	return ""
 }
@@ -8022,7 +11127,7 @@ type MsgCreateDidDocResponse struct {
 func (x *MsgCreateDidDocResponse) Reset() {
 	*x = MsgCreateDidDocResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[5]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8036,7 +11141,7 @@ func (*MsgCreateDidDocResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateDidDocResponse.ProtoReflect.Descriptor instead.
 func (*MsgCreateDidDocResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{5}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MsgCreateDidDocResponse) GetValue() *DidDocWithMetadata {
@@ -8059,6 +11164,8 @@ type MsgUpdateDidDocPayload struct {
 	// Format: did:canow:<namespace>:<unique-identifier>
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// controller is a list of DIDs that are allowed to control the DID document.
+	// Besides did:canow DIDs of the ledger, did:key and did:jwk DIDs are allowed. Their DID documents
+	// are derived from the DIDs and aren't written to the ledger.
 	Controller []string `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
 	// verificationMethod is a list of verification methods that can be used to
 	// verify a digital signature or cryptographic proof.
@@ -8100,12 +11207,28 @@ type MsgUpdateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Version ID of the DID Document the update is based on. OPTIONAL.
+	// If set, the update is rejected unless it is the latest version of the DID Document.
+	//
+	// Format: <uuid>
+	PreviousVersionId string `protobuf:"bytes,13,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// controllerThreshold is the minimal number of controllers that have to sign changes of the DID Document. OPTIONAL.
+	// Default: 0, which means that all controllers have to sign.
+	//
+	// The update has to satisfy thresholds of both the existing and the updated versions.
+	ControllerThreshold uint32 `protobuf:"varint,14,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
+	// nextKeyCommitment is the pre-rotation commitment to the next authentication key set. OPTIONAL.
+	// If the existing version has a commitment, it can be replaced only together with rotating the committed keys.
+	// Otherwise, the existing commitment is kept and this field has to be either empty or equal to it.
+	//
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	NextKeyCommitment string `protobuf:"bytes,15,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
 }
 
 func (x *MsgUpdateDidDocPayload) Reset() {
 	*x = MsgUpdateDidDocPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[6]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8119,7 +11242,7 @@ func (*MsgUpdateDidDocPayload) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateDidDocPayload.ProtoReflect.Descriptor instead.
 func (*MsgUpdateDidDocPayload) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{6}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MsgUpdateDidDocPayload) GetContext() []string {
@@ -8206,6 +11329,27 @@ func (x *MsgUpdateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (x *MsgUpdateDidDocPayload) GetPreviousVersionId() string {
+	if x != nil {
+		return x.PreviousVersionId
+	}
+	return ""
+}
+
+func (x *MsgUpdateDidDocPayload) GetControllerThreshold() uint32 {
+	if x != nil {
+		return x.ControllerThreshold
+	}
+	return 0
+}
+
+func (x *MsgUpdateDidDocPayload) GetNextKeyCommitment() string {
+	if x != nil {
+		return x.NextKeyCommitment
+	}
+	return ""
+}
+
 type MsgUpdateDidDocResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -8218,7 +11362,7 @@ type MsgUpdateDidDocResponse struct {
 func (x *MsgUpdateDidDocResponse) Reset() {
 	*x = MsgUpdateDidDocResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[7]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8232,7 +11376,7 @@ func (*MsgUpdateDidDocResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateDidDocResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateDidDocResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{7}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MsgUpdateDidDocResponse) GetValue() *DidDocWithMetadata {
@@ -8253,12 +11397,17 @@ type MsgDeactivateDidDocPayload struct {
 	// Version ID of the DID Document to be deactivated
 	// This is primarily used as a sanity check to ensure that the correct DID Document is being deactivated.
 	VersionId string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Version ID of the DID Document the deactivation is based on. OPTIONAL.
+	// If set, the deactivation is rejected unless it is the latest version of the DID Document.
+	//
+	// Format: <uuid>
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
 }
 
 func (x *MsgDeactivateDidDocPayload) Reset() {
 	*x = MsgDeactivateDidDocPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[8]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8272,7 +11421,7 @@ func (*MsgDeactivateDidDocPayload) ProtoMessage() {}
 
 // Deprecated: Use MsgDeactivateDidDocPayload.ProtoReflect.Descriptor instead.
 func (*MsgDeactivateDidDocPayload) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{8}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MsgDeactivateDidDocPayload) GetId() string {
@@ -8289,6 +11438,13 @@ func (x *MsgDeactivateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (x *MsgDeactivateDidDocPayload) GetPreviousVersionId() string {
+	if x != nil {
+		return x.PreviousVersionId
+	}
+	return ""
+}
+
 // MsgDeactivateDidDocResponse defines response type for Msg/DeactivateDidDoc.
 type MsgDeactivateDidDocResponse struct {
 	state         protoimpl.MessageState
@@ -8302,7 +11458,7 @@ type MsgDeactivateDidDocResponse struct {
 func (x *MsgDeactivateDidDocResponse) Reset() {
 	*x = MsgDeactivateDidDocResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cheqd_did_v2_tx_proto_msgTypes[9]
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8316,7 +11472,7 @@ func (*MsgDeactivateDidDocResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgDeactivateDidDocResponse.ProtoReflect.Descriptor instead.
 func (*MsgDeactivateDidDocResponse) Descriptor() ([]byte, []int) {
-	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{9}
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MsgDeactivateDidDocResponse) GetValue() *DidDocWithMetadata {
@@ -8326,6 +11482,225 @@ func (x *MsgDeactivateDidDocResponse) GetValue() *DidDocWithMetadata {
 	return nil
 }
 
+// MsgPatchDidDocPayload defines the structure of the payload for partially updating an existing DID document
+type MsgPatchDidDocPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID of the DID document.
+	// Format: did:canow:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// operations is the list of operations applied in order to the latest version of the DID Document
+	Operations []*PatchOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Version ID of the DID Document the patch is based on.
+	// The patch is rejected if it is not the latest version of the DID Document.
+	//
+	// Format: <uuid>
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// Version ID of the patched DID Document.
+	//
+	// Format: <uuid>
+	VersionId string `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// nextKeyCommitment is the pre-rotation commitment to the next authentication key set. OPTIONAL.
+	// The same rules as for DID Document update apply.
+	//
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	NextKeyCommitment string `protobuf:"bytes,5,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
+}
+
+func (x *MsgPatchDidDocPayload) Reset() {
+	*x = MsgPatchDidDocPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPatchDidDocPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPatchDidDocPayload) ProtoMessage() {}
+
+// Deprecated: Use MsgPatchDidDocPayload.ProtoReflect.Descriptor instead.
+func (*MsgPatchDidDocPayload) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgPatchDidDocPayload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MsgPatchDidDocPayload) GetOperations() []*PatchOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *MsgPatchDidDocPayload) GetPreviousVersionId() string {
+	if x != nil {
+		return x.PreviousVersionId
+	}
+	return ""
+}
+
+func (x *MsgPatchDidDocPayload) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *MsgPatchDidDocPayload) GetNextKeyCommitment() string {
+	if x != nil {
+		return x.NextKeyCommitment
+	}
+	return ""
+}
+
+// PatchOperation defines an RFC 6902 style operation on a list property of a DID Document.
+// Exactly one value field matching the path is required for add and replace operations.
+type PatchOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// op is the operation to apply: add, remove or replace
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// path is a JSON pointer to an item of a list property of the DID Document.
+	// Supported properties: verificationMethod, authentication, assertionMethod,
+	// capabilityInvocation, capabilityDelegation, keyAgreement, service, alsoKnownAs, context.
+	// The index "-" refers to the end of the list and can be used only with add.
+	//
+	// Examples:
+	// - /verificationMethod/-
+	// - /authentication/0
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// verification_method is the value for verificationMethod paths
+	VerificationMethod *VerificationMethod `protobuf:"bytes,3,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// verification_relationship is the value for authentication, assertionMethod,
+	// capabilityInvocation, capabilityDelegation and keyAgreement paths
+	VerificationRelationship *VerificationRelationship `protobuf:"bytes,4,opt,name=verification_relationship,json=verificationRelationship,proto3" json:"verification_relationship,omitempty"`
+	// service is the value for service paths
+	Service *Service `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	// also_known_as is the value for alsoKnownAs paths
+	AlsoKnownAs string `protobuf:"bytes,6,opt,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// context is the value for context paths
+	Context string `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *PatchOperation) Reset() {
+	*x = PatchOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchOperation) ProtoMessage() {}
+
+// Deprecated: Use PatchOperation.ProtoReflect.Descriptor instead.
+func (*PatchOperation) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PatchOperation) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *PatchOperation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PatchOperation) GetVerificationMethod() *VerificationMethod {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return nil
+}
+
+func (x *PatchOperation) GetVerificationRelationship() *VerificationRelationship {
+	if x != nil {
+		return x.VerificationRelationship
+	}
+	return nil
+}
+
+func (x *PatchOperation) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *PatchOperation) GetAlsoKnownAs() string {
+	if x != nil {
+		return x.AlsoKnownAs
+	}
+	return ""
+}
+
+func (x *PatchOperation) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+// MsgPatchDidDocResponse defines response type for Msg/PatchDidDoc.
+type MsgPatchDidDocResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Return the patched DID Document with metadata
+	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MsgPatchDidDocResponse) Reset() {
+	*x = MsgPatchDidDocResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_did_v2_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPatchDidDocResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPatchDidDocResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPatchDidDocResponse.ProtoReflect.Descriptor instead.
+func (*MsgPatchDidDocResponse) Descriptor() ([]byte, []int) {
+	return file_cheqd_did_v2_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgPatchDidDocResponse) GetValue() *DidDocWithMetadata {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 var File_cheqd_did_v2_tx_proto protoreflect.FileDescriptor
 
 var file_cheqd_did_v2_tx_proto_rawDesc = []byte{
@@ -8359,64 +11734,24 @@ var file_cheqd_did_v2_tx_proto_rawDesc = []byte{
 	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
 	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
 	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x08,
-	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xd3, 0x05, 0x0a,
-	0x16, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x12, 0x4e, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x68, 0x69, 0x70, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x5b, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64,
-	0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x14,
-	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x15, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x14, 0x63, 0x61, 0x70,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x4b, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
-	0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f,
-	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x22, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73,
-	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x41, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
-	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44,
-	0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd3, 0x05, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a,
+	0x0e, 0x4d, 0x73, 0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12,
+	0x3d, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x73, 0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x36,
+	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb6, 0x06, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
@@ -8461,51 +11796,167 @@ var file_cheqd_did_v2_tx_proto_rawDesc = []byte{
 	0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
 	0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
 	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
-	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4b,
-	0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44,
-	0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1b, 0x4d,
-	0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44,
-	0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57,
-	0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x32, 0x93, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x54, 0x0a, 0x0c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x54, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63,
+	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65, 0x78,
+	0x74, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x51,
+	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xe6, 0x06, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x4e, 0x0a, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x10, 0x61, 0x73, 0x73,
+	0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0f, 0x61, 0x73, 0x73,
+	0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x5b, 0x0a, 0x15,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68,
+	0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x52, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x15, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x73,
+	0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7b, 0x0a,
+	0x1a, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x51, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x63, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x18, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f,
+	0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x44,
+	0x69, 0x64, 0x44, 0x6f, 0x63, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xe6, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67,
+	0x12, 0x54, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63,
 	0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a,
 	0x25, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x29, 0x2e,
-	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x10,
 	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa4, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f, 0x63, 0x68,
-	0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65,
-	0x71, 0x64, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x32, 0xa2,
-	0x02, 0x03, 0x43, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x44, 0x69,
-	0x64, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64,
-	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c,
-	0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0e, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x21, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x69, 0x64,
+	0x44, 0x6f, 0x63, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x12, 0x1c, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x1a, 0x24, 0x2e, 0x63, 0x68,
+	0x65, 0x71, 0x64, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0xa7, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x32, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61,
+	0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x64,
+	0x69, 0x64, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x44,
+	0x58, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x32,
+	0xca, 0x02, 0x0c, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0xe2,
+	0x02, 0x18, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x32, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x43, 0x68, 0x65,
+	0x71, 0x64, 0x3a, 0x3a, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8520,58 +11971,71 @@ func file_cheqd_did_v2_tx_proto_rawDescGZIP() []byte {
 	return file_cheqd_did_v2_tx_proto_rawDescData
 }
 
-var file_cheqd_did_v2_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cheqd_did_v2_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_cheqd_did_v2_tx_proto_goTypes = []interface{}{
 	(*MsgCreateDidDoc)(nil),             // 0: cheqd.did.v2.MsgCreateDidDoc
 	(*MsgUpdateDidDoc)(nil),             // 1: cheqd.did.v2.MsgUpdateDidDoc
 	(*MsgDeactivateDidDoc)(nil),         // 2: cheqd.did.v2.MsgDeactivateDidDoc
-	(*SignInfo)(nil),                    // 3: cheqd.did.v2.SignInfo
-	(*MsgCreateDidDocPayload)(nil),      // 4: cheqd.did.v2.MsgCreateDidDocPayload
-	(*MsgCreateDidDocResponse)(nil),     // 5: cheqd.did.v2.MsgCreateDidDocResponse
-	(*MsgUpdateDidDocPayload)(nil),      // 6: cheqd.did.v2.MsgUpdateDidDocPayload
-	(*MsgUpdateDidDocResponse)(nil),     // 7: cheqd.did.v2.MsgUpdateDidDocResponse
-	(*MsgDeactivateDidDocPayload)(nil),  // 8: cheqd.did.v2.MsgDeactivateDidDocPayload
-	(*MsgDeactivateDidDocResponse)(nil), // 9: cheqd.did.v2.MsgDeactivateDidDocResponse
-	(*VerificationMethod)(nil),          // 10: cheqd.did.v2.VerificationMethod
-	(*VerificationRelationship)(nil),    // 11: cheqd.did.v2.VerificationRelationship
-	(*Service)(nil),                     // 12: cheqd.did.v2.Service
-	(*DidDocWithMetadata)(nil),          // 13: cheqd.did.v2.DidDocWithMetadata
+	(*MsgPatchDidDoc)(nil),              // 3: cheqd.did.v2.MsgPatchDidDoc
+	(*SignInfo)(nil),                    // 4: cheqd.did.v2.SignInfo
+	(*MsgCreateDidDocPayload)(nil),      // 5: cheqd.did.v2.MsgCreateDidDocPayload
+	(*MsgCreateDidDocResponse)(nil),     // 6: cheqd.did.v2.MsgCreateDidDocResponse
+	(*MsgUpdateDidDocPayload)(nil),      // 7: cheqd.did.v2.MsgUpdateDidDocPayload
+	(*MsgUpdateDidDocResponse)(nil),     // 8: cheqd.did.v2.MsgUpdateDidDocResponse
+	(*MsgDeactivateDidDocPayload)(nil),  // 9: cheqd.did.v2.MsgDeactivateDidDocPayload
+	(*MsgDeactivateDidDocResponse)(nil), // 10: cheqd.did.v2.MsgDeactivateDidDocResponse
+	(*MsgPatchDidDocPayload)(nil),       // 11: cheqd.did.v2.MsgPatchDidDocPayload
+	(*PatchOperation)(nil),              // 12: cheqd.did.v2.PatchOperation
+	(*MsgPatchDidDocResponse)(nil),      // 13: cheqd.did.v2.MsgPatchDidDocResponse
+	(*VerificationMethod)(nil),          // 14: cheqd.did.v2.VerificationMethod
+	(*VerificationRelationship)(nil),    // 15: cheqd.did.v2.VerificationRelationship
+	(*Service)(nil),                     // 16: cheqd.did.v2.Service
+	(*DidDocWithMetadata)(nil),          // 17: cheqd.did.v2.DidDocWithMetadata
 }
 var file_cheqd_did_v2_tx_proto_depIdxs = []int32{
-	4,  // 0: cheqd.did.v2.MsgCreateDidDoc.payload:type_name -> cheqd.did.v2.MsgCreateDidDocPayload
-	3,  // 1: cheqd.did.v2.MsgCreateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
-	6,  // 2: cheqd.did.v2.MsgUpdateDidDoc.payload:type_name -> cheqd.did.v2.MsgUpdateDidDocPayload
-	3,  // 3: cheqd.did.v2.MsgUpdateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
-	8,  // 4: cheqd.did.v2.MsgDeactivateDidDoc.payload:type_name -> cheqd.did.v2.MsgDeactivateDidDocPayload
-	3,  // 5: cheqd.did.v2.MsgDeactivateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
-	10, // 6: cheqd.did.v2.MsgCreateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
-	11, // 7: cheqd.did.v2.MsgCreateDidDocPayload.authentication:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 8: cheqd.did.v2.MsgCreateDidDocPayload.assertion_method:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 9: cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 10: cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 11: cheqd.did.v2.MsgCreateDidDocPayload.key_agreement:type_name -> cheqd.did.v2.VerificationRelationship
-	12, // 12: cheqd.did.v2.MsgCreateDidDocPayload.service:type_name -> cheqd.did.v2.Service
-	13, // 13: cheqd.did.v2.MsgCreateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
-	10, // 14: cheqd.did.v2.MsgUpdateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
-	11, // 15: cheqd.did.v2.MsgUpdateDidDocPayload.authentication:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 16: cheqd.did.v2.MsgUpdateDidDocPayload.assertion_method:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 17: cheqd.did.v2.MsgUpdateDidDocPayload.capability_invocation:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 18: cheqd.did.v2.MsgUpdateDidDocPayload.capability_delegation:type_name -> cheqd.did.v2.VerificationRelationship
-	11, // 19: cheqd.did.v2.MsgUpdateDidDocPayload.key_agreement:type_name -> cheqd.did.v2.VerificationRelationship
-	12, // 20: cheqd.did.v2.MsgUpdateDidDocPayload.service:type_name -> cheqd.did.v2.Service
-	13, // 21: cheqd.did.v2.MsgUpdateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
-	13, // 22: cheqd.did.v2.MsgDeactivateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
-	0,  // 23: cheqd.did.v2.Msg.CreateDidDoc:input_type -> cheqd.did.v2.MsgCreateDidDoc
-	1,  // 24: cheqd.did.v2.Msg.UpdateDidDoc:input_type -> cheqd.did.v2.MsgUpdateDidDoc
-	2,  // 25: cheqd.did.v2.Msg.DeactivateDidDoc:input_type -> cheqd.did.v2.MsgDeactivateDidDoc
-	5,  // 26: cheqd.did.v2.Msg.CreateDidDoc:output_type -> cheqd.did.v2.MsgCreateDidDocResponse
-	7,  // 27: cheqd.did.v2.Msg.UpdateDidDoc:output_type -> cheqd.did.v2.MsgUpdateDidDocResponse
-	9,  // 28: cheqd.did.v2.Msg.DeactivateDidDoc:output_type -> cheqd.did.v2.MsgDeactivateDidDocResponse
-	26, // [26:29] is the sub-list for method output_type
-	23, // [23:26] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	5,  // 0: cheqd.did.v2.MsgCreateDidDoc.payload:type_name -> cheqd.did.v2.MsgCreateDidDocPayload
+	4,  // 1: cheqd.did.v2.MsgCreateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
+	7,  // 2: cheqd.did.v2.MsgUpdateDidDoc.payload:type_name -> cheqd.did.v2.MsgUpdateDidDocPayload
+	4,  // 3: cheqd.did.v2.MsgUpdateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
+	9,  // 4: cheqd.did.v2.MsgDeactivateDidDoc.payload:type_name -> cheqd.did.v2.MsgDeactivateDidDocPayload
+	4,  // 5: cheqd.did.v2.MsgDeactivateDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
+	11, // 6: cheqd.did.v2.MsgPatchDidDoc.payload:type_name -> cheqd.did.v2.MsgPatchDidDocPayload
+	4,  // 7: cheqd.did.v2.MsgPatchDidDoc.signatures:type_name -> cheqd.did.v2.SignInfo
+	14, // 8: cheqd.did.v2.MsgCreateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	15, // 9: cheqd.did.v2.MsgCreateDidDocPayload.authentication:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 10: cheqd.did.v2.MsgCreateDidDocPayload.assertion_method:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 11: cheqd.did.v2.MsgCreateDidDocPayload.capability_invocation:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 12: cheqd.did.v2.MsgCreateDidDocPayload.capability_delegation:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 13: cheqd.did.v2.MsgCreateDidDocPayload.key_agreement:type_name -> cheqd.did.v2.VerificationRelationship
+	16, // 14: cheqd.did.v2.MsgCreateDidDocPayload.service:type_name -> cheqd.did.v2.Service
+	17, // 15: cheqd.did.v2.MsgCreateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	14, // 16: cheqd.did.v2.MsgUpdateDidDocPayload.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	15, // 17: cheqd.did.v2.MsgUpdateDidDocPayload.authentication:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 18: cheqd.did.v2.MsgUpdateDidDocPayload.assertion_method:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 19: cheqd.did.v2.MsgUpdateDidDocPayload.capability_invocation:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 20: cheqd.did.v2.MsgUpdateDidDocPayload.capability_delegation:type_name -> cheqd.did.v2.VerificationRelationship
+	15, // 21: cheqd.did.v2.MsgUpdateDidDocPayload.key_agreement:type_name -> cheqd.did.v2.VerificationRelationship
+	16, // 22: cheqd.did.v2.MsgUpdateDidDocPayload.service:type_name -> cheqd.did.v2.Service
+	17, // 23: cheqd.did.v2.MsgUpdateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	17, // 24: cheqd.did.v2.MsgDeactivateDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	12, // 25: cheqd.did.v2.MsgPatchDidDocPayload.operations:type_name -> cheqd.did.v2.PatchOperation
+	14, // 26: cheqd.did.v2.PatchOperation.verification_method:type_name -> cheqd.did.v2.VerificationMethod
+	15, // 27: cheqd.did.v2.PatchOperation.verification_relationship:type_name -> cheqd.did.v2.VerificationRelationship
+	16, // 28: cheqd.did.v2.PatchOperation.service:type_name -> cheqd.did.v2.Service
+	17, // 29: cheqd.did.v2.MsgPatchDidDocResponse.value:type_name -> cheqd.did.v2.DidDocWithMetadata
+	0,  // 30: cheqd.did.v2.Msg.CreateDidDoc:input_type -> cheqd.did.v2.MsgCreateDidDoc
+	1,  // 31: cheqd.did.v2.Msg.UpdateDidDoc:input_type -> cheqd.did.v2.MsgUpdateDidDoc
+	2,  // 32: cheqd.did.v2.Msg.DeactivateDidDoc:input_type -> cheqd.did.v2.MsgDeactivateDidDoc
+	3,  // 33: cheqd.did.v2.Msg.PatchDidDoc:input_type -> cheqd.did.v2.MsgPatchDidDoc
+	6,  // 34: cheqd.did.v2.Msg.CreateDidDoc:output_type -> cheqd.did.v2.MsgCreateDidDocResponse
+	8,  // 35: cheqd.did.v2.Msg.UpdateDidDoc:output_type -> cheqd.did.v2.MsgUpdateDidDocResponse
+	10, // 36: cheqd.did.v2.Msg.DeactivateDidDoc:output_type -> cheqd.did.v2.MsgDeactivateDidDocResponse
+	13, // 37: cheqd.did.v2.Msg.PatchDidDoc:output_type -> cheqd.did.v2.MsgPatchDidDocResponse
+	34, // [34:38] is the sub-list for method output_type
+	30, // [30:34] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_did_v2_tx_proto_init() }
@@ -8618,7 +12082,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInfo); i {
+			switch v := v.(*MsgPatchDidDoc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8630,7 +12094,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateDidDocPayload); i {
+			switch v := v.(*SignInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8642,7 +12106,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateDidDocResponse); i {
+			switch v := v.(*MsgCreateDidDocPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8654,7 +12118,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateDidDocPayload); i {
+			switch v := v.(*MsgCreateDidDocResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8666,7 +12130,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateDidDocResponse); i {
+			switch v := v.(*MsgUpdateDidDocPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8678,7 +12142,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeactivateDidDocPayload); i {
+			switch v := v.(*MsgUpdateDidDocResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8690,6 +12154,18 @@ func file_cheqd_did_v2_tx_proto_init() {
 			}
 		}
 		file_cheqd_did_v2_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeactivateDidDocPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgDeactivateDidDocResponse); i {
 			case 0:
 				return &v.state
@@ -8701,6 +12177,42 @@ func file_cheqd_did_v2_tx_proto_init() {
 				return nil
 			}
 		}
+		file_cheqd_did_v2_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPatchDidDocPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_did_v2_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPatchDidDocResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8708,7 +12220,7 @@ func file_cheqd_did_v2_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cheqd_did_v2_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	Msg_CreateDidDoc_FullMethodName     = "/cheqd.did.v2.Msg/CreateDidDoc"
 	Msg_UpdateDidDoc_FullMethodName     = "/cheqd.did.v2.Msg/UpdateDidDoc"
 	Msg_DeactivateDidDoc_FullMethodName = "/cheqd.did.v2.Msg/DeactivateDidDoc"
+	Msg_PatchDidDoc_FullMethodName      = "/cheqd.did.v2.Msg/PatchDidDoc"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +35,8 @@ type MsgClient interface {
 	UpdateDidDoc(ctx context.Context, in *MsgUpdateDidDoc, opts ...grpc.CallOption) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDoc, opts ...grpc.CallOption) (*MsgDeactivateDidDocResponse, error)
+	// PatchDidDoc defines a method for partially updating an existing DID document
+	PatchDidDoc(ctx context.Context, in *MsgPatchDidDoc, opts ...grpc.CallOption) (*MsgPatchDidDocResponse, error)
 }
 
 type msgClient struct {
@@ -71,6 +74,15 @@ func (c *msgClient) DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDo
 	return out, nil
 }
 
+func (c *msgClient) PatchDidDoc(ctx context.Context, in *MsgPatchDidDoc, opts ...grpc.CallOption) (*MsgPatchDidDocResponse, error) {
+	out := new(MsgPatchDidDocResponse)
+	err := c.cc.Invoke(ctx, Msg_PatchDidDoc_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -81,6 +93,8 @@ type MsgServer interface {
 	UpdateDidDoc(context.Context, *MsgUpdateDidDoc) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(context.Context, *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error)
+	// PatchDidDoc defines a method for partially updating an existing DID document
+	PatchDidDoc(context.Context, *MsgPatchDidDoc) (*MsgPatchDidDocResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +111,9 @@ func (UnimplementedMsgServer) UpdateDidDoc(context.Context, *MsgUpdateDidDoc) (*
 func (UnimplementedMsgServer) DeactivateDidDoc(context.Context, *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDidDoc not implemented")
 }
+func (UnimplementedMsgServer) PatchDidDoc(context.Context, *MsgPatchDidDoc) (*MsgPatchDidDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchDidDoc not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +181,24 @@ func _Msg_DeactivateDidDoc_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PatchDidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPatchDidDoc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PatchDidDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PatchDidDoc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PatchDidDoc(ctx, req.(*MsgPatchDidDoc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +218,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateDidDoc",
 			Handler:    _Msg_DeactivateDidDoc_Handler,
 		},
+		{
+			MethodName: "PatchDidDoc",
+			Handler:    _Msg_PatchDidDoc_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/did/v2/tx.proto",
@@ -64,9 +64,10 @@ func (x *_GenesisState_1_list) IsValid() bool {
 }
 
 var (
-	md_GenesisState            protoreflect.MessageDescriptor
-	fd_GenesisState_resources  protoreflect.FieldDescriptor
-	fd_GenesisState_fee_params protoreflect.FieldDescriptor
+	md_GenesisState               protoreflect.MessageDescriptor
+	fd_GenesisState_resources     protoreflect.FieldDescriptor
+	fd_GenesisState_fee_params    protoreflect.FieldDescriptor
+	fd_GenesisState_upload_params protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -74,6 +75,7 @@ func init() {
 	md_GenesisState = File_cheqd_resource_v2_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_resources = md_GenesisState.Fields().ByName("resources")
 	fd_GenesisState_fee_params = md_GenesisState.Fields().ByName("fee_params")
+	fd_GenesisState_upload_params = md_GenesisState.Fields().ByName("upload_params")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -153,6 +155,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.UploadParams != nil {
+		value := protoreflect.ValueOfMessage(x.UploadParams.ProtoReflect())
+		if !f(fd_GenesisState_upload_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -172,6 +180,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Resources) != 0
 	case "cheqd.resource.v2.GenesisState.fee_params":
 		return x.FeeParams != nil
+	case "cheqd.resource.v2.GenesisState.upload_params":
+		return x.UploadParams != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.GenesisState"))
@@ -192,6 +202,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Resources = nil
 	case "cheqd.resource.v2.GenesisState.fee_params":
 		x.FeeParams = nil
+	case "cheqd.resource.v2.GenesisState.upload_params":
+		x.UploadParams = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.GenesisState"))
@@ -217,6 +229,9 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cheqd.resource.v2.GenesisState.fee_params":
 		value := x.FeeParams
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.GenesisState.upload_params":
+		value := x.UploadParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.GenesisState"))
@@ -243,6 +258,8 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Resources = *clv.list
 	case "cheqd.resource.v2.GenesisState.fee_params":
 		x.FeeParams = value.Message().Interface().(*FeeParams)
+	case "cheqd.resource.v2.GenesisState.upload_params":
+		x.UploadParams = value.Message().Interface().(*UploadParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.GenesisState"))
@@ -274,6 +291,11 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.FeeParams = new(FeeParams)
 		}
 		return protoreflect.ValueOfMessage(x.FeeParams.ProtoReflect())
+	case "cheqd.resource.v2.GenesisState.upload_params":
+		if x.UploadParams == nil {
+			x.UploadParams = new(UploadParams)
+		}
+		return protoreflect.ValueOfMessage(x.UploadParams.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.GenesisState"))
@@ -293,6 +315,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "cheqd.resource.v2.GenesisState.fee_params":
 		m := new(FeeParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.GenesisState.upload_params":
+		m := new(UploadParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.GenesisState"))
@@ -372,6 +397,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.FeeParams)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.UploadParams != nil {
+			l = options.Size(x.UploadParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -401,6 +430,20 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UploadParams != nil {
+			encoded, err := options.Marshal(x.UploadParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.FeeParams != nil {
 			encoded, err := options.Marshal(x.FeeParams)
 			if err != nil {
@@ -550,6 +593,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UploadParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UploadParams == nil {
+					x.UploadParams = &UploadParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UploadParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -609,6 +688,9 @@ type GenesisState struct {
 	// Fee parameters for the Resource module
 	// Defines fixed fees and burn percentage for resources
 	FeeParams *FeeParams `protobuf:"bytes,2,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// Chunked upload parameters for the Resource module
+	// Genesis files created before they were introduced use the defaults
+	UploadParams *UploadParams `protobuf:"bytes,3,opt,name=upload_params,json=uploadParams,proto3" json:"upload_params,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -645,6 +727,13 @@ func (x *GenesisState) GetFeeParams() *FeeParams {
 	return nil
 }
 
+func (x *GenesisState) GetUploadParams() *UploadParams {
+	if x != nil {
+		return x.UploadParams
+	}
+	return nil
+}
+
 var File_cheqd_resource_v2_genesis_proto protoreflect.FileDescriptor
 
 var file_cheqd_resource_v2_genesis_proto_rawDesc = []byte{
@@ -655,7 +744,9 @@ var file_cheqd_resource_v2_genesis_proto_rawDesc = []byte{
 	0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x20, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
@@ -664,20 +755,25 @@ var file_cheqd_resource_v2_genesis_proto_rawDesc = []byte{
 	0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x09, 0x66,
-	0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xcc, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x76, 0x32, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71,
-	0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11,
-	0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56,
-	0x32, 0xe2, 0x02, 0x1d, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x13, 0x43, 0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xcf,
+	0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f, 0x63, 0x68,
+	0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76,
+	0x32, 0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43,
+	0x52, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x5c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x68, 0x65,
+	0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x68, 0x65,
+	0x71, 0x64, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x32,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -697,15 +793,17 @@ var file_cheqd_resource_v2_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil),         // 0: cheqd.resource.v2.GenesisState
 	(*ResourceWithMetadata)(nil), // 1: cheqd.resource.v2.ResourceWithMetadata
 	(*FeeParams)(nil),            // 2: cheqd.resource.v2.FeeParams
+	(*UploadParams)(nil),         // 3: cheqd.resource.v2.UploadParams
 }
 var file_cheqd_resource_v2_genesis_proto_depIdxs = []int32{
 	1, // 0: cheqd.resource.v2.GenesisState.resources:type_name -> cheqd.resource.v2.ResourceWithMetadata
 	2, // 1: cheqd.resource.v2.GenesisState.fee_params:type_name -> cheqd.resource.v2.FeeParams
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: cheqd.resource.v2.GenesisState.upload_params:type_name -> cheqd.resource.v2.UploadParams
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cheqd_resource_v2_genesis_proto_init() }
@@ -715,6 +813,7 @@ func file_cheqd_resource_v2_genesis_proto_init() {
 	}
 	file_cheqd_resource_v2_fee_proto_init()
 	file_cheqd_resource_v2_resource_proto_init()
+	file_cheqd_resource_v2_upload_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_cheqd_resource_v2_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
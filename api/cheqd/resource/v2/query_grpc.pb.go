@@ -22,6 +22,9 @@ const (
 	Query_Resource_FullMethodName            = "/cheqd.resource.v2.Query/Resource"
 	Query_ResourceMetadata_FullMethodName    = "/cheqd.resource.v2.Query/ResourceMetadata"
 	Query_CollectionResources_FullMethodName = "/cheqd.resource.v2.Query/CollectionResources"
+	Query_LatestResource_FullMethodName      = "/cheqd.resource.v2.Query/LatestResource"
+	Query_ResourceVersions_FullMethodName    = "/cheqd.resource.v2.Query/ResourceVersions"
+	Query_Dereference_FullMethodName         = "/cheqd.resource.v2.Query/Dereference"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,13 @@ type QueryClient interface {
 	ResourceMetadata(ctx context.Context, in *QueryResourceMetadataRequest, opts ...grpc.CallOption) (*QueryResourceMetadataResponse, error)
 	// Fetch metadata for all resources in a collection
 	CollectionResources(ctx context.Context, in *QueryCollectionResourcesRequest, opts ...grpc.CallOption) (*QueryCollectionResourcesResponse, error)
+	// Fetch the latest version of a resource with the given name and type in a collection
+	LatestResource(ctx context.Context, in *QueryLatestResourceRequest, opts ...grpc.CallOption) (*QueryLatestResourceResponse, error)
+	// Fetch metadata for all versions of a resource with the given name and type in a collection
+	ResourceVersions(ctx context.Context, in *QueryResourceVersionsRequest, opts ...grpc.CallOption) (*QueryResourceVersionsResponse, error)
+	// Dereference a DID URL according to the W3C DID Resolution specification.
+	// Supports DID Document fragments, service endpoint selection and DID-Linked Resources.
+	Dereference(ctx context.Context, in *QueryDereferenceRequest, opts ...grpc.CallOption) (*QueryDereferenceResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +81,33 @@ func (c *queryClient) CollectionResources(ctx context.Context, in *QueryCollecti
 	return out, nil
 }
 
+func (c *queryClient) LatestResource(ctx context.Context, in *QueryLatestResourceRequest, opts ...grpc.CallOption) (*QueryLatestResourceResponse, error) {
+	out := new(QueryLatestResourceResponse)
+	err := c.cc.Invoke(ctx, Query_LatestResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceVersions(ctx context.Context, in *QueryResourceVersionsRequest, opts ...grpc.CallOption) (*QueryResourceVersionsResponse, error) {
+	out := new(QueryResourceVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Dereference(ctx context.Context, in *QueryDereferenceRequest, opts ...grpc.CallOption) (*QueryDereferenceResponse, error) {
+	out := new(QueryDereferenceResponse)
+	err := c.cc.Invoke(ctx, Query_Dereference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +118,13 @@ type QueryServer interface {
 	ResourceMetadata(context.Context, *QueryResourceMetadataRequest) (*QueryResourceMetadataResponse, error)
 	// Fetch metadata for all resources in a collection
 	CollectionResources(context.Context, *QueryCollectionResourcesRequest) (*QueryCollectionResourcesResponse, error)
+	// Fetch the latest version of a resource with the given name and type in a collection
+	LatestResource(context.Context, *QueryLatestResourceRequest) (*QueryLatestResourceResponse, error)
+	// Fetch metadata for all versions of a resource with the given name and type in a collection
+	ResourceVersions(context.Context, *QueryResourceVersionsRequest) (*QueryResourceVersionsResponse, error)
+	// Dereference a DID URL according to the W3C DID Resolution specification.
+	// Supports DID Document fragments, service endpoint selection and DID-Linked Resources.
+	Dereference(context.Context, *QueryDereferenceRequest) (*QueryDereferenceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +141,15 @@ func (UnimplementedQueryServer) ResourceMetadata(context.Context, *QueryResource
 func (UnimplementedQueryServer) CollectionResources(context.Context, *QueryCollectionResourcesRequest) (*QueryCollectionResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectionResources not implemented")
 }
+func (UnimplementedQueryServer) LatestResource(context.Context, *QueryLatestResourceRequest) (*QueryLatestResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestResource not implemented")
+}
+func (UnimplementedQueryServer) ResourceVersions(context.Context, *QueryResourceVersionsRequest) (*QueryResourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceVersions not implemented")
+}
+func (UnimplementedQueryServer) Dereference(context.Context, *QueryDereferenceRequest) (*QueryDereferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dereference not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +217,60 @@ func _Query_CollectionResources_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LatestResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestResource(ctx, req.(*QueryLatestResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceVersions(ctx, req.(*QueryResourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dereference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDereferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dereference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Dereference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dereference(ctx, req.(*QueryDereferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +290,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectionResources",
 			Handler:    _Query_CollectionResources_Handler,
 		},
+		{
+			MethodName: "LatestResource",
+			Handler:    _Query_LatestResource_Handler,
+		},
+		{
+			MethodName: "ResourceVersions",
+			Handler:    _Query_ResourceVersions_Handler,
+		},
+		{
+			MethodName: "Dereference",
+			Handler:    _Query_Dereference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/resource/v2/query.proto",
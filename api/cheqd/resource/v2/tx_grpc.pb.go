@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateResource_FullMethodName = "/cheqd.resource.v2.Msg/CreateResource"
+	Msg_CreateResource_FullMethodName      = "/cheqd.resource.v2.Msg/CreateResource"
+	Msg_BeginResourceUpload_FullMethodName = "/cheqd.resource.v2.Msg/BeginResourceUpload"
+	Msg_AppendResourceChunk_FullMethodName = "/cheqd.resource.v2.Msg/AppendResourceChunk"
+	Msg_FinalizeResource_FullMethodName    = "/cheqd.resource.v2.Msg/FinalizeResource"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +31,14 @@ const (
 type MsgClient interface {
 	// CreateResource defines a method for creating a resource.
 	CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error)
+	// BeginResourceUpload defines a method for starting a chunked upload of a resource
+	// which data exceeds the size limit of a single transaction.
+	BeginResourceUpload(ctx context.Context, in *MsgBeginResourceUpload, opts ...grpc.CallOption) (*MsgBeginResourceUploadResponse, error)
+	// AppendResourceChunk defines a method for staging the next chunk of a chunked resource upload.
+	AppendResourceChunk(ctx context.Context, in *MsgAppendResourceChunk, opts ...grpc.CallOption) (*MsgAppendResourceChunkResponse, error)
+	// FinalizeResource defines a method for verifying the staged data of a chunked resource upload
+	// and publishing the resource.
+	FinalizeResource(ctx context.Context, in *MsgFinalizeResource, opts ...grpc.CallOption) (*MsgFinalizeResourceResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +58,47 @@ func (c *msgClient) CreateResource(ctx context.Context, in *MsgCreateResource, o
 	return out, nil
 }
 
+func (c *msgClient) BeginResourceUpload(ctx context.Context, in *MsgBeginResourceUpload, opts ...grpc.CallOption) (*MsgBeginResourceUploadResponse, error) {
+	out := new(MsgBeginResourceUploadResponse)
+	err := c.cc.Invoke(ctx, Msg_BeginResourceUpload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppendResourceChunk(ctx context.Context, in *MsgAppendResourceChunk, opts ...grpc.CallOption) (*MsgAppendResourceChunkResponse, error) {
+	out := new(MsgAppendResourceChunkResponse)
+	err := c.cc.Invoke(ctx, Msg_AppendResourceChunk_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeResource(ctx context.Context, in *MsgFinalizeResource, opts ...grpc.CallOption) (*MsgFinalizeResourceResponse, error) {
+	out := new(MsgFinalizeResourceResponse)
+	err := c.cc.Invoke(ctx, Msg_FinalizeResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// CreateResource defines a method for creating a resource.
 	CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error)
+	// BeginResourceUpload defines a method for starting a chunked upload of a resource
+	// which data exceeds the size limit of a single transaction.
+	BeginResourceUpload(context.Context, *MsgBeginResourceUpload) (*MsgBeginResourceUploadResponse, error)
+	// AppendResourceChunk defines a method for staging the next chunk of a chunked resource upload.
+	AppendResourceChunk(context.Context, *MsgAppendResourceChunk) (*MsgAppendResourceChunkResponse, error)
+	// FinalizeResource defines a method for verifying the staged data of a chunked resource upload
+	// and publishing the resource.
+	FinalizeResource(context.Context, *MsgFinalizeResource) (*MsgFinalizeResourceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +109,15 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateResource not implemented")
 }
+func (UnimplementedMsgServer) BeginResourceUpload(context.Context, *MsgBeginResourceUpload) (*MsgBeginResourceUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginResourceUpload not implemented")
+}
+func (UnimplementedMsgServer) AppendResourceChunk(context.Context, *MsgAppendResourceChunk) (*MsgAppendResourceChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendResourceChunk not implemented")
+}
+func (UnimplementedMsgServer) FinalizeResource(context.Context, *MsgFinalizeResource) (*MsgFinalizeResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeResource not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +149,60 @@ func _Msg_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BeginResourceUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginResourceUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginResourceUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BeginResourceUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginResourceUpload(ctx, req.(*MsgBeginResourceUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendResourceChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendResourceChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendResourceChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppendResourceChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendResourceChunk(ctx, req.(*MsgAppendResourceChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinalizeResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeResource(ctx, req.(*MsgFinalizeResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +214,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateResource",
 			Handler:    _Msg_CreateResource_Handler,
 		},
+		{
+			MethodName: "BeginResourceUpload",
+			Handler:    _Msg_BeginResourceUpload_Handler,
+		},
+		{
+			MethodName: "AppendResourceChunk",
+			Handler:    _Msg_AppendResourceChunk_Handler,
+		},
+		{
+			MethodName: "FinalizeResource",
+			Handler:    _Msg_FinalizeResource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/resource/v2/tx.proto",
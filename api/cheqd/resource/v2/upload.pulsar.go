@@ -0,0 +1,2100 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package resourcev2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	v1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/v1beta1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ResourceUpload_6_list)(nil)
+
+type _ResourceUpload_6_list struct {
+	list *[]*AlternativeUri
+}
+
+func (x *_ResourceUpload_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ResourceUpload_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ResourceUpload_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AlternativeUri)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ResourceUpload_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AlternativeUri)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ResourceUpload_6_list) AppendMutable() protoreflect.Value {
+	v := new(AlternativeUri)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ResourceUpload_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ResourceUpload_6_list) NewElement() protoreflect.Value {
+	v := new(AlternativeUri)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ResourceUpload_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ResourceUpload               protoreflect.MessageDescriptor
+	fd_ResourceUpload_collection_id protoreflect.FieldDescriptor
+	fd_ResourceUpload_id            protoreflect.FieldDescriptor
+	fd_ResourceUpload_name          protoreflect.FieldDescriptor
+	fd_ResourceUpload_version       protoreflect.FieldDescriptor
+	fd_ResourceUpload_resource_type protoreflect.FieldDescriptor
+	fd_ResourceUpload_also_known_as protoreflect.FieldDescriptor
+	fd_ResourceUpload_total_size    protoreflect.FieldDescriptor
+	fd_ResourceUpload_checksum      protoreflect.FieldDescriptor
+	fd_ResourceUpload_chunk_count   protoreflect.FieldDescriptor
+	fd_ResourceUpload_received_size protoreflect.FieldDescriptor
+	fd_ResourceUpload_expiry_height protoreflect.FieldDescriptor
+	fd_ResourceUpload_media_type    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_resource_v2_upload_proto_init()
+	md_ResourceUpload = File_cheqd_resource_v2_upload_proto.Messages().ByName("ResourceUpload")
+	fd_ResourceUpload_collection_id = md_ResourceUpload.Fields().ByName("collection_id")
+	fd_ResourceUpload_id = md_ResourceUpload.Fields().ByName("id")
+	fd_ResourceUpload_name = md_ResourceUpload.Fields().ByName("name")
+	fd_ResourceUpload_version = md_ResourceUpload.Fields().ByName("version")
+	fd_ResourceUpload_resource_type = md_ResourceUpload.Fields().ByName("resource_type")
+	fd_ResourceUpload_also_known_as = md_ResourceUpload.Fields().ByName("also_known_as")
+	fd_ResourceUpload_total_size = md_ResourceUpload.Fields().ByName("total_size")
+	fd_ResourceUpload_checksum = md_ResourceUpload.Fields().ByName("checksum")
+	fd_ResourceUpload_chunk_count = md_ResourceUpload.Fields().ByName("chunk_count")
+	fd_ResourceUpload_received_size = md_ResourceUpload.Fields().ByName("received_size")
+	fd_ResourceUpload_expiry_height = md_ResourceUpload.Fields().ByName("expiry_height")
+	fd_ResourceUpload_media_type = md_ResourceUpload.Fields().ByName("media_type")
+}
+
+var _ protoreflect.Message = (*fastReflection_ResourceUpload)(nil)
+
+type fastReflection_ResourceUpload ResourceUpload
+
+func (x *ResourceUpload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ResourceUpload)(x)
+}
+
+func (x *ResourceUpload) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_resource_v2_upload_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ResourceUpload_messageType fastReflection_ResourceUpload_messageType
+var _ protoreflect.MessageType = fastReflection_ResourceUpload_messageType{}
+
+type fastReflection_ResourceUpload_messageType struct{}
+
+func (x fastReflection_ResourceUpload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ResourceUpload)(nil)
+}
+func (x fastReflection_ResourceUpload_messageType) New() protoreflect.Message {
+	return new(fastReflection_ResourceUpload)
+}
+func (x fastReflection_ResourceUpload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResourceUpload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ResourceUpload) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResourceUpload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ResourceUpload) Type() protoreflect.MessageType {
+	return _fastReflection_ResourceUpload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ResourceUpload) New() protoreflect.Message {
+	return new(fastReflection_ResourceUpload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ResourceUpload) Interface() protoreflect.ProtoMessage {
+	return (*ResourceUpload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ResourceUpload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CollectionId != "" {
+		value := protoreflect.ValueOfString(x.CollectionId)
+		if !f(fd_ResourceUpload_collection_id, value) {
+			return
+		}
+	}
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_ResourceUpload_id, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_ResourceUpload_name, value) {
+			return
+		}
+	}
+	if x.Version != "" {
+		value := protoreflect.ValueOfString(x.Version)
+		if !f(fd_ResourceUpload_version, value) {
+			return
+		}
+	}
+	if x.ResourceType != "" {
+		value := protoreflect.ValueOfString(x.ResourceType)
+		if !f(fd_ResourceUpload_resource_type, value) {
+			return
+		}
+	}
+	if len(x.AlsoKnownAs) != 0 {
+		value := protoreflect.ValueOfList(&_ResourceUpload_6_list{list: &x.AlsoKnownAs})
+		if !f(fd_ResourceUpload_also_known_as, value) {
+			return
+		}
+	}
+	if x.TotalSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalSize)
+		if !f(fd_ResourceUpload_total_size, value) {
+			return
+		}
+	}
+	if x.Checksum != "" {
+		value := protoreflect.ValueOfString(x.Checksum)
+		if !f(fd_ResourceUpload_checksum, value) {
+			return
+		}
+	}
+	if x.ChunkCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChunkCount)
+		if !f(fd_ResourceUpload_chunk_count, value) {
+			return
+		}
+	}
+	if x.ReceivedSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceivedSize)
+		if !f(fd_ResourceUpload_received_size, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_ResourceUpload_expiry_height, value) {
+			return
+		}
+	}
+	if x.MediaType != "" {
+		value := protoreflect.ValueOfString(x.MediaType)
+		if !f(fd_ResourceUpload_media_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ResourceUpload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		return x.CollectionId != ""
+	case "cheqd.resource.v2.ResourceUpload.id":
+		return x.Id != ""
+	case "cheqd.resource.v2.ResourceUpload.name":
+		return x.Name != ""
+	case "cheqd.resource.v2.ResourceUpload.version":
+		return x.Version != ""
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		return x.ResourceType != ""
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		return len(x.AlsoKnownAs) != 0
+	case "cheqd.resource.v2.ResourceUpload.total_size":
+		return x.TotalSize != uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		return x.Checksum != ""
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		return x.ChunkCount != uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.received_size":
+		return x.ReceivedSize != uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "cheqd.resource.v2.ResourceUpload.media_type":
+		return x.MediaType != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceUpload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		x.CollectionId = ""
+	case "cheqd.resource.v2.ResourceUpload.id":
+		x.Id = ""
+	case "cheqd.resource.v2.ResourceUpload.name":
+		x.Name = ""
+	case "cheqd.resource.v2.ResourceUpload.version":
+		x.Version = ""
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		x.ResourceType = ""
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		x.AlsoKnownAs = nil
+	case "cheqd.resource.v2.ResourceUpload.total_size":
+		x.TotalSize = uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		x.Checksum = ""
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		x.ChunkCount = uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.received_size":
+		x.ReceivedSize = uint64(0)
+	case "cheqd.resource.v2.ResourceUpload.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "cheqd.resource.v2.ResourceUpload.media_type":
+		x.MediaType = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ResourceUpload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		value := x.CollectionId
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.version":
+		value := x.Version
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		value := x.ResourceType
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		if len(x.AlsoKnownAs) == 0 {
+			return protoreflect.ValueOfList(&_ResourceUpload_6_list{})
+		}
+		listValue := &_ResourceUpload_6_list{list: &x.AlsoKnownAs}
+		return protoreflect.ValueOfList(listValue)
+	case "cheqd.resource.v2.ResourceUpload.total_size":
+		value := x.TotalSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		value := x.Checksum
+		return protoreflect.ValueOfString(value)
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		value := x.ChunkCount
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.ResourceUpload.received_size":
+		value := x.ReceivedSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.ResourceUpload.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cheqd.resource.v2.ResourceUpload.media_type":
+		value := x.MediaType
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceUpload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		x.CollectionId = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.id":
+		x.Id = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.name":
+		x.Name = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.version":
+		x.Version = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		x.ResourceType = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		lv := value.List()
+		clv := lv.(*_ResourceUpload_6_list)
+		x.AlsoKnownAs = *clv.list
+	case "cheqd.resource.v2.ResourceUpload.total_size":
+		x.TotalSize = value.Uint()
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		x.Checksum = value.Interface().(string)
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		x.ChunkCount = value.Uint()
+	case "cheqd.resource.v2.ResourceUpload.received_size":
+		x.ReceivedSize = value.Uint()
+	case "cheqd.resource.v2.ResourceUpload.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "cheqd.resource.v2.ResourceUpload.media_type":
+		x.MediaType = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceUpload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		if x.AlsoKnownAs == nil {
+			x.AlsoKnownAs = []*AlternativeUri{}
+		}
+		value := &_ResourceUpload_6_list{list: &x.AlsoKnownAs}
+		return protoreflect.ValueOfList(value)
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		panic(fmt.Errorf("field collection_id of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.id":
+		panic(fmt.Errorf("field id of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.name":
+		panic(fmt.Errorf("field name of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.version":
+		panic(fmt.Errorf("field version of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		panic(fmt.Errorf("field resource_type of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.total_size":
+		panic(fmt.Errorf("field total_size of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		panic(fmt.Errorf("field checksum of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		panic(fmt.Errorf("field chunk_count of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.received_size":
+		panic(fmt.Errorf("field received_size of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	case "cheqd.resource.v2.ResourceUpload.media_type":
+		panic(fmt.Errorf("field media_type of message cheqd.resource.v2.ResourceUpload is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ResourceUpload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.ResourceUpload.collection_id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.id":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.name":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.version":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.resource_type":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.also_known_as":
+		list := []*AlternativeUri{}
+		return protoreflect.ValueOfList(&_ResourceUpload_6_list{list: &list})
+	case "cheqd.resource.v2.ResourceUpload.total_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.ResourceUpload.checksum":
+		return protoreflect.ValueOfString("")
+	case "cheqd.resource.v2.ResourceUpload.chunk_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.ResourceUpload.received_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.ResourceUpload.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cheqd.resource.v2.ResourceUpload.media_type":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.ResourceUpload"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.ResourceUpload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ResourceUpload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.resource.v2.ResourceUpload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ResourceUpload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResourceUpload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ResourceUpload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ResourceUpload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ResourceUpload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CollectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ResourceType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			for _, e := range x.AlsoKnownAs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalSize))
+		}
+		l = len(x.Checksum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChunkCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChunkCount))
+		}
+		if x.ReceivedSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceivedSize))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		l = len(x.MediaType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ResourceUpload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MediaType) > 0 {
+			i -= len(x.MediaType)
+			copy(dAtA[i:], x.MediaType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MediaType)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ReceivedSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceivedSize))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChunkCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChunkCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Checksum) > 0 {
+			i -= len(x.Checksum)
+			copy(dAtA[i:], x.Checksum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Checksum)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.TotalSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalSize))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.AlsoKnownAs) > 0 {
+			for iNdEx := len(x.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AlsoKnownAs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ResourceType) > 0 {
+			i -= len(x.ResourceType)
+			copy(dAtA[i:], x.ResourceType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ResourceType)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CollectionId) > 0 {
+			i -= len(x.CollectionId)
+			copy(dAtA[i:], x.CollectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ResourceUpload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ResourceUpload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ResourceUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResourceType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlsoKnownAs = append(x.AlsoKnownAs, &AlternativeUri{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AlsoKnownAs[len(x.AlsoKnownAs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+				}
+				x.TotalSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Checksum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+				}
+				x.ChunkCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChunkCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+				}
+				x.ReceivedSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceivedSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MediaType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_UploadParams                   protoreflect.MessageDescriptor
+	fd_UploadParams_chunk             protoreflect.FieldDescriptor
+	fd_UploadParams_max_chunk_size    protoreflect.FieldDescriptor
+	fd_UploadParams_max_resource_size protoreflect.FieldDescriptor
+	fd_UploadParams_expiry_blocks     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cheqd_resource_v2_upload_proto_init()
+	md_UploadParams = File_cheqd_resource_v2_upload_proto.Messages().ByName("UploadParams")
+	fd_UploadParams_chunk = md_UploadParams.Fields().ByName("chunk")
+	fd_UploadParams_max_chunk_size = md_UploadParams.Fields().ByName("max_chunk_size")
+	fd_UploadParams_max_resource_size = md_UploadParams.Fields().ByName("max_resource_size")
+	fd_UploadParams_expiry_blocks = md_UploadParams.Fields().ByName("expiry_blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_UploadParams)(nil)
+
+type fastReflection_UploadParams UploadParams
+
+func (x *UploadParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UploadParams)(x)
+}
+
+func (x *UploadParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cheqd_resource_v2_upload_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UploadParams_messageType fastReflection_UploadParams_messageType
+var _ protoreflect.MessageType = fastReflection_UploadParams_messageType{}
+
+type fastReflection_UploadParams_messageType struct{}
+
+func (x fastReflection_UploadParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UploadParams)(nil)
+}
+func (x fastReflection_UploadParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_UploadParams)
+}
+func (x fastReflection_UploadParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UploadParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UploadParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_UploadParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UploadParams) Type() protoreflect.MessageType {
+	return _fastReflection_UploadParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UploadParams) New() protoreflect.Message {
+	return new(fastReflection_UploadParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UploadParams) Interface() protoreflect.ProtoMessage {
+	return (*UploadParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UploadParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Chunk != nil {
+		value := protoreflect.ValueOfMessage(x.Chunk.ProtoReflect())
+		if !f(fd_UploadParams_chunk, value) {
+			return
+		}
+	}
+	if x.MaxChunkSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxChunkSize)
+		if !f(fd_UploadParams_max_chunk_size, value) {
+			return
+		}
+	}
+	if x.MaxResourceSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxResourceSize)
+		if !f(fd_UploadParams_max_resource_size, value) {
+			return
+		}
+	}
+	if x.ExpiryBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryBlocks)
+		if !f(fd_UploadParams_expiry_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UploadParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.UploadParams.chunk":
+		return x.Chunk != nil
+	case "cheqd.resource.v2.UploadParams.max_chunk_size":
+		return x.MaxChunkSize != uint64(0)
+	case "cheqd.resource.v2.UploadParams.max_resource_size":
+		return x.MaxResourceSize != uint64(0)
+	case "cheqd.resource.v2.UploadParams.expiry_blocks":
+		return x.ExpiryBlocks != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.UploadParams"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.UploadParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UploadParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.UploadParams.chunk":
+		x.Chunk = nil
+	case "cheqd.resource.v2.UploadParams.max_chunk_size":
+		x.MaxChunkSize = uint64(0)
+	case "cheqd.resource.v2.UploadParams.max_resource_size":
+		x.MaxResourceSize = uint64(0)
+	case "cheqd.resource.v2.UploadParams.expiry_blocks":
+		x.ExpiryBlocks = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.UploadParams"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.UploadParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UploadParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cheqd.resource.v2.UploadParams.chunk":
+		value := x.Chunk
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cheqd.resource.v2.UploadParams.max_chunk_size":
+		value := x.MaxChunkSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.UploadParams.max_resource_size":
+		value := x.MaxResourceSize
+		return protoreflect.ValueOfUint64(value)
+	case "cheqd.resource.v2.UploadParams.expiry_blocks":
+		value := x.ExpiryBlocks
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.UploadParams"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.UploadParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UploadParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.UploadParams.chunk":
+		x.Chunk = value.Message().Interface().(*v1beta1.Coin)
+	case "cheqd.resource.v2.UploadParams.max_chunk_size":
+		x.MaxChunkSize = value.Uint()
+	case "cheqd.resource.v2.UploadParams.max_resource_size":
+		x.MaxResourceSize = value.Uint()
+	case "cheqd.resource.v2.UploadParams.expiry_blocks":
+		x.ExpiryBlocks = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.UploadParams"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.UploadParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UploadParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.UploadParams.chunk":
+		if x.Chunk == nil {
+			x.Chunk = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Chunk.ProtoReflect())
+	case "cheqd.resource.v2.UploadParams.max_chunk_size":
+		panic(fmt.Errorf("field max_chunk_size of message cheqd.resource.v2.UploadParams is not mutable"))
+	case "cheqd.resource.v2.UploadParams.max_resource_size":
+		panic(fmt.Errorf("field max_resource_size of message cheqd.resource.v2.UploadParams is not mutable"))
+	case "cheqd.resource.v2.UploadParams.expiry_blocks":
+		panic(fmt.Errorf("field expiry_blocks of message cheqd.resource.v2.UploadParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.UploadParams"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.UploadParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UploadParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cheqd.resource.v2.UploadParams.chunk":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cheqd.resource.v2.UploadParams.max_chunk_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.UploadParams.max_resource_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cheqd.resource.v2.UploadParams.expiry_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cheqd.resource.v2.UploadParams"))
+		}
+		panic(fmt.Errorf("message cheqd.resource.v2.UploadParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UploadParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cheqd.resource.v2.UploadParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UploadParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UploadParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UploadParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UploadParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UploadParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Chunk != nil {
+			l = options.Size(x.Chunk)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxChunkSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxChunkSize))
+		}
+		if x.MaxResourceSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxResourceSize))
+		}
+		if x.ExpiryBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryBlocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UploadParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxResourceSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxResourceSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxChunkSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxChunkSize))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Chunk != nil {
+			encoded, err := options.Marshal(x.Chunk)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UploadParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UploadParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UploadParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Chunk == nil {
+					x.Chunk = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Chunk); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChunkSize", wireType)
+				}
+				x.MaxChunkSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxChunkSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxResourceSize", wireType)
+				}
+				x.MaxResourceSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxResourceSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlocks", wireType)
+				}
+				x.ExpiryBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cheqd/resource/v2/upload.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ResourceUpload is a chunked resource upload staged until it is finalized or expires.
+// The chunks are stored separately.
+type ResourceUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// collection_id is the unique identifier part of the DID the resource is linked to
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// id is the unique identifier of the resource.
+	// Format: UUID
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human-readable name of the resource
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the human-readable version of the resource. OPTIONAL.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// resource_type is the type of the resource
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// also_known_as is a list of URIs that can be used to get the resource
+	AlsoKnownAs []*AlternativeUri `protobuf:"bytes,6,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// total_size is the declared total size of the resource data in bytes
+	TotalSize uint64 `protobuf:"varint,7,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// checksum is the declared hex encoded SHA-256 checksum of the resource data
+	Checksum string `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// chunk_count is the number of chunks staged so far
+	ChunkCount uint64 `protobuf:"varint,9,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// received_size is the total size of the chunks staged so far in bytes
+	ReceivedSize uint64 `protobuf:"varint,10,opt,name=received_size,json=receivedSize,proto3" json:"received_size,omitempty"`
+	// expiry_height is the block height at the end of which the upload is removed if it isn't finalized
+	ExpiryHeight int64 `protobuf:"varint,11,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// media_type is the media type detected from the resource data once all the chunks are staged.
+	// Empty until then.
+	MediaType string `protobuf:"bytes,12,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+}
+
+func (x *ResourceUpload) Reset() {
+	*x = ResourceUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_resource_v2_upload_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUpload) ProtoMessage() {}
+
+// Deprecated: Use ResourceUpload.ProtoReflect.Descriptor instead.
+func (*ResourceUpload) Descriptor() ([]byte, []int) {
+	return file_cheqd_resource_v2_upload_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResourceUpload) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetAlsoKnownAs() []*AlternativeUri {
+	if x != nil {
+		return x.AlsoKnownAs
+	}
+	return nil
+}
+
+func (x *ResourceUpload) GetTotalSize() uint64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *ResourceUpload) GetChunkCount() uint64 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetReceivedSize() uint64 {
+	if x != nil {
+		return x.ReceivedSize
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *ResourceUpload) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+// UploadParams defines the parameters of chunked resource uploads
+type UploadParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fixed fee for staging a chunk of a resource upload.
+	// The burn factor of the resource fee params applies.
+	//
+	// Default: 2.5 ARX or 2500000000zarx
+	Chunk *v1beta1.Coin `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// Maximum size of a chunk in bytes
+	//
+	// Default: 204800 (200KB)
+	MaxChunkSize uint64 `protobuf:"varint,2,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"`
+	// Maximum total size of a resource uploaded in chunks in bytes
+	//
+	// Default: 10485760 (10MB)
+	MaxResourceSize uint64 `protobuf:"varint,3,opt,name=max_resource_size,json=maxResourceSize,proto3" json:"max_resource_size,omitempty"`
+	// Number of blocks a staged upload is kept for before it is removed unless finalized
+	//
+	// Default: 14400 (about 24 hours with 6 seconds blocks)
+	ExpiryBlocks int64 `protobuf:"varint,4,opt,name=expiry_blocks,json=expiryBlocks,proto3" json:"expiry_blocks,omitempty"`
+}
+
+func (x *UploadParams) Reset() {
+	*x = UploadParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cheqd_resource_v2_upload_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadParams) ProtoMessage() {}
+
+// Deprecated: Use UploadParams.ProtoReflect.Descriptor instead.
+func (*UploadParams) Descriptor() ([]byte, []int) {
+	return file_cheqd_resource_v2_upload_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadParams) GetChunk() *v1beta1.Coin {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *UploadParams) GetMaxChunkSize() uint64 {
+	if x != nil {
+		return x.MaxChunkSize
+	}
+	return 0
+}
+
+func (x *UploadParams) GetMaxResourceSize() uint64 {
+	if x != nil {
+		return x.MaxResourceSize
+	}
+	return 0
+}
+
+func (x *UploadParams) GetExpiryBlocks() int64 {
+	if x != nil {
+		return x.ExpiryBlocks
+	}
+	return 0
+}
+
+var File_cheqd_resource_v2_upload_proto protoreflect.FileDescriptor
+
+var file_cheqd_resource_v2_upload_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x76, 0x32, 0x1a, 0x20, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
+	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x03, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x61, 0x6c, 0x73, 0x6f, 0x5f, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x69,
+	0x52, 0x0b, 0x61, 0x6c, 0x73, 0x6f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x42, 0xce, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x77, 0x2d, 0x63, 0x6f, 0x2f,
+	0x63, 0x68, 0x65, 0x71, 0x64, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x32, 0x2f, 0x63, 0x68, 0x65, 0x71, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2f, 0x76, 0x32, 0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x32, 0xa2, 0x02,
+	0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x11, 0x43, 0x68, 0x65, 0x71, 0x64,
+	0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43,
+	0x68, 0x65, 0x71, 0x64, 0x5c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x56, 0x32,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43,
+	0x68, 0x65, 0x71, 0x64, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x3a,
+	0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cheqd_resource_v2_upload_proto_rawDescOnce sync.Once
+	file_cheqd_resource_v2_upload_proto_rawDescData = file_cheqd_resource_v2_upload_proto_rawDesc
+)
+
+func file_cheqd_resource_v2_upload_proto_rawDescGZIP() []byte {
+	file_cheqd_resource_v2_upload_proto_rawDescOnce.Do(func() {
+		file_cheqd_resource_v2_upload_proto_rawDescData = protoimpl.X.CompressGZIP(file_cheqd_resource_v2_upload_proto_rawDescData)
+	})
+	return file_cheqd_resource_v2_upload_proto_rawDescData
+}
+
+var file_cheqd_resource_v2_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cheqd_resource_v2_upload_proto_goTypes = []interface{}{
+	(*ResourceUpload)(nil), // 0: cheqd.resource.v2.ResourceUpload
+	(*UploadParams)(nil),   // 1: cheqd.resource.v2.UploadParams
+	(*AlternativeUri)(nil), // 2: cheqd.resource.v2.AlternativeUri
+	(*v1beta1.Coin)(nil),   // 3: cosmos.base.v1beta1.Coin
+}
+var file_cheqd_resource_v2_upload_proto_depIdxs = []int32{
+	2, // 0: cheqd.resource.v2.ResourceUpload.also_known_as:type_name -> cheqd.resource.v2.AlternativeUri
+	3, // 1: cheqd.resource.v2.UploadParams.chunk:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cheqd_resource_v2_upload_proto_init() }
+func file_cheqd_resource_v2_upload_proto_init() {
+	if File_cheqd_resource_v2_upload_proto != nil {
+		return
+	}
+	file_cheqd_resource_v2_resource_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_cheqd_resource_v2_upload_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUpload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cheqd_resource_v2_upload_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cheqd_resource_v2_upload_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cheqd_resource_v2_upload_proto_goTypes,
+		DependencyIndexes: file_cheqd_resource_v2_upload_proto_depIdxs,
+		MessageInfos:      file_cheqd_resource_v2_upload_proto_msgTypes,
+	}.Build()
+	File_cheqd_resource_v2_upload_proto = out.File
+	file_cheqd_resource_v2_upload_proto_rawDesc = nil
+	file_cheqd_resource_v2_upload_proto_goTypes = nil
+	file_cheqd_resource_v2_upload_proto_depIdxs = nil
+}
@@ -856,6 +856,27 @@ func New(
 
 					// Resource default alternative url
 					migrations.MigrateResourceDefaultAlternativeURL,
+
+					// Did version time index
+					migrations.MigrateDidVersionTimeIndex,
+
+					// Did controller index
+					migrations.MigrateDidControllerIndex,
+
+					// Did public key index
+					migrations.MigrateDidPublicKeyIndex,
+
+					// Did created height index
+					migrations.MigrateDidCreatedHeightIndex,
+
+					// Did unique id index
+					migrations.MigrateDidUniqueIDIndex,
+
+					// Did capability invocation authorization change notice
+					migrations.MigrateDidCapabilityInvocationNotice,
+
+					// Resource latest version index
+					migrations.MigrateResourceLatestVersionIndex,
 				})
 
 			err = cheqdMigrator.Migrate(ctx)
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateDidCapabilityInvocationNotice doesn't change the state. It reports DID Documents with verification methods
+// referenced by capabilityInvocation but not by authentication. Before capability invocation was enforced, such methods
+// could authorize any change of the DID Document. They keep doing so while the AllowCapabilityInvocationFullUpdates
+// signing param is enabled, so controllers relying on them have to reference them from authentication before
+// governance disables it. Afterwards they authorize service updates only.
+func MigrateDidCapabilityInvocationNotice(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateDidCapabilityInvocationNotice: Starting migration")
+
+	var err error
+	mctx.didKeeperNew.IterateDids(&sctx, func(did string) bool {
+		didDoc, getErr := mctx.didKeeperNew.GetLatestDidDoc(&sctx, did)
+		if getErr != nil {
+			err = getErr
+			return false
+		}
+
+		methodIDs := didDoc.DidDoc.CapabilityInvocationOnlyMethodIDs()
+		if len(methodIDs) > 0 {
+			sctx.Logger().Info("MigrateDidCapabilityInvocationNotice: Methods will authorize service updates only once full updates by them are disabled",
+				"did", did, "methods", strings.Join(methodIDs, ","))
+		}
+
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	sctx.Logger().Debug("MigrateDidCapabilityInvocationNotice: Migration finished")
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/canow-co/cheqd-node/app/migrations/helpers"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateDidControllerIndex rebuilds the controller index of DID Documents.
+// Should be run after all the migrations that rewrite DID Documents.
+func MigrateDidControllerIndex(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateDidControllerIndex: Starting migration")
+
+	store := sctx.KVStore(mctx.didStoreKey)
+
+	sctx.Logger().Debug("MigrateDidControllerIndex: Removing stale index entries")
+	keys := helpers.ReadAllKeys(store, []byte(didtypes.DidDocControllerKey))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	sctx.Logger().Debug("MigrateDidControllerIndex: Indexing controllers of all DIDDocs")
+	err := mctx.didKeeperNew.IndexAllDidDocControllers(&sctx)
+	if err != nil {
+		return err
+	}
+
+	sctx.Logger().Debug("MigrateDidControllerIndex: Migration finished")
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateDidCreatedHeightIndex indexes the creation height of DID Documents created before the index was introduced.
+// Their real creation height isn't stored on ledger so the upgrade height is used as the upper bound.
+func MigrateDidCreatedHeightIndex(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateDidCreatedHeightIndex: Starting migration")
+
+	sctx.Logger().Debug("MigrateDidCreatedHeightIndex: Indexing DIDDocs without creation height")
+	mctx.didKeeperNew.IndexMissingDidDocCreatedHeights(&sctx, uint64(sctx.BlockHeight()))
+
+	sctx.Logger().Debug("MigrateDidCreatedHeightIndex: Migration finished")
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/canow-co/cheqd-node/app/migrations/helpers"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateDidPublicKeyIndex rebuilds the public key index of DID Documents.
+// Should be run after all the migrations that rewrite DID Documents.
+func MigrateDidPublicKeyIndex(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateDidPublicKeyIndex: Starting migration")
+
+	store := sctx.KVStore(mctx.didStoreKey)
+
+	sctx.Logger().Debug("MigrateDidPublicKeyIndex: Removing stale index entries")
+	keys := helpers.ReadAllKeys(store, []byte(didtypes.DidDocPublicKeyKey))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	sctx.Logger().Debug("MigrateDidPublicKeyIndex: Indexing public keys of all DIDDocs")
+	err := mctx.didKeeperNew.IndexAllDidDocPublicKeys(&sctx)
+	if err != nil {
+		return err
+	}
+
+	sctx.Logger().Debug("MigrateDidPublicKeyIndex: Migration finished")
+
+	return nil
+}
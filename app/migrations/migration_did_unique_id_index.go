@@ -0,0 +1,17 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateDidUniqueIDIndex indexes DID Documents created before the unique id index was introduced
+func MigrateDidUniqueIDIndex(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateDidUniqueIDIndex: Starting migration")
+
+	sctx.Logger().Debug("MigrateDidUniqueIDIndex: Indexing all DIDDocs by unique id")
+	mctx.didKeeperNew.IndexAllDidUniqueIDs(&sctx)
+
+	sctx.Logger().Debug("MigrateDidUniqueIDIndex: Migration finished")
+
+	return nil
+}
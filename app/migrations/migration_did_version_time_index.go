@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/canow-co/cheqd-node/app/migrations/helpers"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateDidVersionTimeIndex rebuilds the time index of DID Document versions.
+// Should be run after all the migrations that rewrite DID Documents.
+func MigrateDidVersionTimeIndex(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateDidVersionTimeIndex: Starting migration")
+
+	store := sctx.KVStore(mctx.didStoreKey)
+
+	sctx.Logger().Debug("MigrateDidVersionTimeIndex: Removing stale index entries")
+	keys := helpers.ReadAllKeys(store, []byte(didtypes.DidDocVersionTimeKey))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	sctx.Logger().Debug("MigrateDidVersionTimeIndex: Indexing all DIDDoc versions")
+	err := mctx.didKeeperNew.IndexAllDidDocVersionTimes(&sctx)
+	if err != nil {
+		return err
+	}
+
+	sctx.Logger().Debug("MigrateDidVersionTimeIndex: Migration finished")
+
+	return nil
+}
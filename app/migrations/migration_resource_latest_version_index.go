@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/canow-co/cheqd-node/app/migrations/helpers"
+	resourcetypes "github.com/canow-co/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateResourceLatestVersionIndex rebuilds the latest version index of resources from their version links.
+// Should be run after all the migrations that rewrite resources.
+func MigrateResourceLatestVersionIndex(sctx sdk.Context, mctx MigrationContext) error {
+	sctx.Logger().Debug("MigrateResourceLatestVersionIndex: Starting migration")
+
+	store := sctx.KVStore(mctx.resourceStoreKey)
+
+	sctx.Logger().Debug("MigrateResourceLatestVersionIndex: Removing stale index entries")
+	keys := helpers.ReadAllKeys(store, []byte(resourcetypes.ResourceLatestVersionKey))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	sctx.Logger().Debug("MigrateResourceLatestVersionIndex: Indexing latest versions of all resources")
+	mctx.resourceKeeperNew.IndexAllLatestResourceVersions(&sctx)
+
+	sctx.Logger().Debug("MigrateResourceLatestVersionIndex: Migration finished")
+
+	return nil
+}
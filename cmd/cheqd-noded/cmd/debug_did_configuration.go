@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/client/domainlinkage"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagIssuanceDate   = "issuance-date"
+	FlagExpirationDate = "expiration-date"
+	FlagFetchFrom      = "fetch-from"
+)
+
+// didConfigurationCmd returns cobra Command.
+func didConfigurationCmd(fetch domainlinkage.DidConfigurationFetcher) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-configuration",
+		Short: "Build and verify Domain Linkage Credentials (.well-known/did-configuration.json) of a DID",
+	}
+
+	cmd.AddCommand(didConfigurationBuildCmd(), didConfigurationVerifyCmd(fetch))
+
+	return cmd
+}
+
+// didConfigurationBuildCmd returns cobra Command.
+func didConfigurationBuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build [verification-method-id] [origin] [private-key-file]",
+		Short: "Build the DID Configuration resource linking the DID with the origin",
+		Long: `Builds the DID Configuration resource with a Domain Linkage Credential in the JWT format.
+The credential is signed by the Ed25519 private key of the verification method, which must be an assertion method of the DID Document on the ledger.
+The private key file contains the private key bytes encoded to base64. Use '-' to read the private key from stdin.
+Output is the content of <origin>/.well-known/did-configuration.json.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyID, origin := args[0], args[1]
+
+			privateKeyBytes, err := readPrivateKey(cmd, args[2])
+			if err != nil {
+				return err
+			}
+
+			issuanceDate, err := getDateFlag(cmd, FlagIssuanceDate, time.Now())
+			if err != nil {
+				return err
+			}
+
+			expirationDate, err := getDateFlag(cmd, FlagExpirationDate, issuanceDate.AddDate(1, 0, 0))
+			if err != nil {
+				return err
+			}
+
+			err = didutils.ValidateDIDUrl(keyID, didtypes.DidMethod, nil)
+			if err != nil {
+				return err
+			}
+
+			did, _, _, _ := didutils.MustSplitDIDUrl(keyID)
+
+			didDoc, err := queryDidDoc(cmd.Context(), clientCtx, did)
+			if err != nil {
+				return err
+			}
+
+			jwt, err := domainlinkage.BuildDomainLinkageJWT(keyID, origin, privateKeyBytes, issuanceDate, expirationDate)
+			if err != nil {
+				return err
+			}
+
+			// Make sure the key is the one published by the DID Document
+			err = domainlinkage.VerifyDomainLinkageJWT(didDoc, origin, jwt, issuanceDate)
+			if err != nil {
+				return err
+			}
+
+			didConfigurationJSON, err := json.MarshalIndent(domainlinkage.NewDidConfiguration(jwt), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(didConfigurationJSON))
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagIssuanceDate, "", "Issuance date of the credential in RFC3339 format, current time by default")
+	cmd.Flags().String(FlagExpirationDate, "", "Expiration date of the credential in RFC3339 format, one year after the issuance date by default")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// didConfigurationVerifyCmd returns cobra Command.
+func didConfigurationVerifyCmd(fetch domainlinkage.DidConfigurationFetcher) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [did] [origin]",
+		Short: "Verify that origins are linked with the DID",
+		Long: `Fetches <origin>/.well-known/did-configuration.json and verifies its Domain Linkage Credentials against the assertion methods of the DID Document on the ledger.
+If the origin is not provided, origins of the DID's LinkedDomains services are verified.
+The '--fetch-from' flag replaces the origin in the URL the DID Configuration is fetched from, e.g. to verify it against a local file server.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fetchFrom, err := cmd.Flags().GetString(FlagFetchFrom)
+			if err != nil {
+				return err
+			}
+
+			didDoc, err := queryDidDoc(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			origins := didDoc.LinkedDomainsOrigins()
+			if len(args) == 2 {
+				origins = []string{args[1]}
+			}
+
+			if len(origins) == 0 {
+				return fmt.Errorf("%s has no %s services", didDoc.Id, didtypes.LinkedDomainsServiceType)
+			}
+
+			type originResult struct {
+				Origin string `json:"origin"`
+				Linked bool   `json:"linked"`
+				Error  string `json:"error,omitempty"`
+			}
+
+			results := make([]originResult, 0, len(origins))
+			allLinked := true
+
+			for _, origin := range origins {
+				err := domainlinkage.VerifyOrigin(cmd.Context(), fetch, didDoc, origin, fetchFrom, time.Now())
+
+				result := originResult{Origin: origin, Linked: err == nil}
+				if err != nil {
+					result.Error = err.Error()
+					allLinked = false
+				}
+
+				results = append(results, result)
+			}
+
+			resultsJSON, err := json.MarshalIndent(results, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(resultsJSON))
+			if err != nil {
+				return err
+			}
+
+			if !allLinked {
+				return errors.New("domain linkage verification failed")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagFetchFrom, "", "Base URL to fetch the DID Configuration from instead of the origin")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readPrivateKey reads the base64 encoded Ed25519 private key from the file or from stdin if the path is '-'
+func readPrivateKey(cmd *cobra.Command, path string) (ed25519.PrivateKey, error) {
+	var encoded []byte
+	var err error
+
+	if path == "-" {
+		encoded, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		encoded, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(privateKeyBytes) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("ed25519 private key must be %d bytes long", ed25519.PrivateKeySize)
+	}
+
+	return privateKeyBytes, nil
+}
+
+func queryDidDoc(ctx context.Context, clientCtx client.Context, did string) (*didtypes.DidDoc, error) {
+	queryClient := didtypes.NewQueryClient(clientCtx)
+
+	resp, err := queryClient.DidDoc(ctx, &didtypes.QueryDidDocRequest{Id: did})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Value.Metadata.Deactivated {
+		return nil, fmt.Errorf("%s is deactivated", did)
+	}
+
+	return resp.Value.DidDoc, nil
+}
+
+func getDateFlag(cmd *cobra.Command, flag string, defaultValue time.Time) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
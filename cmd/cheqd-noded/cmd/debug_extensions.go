@@ -6,7 +6,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
+	"github.com/canow-co/cheqd-node/x/did/client/domainlinkage"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/mr-tron/base58"
 	"github.com/multiformats/go-multibase"
@@ -15,7 +17,8 @@ import (
 
 func ExtendDebug(debugCmd *cobra.Command) *cobra.Command {
 	debugCmd.AddCommand(ed25519Cmd(),
-		encodingCmd())
+		encodingCmd(),
+		didConfigurationCmd(domainlinkage.NewHTTPDidConfigurationFetcher(&http.Client{})))
 
 	return debugCmd
 }
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	appmigrations "github.com/canow-co/cheqd-node/app/migrations"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
 )
 
 var _ = Describe("Migration - Unit", func() {
@@ -190,4 +191,157 @@ var _ = Describe("Migration - Unit", func() {
 		err := migrator.Run()
 		Expect(err).To(BeNil())
 	})
+
+	It("checks that Did created height index migration works", func() {
+		By("Ensuring the Did created height index migration handler is working as expected")
+		// Init storages, keepers and setup the migration context.
+		setup := Setup()
+		setup.SdkCtx = setup.SdkCtx.WithBlockHeight(100)
+
+		// Existing dataset
+		existingDataset := NewExistingDataset(setup)
+		existingDataset.MustAddDidDocV2(JoinGenerated("payload", "service_endpoint", "existing", "v2"), "diddoc")
+
+		// Expected dataset
+		expectedDataset := NewExpectedDataset(setup)
+		expectedDataset.MustAddDidDocV2(JoinGenerated("payload", "service_endpoint", "existing", "v2"), "diddoc")
+
+		// Migrator
+		migrator := NewMigrator(
+			setup,
+			[]appmigrations.Migration{
+				appmigrations.MigrateDidCreatedHeightIndex,
+			},
+			*existingDataset,
+			*expectedDataset)
+
+		// Run migration
+		err := migrator.Run()
+		Expect(err).To(BeNil())
+
+		// Check that DIDDocs created before the upgrade are indexed at the upgrade height
+		didDocs, err := setup.DidKeeper.GetAllDidDocs(&setup.SdkCtx)
+		Expect(err).To(BeNil())
+		Expect(didDocs).NotTo(BeEmpty())
+
+		for _, versionSet := range didDocs {
+			height, found := setup.DidKeeper.GetDidDocCreatedHeight(&setup.SdkCtx, versionSet.DidDocs[0].DidDoc.Id)
+			Expect(found).To(BeTrue())
+			Expect(height).To(Equal(uint64(100)))
+		}
+	})
+
+	It("checks that Did unique id index migration works", func() {
+		By("Ensuring the Did unique id index migration handler is working as expected")
+		// Init storages, keepers and setup the migration context.
+		setup := Setup()
+
+		// Existing dataset
+		existingDataset := NewExistingDataset(setup)
+		existingDataset.MustAddDidDocV2(JoinGenerated("payload", "service_endpoint", "existing", "v2"), "diddoc")
+
+		// Expected dataset
+		expectedDataset := NewExpectedDataset(setup)
+		expectedDataset.MustAddDidDocV2(JoinGenerated("payload", "service_endpoint", "existing", "v2"), "diddoc")
+
+		// Migrator
+		migrator := NewMigrator(
+			setup,
+			[]appmigrations.Migration{
+				appmigrations.MigrateDidUniqueIDIndex,
+			},
+			*existingDataset,
+			*expectedDataset)
+
+		// Run migration
+		err := migrator.Run()
+		Expect(err).To(BeNil())
+
+		// Check that DIDDocs created before the upgrade are found by their unique id
+		didDocs, err := setup.DidKeeper.GetAllDidDocs(&setup.SdkCtx)
+		Expect(err).To(BeNil())
+		Expect(didDocs).NotTo(BeEmpty())
+
+		for _, versionSet := range didDocs {
+			did := versionSet.DidDocs[0].DidDoc.Id
+			_, _, uniqueID := didutils.MustSplitDID(did)
+
+			found, ok := setup.DidKeeper.FindDidByUniqueID(&setup.SdkCtx, uniqueID)
+			Expect(ok).To(BeTrue())
+			Expect(found).To(Equal(did))
+		}
+	})
+
+	It("checks that Did capability invocation notice migration doesn't change the state", func() {
+		By("Ensuring the Did capability invocation notice migration handler is working as expected")
+		// Init storages, keepers and setup the migration context.
+		setup := Setup()
+
+		// Existing dataset
+		existingDataset := NewExistingDataset(setup)
+		existingDataset.MustAddDidDocV2(JoinGenerated("payload", "service_endpoint", "existing", "v2"), "diddoc")
+
+		// Expected dataset
+		expectedDataset := NewExpectedDataset(setup)
+		expectedDataset.MustAddDidDocV2(JoinGenerated("payload", "service_endpoint", "existing", "v2"), "diddoc")
+
+		// Migrator
+		migrator := NewMigrator(
+			setup,
+			[]appmigrations.Migration{
+				appmigrations.MigrateDidCapabilityInvocationNotice,
+			},
+			*existingDataset,
+			*expectedDataset)
+
+		// Run migration and check that the store is unchanged
+		err := migrator.Run()
+		Expect(err).To(BeNil())
+	})
+
+	It("checks that Resource latest version index migration works", func() {
+		By("Ensuring the Resource latest version index migration handler is working as expected")
+		// Init storages, keepers and setup the migration context.
+		setup := Setup()
+
+		// Existing dataset
+		existingDataset := NewExistingDataset(setup)
+		existingDataset.MustAddDidDocV2(JoinGenerated("payload", "resource_links", "expected", "v2"), "diddoc")
+		existingDataset.MustAddResourceV2(JoinGenerated("payload", "resource_links", "expected", "v2"), "resource")
+
+		// Expected dataset
+		expectedDataset := NewExpectedDataset(setup)
+		expectedDataset.MustAddDidDocV2(JoinGenerated("payload", "resource_links", "expected", "v2"), "diddoc")
+		expectedDataset.MustAddResourceV2(JoinGenerated("payload", "resource_links", "expected", "v2"), "resource")
+
+		// Migrator
+		migrator := NewMigrator(
+			setup,
+			[]appmigrations.Migration{
+				appmigrations.MigrateResourceLatestVersionIndex,
+			},
+			*existingDataset,
+			*expectedDataset)
+
+		// Run migration
+		err := migrator.Run()
+		Expect(err).To(BeNil())
+
+		// Check that the index points to the last version of every resource
+		resources, err := setup.ResourceKeeper.GetAllResources(&setup.SdkCtx)
+		Expect(err).To(BeNil())
+		Expect(resources).NotTo(BeEmpty())
+
+		for _, resource := range resources {
+			latest, found := setup.ResourceKeeper.GetLastResourceVersionMetadata(&setup.SdkCtx,
+				resource.Metadata.CollectionId, resource.Metadata.Name, resource.Metadata.ResourceType)
+			Expect(found).To(BeTrue())
+
+			if resource.Metadata.NextVersionId == "" {
+				Expect(latest.Id).To(Equal(resource.Metadata.Id))
+			} else {
+				Expect(latest.Id).NotTo(Equal(resource.Metadata.Id))
+			}
+		}
+	})
 })
@@ -23,6 +23,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetDidDoc(),
 		CmdGetDidDocVersion(),
 		CmdGetAllDidDocVersionsMetadata(),
+		CmdResolveDid(),
+		CmdGetDidDocAtTime(),
+		CmdGetDidDocsByController(),
+		CmdGetDidDocsByPublicKey(),
+		CmdGetAllDidDocs(),
 	)
 
 	return cmd
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDeactivated       = "deactivated"
+	FlagCreatedHeightFrom = "created-height-from"
+	FlagCreatedHeightTo   = "created-height-to"
+	FlagCreatedAfter      = "created-after"
+	FlagCreatedBefore     = "created-before"
+	FlagServiceType       = "service-type"
+	FlagNamespace         = "namespace"
+)
+
+// deactivationFilters maps values of the deactivated flag to the query filter
+var deactivationFilters = map[string]types.DeactivationFilter{
+	"any":         types.DEACTIVATION_FILTER_ANY,
+	"active":      types.DEACTIVATION_FILTER_ACTIVE,
+	"deactivated": types.DEACTIVATION_FILTER_DEACTIVATED,
+}
+
+func CmdGetAllDidDocs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-dids",
+		Short: "Query latest versions of all DID Documents matching the filters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			deactivated, err := cmd.Flags().GetString(FlagDeactivated)
+			if err != nil {
+				return err
+			}
+
+			deactivationFilter, ok := deactivationFilters[deactivated]
+			if !ok {
+				return fmt.Errorf("invalid %s value: %s", FlagDeactivated, deactivated)
+			}
+
+			createdHeightFrom, err := cmd.Flags().GetUint64(FlagCreatedHeightFrom)
+			if err != nil {
+				return err
+			}
+
+			createdHeightTo, err := cmd.Flags().GetUint64(FlagCreatedHeightTo)
+			if err != nil {
+				return err
+			}
+
+			createdAfter, err := cmd.Flags().GetString(FlagCreatedAfter)
+			if err != nil {
+				return err
+			}
+
+			createdBefore, err := cmd.Flags().GetString(FlagCreatedBefore)
+			if err != nil {
+				return err
+			}
+
+			vmType, err := cmd.Flags().GetString(FlagVerificationMethodType)
+			if err != nil {
+				return err
+			}
+
+			serviceType, err := cmd.Flags().GetString(FlagServiceType)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := cmd.Flags().GetString(FlagNamespace)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllDidDocsRequest{
+				Deactivated:            deactivationFilter,
+				CreatedHeightFrom:      createdHeightFrom,
+				CreatedHeightTo:        createdHeightTo,
+				CreatedAfter:           createdAfter,
+				CreatedBefore:          createdBefore,
+				VerificationMethodType: vmType,
+				ServiceType:            serviceType,
+				Namespace:              namespace,
+				Pagination:             pageReq,
+			}
+
+			resp, err := queryClient.AllDidDocs(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-dids")
+
+	cmd.Flags().String(FlagDeactivated, "any", "Deactivation status of DID Documents: any, active or deactivated")
+	cmd.Flags().Uint64(FlagCreatedHeightFrom, 0, "Lowest block height (inclusive) the DID Documents were created at")
+	cmd.Flags().Uint64(FlagCreatedHeightTo, 0, "Highest block height (inclusive) the DID Documents were created at")
+	cmd.Flags().String(FlagCreatedAfter, "", "Earliest creation time (inclusive) of the DID Documents in RFC3339 format")
+	cmd.Flags().String(FlagCreatedBefore, "", "Latest creation time (inclusive) of the DID Documents in RFC3339 format")
+	cmd.Flags().String(FlagVerificationMethodType, "", "Type of verification method the DID Documents must contain")
+	cmd.Flags().String(FlagServiceType, "", "Type of service the DID Documents must contain")
+	cmd.Flags().String(FlagNamespace, "", "Namespace of the DIDs, e.g. testnet. DIDs of all namespaces are returned if not set")
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetDidDocAtTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-at-time [id] [timestamp]",
+		Short: "Query the version of a DID Document that was active at the given RFC3339 time",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			did := args[0]
+			timestamp := args[1]
+			params := &types.QueryDidDocAtTimeRequest{
+				Id:        did,
+				Timestamp: timestamp,
+			}
+
+			resp, err := queryClient.DidDocAtTime(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetDidDocsByController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dids-by-controller [controller]",
+		Short: "Query latest versions of DID Documents controlled by a DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			controller := args[0]
+			params := &types.QueryDidDocsByControllerRequest{
+				Controller: controller,
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.DidDocsByController(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dids-by-controller")
+
+	return cmd
+}
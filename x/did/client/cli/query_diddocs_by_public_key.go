@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const FlagVerificationMethodType = "verification-method-type"
+
+func CmdGetDidDocsByPublicKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dids-by-public-key [public-key]",
+		Short: "Query latest versions of active DID Documents having a verification method with the public key",
+		Long: `Query latest versions of active DID Documents having a verification method with the public key.
+
+		By default, the public key is a multibase encoded multicodec prefixed key, the same as used in did:key.
+		Example: z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK
+
+		Use --verification-method-type to pass verification material of the given type instead.
+		Example: --verification-method-type JsonWebKey2020 '{"crv":"Ed25519","kty":"OKP","x":"..."}'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vmType, err := cmd.Flags().GetString(FlagVerificationMethodType)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			publicKey := args[0]
+			params := &types.QueryDidDocsByPublicKeyRequest{
+				PublicKey:              publicKey,
+				VerificationMethodType: vmType,
+				Pagination:             pageReq,
+			}
+
+			resp, err := queryClient.DidDocsByPublicKey(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagVerificationMethodType, "", "Verification method type the public key is represented with")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dids-by-public-key")
+
+	return cmd
+}
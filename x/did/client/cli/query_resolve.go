@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagAccept      = "accept"
+	FlagVersionTime = "version-time"
+)
+
+func CmdResolveDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [id]",
+		Short: "Resolve a DID according to the W3C DID Resolution specification",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			accept, err := cmd.Flags().GetString(FlagAccept)
+			if err != nil {
+				return err
+			}
+
+			versionID, err := cmd.Flags().GetString(FlagVersionID)
+			if err != nil {
+				return err
+			}
+
+			versionTime, err := cmd.Flags().GetString(FlagVersionTime)
+			if err != nil {
+				return err
+			}
+
+			did := args[0]
+			params := &types.QueryResolveRequest{
+				Id:          did,
+				Accept:      accept,
+				VersionId:   versionID,
+				VersionTime: versionTime,
+			}
+
+			resp, err := queryClient.Resolve(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagAccept, types.DIDJSONLDContentType, "Requested representation of the DID Document (application/did+ld+json|application/did+json)")
+	cmd.Flags().String(FlagVersionID, "", "Resolve a specific version of the DID Document")
+	cmd.Flags().String(FlagVersionTime, "", "Resolve the version of the DID Document that was active at the given RFC3339 time")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -17,7 +19,10 @@ import (
 )
 
 const (
-	FlagVersionID = "version-id"
+	FlagVersionID           = "version-id"
+	FlagPreviousVersionID   = "previous-version-id"
+	FlagNextKeyCommitment   = "next-key-commitment"
+	FlagAutoCompleteContext = "auto-complete-context"
 )
 
 type DIDDocument struct {
@@ -32,14 +37,16 @@ type DIDDocument struct {
 	KeyAgreement         []any                `json:"keyAgreement,omitempty"`
 	Service              []Service            `json:"service,omitempty"`
 	AlsoKnownAs          []string             `json:"alsoKnownAs,omitempty"`
+	ControllerThreshold  uint32               `json:"controllerThreshold,omitempty"`
 }
 
 type VerificationMethod map[string]any
 
+// Service is a service in the DID Core form. ServiceEndpoint is a string, a map or a list of strings and maps.
 type Service struct {
 	ID              string   `json:"id"`
 	Type            string   `json:"type"`
-	ServiceEndpoint []string `json:"serviceEndpoint"`
+	ServiceEndpoint any      `json:"serviceEndpoint"`
 	Accept          []string `json:"accept,omitempty"`
 	RoutingKeys     []string `json:"routingKeys,omitempty"`
 }
@@ -52,6 +59,8 @@ type PayloadWithSignInputs struct {
 type SignInput struct {
 	VerificationMethodID string
 	PrivKey              ed25519.PrivateKey
+	// Version of the DID Document the verification method is taken from (optional)
+	VersionID string
 }
 
 // AddTxFlagsToCmd adds common flags to a module tx command.
@@ -98,6 +107,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateDidDoc())
 	cmd.AddCommand(CmdUpdateDidDoc())
 	cmd.AddCommand(CmdDeactivateDidDoc())
+	cmd.AddCommand(CmdPatchDidDoc())
+	cmd.AddCommand(CmdGenerateNextKeyCommitment())
 
 	return cmd
 }
@@ -111,6 +122,7 @@ func SignWithSignInputs(signBytes []byte, signInputs []SignInput) []*types.SignI
 		signInfo := types.SignInfo{
 			VerificationMethodId: signInput.VerificationMethodID,
 			Signature:            signatureBytes,
+			VersionId:            signInput.VersionID,
 		}
 
 		signatures = append(signatures, &signInfo)
@@ -176,18 +188,31 @@ func GetFromSpecCompliantPayload(specPayload DIDDocument) ([]*types.Verification
 	}
 	service := make([]*types.Service, 0, len(specPayload.Service))
 	for _, s := range specPayload.Service {
-		service = append(service, &types.Service{
-			Id:              s.ID,
-			ServiceType:     s.Type,
-			ServiceEndpoint: s.ServiceEndpoint,
-			Accept:          s.Accept,
-			RoutingKeys:     s.RoutingKeys,
-		})
+		normalizedService, err := GetServiceFromSpecCompliant(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		service = append(service, normalizedService)
 	}
 
 	return verificationMethod, service, nil
 }
 
+func GetServiceFromSpecCompliant(specService Service) (*types.Service, error) {
+	bz, err := json.Marshal(specService)
+	if err != nil {
+		return nil, err
+	}
+
+	var service types.Service
+	err = service.UnmarshalW3CJSON(bz)
+	if err != nil {
+		return nil, fmt.Errorf("service %s: %w", specService.ID, err)
+	}
+
+	return &service, nil
+}
+
 func GetMixedVerificationMethodList(verificationRelationship []any) ([]*types.VerificationRelationship, error) {
 	verificationRelationshipList := make([]*types.VerificationRelationship, 0, len(verificationRelationship))
 	for i, vr := range verificationRelationship {
@@ -221,7 +246,7 @@ func getVerificationMethodFromSpecComplant(index int, vm VerificationMethod) (*t
 		verificationMethodType = value
 	}
 	switch verificationMethodType {
-	case "Ed25519VerificationKey2020":
+	case "Ed25519VerificationKey2020", "Multikey", "X25519KeyAgreementKey2020":
 		_, ok := vm["publicKeyMultibase"]
 		if !ok {
 			return nil, fmt.Errorf("%d: publicKeyMultibase is not specified", index)
@@ -233,7 +258,7 @@ func getVerificationMethodFromSpecComplant(index int, vm VerificationMethod) (*t
 			Controller:             vm["controller"].(string),
 			VerificationMaterial:   vm["publicKeyMultibase"].(string),
 		}, nil
-	case "Ed25519VerificationKey2018":
+	case "Ed25519VerificationKey2018", "X25519KeyAgreementKey2019":
 		_, ok := vm["publicKeyBase58"]
 		if !ok {
 			return nil, fmt.Errorf("%d: publicKeyBase58 is not specified", index)
@@ -245,7 +270,7 @@ func getVerificationMethodFromSpecComplant(index int, vm VerificationMethod) (*t
 			Controller:             vm["controller"].(string),
 			VerificationMaterial:   vm["publicKeyBase58"].(string),
 		}, nil
-	case "JsonWebKey2020":
+	case "JsonWebKey2020", "JsonWebKey":
 		_, ok := vm["publicKeyJwk"]
 		if !ok {
 			return nil, fmt.Errorf("%d: publicKeyJwk is not specified", index)
@@ -262,7 +287,89 @@ func getVerificationMethodFromSpecComplant(index int, vm VerificationMethod) (*t
 			Controller:             vm["controller"].(string),
 			VerificationMaterial:   string(jwk),
 		}, nil
+	case "EcdsaSecp256k1VerificationKey2019":
+		var verificationMaterial string
+
+		if publicKeyJwk, ok := vm["publicKeyJwk"]; ok {
+			jwk, err := json.Marshal(publicKeyJwk)
+			if err != nil {
+				return nil, err
+			}
+
+			verificationMaterial = string(jwk)
+		} else if publicKeyMultibase, ok := vm["publicKeyMultibase"].(string); ok {
+			verificationMaterial = publicKeyMultibase
+		} else {
+			return nil, fmt.Errorf("%d: neither publicKeyJwk nor publicKeyMultibase is specified", index)
+		}
+
+		return &types.VerificationMethod{
+			Id:                     vm["id"].(string),
+			VerificationMethodType: vm["type"].(string),
+			Controller:             vm["controller"].(string),
+			VerificationMaterial:   verificationMaterial,
+		}, nil
 	default:
 		return nil, fmt.Errorf("%d: verification method type is not supported", index)
 	}
 }
+
+// GetNextKeyCommitment reads and validates the next key commitment flag
+func GetNextKeyCommitment(cmd *cobra.Command) (string, error) {
+	nextKeyCommitment, err := cmd.Flags().GetString(FlagNextKeyCommitment)
+	if err != nil {
+		return "", err
+	}
+
+	if nextKeyCommitment != "" {
+		err = utils.ValidateMultibaseSha256Multihash(nextKeyCommitment)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return nextKeyCommitment, nil
+}
+
+// GetPreviousVersionID returns the version the change is based on: the value of the previous-version-id flag,
+// the value set in the payload or the latest version of the DID Document queried from the ledger.
+// The query is skipped in offline mode.
+func GetPreviousVersionID(cmd *cobra.Command, clientCtx client.Context, did string, payloadPreviousVersionID string) (string, error) {
+	previousVersionID, err := cmd.Flags().GetString(FlagPreviousVersionID)
+	if err != nil {
+		return "", err
+	}
+
+	if previousVersionID != "" {
+		return previousVersionID, nil
+	}
+
+	if payloadPreviousVersionID != "" || clientCtx.Offline {
+		return payloadPreviousVersionID, nil
+	}
+
+	return QueryLatestVersionID(cmd.Context(), clientCtx, did)
+}
+
+// QueryLatestVersionID returns the latest version of the DID Document queried from the ledger
+func QueryLatestVersionID(ctx context.Context, clientCtx client.Context, did string) (string, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	resp, err := queryClient.DidDoc(ctx, &types.QueryDidDocRequest{Id: did})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Value.Metadata.VersionId, nil
+}
+
+// GetEnvelopeSignBytes wraps the payload sign bytes into the signing envelope of the chain the transaction is sent to.
+// currentVersionID is the latest version of the DID Document the payload is applied to, empty for DID Document creation.
+func GetEnvelopeSignBytes(clientCtx client.Context, did string, currentVersionID string, payloadSignBytes []byte) ([]byte, error) {
+	_, namespace, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.GetEnvelopeSignBytes(clientCtx.ChainID, namespace, currentVersionID, payloadSignBytes), nil
+}
@@ -21,11 +21,15 @@ func CmdCreateDidDoc() *cobra.Command {
 [payload-file] is JSON encoded DID Document alongside with sign inputs.
 Version ID is optional and is determined by the '--version-id' flag.
 If not provided, a random UUID will be used as version-id.
+Next key commitment is optional and is determined by the '--next-key-commitment' flag.
+Contexts required by the used verification method and service types are added to the payload if '--auto-complete-context' flag is set.
 
 NOTES:
 1. Fee used for the transaction will ALWAYS take the fixed fee for DID Document creation, REGARDLESS of what value is passed in '--fees' flag.
 2. Payload file should be a JSON file containing properties specified in the DID Core Specification. Rules from DID Core spec are followed on which properties are mandatory and which ones are optional.
 3. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+4. If the DID Document declares contexts, they have to include the contexts defining its verification method and service types, e.g. "https://w3id.org/security/suites/ed25519-2020/v1" for Ed25519VerificationKey2020.
+5. Service "serviceEndpoint" may be a string, a map (e.g. a DIDComm v2 endpoint {"uri": "...", "accept": [...], "routingKeys": [...]}) or a list of strings and maps.
 
 Example payload file:
 {
@@ -97,6 +101,11 @@ Example payload file:
 				versionID = uuid.NewString()
 			}
 
+			nextKeyCommitment, err := GetNextKeyCommitment(cmd)
+			if err != nil {
+				return err
+			}
+
 			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(payloadFile)
 			if err != nil {
 				return err
@@ -149,11 +158,29 @@ Example payload file:
 				KeyAgreement:         keyAgreement,
 				Service:              service,
 				AlsoKnownAs:          specPayload.AlsoKnownAs,
+				ControllerThreshold:  specPayload.ControllerThreshold,
 				VersionId:            versionID,
+				NextKeyCommitment:    nextKeyCommitment,
+			}
+
+			// Add JSON-LD contexts required by the used types if requested
+			autoCompleteContext, err := cmd.Flags().GetBool(FlagAutoCompleteContext)
+			if err != nil {
+				return err
+			}
+
+			if autoCompleteContext {
+				didDoc := payload.ToDidDoc()
+				didDoc.AutoCompleteContext()
+				payload.Context = didDoc.Context
 			}
 
 			// Build identity message
-			signBytes := payload.GetSignBytes()
+			signBytes, err := GetEnvelopeSignBytes(clientCtx, payload.Id, "", payload.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
 			identitySignatures := SignWithSignInputs(signBytes, signInputs)
 
 			msg := types.MsgCreateDidDoc{
@@ -176,6 +203,8 @@ Example payload file:
 
 	// add custom / override flags
 	cmd.Flags().String(FlagVersionID, "", "Version ID of the DID Document")
+	cmd.Flags().Bool(FlagAutoCompleteContext, false, "Add JSON-LD contexts required by the used verification method and service types to the DID Document")
+	cmd.Flags().String(FlagNextKeyCommitment, "", "Pre-rotation commitment to the next authentication key set, see 'generate-next-key-commitment' command")
 	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultCreateDidTxFee)).String(), "Fixed fee for DID creation, e.g., 50000000000"+types.BaseMinimalDenom+". Please check what the current fees are by running 'cheqd-noded query params subspace cheqd feeparams'")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFees)
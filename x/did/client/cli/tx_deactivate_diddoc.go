@@ -13,7 +13,7 @@ import (
 
 func CmdDeactivateDidDoc() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "deactivate-did [payload-file] --version-id [version-id]",
+		Use:   "deactivate-did [payload-file] --version-id [version-id] --previous-version-id [previous-version-id]",
 		Short: "Deactivate a DID.",
 		Long: `Deactivates a DID and its associated DID Document.
 [payload-file] is JSON encoded MsgDeactivateDidDocPayload alongside with sign inputs.
@@ -21,8 +21,9 @@ func CmdDeactivateDidDoc() *cobra.Command {
 NOTES:
 1. Fee used for the transaction will ALWAYS take the fixed fee for DID Document deactivation, REGARDLESS of what value is passed in '--fees' flag.
 2. A new DID Document version is created when deactivating a DID Document so that the operation timestamp can be recorded. Version ID is optional and is determined by the '--version-id' flag. If not provided, a random UUID will be used as version-id.
-3. Payload file should be a JSON file containing the properties given in example below.
-4. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+3. Previous version ID is optional and is determined by the '--previous-version-id' flag or the payload. If not provided, the latest version of the DID Document is queried from the ledger. The deactivation is rejected if it isn't the latest version.
+4. Payload file should be a JSON file containing the properties given in example below.
+5. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
 
 Example payload file:
 {
@@ -77,8 +78,18 @@ Example payload file:
 			// Set version id from flag or random
 			payload.VersionId = versionID
 
+			// Set previous version id from flag, payload or query
+			payload.PreviousVersionId, err = GetPreviousVersionID(cmd, clientCtx, payload.Id, payload.PreviousVersionId)
+			if err != nil {
+				return err
+			}
+
 			// Build identity message
-			signBytes := payload.GetSignBytes()
+			signBytes, err := GetEnvelopeSignBytes(clientCtx, payload.Id, payload.PreviousVersionId, payload.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
 			identitySignatures := SignWithSignInputs(signBytes, signInputs)
 
 			msg := types.MsgDeactivateDidDoc{
@@ -101,6 +112,7 @@ Example payload file:
 
 	// add custom / override flags
 	cmd.Flags().String(FlagVersionID, "", "Version ID of the DID Document")
+	cmd.Flags().String(FlagPreviousVersionID, "", "Version ID of the DID Document the deactivation is based on, overrides the payload. Required to sign the payload in offline mode")
 	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultDeactivateDidTxFee)).String(), "Fixed fee for DID deactivation, e.g., 10000000000"+types.BaseMinimalDenom+". Please check what the current fees by running 'cheqd-noded query params subspace cheqd feeparams'")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFees)
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGenerateNextKeyCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-next-key-commitment [payload-file]",
+		Short: "Generates a pre-rotation commitment to the next authentication key set of a DID Document.",
+		Long: `Generates a pre-rotation commitment to the authentication keys of the next version of a DID Document.
+[payload-file] is JSON encoded next version of the DID Document, the same as for 'update-did' command. Sign inputs are not required.
+The commitment is printed to the output and can be passed to 'create-did', 'update-did' and 'patch-did' commands with '--next-key-commitment' flag.
+The command works offline and doesn't send anything to the ledger.
+
+NOTES:
+1. The commitment covers public keys of embedded authentication methods and of all verification methods except key agreement ones. Verification method ids and key representations are not committed to.
+2. Once the commitment is published, authentication keys of the DID Document can be changed only to exactly the committed key set.
+3. Only hash of the keys is published, so the next keys stay unknown until they are used.
+
+Example payload file:
+{
+    "payload": {
+        "id": "did:canow:<namespace>:<unique-identifier>",
+        "authentication": [
+            "did:canow:<namespace>:<unique-identifier>#<next-key-id>"
+        ],
+        "verificationMethod": [
+            {
+                "id": "did:canow:<namespace>:<unique-identifier>#<next-key-id>",
+                "type": "<verification-method-type>",
+                "controller": "did:canow:<namespace>:<unique-identifier>",
+                "publicKeyMultibase": "<next-public-key>"
+            }
+        ]
+    }
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			payloadJSON, _, err := ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal spec-compliant payload
+			var specPayload DIDDocument
+			err = json.Unmarshal([]byte(payloadJSON), &specPayload)
+			if err != nil {
+				return err
+			}
+
+			verificationMethod, _, err := GetFromSpecCompliantPayload(specPayload)
+			if err != nil {
+				return err
+			}
+
+			authentication, err := GetMixedVerificationMethodList(specPayload.Authentication)
+			if err != nil {
+				return err
+			}
+
+			didDoc := types.DidDoc{
+				Id:                 specPayload.ID,
+				VerificationMethod: verificationMethod,
+				Authentication:     authentication,
+			}
+
+			commitment, err := didDoc.GetNextKeyCommitment()
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), commitment)
+			return err
+		},
+	}
+
+	return cmd
+}
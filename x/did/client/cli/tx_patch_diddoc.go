@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+type DIDDocumentPatch struct {
+	ID                string           `json:"id"`
+	PreviousVersionID string           `json:"previousVersionId"`
+	Operations        []PatchOperation `json:"operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+func CmdPatchDidDoc() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch-did [payload-file] --version-id [version-id]",
+		Short: "Partially updates a DID Document.",
+		Long: `Applies a list of RFC 6902 style operations to the latest version of the DID Document associated with a given DID.
+[payload-file] is JSON encoded patch alongside with sign inputs.
+Version ID is optional and is determined by the '--version-id' flag.
+If not provided, a random UUID will be used as version-id.
+Next key commitment is optional and is determined by the '--next-key-commitment' flag.
+
+NOTES:
+1. Fee used for the transaction will ALWAYS take the fixed fee for DID Document update, REGARDLESS of what value is passed in '--fees' flag.
+2. Supported operations are add, remove and replace. Paths have the form /<property>/<index>, where property is one of verificationMethod, authentication, assertionMethod, capabilityInvocation, capabilityDelegation, keyAgreement, service, alsoKnownAs and context. Index "-" appends an item to the list.
+3. Previous version ID must be the latest version of the DID Document, otherwise the patch is rejected. It is determined by the '--previous-version-id' flag or the payload. If not provided, the latest version of the DID Document is queried from the ledger.
+4. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+5. Next key commitment rules are the same as for 'update-did' command.
+6. Patches changing services only can be signed by a key from "capabilityInvocation" instead of controllers.
+7. Service "serviceEndpoint" may be a string, a map (e.g. a DIDComm v2 endpoint {"uri": "...", "accept": [...], "routingKeys": [...]}) or a list of strings and maps.
+
+Example payload file:
+{
+    "payload": {
+        "id": "did:canow:<namespace>:<unique-identifier>",
+        "previousVersionId": "<latest-version-id>",
+        "operations": [
+            {
+                "op": "add",
+                "path": "/verificationMethod/-",
+                "value": {
+                    "id": "did:canow:<namespace>:<unique-identifier>#<key-id>",
+                    "type": "<verification-method-type>",
+                    "controller": "did:canow:<namespace>:<unique-identifier>",
+                    "publicKeyMultibase": "<public-key>"
+                }
+            },
+            {
+                "op": "add",
+                "path": "/assertionMethod/-",
+                "value": "did:canow:<namespace>:<unique-identifier>#<key-id>"
+            },
+            {
+                "op": "remove",
+                "path": "/service/0"
+            }
+        ]
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:canow:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read payload file arg
+			payloadFile := args[0]
+
+			// Read version-id flag
+			versionID, err := cmd.Flags().GetString(FlagVersionID)
+			if err != nil {
+				return err
+			}
+
+			if versionID != "" {
+				err = utils.ValidateUUID(versionID)
+				if err != nil {
+					return err
+				}
+			} else {
+				versionID = uuid.NewString()
+			}
+
+			nextKeyCommitment, err := GetNextKeyCommitment(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(payloadFile)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal spec-compliant patch
+			var specPatch DIDDocumentPatch
+			err = json.Unmarshal([]byte(payloadJSON), &specPatch)
+			if err != nil {
+				return err
+			}
+
+			operations := make([]*types.PatchOperation, 0, len(specPatch.Operations))
+			for i, specOperation := range specPatch.Operations {
+				operation, err := getPatchOperationFromSpecCompliant(i, specOperation)
+				if err != nil {
+					return err
+				}
+
+				operations = append(operations, operation)
+			}
+
+			// Set previous version id from flag, payload or query
+			specPatch.PreviousVersionID, err = GetPreviousVersionID(cmd, clientCtx, specPatch.ID, specPatch.PreviousVersionID)
+			if err != nil {
+				return err
+			}
+
+			// Construct MsgPatchDidDocPayload
+			payload := types.MsgPatchDidDocPayload{
+				Id:                specPatch.ID,
+				Operations:        operations,
+				PreviousVersionId: specPatch.PreviousVersionID,
+				VersionId:         versionID, // Set version id, from flag or random
+				NextKeyCommitment: nextKeyCommitment,
+			}
+
+			// Build identity message
+			signBytes, err := GetEnvelopeSignBytes(clientCtx, payload.Id, payload.PreviousVersionId, payload.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			identitySignatures := SignWithSignInputs(signBytes, signInputs)
+
+			msg := types.MsgPatchDidDoc{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(FlagVersionID, "", "Version ID of the DID Document")
+	cmd.Flags().String(FlagNextKeyCommitment, "", "Pre-rotation commitment to the next authentication key set, see 'generate-next-key-commitment' command")
+	cmd.Flags().String(FlagPreviousVersionID, "", "Version ID of the DID Document the patch is based on, overrides the payload. Required to sign the payload in offline mode")
+	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultUpdateDidTxFee)).String(), "Fixed fee for DID update, e.g., 25000000000"+types.BaseMinimalDenom+". Please check what the current fees by running 'cheqd-noded query params subspace cheqd feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
+
+// getPatchOperationFromSpecCompliant converts the spec-compliant value of the operation to the type matching its path
+func getPatchOperationFromSpecCompliant(index int, specOperation PatchOperation) (*types.PatchOperation, error) {
+	operation := &types.PatchOperation{
+		Op:   specOperation.Op,
+		Path: specOperation.Path,
+	}
+
+	if len(specOperation.Value) == 0 {
+		return operation, nil
+	}
+
+	property, _, err := operation.ParsePath()
+	if err != nil {
+		return nil, fmt.Errorf("%d: %w", index, err)
+	}
+
+	switch property {
+	case types.PatchPropertyVerificationMethod:
+		var vm VerificationMethod
+		err = json.Unmarshal(specOperation.Value, &vm)
+		if err != nil {
+			return nil, err
+		}
+
+		operation.VerificationMethod, err = getVerificationMethodFromSpecComplant(index, vm)
+		if err != nil {
+			return nil, err
+		}
+	case types.PatchPropertyAuthentication, types.PatchPropertyAssertionMethod, types.PatchPropertyCapabilityInvocation,
+		types.PatchPropertyCapabilityDelegation, types.PatchPropertyKeyAgreement:
+		var vr any
+		err = json.Unmarshal(specOperation.Value, &vr)
+		if err != nil {
+			return nil, err
+		}
+
+		relationships, err := GetMixedVerificationMethodList([]any{vr})
+		if err != nil {
+			return nil, err
+		}
+
+		operation.VerificationRelationship = relationships[0]
+	case types.PatchPropertyService:
+		var service Service
+		err = json.Unmarshal(specOperation.Value, &service)
+		if err != nil {
+			return nil, err
+		}
+
+		operation.Service, err = GetServiceFromSpecCompliant(service)
+		if err != nil {
+			return nil, err
+		}
+	case types.PatchPropertyAlsoKnownAs:
+		err = json.Unmarshal(specOperation.Value, &operation.AlsoKnownAs)
+		if err != nil {
+			return nil, err
+		}
+	case types.PatchPropertyContext:
+		err = json.Unmarshal(specOperation.Value, &operation.Context)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%d: unsupported property: %s", index, property)
+	}
+
+	return operation, nil
+}
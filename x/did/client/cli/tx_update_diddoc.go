@@ -15,18 +15,27 @@ import (
 
 func CmdUpdateDidDoc() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-did [payload-file] --version-id [version-id]",
+		Use:   "update-did [payload-file] --version-id [version-id] --previous-version-id [previous-version-id]",
 		Short: "Updates a DID and its associated DID Document.",
 		Long: `Update DID Document associated with a given DID.
 [payload-file] is JSON encoded DID Document alongside with sign inputs.
 Version ID is optional and is determined by the '--version-id' flag.
 If not provided, a random UUID will be used as version-id.
+Previous version ID is optional and is determined by the '--previous-version-id' flag.
+If not provided, the latest version of the DID Document is queried from the ledger. The update is rejected if another update lands first.
+Next key commitment is optional and is determined by the '--next-key-commitment' flag.
+Contexts required by the used verification method and service types are added to the payload if '--auto-complete-context' flag is set.
 
 NOTES:
 1. Fee used for the transaction will ALWAYS take the fixed fee for DID Document update, REGARDLESS of what value is passed in '--fees' flag.
 2. DID update operations require the FULL new DID Document to be provided. Specifying just the changes/diff is not supported.
 3. Payload file should be a JSON file containing properties specified in the DID Core Specification. Rules from DID Core spec are followed on which properties are mandatory and which ones are optional.
 4. Private key provided in sign inputs is ONLY used locally to generate signature(s) and not sent to the ledger.
+5. If the DID Document has a next key commitment, authentication keys can be changed only to the committed ones. Controllers, the controller threshold and the commitment itself can be changed only together with such key rotation.
+6. A sign input may set "versionId" to the version of the DID Document its key is taken from: the version being updated or the new one set by '--version-id'. Otherwise, the signature is checked against both versions.
+7. Updates changing services only can be signed by a key from "capabilityInvocation" instead of controllers. Keys referenced from "capabilityInvocation" but not from "authentication" can't sign any other changes.
+8. If the DID Document declares contexts, they have to include the contexts defining its verification method and service types.
+9. Service "serviceEndpoint" may be a string, a map (e.g. a DIDComm v2 endpoint {"uri": "...", "accept": [...], "routingKeys": [...]}) or a list of strings and maps.
 
 Example payload file:
 {
@@ -94,6 +103,11 @@ Example payload file:
 				versionID = uuid.NewString()
 			}
 
+			nextKeyCommitment, err := GetNextKeyCommitment(cmd)
+			if err != nil {
+				return err
+			}
+
 			payloadJSON, signInputs, err := ReadPayloadWithSignInputsFromFile(payloadFile)
 			if err != nil {
 				return err
@@ -133,6 +147,11 @@ Example payload file:
 				return err
 			}
 
+			previousVersionID, err := GetPreviousVersionID(cmd, clientCtx, specPayload.ID, "")
+			if err != nil {
+				return err
+			}
+
 			// Construct MsgUpdateDidDocPayload
 			payload := types.MsgUpdateDidDocPayload{
 				Context:              specPayload.Context,
@@ -146,11 +165,30 @@ Example payload file:
 				KeyAgreement:         keyAgreement,
 				Service:              service,
 				AlsoKnownAs:          specPayload.AlsoKnownAs,
+				ControllerThreshold:  specPayload.ControllerThreshold,
 				VersionId:            versionID, // Set version id, from flag or random
+				PreviousVersionId:    previousVersionID,
+				NextKeyCommitment:    nextKeyCommitment,
+			}
+
+			// Add JSON-LD contexts required by the used types if requested
+			autoCompleteContext, err := cmd.Flags().GetBool(FlagAutoCompleteContext)
+			if err != nil {
+				return err
+			}
+
+			if autoCompleteContext {
+				didDoc := payload.ToDidDoc()
+				didDoc.AutoCompleteContext()
+				payload.Context = didDoc.Context
 			}
 
 			// Build identity message
-			signBytes := payload.GetSignBytes()
+			signBytes, err := GetEnvelopeSignBytes(clientCtx, payload.Id, payload.PreviousVersionId, payload.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
 			identitySignatures := SignWithSignInputs(signBytes, signInputs)
 
 			msg := types.MsgUpdateDidDoc{
@@ -173,6 +211,9 @@ Example payload file:
 
 	// add custom / override flags
 	cmd.Flags().String(FlagVersionID, "", "Version ID of the DID Document")
+	cmd.Flags().Bool(FlagAutoCompleteContext, false, "Add JSON-LD contexts required by the used verification method and service types to the DID Document")
+	cmd.Flags().String(FlagNextKeyCommitment, "", "Pre-rotation commitment to the next authentication key set, see 'generate-next-key-commitment' command")
+	cmd.Flags().String(FlagPreviousVersionID, "", "Version ID of the DID Document the update is based on. Required to sign the payload in offline mode")
 	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultUpdateDidTxFee)).String(), "Fixed fee for DID update, e.g., 25000000000"+types.BaseMinimalDenom+". Please check what the current fees by running 'cheqd-noded query params subspace cheqd feeparams'")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFees)
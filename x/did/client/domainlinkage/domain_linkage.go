@@ -0,0 +1,319 @@
+package domainlinkage
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+// Domain Linkage Credentials, as defined in the DIF Well Known DID Configuration specification.
+// Only the JWT format is supported.
+// Documentation: https://identity.foundation/.well-known/resources/did-configuration/
+const (
+	DidConfigurationContext        = "https://identity.foundation/.well-known/did-configuration/v1"
+	DidConfigurationPath           = "/.well-known/did-configuration.json"
+	CredentialsContext             = "https://www.w3.org/2018/credentials/v1"
+	VerifiableCredentialType       = "VerifiableCredential"
+	DomainLinkageCredentialType    = "DomainLinkageCredential"
+	DomainLinkageJWTAlgorithmEdDSA = "EdDSA"
+)
+
+// DidConfiguration is the DID Configuration resource served by an origin
+type DidConfiguration struct {
+	Context    string            `json:"@context"`
+	LinkedDids []json.RawMessage `json:"linked_dids"`
+}
+
+type domainLinkageJWTHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ,omitempty"`
+}
+
+type domainLinkageJWTPayload struct {
+	Iss string                     `json:"iss"`
+	Sub string                     `json:"sub"`
+	Nbf int64                      `json:"nbf"`
+	Exp int64                      `json:"exp,omitempty"`
+	Vc  domainLinkageCredentialJWT `json:"vc"`
+}
+
+type domainLinkageCredentialJWT struct {
+	Context           []string                       `json:"@context"`
+	Issuer            string                         `json:"issuer"`
+	IssuanceDate      string                         `json:"issuanceDate"`
+	ExpirationDate    string                         `json:"expirationDate,omitempty"`
+	Type              []string                       `json:"type"`
+	CredentialSubject domainLinkageCredentialSubject `json:"credentialSubject"`
+}
+
+type domainLinkageCredentialSubject struct {
+	ID     string `json:"id"`
+	Origin string `json:"origin"`
+}
+
+// NewDidConfiguration builds the DID Configuration resource from Domain Linkage Credentials in the JWT format
+func NewDidConfiguration(jwts ...string) DidConfiguration {
+	linkedDids := make([]json.RawMessage, 0, len(jwts))
+	for _, jwt := range jwts {
+		linkedDids = append(linkedDids, json.RawMessage(utils.MustEncodeJSON(jwt)))
+	}
+
+	return DidConfiguration{
+		Context:    DidConfigurationContext,
+		LinkedDids: linkedDids,
+	}
+}
+
+// NormalizeOrigin brings the origin to the <scheme>://<host>[:<port>] form
+func NormalizeOrigin(origin string) (string, error) {
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return "", err
+	}
+
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("origin must have a scheme and a host: %s", origin)
+	}
+
+	if strings.Trim(parsed.Path, "/") != "" || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", fmt.Errorf("origin must not have a path, a query or a fragment: %s", origin)
+	}
+
+	return strings.ToLower(parsed.Scheme) + "://" + strings.ToLower(parsed.Host), nil
+}
+
+// BuildDomainLinkageJWT builds a Domain Linkage Credential linking the DID of the key with the origin
+// and signs it with the Ed25519 private key
+func BuildDomainLinkageJWT(keyID string, origin string, privateKey ed25519.PrivateKey, issuanceDate time.Time, expirationDate time.Time) (string, error) {
+	did, _, _, _, err := utils.TrySplitDIDUrl(keyID)
+	if err != nil {
+		return "", err
+	}
+
+	origin, err = NormalizeOrigin(origin)
+	if err != nil {
+		return "", err
+	}
+
+	header := domainLinkageJWTHeader{
+		Alg: DomainLinkageJWTAlgorithmEdDSA,
+		Kid: keyID,
+		Typ: "JWT",
+	}
+
+	payload := domainLinkageJWTPayload{
+		Iss: did,
+		Sub: did,
+		Nbf: issuanceDate.Unix(),
+		Vc: domainLinkageCredentialJWT{
+			Context:      []string{CredentialsContext, DidConfigurationContext},
+			Issuer:       did,
+			IssuanceDate: issuanceDate.UTC().Format(time.RFC3339),
+			Type:         []string{VerifiableCredentialType, DomainLinkageCredentialType},
+			CredentialSubject: domainLinkageCredentialSubject{
+				ID:     did,
+				Origin: origin,
+			},
+		},
+	}
+
+	if !expirationDate.IsZero() {
+		payload.Exp = expirationDate.Unix()
+		payload.Vc.ExpirationDate = expirationDate.UTC().Format(time.RFC3339)
+	}
+
+	signingInput := encodeJWTPart(utils.MustEncodeJSON(header)) + "." + encodeJWTPart(utils.MustEncodeJSON(payload))
+	signature := ed25519.Sign(privateKey, []byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// GetDomainLinkageJWTIssuer returns the DID which issued the Domain Linkage Credential without verifying it
+func GetDomainLinkageJWTIssuer(jwt string) (string, error) {
+	_, payload, _, _, err := parseDomainLinkageJWT(jwt)
+	if err != nil {
+		return "", err
+	}
+
+	return payload.Iss, nil
+}
+
+// VerifyDomainLinkageJWT verifies that the Domain Linkage Credential links the diddoc with the origin
+// and is signed by one of the assertion methods of the diddoc
+func VerifyDomainLinkageJWT(didDoc *types.DidDoc, origin string, jwt string, now time.Time) error {
+	header, payload, signingInput, signature, err := parseDomainLinkageJWT(jwt)
+	if err != nil {
+		return err
+	}
+
+	origin, err = NormalizeOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	if header.Alg == "" || strings.EqualFold(header.Alg, "none") {
+		return errors.New("jwt must be signed")
+	}
+
+	if payload.Iss != didDoc.Id || payload.Sub != didDoc.Id || payload.Vc.CredentialSubject.ID != didDoc.Id {
+		return fmt.Errorf("iss, sub and credentialSubject.id must be %s", didDoc.Id)
+	}
+
+	if payload.Vc.Issuer != "" && payload.Vc.Issuer != didDoc.Id {
+		return fmt.Errorf("issuer must be %s", didDoc.Id)
+	}
+
+	subjectOrigin, err := NormalizeOrigin(payload.Vc.CredentialSubject.Origin)
+	if err != nil || subjectOrigin != origin {
+		return fmt.Errorf("credentialSubject.origin must be %s", origin)
+	}
+
+	if !utils.Contains(payload.Vc.Type, DomainLinkageCredentialType) {
+		return fmt.Errorf("type must include %s", DomainLinkageCredentialType)
+	}
+
+	if !utils.Contains(payload.Vc.Context, DidConfigurationContext) {
+		return fmt.Errorf("@context must include %s", DidConfigurationContext)
+	}
+
+	if now.Before(time.Unix(payload.Nbf, 0)) {
+		return errors.New("credential is not valid yet")
+	}
+
+	if payload.Exp != 0 && !now.Before(time.Unix(payload.Exp, 0)) {
+		return errors.New("credential is expired")
+	}
+
+	vm, found := types.FindVerificationMethod(didDoc.AssertionMethods(), header.Kid)
+	if !found {
+		return fmt.Errorf("kid %s must be an assertion method of %s", header.Kid, didDoc.Id)
+	}
+
+	alg, err := GetDomainLinkageJWTAlgorithm(*vm)
+	if err != nil {
+		return err
+	}
+
+	if header.Alg != alg {
+		return fmt.Errorf("alg %s doesn't match the verification method %s, expected %s", header.Alg, vm.Id, alg)
+	}
+
+	return types.VerifySignature(*vm, []byte(signingInput), signature)
+}
+
+// GetDomainLinkageJWTAlgorithm returns the JWS algorithm of Domain Linkage Credentials signed by the verification method.
+// Only Ed25519 keys are supported: Ed25519 verification keys, Ed25519 JWKs and Ed25519 multikeys.
+func GetDomainLinkageJWTAlgorithm(vm types.VerificationMethod) (string, error) {
+	switch vm.VerificationMethodType {
+	case types.Ed25519VerificationKey2020Type, types.Ed25519VerificationKey2018Type:
+		return DomainLinkageJWTAlgorithmEdDSA, nil
+
+	case types.JSONWebKey2020Type, types.JSONWebKeyType:
+		var key struct {
+			Kty string `json:"kty"`
+			Crv string `json:"crv"`
+		}
+
+		if json.Unmarshal([]byte(vm.VerificationMaterial), &key) == nil && key.Kty == "OKP" && key.Crv == "Ed25519" {
+			return DomainLinkageJWTAlgorithmEdDSA, nil
+		}
+
+	case types.MultikeyType:
+		code, _, err := utils.ParseMultikey(vm.VerificationMaterial)
+		if err == nil && code == utils.Ed25519PubCode {
+			return DomainLinkageJWTAlgorithmEdDSA, nil
+		}
+	}
+
+	return "", fmt.Errorf("verification method %s of type %s can't sign Domain Linkage Credentials, only Ed25519 keys are supported",
+		vm.Id, vm.VerificationMethodType)
+}
+
+// VerifyDidConfiguration verifies that the DID Configuration resource served by the origin links it with the diddoc.
+// At least one Domain Linkage Credential issued by the DID must be valid. Credentials of other DIDs
+// and credentials in the JSON-LD format are skipped.
+func VerifyDidConfiguration(didDoc *types.DidDoc, origin string, didConfigurationJSON []byte, now time.Time) error {
+	var didConfiguration DidConfiguration
+
+	err := json.Unmarshal(didConfigurationJSON, &didConfiguration)
+	if err != nil {
+		return fmt.Errorf("invalid DID configuration: %w", err)
+	}
+
+	if didConfiguration.Context != DidConfigurationContext {
+		return fmt.Errorf("DID configuration @context must be %s", DidConfigurationContext)
+	}
+
+	var credentialErrors []string
+
+	for _, linkedDid := range didConfiguration.LinkedDids {
+		var jwt string
+		if json.Unmarshal(linkedDid, &jwt) != nil {
+			continue
+		}
+
+		issuer, err := GetDomainLinkageJWTIssuer(jwt)
+		if err != nil || issuer != didDoc.Id {
+			continue
+		}
+
+		err = VerifyDomainLinkageJWT(didDoc, origin, jwt, now)
+		if err == nil {
+			return nil
+		}
+
+		credentialErrors = append(credentialErrors, err.Error())
+	}
+
+	if len(credentialErrors) == 0 {
+		return fmt.Errorf("there are no Domain Linkage Credentials of %s", didDoc.Id)
+	}
+
+	return fmt.Errorf("there are no valid Domain Linkage Credentials of %s: %s", didDoc.Id, strings.Join(credentialErrors, "; "))
+}
+
+func parseDomainLinkageJWT(jwt string) (header domainLinkageJWTHeader, payload domainLinkageJWTPayload, signingInput string, signature []byte, err error) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return header, payload, "", nil, errors.New("jwt must consist of header, payload and signature")
+	}
+
+	err = decodeJWTPart(parts[0], &header)
+	if err != nil {
+		return header, payload, "", nil, fmt.Errorf("invalid jwt header: %w", err)
+	}
+
+	err = decodeJWTPart(parts[1], &payload)
+	if err != nil {
+		return header, payload, "", nil, fmt.Errorf("invalid jwt payload: %w", err)
+	}
+
+	signature, err = base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return header, payload, "", nil, fmt.Errorf("invalid jwt signature: %w", err)
+	}
+
+	return header, payload, parts[0] + "." + parts[1], signature, nil
+}
+
+func encodeJWTPart(json string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(json))
+}
+
+func decodeJWTPart(part string, value interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decoded, value)
+}
@@ -0,0 +1,245 @@
+package domainlinkage_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/canow-co/cheqd-node/x/did/client/domainlinkage"
+	testsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	. "github.com/canow-co/cheqd-node/x/did/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	ValidTestDID  = "did:canow:testnet:zABCDEFG123456789abcd"
+	ValidTestDID2 = "did:canow:testnet:zABCDEFG987654321abcd"
+)
+
+var _ = Describe("Domain Linkage Credentials", func() {
+	const origin = "https://example.com"
+
+	var didDoc *DidDoc
+	var assertionKey testsetup.KeyPair
+	var authenticationKey testsetup.KeyPair
+	var issuanceDate time.Time
+	var expirationDate time.Time
+
+	BeforeEach(func() {
+		assertionKey = testsetup.GenerateKeyPair()
+		authenticationKey = testsetup.GenerateKeyPair()
+		issuanceDate = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		expirationDate = issuanceDate.AddDate(1, 0, 0)
+
+		didDoc = &DidDoc{
+			Id: ValidTestDID,
+			VerificationMethod: []*VerificationMethod{
+				{
+					Id:                     ValidTestDID + "#key-1",
+					VerificationMethodType: Ed25519VerificationKey2020Type,
+					Controller:             ValidTestDID,
+					VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(authenticationKey.Public),
+				},
+			},
+			Authentication: []*VerificationRelationship{{VerificationMethodId: ValidTestDID + "#key-1"}},
+			AssertionMethod: []*VerificationRelationship{
+				{
+					VerificationMethod: &VerificationMethod{
+						Id:                     ValidTestDID + "#assertion-1",
+						VerificationMethodType: JSONWebKey2020Type,
+						Controller:             ValidTestDID,
+						VerificationMaterial:   testsetup.GenerateJSONWebKey2020VerificationMaterial(assertionKey.Public),
+					},
+				},
+			},
+			Service: []*Service{
+				NewServiceWithEndpoint(ValidTestDID+"#domains", LinkedDomainsServiceType, NewSetServiceEndpoint(
+					NewURIServiceEndpointItem("https://example.com"),
+					NewMapServiceEndpointItem(`{"origins":["https://example.org","https://example.com"]}`),
+				)),
+				NewServiceWithEndpoint(ValidTestDID+"#agent", DIDCommMessagingServiceType, NewURIServiceEndpoint("https://agent.example.com")),
+			},
+		}
+	})
+
+	build := func(keyID string, key testsetup.KeyPair, origin string) string {
+		jwt, err := BuildDomainLinkageJWT(keyID, origin, key.Private, issuanceDate, expirationDate)
+		Expect(err).To(BeNil())
+
+		return jwt
+	}
+
+	It("Verifies credentials signed by assertion methods", func() {
+		jwt := build(ValidTestDID+"#assertion-1", assertionKey, origin)
+
+		Expect(VerifyDomainLinkageJWT(didDoc, origin, jwt, issuanceDate.Add(time.Hour))).To(Succeed())
+		Expect(VerifyDomainLinkageJWT(didDoc, "HTTPS://EXAMPLE.COM/", jwt, issuanceDate.Add(time.Hour))).To(Succeed())
+	})
+
+	DescribeTable("Rejects invalid credentials", func(jwt func() string, verifiedOrigin string, at func() time.Time, errorMsg string) {
+		err := VerifyDomainLinkageJWT(didDoc, verifiedOrigin, jwt(), at())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(errorMsg))
+	},
+		Entry("Signed by a key which is not an assertion method",
+			func() string { return build(ValidTestDID+"#key-1", authenticationKey, origin) },
+			origin, func() time.Time { return issuanceDate }, "must be an assertion method"),
+		Entry("Signed by another key",
+			func() string { return build(ValidTestDID+"#assertion-1", authenticationKey, origin) },
+			origin, func() time.Time { return issuanceDate }, "invalid signature"),
+		Entry("Issued for another origin",
+			func() string { return build(ValidTestDID+"#assertion-1", assertionKey, "https://example.org") },
+			origin, func() time.Time { return issuanceDate }, "credentialSubject.origin must be https://example.com"),
+		Entry("Issued by another DID",
+			func() string { return build(ValidTestDID2+"#assertion-1", assertionKey, origin) },
+			origin, func() time.Time { return issuanceDate }, "iss, sub and credentialSubject.id must be"),
+		Entry("Not valid yet",
+			func() string { return build(ValidTestDID+"#assertion-1", assertionKey, origin) },
+			origin, func() time.Time { return issuanceDate.Add(-time.Second) }, "credential is not valid yet"),
+		Entry("Expired",
+			func() string { return build(ValidTestDID+"#assertion-1", assertionKey, origin) },
+			origin, func() time.Time { return expirationDate }, "credential is expired"),
+		Entry("Tampered",
+			func() string {
+				parts := strings.Split(build(ValidTestDID+"#assertion-1", assertionKey, origin), ".")
+				return parts[0] + "." + strings.ToLower(parts[1]) + "." + parts[2]
+			},
+			origin, func() time.Time { return issuanceDate }, "invalid jwt payload"),
+		Entry("Signed with an algorithm other than the one of the key",
+			func() string {
+				parts := strings.Split(build(ValidTestDID+"#assertion-1", assertionKey, origin), ".")
+				header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","kid":"` + ValidTestDID + `#assertion-1","typ":"JWT"}`))
+				signature := ed25519.Sign(assertionKey.Private, []byte(header+"."+parts[1]))
+				return header + "." + parts[1] + "." + base64.RawURLEncoding.EncodeToString(signature)
+			},
+			origin, func() time.Time { return issuanceDate }, "alg ES256 doesn't match the verification method "+ValidTestDID+"#assertion-1, expected EdDSA"),
+		Entry("Not a JWT",
+			func() string { return "abc" },
+			origin, func() time.Time { return issuanceDate }, "jwt must consist of header, payload and signature"),
+	)
+
+	It("Verifies DID Configuration with credentials of several DIDs", func() {
+		otherKey := testsetup.GenerateKeyPair()
+		didConfiguration := NewDidConfiguration(
+			build(ValidTestDID2+"#key-1", otherKey, origin),
+			build(ValidTestDID+"#assertion-1", assertionKey, origin),
+		)
+		didConfigurationJSON, err := json.Marshal(didConfiguration)
+		Expect(err).To(BeNil())
+
+		Expect(VerifyDidConfiguration(didDoc, origin, didConfigurationJSON, issuanceDate)).To(Succeed())
+
+		err = VerifyDidConfiguration(didDoc, "https://example.org", didConfigurationJSON, issuanceDate)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("there are no valid Domain Linkage Credentials of " + ValidTestDID))
+	})
+
+	It("Reports DID Configuration without credentials of the DID", func() {
+		didConfigurationJSON := []byte(`{"@context":"` + DidConfigurationContext + `","linked_dids":[{"type":["VerifiableCredential"]}]}`)
+
+		err := VerifyDidConfiguration(didDoc, origin, didConfigurationJSON, issuanceDate)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("there are no Domain Linkage Credentials of " + ValidTestDID))
+	})
+
+	It("Rejects keys which can't sign Domain Linkage Credentials", func() {
+		_, err := GetDomainLinkageJWTAlgorithm(VerificationMethod{
+			Id:                     ValidTestDID + "#bls-1",
+			VerificationMethodType: Bls12381G2Key2020Type,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("only Ed25519 keys are supported"))
+	})
+
+	Describe("Verification of origins", func() {
+		var fetchedURLs []string
+
+		// Serves DID Configuration resources by their URLs like a local file server
+		fetcher := func(resources map[string][]byte) DidConfigurationFetcher {
+			return func(ctx context.Context, url string) ([]byte, error) {
+				fetchedURLs = append(fetchedURLs, url)
+
+				resource, found := resources[url]
+				if !found {
+					return nil, errors.New("GET " + url + ": unexpected status 404 Not Found")
+				}
+
+				return resource, nil
+			}
+		}
+
+		didConfigurationJSON := func(jwts ...string) []byte {
+			didConfigurationJSON, err := json.Marshal(NewDidConfiguration(jwts...))
+			Expect(err).To(BeNil())
+
+			return didConfigurationJSON
+		}
+
+		BeforeEach(func() {
+			fetchedURLs = nil
+		})
+
+		It("Verifies the DID Configuration of the origin", func() {
+			fetch := fetcher(map[string][]byte{
+				origin + DidConfigurationPath: didConfigurationJSON(build(ValidTestDID+"#assertion-1", assertionKey, origin)),
+			})
+
+			Expect(VerifyOrigin(context.Background(), fetch, didDoc, origin, "", issuanceDate)).To(Succeed())
+			Expect(fetchedURLs).To(Equal([]string{"https://example.com/.well-known/did-configuration.json"}))
+		})
+
+		It("Fetches the DID Configuration from another base URL", func() {
+			fetch := fetcher(map[string][]byte{
+				"http://localhost:8080" + DidConfigurationPath: didConfigurationJSON(build(ValidTestDID+"#assertion-1", assertionKey, origin)),
+			})
+
+			Expect(VerifyOrigin(context.Background(), fetch, didDoc, origin, "http://localhost:8080/", issuanceDate)).To(Succeed())
+		})
+
+		It("Rejects the DID Configuration of another origin", func() {
+			fetch := fetcher(map[string][]byte{
+				"http://localhost:8080" + DidConfigurationPath: didConfigurationJSON(build(ValidTestDID+"#assertion-1", assertionKey, "https://example.org")),
+			})
+
+			err := VerifyOrigin(context.Background(), fetch, didDoc, origin, "http://localhost:8080", issuanceDate)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("credentialSubject.origin must be https://example.com"))
+		})
+
+		It("Rejects expired credentials", func() {
+			fetch := fetcher(map[string][]byte{
+				origin + DidConfigurationPath: didConfigurationJSON(build(ValidTestDID+"#assertion-1", assertionKey, origin)),
+			})
+
+			err := VerifyOrigin(context.Background(), fetch, didDoc, origin, "", expirationDate.Add(time.Hour))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("credential is expired"))
+		})
+
+		It("Reports fetching errors", func() {
+			err := VerifyOrigin(context.Background(), fetcher(nil), didDoc, origin, "", issuanceDate)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unexpected status 404 Not Found"))
+		})
+	})
+
+	DescribeTable("Normalizes origins", func(origin string, expected string, isValid bool) {
+		normalized, err := NormalizeOrigin(origin)
+		if isValid {
+			Expect(err).To(BeNil())
+			Expect(normalized).To(Equal(expected))
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+		Entry("Lower case", "HTTPS://Example.COM", "https://example.com", true),
+		Entry("With port and trailing slash", "http://localhost:8080/", "http://localhost:8080", true),
+		Entry("With path", "https://example.com/path", "", false),
+		Entry("Without scheme", "example.com", "", false),
+	)
+})
@@ -0,0 +1,13 @@
+package domainlinkage_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDomainLinkage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DID Module Domain Linkage")
+}
@@ -0,0 +1,63 @@
+package domainlinkage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+const DidConfigurationFetchTimeout = 30 * time.Second
+
+// DidConfigurationFetcher fetches the DID Configuration resource by its URL
+type DidConfigurationFetcher func(ctx context.Context, url string) ([]byte, error)
+
+// NewHTTPDidConfigurationFetcher returns a fetcher performing GET requests with the given HTTP client
+func NewHTTPDidConfigurationFetcher(httpClient *http.Client) DidConfigurationFetcher {
+	return func(ctx context.Context, url string) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+		}
+
+		return io.ReadAll(resp.Body)
+	}
+}
+
+// VerifyOrigin fetches the DID Configuration resource of the origin and verifies that it links the origin with the diddoc.
+// Non-empty fetchFrom replaces the origin in the URL the resource is fetched from, e.g. to fetch it from a local file server.
+func VerifyOrigin(ctx context.Context, fetch DidConfigurationFetcher, didDoc *types.DidDoc, origin string, fetchFrom string, now time.Time) error {
+	origin, err := NormalizeOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	baseURL := origin
+	if fetchFrom != "" {
+		baseURL = strings.TrimSuffix(fetchFrom, "/")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DidConfigurationFetchTimeout)
+	defer cancel()
+
+	didConfigurationJSON, err := fetch(ctx, baseURL+DidConfigurationPath)
+	if err != nil {
+		return err
+	}
+
+	return VerifyDidConfiguration(didDoc, origin, didConfigurationJSON, now)
+}
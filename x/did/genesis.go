@@ -24,11 +24,52 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 		}
 	}
 
+	// Build time index
+	err := k.IndexAllDidDocVersionTimes(&ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Build controller index
+	err = k.IndexAllDidDocControllers(&ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Build public key index
+	err = k.IndexAllDidDocPublicKeys(&ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Build unique id index
+	k.IndexAllDidUniqueIDs(&ctx)
+
+	// Set creation heights. Genesis files created before they were exported use the initial height
+	for _, createdHeight := range genState.CreatedHeights {
+		k.SetDidDocCreatedHeight(&ctx, createdHeight.Did, createdHeight.Height)
+	}
+	k.IndexMissingDidDocCreatedHeights(&ctx, uint64(ctx.BlockHeight()))
+
 	// Set did namespace
 	k.SetDidNamespace(&ctx, genState.DidNamespace)
 
 	// Set fee params
 	k.SetParams(ctx, *genState.FeeParams)
+
+	// Set signing params. Genesis files created before they were introduced use the defaults
+	signingParams := genState.SigningParams
+	if signingParams == nil {
+		signingParams = types.DefaultSigningParams()
+	}
+	k.SetSigningParams(ctx, *signingParams)
+
+	// Set namespace params. Genesis files created before they were introduced use the defaults
+	namespaceParams := genState.NamespaceParams
+	if namespaceParams == nil {
+		namespaceParams = types.DefaultNamespaceParams()
+	}
+	k.SetNamespaceParams(ctx, *namespaceParams)
 }
 
 // ExportGenesis returns the cheqd module's exported genesis.
@@ -38,10 +79,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 	feeParams := k.GetParams(ctx)
+	signingParams := k.GetSigningParams(ctx)
+	namespaceParams := k.GetNamespaceParams(ctx)
+	createdHeights := k.GetAllDidDocCreatedHeights(&ctx)
 	genesis := types.GenesisState{
-		DidNamespace: k.GetDidNamespace(&ctx),
-		VersionSets:  didDocs,
-		FeeParams:    &feeParams,
+		DidNamespace:    k.GetDidNamespace(&ctx),
+		VersionSets:     didDocs,
+		FeeParams:       &feeParams,
+		SigningParams:   &signingParams,
+		NamespaceParams: &namespaceParams,
+		CreatedHeights:  createdHeights,
 	}
 
 	return &genesis
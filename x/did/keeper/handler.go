@@ -27,6 +27,10 @@ func NewHandler(k Keeper) sdk.Handler {
 			res, err := msgServer.DeactivateDidDoc(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgPatchDidDoc:
+			res, err := msgServer.PatchDidDoc(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
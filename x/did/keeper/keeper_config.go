@@ -25,3 +25,27 @@ func (k Keeper) SetDidNamespace(ctx *sdk.Context, namespace string) {
 
 	store.Set(key, value)
 }
+
+// GetAllowedNamespaces returns the did namespace followed by the additional namespaces allowed by the namespace params
+func (k Keeper) GetAllowedNamespaces(ctx *sdk.Context) []string {
+	namespaceParams := k.GetNamespaceParams(*ctx)
+	return namespaceParams.AllowedNamespaces(k.GetDidNamespace(ctx))
+}
+
+// IsAllowedNamespace checks whether DIDs of the namespace can be stored on the chain
+func (k Keeper) IsAllowedNamespace(ctx *sdk.Context, namespace string) bool {
+	return utils.Contains(k.GetAllowedNamespaces(ctx), namespace)
+}
+
+// GetNamespacesAllowedForDid returns the allowed namespaces along with the namespace of the did if the did exists.
+// DIDs created in a namespace which is no longer allowed can still be updated and deactivated.
+func (k Keeper) GetNamespacesAllowedForDid(ctx *sdk.Context, did string) []string {
+	allowedNamespaces := k.GetAllowedNamespaces(ctx)
+
+	_, namespace, _, err := utils.TrySplitDID(did)
+	if err != nil || utils.Contains(allowedNamespaces, namespace) || !k.HasDidDoc(ctx, did) {
+		return allowedNamespaces
+	}
+
+	return append(allowedNamespaces, namespace)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/canow-co/cheqd-node/x/did/types"
 	"github.com/canow-co/cheqd-node/x/did/utils"
@@ -69,6 +70,10 @@ func (k Keeper) AddNewDidDocVersion(ctx *sdk.Context, didDoc *types.DidDocWithMe
 		if err != nil {
 			return err
 		}
+
+		// Controllers and public keys of the previous version are reindexed below
+		k.RemoveDidDocControllers(ctx, latestVersion.DidDoc.Id, latestVersion.DidDoc.AllControllerDids())
+		k.RemoveDidDocPublicKeys(ctx, latestVersion.DidDoc.Id, latestVersion.DidDoc.GetPublicKeyFingerprints())
 	}
 
 	// Update latest version
@@ -77,6 +82,17 @@ func (k Keeper) AddNewDidDocVersion(ctx *sdk.Context, didDoc *types.DidDocWithMe
 		return err
 	}
 
+	// Index by time. The new version overrides previous ones created in the same block.
+	k.SetDidDocVersionTime(ctx, didDoc.DidDoc.Id, didDoc.Metadata.Timestamp(), didDoc.Metadata.VersionId)
+
+	// Index by controllers
+	k.SetDidDocControllers(ctx, didDoc.DidDoc.Id, didDoc.DidDoc.AllControllerDids())
+
+	// Index by public keys. Keys of deactivated diddocs can't be used anymore.
+	if !didDoc.Metadata.Deactivated {
+		k.SetDidDocPublicKeys(ctx, didDoc.DidDoc.Id, didDoc.DidDoc.GetPublicKeyFingerprints())
+	}
+
 	// Write new version (no override)
 	return k.SetDidDocVersion(ctx, didDoc, false)
 }
@@ -145,6 +161,65 @@ func (k Keeper) GetAllDidDocVersions(ctx *sdk.Context, did string) ([]*types.Met
 	return result, nil
 }
 
+// GetDidDocVersionAtTime returns the version of a diddoc that was active at the given moment
+func (k Keeper) GetDidDocVersionAtTime(ctx *sdk.Context, did string, at time.Time) (types.DidDocWithMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	// The latest index entry not after the given moment
+	start := types.GetDidDocVersionTimePrefix(did)
+	end := sdk.PrefixEndBytes(types.GetDidDocVersionTimeKey(did, at))
+
+	iterator := store.ReverseIterator(start, end)
+	defer closeIteratorOrPanic(iterator)
+
+	if !iterator.Valid() {
+		return types.DidDocWithMetadata{}, false
+	}
+
+	didDoc, err := k.GetDidDocVersion(ctx, did, string(iterator.Value()))
+	if err != nil {
+		return types.DidDocWithMetadata{}, false
+	}
+
+	return didDoc, true
+}
+
+// SetDidDocVersionTime adds a diddoc version to the time index
+func (k Keeper) SetDidDocVersionTime(ctx *sdk.Context, did string, timestamp time.Time, version string) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.GetDidDocVersionTimeKey(did, timestamp)
+	store.Set(key, utils.StrBytes(version))
+}
+
+// IndexAllDidDocVersionTimes builds the time index for all diddoc versions in the store.
+// Versions created in the same block share the timestamp, so only the last one in the chain is indexed.
+func (k Keeper) IndexAllDidDocVersionTimes(ctx *sdk.Context) error {
+	var versions []types.DidDocWithMetadata
+
+	k.IterateAllDidDocVersions(ctx, func(version types.DidDocWithMetadata) bool {
+		versions = append(versions, version)
+		return true
+	})
+
+	for _, version := range versions {
+		if version.Metadata.NextVersionId != "" {
+			nextVersion, err := k.GetDidDocVersion(ctx, version.DidDoc.Id, version.Metadata.NextVersionId)
+			if err != nil {
+				return err
+			}
+
+			if nextVersion.Metadata.Timestamp().Equal(version.Metadata.Timestamp()) {
+				continue
+			}
+		}
+
+		k.SetDidDocVersionTime(ctx, version.DidDoc.Id, version.Metadata.Timestamp(), version.Metadata.VersionId)
+	}
+
+	return nil
+}
+
 // SetDidDocLatestVersion sets the latest version id value for a diddoc
 func (k Keeper) SetLatestDidDocVersion(ctx *sdk.Context, did, version string) error {
 	// Update counter. We use latest version as existence indicator.
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// SetDidDocControllers adds the did to the controller index for each of the controllers
+func (k Keeper) SetDidDocControllers(ctx *sdk.Context, did string, controllers []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, controller := range controllers {
+		store.Set(types.GetDidDocControllerKey(controller, did), utils.StrBytes(did))
+	}
+}
+
+// RemoveDidDocControllers removes the did from the controller index for each of the controllers
+func (k Keeper) RemoveDidDocControllers(ctx *sdk.Context, did string, controllers []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, controller := range controllers {
+		store.Delete(types.GetDidDocControllerKey(controller, did))
+	}
+}
+
+// GetDidsByController returns a page of DIDs controlled by the given controller
+func (k Keeper) GetDidsByController(ctx *sdk.Context, controller string, pageRequest *query.PageRequest) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDidDocControllerPrefix(controller))
+
+	var dids []string
+	pageResponse, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		dids = append(dids, string(value))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dids, pageResponse, nil
+}
+
+// IndexAllDidDocControllers builds the controller index for the latest versions of all diddocs in the store
+func (k Keeper) IndexAllDidDocControllers(ctx *sdk.Context) error {
+	var dids []string
+
+	k.IterateDids(ctx, func(did string) bool {
+		dids = append(dids, did)
+		return true
+	})
+
+	for _, did := range dids {
+		latestVersion, err := k.GetLatestDidDoc(ctx, did)
+		if err != nil {
+			return err
+		}
+
+		k.SetDidDocControllers(ctx, did, latestVersion.DidDoc.AllControllerDids())
+	}
+
+	return nil
+}
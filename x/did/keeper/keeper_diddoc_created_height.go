@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// SetDidDocCreatedHeight records the block height a diddoc was created at
+func (k Keeper) SetDidDocCreatedHeight(ctx *sdk.Context, did string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetDidDocCreatedHeightKey(did), []byte(strconv.FormatUint(height, 10)))
+}
+
+// GetDidDocCreatedHeight returns the block height a diddoc was created at
+func (k Keeper) GetDidDocCreatedHeight(ctx *sdk.Context, did string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	valueBytes := store.Get(types.GetDidDocCreatedHeightKey(did))
+	if valueBytes == nil {
+		return 0, false
+	}
+
+	height, err := strconv.ParseUint(string(valueBytes), 10, 64)
+	if err != nil {
+		// Panic because the height should be always formattable to uint64
+		panic("cannot decode created height")
+	}
+
+	return height, true
+}
+
+// GetAllDidDocCreatedHeights returns the creation heights of all diddocs
+func (k Keeper) GetAllDidDocCreatedHeights(ctx *sdk.Context) []*types.DidDocCreatedHeight {
+	var createdHeights []*types.DidDocCreatedHeight
+
+	k.IterateDids(ctx, func(did string) bool {
+		if height, found := k.GetDidDocCreatedHeight(ctx, did); found {
+			createdHeights = append(createdHeights, &types.DidDocCreatedHeight{Did: did, Height: height})
+		}
+
+		return true
+	})
+
+	return createdHeights
+}
+
+// IndexMissingDidDocCreatedHeights records the given height as the creation height of diddocs which don't have it.
+// Used for diddocs created before the creation height index was introduced, their real creation height is unknown.
+func (k Keeper) IndexMissingDidDocCreatedHeights(ctx *sdk.Context, height uint64) {
+	// Collect the diddocs first, the store can't be modified while iterating over it
+	var missing []string
+
+	k.IterateDids(ctx, func(did string) bool {
+		if _, found := k.GetDidDocCreatedHeight(ctx, did); !found {
+			missing = append(missing, did)
+		}
+
+		return true
+	})
+
+	for _, did := range missing {
+		k.SetDidDocCreatedHeight(ctx, did, height)
+	}
+}
+
+// GetFilteredDidDocs returns a page of latest versions of diddocs matching the filter
+func (k Keeper) GetFilteredDidDocs(ctx *sdk.Context, filter types.DidDocFilter, pageRequest *query.PageRequest) ([]*types.DidDocWithMetadata, *query.PageResponse, error) {
+	didPrefix := filter.GetDidPrefix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.GetLatestDidDocVersionPrefix(), didPrefix...))
+
+	var didDocs []*types.DidDocWithMetadata
+	pageResponse, err := query.FilteredPaginate(store, pageRequest, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		did := didPrefix + string(key)
+
+		var createdHeight uint64
+		if filter.HasHeightRange() {
+			var found bool
+			createdHeight, found = k.GetDidDocCreatedHeight(ctx, did)
+			if !found {
+				return false, nil
+			}
+		}
+
+		didDoc, err := k.GetDidDocVersion(ctx, did, string(value))
+		if err != nil {
+			return false, err
+		}
+
+		if !filter.Matches(didDoc, createdHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			didDocs = append(didDocs, &didDoc)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return didDocs, pageResponse, nil
+}
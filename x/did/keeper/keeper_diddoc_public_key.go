@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// SetDidDocPublicKeys adds the did to the public key index for each of the public key fingerprints
+func (k Keeper) SetDidDocPublicKeys(ctx *sdk.Context, did string, fingerprints []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, fingerprint := range fingerprints {
+		store.Set(types.GetDidDocPublicKeyKey(fingerprint, did), utils.StrBytes(did))
+	}
+}
+
+// RemoveDidDocPublicKeys removes the did from the public key index for each of the public key fingerprints
+func (k Keeper) RemoveDidDocPublicKeys(ctx *sdk.Context, did string, fingerprints []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, fingerprint := range fingerprints {
+		store.Delete(types.GetDidDocPublicKeyKey(fingerprint, did))
+	}
+}
+
+// GetDidsByPublicKey returns a page of DIDs having a verification method with the given public key fingerprint
+func (k Keeper) GetDidsByPublicKey(ctx *sdk.Context, fingerprint string, pageRequest *query.PageRequest) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDidDocPublicKeyPrefix(fingerprint))
+
+	var dids []string
+	pageResponse, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		dids = append(dids, string(value))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dids, pageResponse, nil
+}
+
+// IndexAllDidDocPublicKeys builds the public key index for the latest versions of all active diddocs in the store
+func (k Keeper) IndexAllDidDocPublicKeys(ctx *sdk.Context) error {
+	var dids []string
+
+	k.IterateDids(ctx, func(did string) bool {
+		dids = append(dids, did)
+		return true
+	})
+
+	for _, did := range dids {
+		latestVersion, err := k.GetLatestDidDoc(ctx, did)
+		if err != nil {
+			return err
+		}
+
+		if latestVersion.Metadata.Deactivated {
+			continue
+		}
+
+		k.SetDidDocPublicKeys(ctx, did, latestVersion.DidDoc.GetPublicKeyFingerprints())
+	}
+
+	return nil
+}
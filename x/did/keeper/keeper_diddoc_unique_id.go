@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDidUniqueID indexes the did by its unique id
+func (k Keeper) SetDidUniqueID(ctx *sdk.Context, did string) {
+	store := ctx.KVStore(k.storeKey)
+
+	_, _, uniqueID := utils.MustSplitDID(did)
+	store.Set(types.GetDidUniqueIDKey(uniqueID), []byte(did))
+}
+
+// FindDidByUniqueID returns the DID with the unique id in any namespace, including the ones which aren't allowed anymore.
+// Resource collections are identified by the unique id only, so a unique id can't be used by DIDs in different namespaces.
+func (k Keeper) FindDidByUniqueID(ctx *sdk.Context, uniqueID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	did := store.Get(types.GetDidUniqueIDKey(uniqueID))
+	if did == nil {
+		return "", false
+	}
+
+	return string(did), true
+}
+
+// IndexAllDidUniqueIDs builds the unique id index for all diddocs in the store
+func (k Keeper) IndexAllDidUniqueIDs(ctx *sdk.Context) {
+	// Collect the dids first, the store can't be modified while iterating over it
+	var dids []string
+
+	k.IterateDids(ctx, func(did string) bool {
+		dids = append(dids, did)
+		return true
+	})
+
+	for _, did := range dids {
+		k.SetDidUniqueID(ctx, did)
+	}
+}
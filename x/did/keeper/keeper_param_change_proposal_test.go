@@ -81,6 +81,108 @@ var _ = DescribeTable("Proposal Handler", func(testCase TestCaseKeeperProposal)
 			false,
 			"",
 		}),
+	Entry("signing params",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeySigningParams),
+				Value:    `{"allow_legacy_signatures": false}`,
+			}),
+			func(handlerSuite *HandlerTestSuite) {
+				signingParams := handlerSuite.app.DidKeeper.GetSigningParams(handlerSuite.ctx)
+
+				Expect(signingParams.AllowLegacySignatures).To(BeFalse())
+			},
+			false,
+			"",
+		}),
+	Entry("signing params disabling full updates by capability invocation methods",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeySigningParams),
+				Value:    `{"allow_capability_invocation_full_updates": false}`,
+			}),
+			func(handlerSuite *HandlerTestSuite) {
+				signingParams := handlerSuite.app.DidKeeper.GetSigningParams(handlerSuite.ctx)
+
+				Expect(signingParams.AllowCapabilityInvocationFullUpdates).To(BeFalse())
+				Expect(signingParams.AllowLegacySignatures).To(BeTrue())
+			},
+			false,
+			"",
+		}),
+	Entry("namespace params",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeyNamespaceParams),
+				Value:    `{"namespaces": [{"namespace": "partners", "fee_params": {"create_did": {"denom": "zarx", "amount": "10000000000"}, "update_did": {"denom": "zarx", "amount": "4000000000"}, "deactivate_did": {"denom": "zarx", "amount": "2000000000"}, "burn_factor": "0.600000000000000000"}}, {"namespace": "sandbox"}]}`,
+			}),
+			func(handlerSuite *HandlerTestSuite) {
+				Expect(handlerSuite.app.DidKeeper.GetAllowedNamespaces(&handlerSuite.ctx)).To(ContainElements("partners", "sandbox"))
+
+				feeParams, found := handlerSuite.app.DidKeeper.GetNamespaceFeeParams(handlerSuite.ctx, "partners")
+				Expect(found).To(BeTrue())
+				Expect(feeParams.CreateDid).To(Equal(sdk.Coin{Denom: didtypes.BaseMinimalDenom, Amount: sdk.NewInt(10000000000)}))
+
+				_, found = handlerSuite.app.DidKeeper.GetNamespaceFeeParams(handlerSuite.ctx, "sandbox")
+				Expect(found).To(BeFalse())
+			},
+			false,
+			"",
+		}),
+	Entry("namespace resource fee params",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeyNamespaceParams),
+				Value:    `{"namespaces": [{"namespace": "partners", "resource_fee_params": {"image": {"denom": "zarx", "amount": "4000000000"}, "json": {"denom": "zarx", "amount": "1000000000"}, "default": {"denom": "zarx", "amount": "2000000000"}, "chunk": {"denom": "zarx", "amount": "500000000"}, "burn_factor": "0.200000000000000000"}}]}`,
+			}),
+			func(handlerSuite *HandlerTestSuite) {
+				resourceFeeParams, found := handlerSuite.app.DidKeeper.GetNamespaceResourceFeeParams(handlerSuite.ctx, "partners")
+				Expect(found).To(BeTrue())
+				Expect(resourceFeeParams.Chunk).To(Equal(sdk.Coin{Denom: didtypes.BaseMinimalDenom, Amount: sdk.NewInt(500000000)}))
+
+				_, found = handlerSuite.app.DidKeeper.GetNamespaceFeeParams(handlerSuite.ctx, "partners")
+				Expect(found).To(BeFalse())
+			},
+			false,
+			"",
+		}),
+	Entry("invalid value: namespace resource fee params without chunk fee",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeyNamespaceParams),
+				Value:    `{"namespaces": [{"namespace": "partners", "resource_fee_params": {"image": {"denom": "zarx", "amount": "4000000000"}, "json": {"denom": "zarx", "amount": "1000000000"}, "default": {"denom": "zarx", "amount": "2000000000"}, "burn_factor": "0.200000000000000000"}}]}`,
+			}),
+			func(*HandlerTestSuite) {},
+			true,
+			"",
+		}),
+	Entry("invalid value: duplicated namespace",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeyNamespaceParams),
+				Value:    `{"namespaces": [{"namespace": "partners"}, {"namespace": "partners"}]}`,
+			}),
+			func(*HandlerTestSuite) {},
+			true,
+			"",
+		}),
+	Entry("invalid value: namespace with invalid characters",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: didtypes.ModuleName,
+				Key:      string(didtypes.ParamStoreKeyNamespaceParams),
+				Value:    `{"namespaces": [{"namespace": "Partners!"}]}`,
+			}),
+			func(*HandlerTestSuite) {},
+			true,
+			"",
+		}),
 	Entry("empty value",
 		TestCaseKeeperProposal{
 			testProposal(proposal.ParamChange{
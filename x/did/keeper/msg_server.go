@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/canow-co/cheqd-node/x/did/types"
 	"github.com/canow-co/cheqd-node/x/did/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// VersionIDQuery is the DID URL query prefix of the signers bound to a specific version of their DID document
+const VersionIDQuery = "versionId="
+
 type MsgServer struct {
 	Keeper
 }
@@ -17,6 +23,19 @@ func NewMsgServer(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = MsgServer{}
 
+// ValidatePreviousVersion checks that the change is based on the latest version of the diddoc.
+// An empty previous version id skips the check.
+func ValidatePreviousVersion(latest types.DidDocWithMetadata, previousVersionID string) error {
+	if previousVersionID != "" && latest.Metadata.VersionId != previousVersionID {
+		return types.ErrVersionConflict.Wrapf("change is based on version %s, but the latest version of %s is %s",
+			previousVersionID, latest.DidDoc.Id, latest.Metadata.VersionId)
+	}
+
+	return nil
+}
+
+// FindDidDoc looks for the diddoc in memory and in the state. DID Documents of DID methods like did:key
+// are derived in-process, so such DIDs can act as controllers and signers without being written to the ledger.
 func FindDidDoc(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, did string) (res types.DidDocWithMetadata, found bool, err error) {
 	// Look in inMemory dict
 	value, found := inMemoryDIDs[did]
@@ -24,6 +43,16 @@ func FindDidDoc(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDo
 		return value, true, nil
 	}
 
+	// Derive in-process
+	if types.IsDerivedDid(did) {
+		didDoc, err := types.ResolveDerivedDid(did)
+		if err != nil {
+			return types.DidDocWithMetadata{}, false, nil
+		}
+
+		return types.DidDocWithMetadata{DidDoc: didDoc, Metadata: &types.Metadata{}}, true, nil
+	}
+
 	// Look in state
 	if k.HasDidDoc(ctx, did) {
 		value, err := k.GetLatestDidDoc(ctx, did)
@@ -37,6 +66,34 @@ func FindDidDoc(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDo
 	return types.DidDocWithMetadata{}, false, nil
 }
 
+// FindDidDocVersion looks for the given version of the diddoc. Only the in-memory version and the latest version in the state
+// are considered, so keys removed from a diddoc can't be used for signing anymore. Empty versionID means any of them.
+func FindDidDocVersion(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, did string, versionID string) (res types.DidDocWithMetadata, found bool, err error) {
+	if versionID == "" {
+		return FindDidDoc(k, ctx, inMemoryDIDs, did)
+	}
+
+	// Look in inMemory dict
+	value, found := inMemoryDIDs[did]
+	if found && value.Metadata.VersionId == versionID {
+		return value, true, nil
+	}
+
+	// Look in state
+	if k.HasDidDoc(ctx, did) {
+		value, err := k.GetLatestDidDoc(ctx, did)
+		if err != nil {
+			return types.DidDocWithMetadata{}, false, err
+		}
+
+		if value.Metadata.VersionId == versionID {
+			return value, true, nil
+		}
+	}
+
+	return types.DidDocWithMetadata{}, false, nil
+}
+
 func MustFindDidDoc(k *Keeper, ctx *sdk.Context, inMemoryDIDDocs map[string]types.DidDocWithMetadata, did string) (res types.DidDocWithMetadata, err error) {
 	res, found, err := FindDidDoc(k, ctx, inMemoryDIDDocs, did)
 	if err != nil {
@@ -79,15 +136,27 @@ func MustFindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[st
 	return res, nil
 }
 
-func FindAuthenticationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, didURL string) (res types.VerificationMethod, found bool, err error) {
+func FindAuthenticationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, didURL string, versionID string) (res types.VerificationMethod, found bool, err error) {
 	did, _, _, _ := utils.MustSplitDIDUrl(didURL)
 
-	didDoc, found, err := FindDidDoc(k, ctx, inMemoryDIDs, did)
+	didDoc, found, err := FindDidDocVersion(k, ctx, inMemoryDIDs, did, versionID)
 	if err != nil || !found {
 		return types.VerificationMethod{}, found, err
 	}
 
-	vm, found := types.FindVerificationMethod(getEffectiveAuthenticationMethods(didDoc.DidDoc), didURL)
+	// A DID being created has no version to authorize changes of yet, so methods from its `verificationMethod` list
+	// referenced by `capabilityInvocation` prove the key possession like any other. Afterwards they authorize service
+	// updates only, unless governance still allows them to authorize any change.
+	methods := didDoc.DidDoc.AuthenticationMethods()
+	_, inMemory := inMemoryDIDs[did]
+	if (inMemory && !k.HasDidDoc(ctx, did)) || k.GetSigningParams(*ctx).AllowCapabilityInvocationFullUpdates {
+		methods = append(types.FilterEmbeddedVerificationMethods(didDoc.DidDoc.Authentication), didDoc.DidDoc.VerificationMethod...)
+	}
+
+	// Key agreement keys (X25519) can't produce signatures, so they never act as authentication methods
+	authenticationMethods := types.FilterSigningVerificationMethods(methods)
+
+	vm, found := types.FindVerificationMethod(authenticationMethods, didURL)
 	if !found {
 		return types.VerificationMethod{}, false, nil
 	}
@@ -95,12 +164,16 @@ func FindAuthenticationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[stri
 	return *vm, true, nil
 }
 
-func MustFindAuthenticationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, didURL string) (res types.VerificationMethod, err error) {
-	res, found, err := FindAuthenticationMethod(k, ctx, inMemoryDIDs, didURL)
+func MustFindAuthenticationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, didURL string, versionID string) (res types.VerificationMethod, err error) {
+	res, found, err := FindAuthenticationMethod(k, ctx, inMemoryDIDs, didURL, versionID)
 	if err != nil {
 		return types.VerificationMethod{}, err
 	}
 
+	if !found && versionID != "" {
+		return types.VerificationMethod{}, types.ErrAuthenticationMethodNotFound.Wrapf("%s, version id: %s", didURL, versionID)
+	}
+
 	if !found {
 		return types.VerificationMethod{}, types.ErrAuthenticationMethodNotFound.Wrap(didURL)
 	}
@@ -108,35 +181,115 @@ func MustFindAuthenticationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[
 	return res, nil
 }
 
-// Verifies validity of a given signature for a given message.
+// GetAcceptedSignBytes returns the messages SignInfo signatures are accepted for: the signing envelope of the payload
+// and, while legacy signatures are allowed by the signing params, the bare payload sign bytes.
+// The envelope is bound to the namespace of did, the DID the payload is applied to.
+// currentVersionID is the latest version id of the DID Document the payload is applied to, empty for creation.
+func GetAcceptedSignBytes(k *Keeper, ctx *sdk.Context, did string, payloadSignBytes []byte, currentVersionID string) [][]byte {
+	_, namespace, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		// Malformed DIDs are rejected by validation, the primary namespace keeps sign bytes well defined until then
+		namespace = k.GetDidNamespace(ctx)
+	}
+
+	messages := [][]byte{
+		types.GetEnvelopeSignBytes(ctx.ChainID(), namespace, currentVersionID, payloadSignBytes),
+	}
+
+	if k.GetSigningParams(*ctx).AllowLegacySignatures {
+		messages = append(messages, payloadSignBytes)
+	}
+
+	return messages
+}
+
+// VersionedSigner returns the signer which signatures are verified against the given version of its DID document.
+// It's a DID URL with versionId query, e.g. did:canow:testnet:123?versionId=456.
+// During update it tells the existing and the updated versions of the DID document apart.
+func VersionedSigner(did string, versionID string) string {
+	return utils.JoinDIDUrl(did, "", VersionIDQuery+versionID, "")
+}
+
+// SplitSigner returns DID and version id of the signer. Version id is empty for signers not bound to a specific version.
+func SplitSigner(signer string) (did string, versionID string) {
+	did, _, query, _ := utils.MustSplitDIDUrl(signer)
+	return did, strings.TrimPrefix(query, VersionIDQuery)
+}
+
+// BindSignerToVersion binds the signer to the given version of the DID document if the signer is the DID itself
+func BindSignerToVersion(signer string, did string, versionID string) string {
+	if signer == did {
+		return VersionedSigner(did, versionID)
+	}
+
+	return signer
+}
+
+// GetSignerDIDs returns DIDs of the signers with version bindings dropped
+func GetSignerDIDs(signers []string) []string {
+	res := make([]string, 0, len(signers))
+
+	for _, signer := range signers {
+		did, _ := SplitSigner(signer)
+		res = append(res, did)
+	}
+
+	return utils.UniqueSorted(res)
+}
+
+// FindSignInfosBySigner returns the sign infos by the signer. Sign infos which select another version of the signer's DID document are skipped.
+func FindSignInfosBySigner(signatures []*types.SignInfo, signer string) []types.SignInfo {
+	did, versionID := SplitSigner(signer)
+
+	var result []types.SignInfo
+	for _, signature := range types.FindSignInfosBySigner(signatures, did) {
+		if versionID != "" && signature.VersionId != "" && signature.VersionId != versionID {
+			continue
+		}
+
+		result = append(result, signature)
+	}
+
+	return result
+}
+
+// Verifies validity of a given signature for any of the given messages.
+// The verification method is taken from the given version of the signer's DID document or, if not set,
+// from the version selected by the signature.
 //
 // This function assumes that the verification method specified for the signature within `SignInfo`
 // is from `authentication` list of the signer's DID document or from its `verificationMethod` list,
 // but is not an embedded verification method from any verification relationship list other than `authentication`.
-func VerifySignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, message []byte, signature types.SignInfo) error {
-	verificationMethod, err := MustFindAuthenticationMethod(k, ctx, inMemoryDIDs, signature.VerificationMethodId)
+func VerifySignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, messages [][]byte, signature types.SignInfo, versionID string) error {
+	if versionID == "" {
+		versionID = signature.VersionId
+	}
+
+	verificationMethod, err := MustFindAuthenticationMethod(k, ctx, inMemoryDIDs, signature.VerificationMethodId, versionID)
 	if err != nil {
 		return err
 	}
 
-	err = types.VerifySignature(verificationMethod, message, signature.Signature)
-	if err != nil {
-		return types.ErrInvalidSignature.Wrapf("method id: %s", signature.VerificationMethodId)
+	for _, message := range messages {
+		if types.VerifySignature(verificationMethod, message, signature.Signature) == nil {
+			return nil
+		}
 	}
 
-	return nil
+	return types.ErrInvalidSignature.Wrapf("method id: %s", signature.VerificationMethodId)
 }
 
-func VerifyAllSignersHaveAllValidSignatures(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, message []byte, signers []string, signatures []*types.SignInfo) error {
+func VerifyAllSignersHaveAllValidSignatures(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, messages [][]byte, signers []string, signatures []*types.SignInfo) error {
 	for _, signer := range signers {
-		signaturesBySigner := types.FindSignInfosBySigner(signatures, signer)
+		signaturesBySigner := FindSignInfosBySigner(signatures, signer)
+		_, versionID := SplitSigner(signer)
 
 		if len(signaturesBySigner) == 0 {
 			return types.ErrSignatureNotFound.Wrapf("signer: %s", signer)
 		}
 
 		for _, signature := range signaturesBySigner {
-			err := VerifySignature(k, ctx, inMemoryDIDs, message, signature)
+			err := VerifySignature(k, ctx, inMemoryDIDs, messages, signature, versionID)
 			if err != nil {
 				return err
 			}
@@ -147,38 +300,124 @@ func VerifyAllSignersHaveAllValidSignatures(k *Keeper, ctx *sdk.Context, inMemor
 }
 
 // VerifyAllSignersHaveAtLeastOneValidSignature verifies that all signers have at least one valid signature.
-// Omit didToBeUpdated and updatedDID if not updating a DID. Otherwise those values will be used to better format error messages.
+// Omit existingVersionSigner and updatedVersionSigner if not updating a DID. Otherwise those values will be used to better format error messages.
 func VerifyAllSignersHaveAtLeastOneValidSignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata,
-	message []byte, signers []string, signatures []*types.SignInfo, didToBeUpdated string, updatedDID string,
+	messages [][]byte, signers []string, signatures []*types.SignInfo, existingVersionSigner string, updatedVersionSigner string,
 ) error {
 	for _, signer := range signers {
-		signaturesBySigner := types.FindSignInfosBySigner(signatures, signer)
-		signerForErrorMessage := GetSignerIDForErrorMessage(signer, didToBeUpdated, updatedDID)
+		signaturesBySigner := FindSignInfosBySigner(signatures, signer)
+		signerForErrorMessage := GetSignerIDForErrorMessage(signer, existingVersionSigner, updatedVersionSigner)
+		_, versionID := SplitSigner(signer)
 
 		if len(signaturesBySigner) == 0 {
 			return types.ErrSignatureNotFound.Wrapf("there should be at least one signature by %s", signerForErrorMessage)
 		}
 
 		found := false
+		var invalid []string
 		for _, signature := range signaturesBySigner {
-			err := VerifySignature(k, ctx, inMemoryDIDs, message, signature)
+			err := VerifySignature(k, ctx, inMemoryDIDs, messages, signature, versionID)
 			if err == nil {
 				found = true
 				break
 			}
+
+			invalid = append(invalid, signature.VerificationMethodId)
 		}
 
 		if !found {
-			return types.ErrInvalidSignature.Wrapf("there should be at least one valid signature by %s", signerForErrorMessage)
+			return types.ErrInvalidSignature.Wrapf("there should be at least one valid signature by %s, signatures by %s are not valid",
+				signerForErrorMessage, strings.Join(invalid, ", "))
 		}
 	}
 
 	return nil
 }
 
-func getEffectiveAuthenticationMethods(didDoc *types.DidDoc) []*types.VerificationMethod {
-	// In the current implementation, when searching for a given authentication method,
-	// we fall back into `verificationMethod` list in case the method is not found in `authentication` list.
-	embeddedAuthenticationMethods := types.FilterEmbeddedVerificationMethods(didDoc.Authentication)
-	return append(embeddedAuthenticationMethods, didDoc.VerificationMethod...)
+// ControllerPolicy requires valid signatures of at least Threshold of Controllers
+type ControllerPolicy struct {
+	Controllers []string
+	Threshold   uint32
+}
+
+// GetControllerPolicy returns the controller policy of the diddoc. Zero threshold means that all controllers are required.
+func GetControllerPolicy(didDoc *types.DidDoc) ControllerPolicy {
+	return ControllerPolicy{
+		Controllers: didDoc.GetControllersOrSubject(),
+		Threshold:   didDoc.ControllerThreshold,
+	}
+}
+
+// BindToVersion binds the self reference of the diddoc among the controllers to the given version of the diddoc
+func (p ControllerPolicy) BindToVersion(did string, versionID string) ControllerPolicy {
+	controllers := make([]string, 0, len(p.Controllers))
+	for _, controller := range p.Controllers {
+		controllers = append(controllers, BindSignerToVersion(controller, did, versionID))
+	}
+
+	return ControllerPolicy{
+		Controllers: controllers,
+		Threshold:   p.Threshold,
+	}
+}
+
+// SplitSignersByControllerThreshold returns the signers which have to sign unconditionally and the controller policies with thresholds.
+// All controllers of a policy without threshold are required signers.
+// Controllers of the affected verification methods are always required signers, even if they are diddoc controllers as well.
+func SplitSignersByControllerThreshold(vmSigners []string, controllerPolicies ...ControllerPolicy) ([]string, []ControllerPolicy) {
+	required := append([]string{}, vmSigners...)
+	var policies []ControllerPolicy
+
+	for _, policy := range controllerPolicies {
+		if policy.Threshold == 0 {
+			required = append(required, policy.Controllers...)
+			continue
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return utils.UniqueSorted(required), policies
+}
+
+// VerifyControllerPolicies verifies that at least the threshold number of controllers of each policy have a valid signature.
+// Returns the controllers with valid signatures. Omit existingVersionSigner and updatedVersionSigner if not updating a DID.
+func VerifyControllerPolicies(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata,
+	messages [][]byte, policies []ControllerPolicy, signatures []*types.SignInfo, existingVersionSigner string, updatedVersionSigner string,
+) ([]string, error) {
+	var signed []string
+
+	for _, policy := range policies {
+		var policySigned []string
+		var missing []string
+
+		for _, controller := range policy.Controllers {
+			if hasValidSignature(k, ctx, inMemoryDIDs, messages, controller, FindSignInfosBySigner(signatures, controller)) {
+				policySigned = append(policySigned, controller)
+			} else {
+				missing = append(missing, fmt.Sprint(GetSignerIDForErrorMessage(controller, existingVersionSigner, updatedVersionSigner)))
+			}
+		}
+
+		if uint32(len(policySigned)) < policy.Threshold {
+			return nil, types.ErrControllerThresholdNotMet.Wrapf("%d of %d controllers signed, at least %d required. valid signatures are missing by: %s",
+				len(policySigned), len(policy.Controllers), policy.Threshold, strings.Join(missing, ", "))
+		}
+
+		signed = append(signed, policySigned...)
+	}
+
+	return utils.UniqueSorted(signed), nil
+}
+
+func hasValidSignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.DidDocWithMetadata, messages [][]byte, signer string, signatures []types.SignInfo) bool {
+	_, versionID := SplitSigner(signer)
+
+	for _, signature := range signatures {
+		if VerifySignature(k, ctx, inMemoryDIDs, messages, signature, versionID) == nil {
+			return true
+		}
+	}
+
+	return false
 }
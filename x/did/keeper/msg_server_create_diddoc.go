@@ -12,7 +12,7 @@ func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDo
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Get sign bytes before modifying payload
-	signBytes := msg.Payload.GetSignBytes()
+	payloadSignBytes := msg.Payload.GetSignBytes()
 
 	// Normalize UUID identifiers
 	msg.Normalize()
@@ -23,15 +23,21 @@ func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDo
 	}
 
 	// Validate namespaces
-	namespace := k.GetDidNamespace(&ctx)
-	err := msg.Validate([]string{namespace})
+	err := msg.Validate(k.GetAllowedNamespaces(&ctx))
 	if err != nil {
 		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
 
+	// Validate the unique id isn't used by a DID in another namespace
+	_, _, uniqueID := utils.MustSplitDID(msg.Payload.Id)
+	if existingDid, found := k.FindDidByUniqueID(&ctx, uniqueID); found {
+		return nil, types.ErrDidDocExists.Wrapf("unique id of %s is used by %s", msg.Payload.Id, existingDid)
+	}
+
 	// Build metadata and stateValue
 	didDoc := msg.Payload.ToDidDoc()
 	metadata := types.NewMetadataFromContext(ctx, msg.Payload.VersionId)
+	metadata.NextKeyCommitment = msg.Payload.NextKeyCommitment
 	didDocWithMetadata := types.NewDidDocWithMetadata(&didDoc, &metadata)
 
 	// Consider did that we are going to create during did resolutions
@@ -47,18 +53,41 @@ func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDo
 	}
 
 	// Verify signatures
-	signers := GetSignerDIDsForDIDCreation(didDoc)
-	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, &ctx, inMemoryDids, signBytes, signers, msg.Signatures)
+	signBytes := GetAcceptedSignBytes(&k.Keeper, &ctx, didDoc.Id, payloadSignBytes, "")
+	requiredSigners, policies := SplitSignersByControllerThreshold(GetVerificationMethodSignerDIDsForDIDCreation(didDoc), GetControllerPolicy(&didDoc))
+	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, &ctx, inMemoryDids, signBytes, requiredSigners, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholdSigners, err := VerifyControllerPolicies(&k.Keeper, &ctx, inMemoryDids, signBytes, policies, msg.Signatures, "", "")
 	if err != nil {
 		return nil, err
 	}
 
+	signers := utils.UniqueSorted(append(requiredSigners, thresholdSigners...))
+
 	// Save first DIDDoc version
 	err = k.AddNewDidDocVersion(&ctx, &didDocWithMetadata)
 	if err != nil {
 		return nil, types.ErrInternal.Wrapf(err.Error())
 	}
 
+	k.SetDidDocCreatedHeight(&ctx, didDoc.Id, uint64(ctx.BlockHeight()))
+	k.SetDidUniqueID(&ctx, didDoc.Id)
+
+	// Emit event
+	diff := types.NewDidDocDiff(nil, &didDoc)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDidDocCreated{
+		Id:        didDoc.Id,
+		VersionId: metadata.VersionId,
+		Signers:   signers,
+		Diff:      &diff,
+	})
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
 	// Build and return response
 	return &types.MsgCreateDidDocResponse{
 		Value: &didDocWithMetadata,
@@ -67,8 +96,16 @@ func (k MsgServer) CreateDidDoc(goCtx context.Context, msg *types.MsgCreateDidDo
 
 func GetSignerDIDsForDIDCreation(did types.DidDoc) []string {
 	res := did.GetControllersOrSubject()
+	res = append(res, GetVerificationMethodSignerDIDsForDIDCreation(did)...)
+
+	return utils.UniqueSorted(res)
+}
+
+// GetVerificationMethodSignerDIDsForDIDCreation returns controllers of the authentication and capability invocation methods of the diddoc
+func GetVerificationMethodSignerDIDsForDIDCreation(did types.DidDoc) []string {
+	var res []string
 
-	for _, vm := range getEffectiveAuthenticationMethods(&did) {
+	for _, vm := range did.AuthorizationMethods() {
 		res = append(res, vm.Controller)
 	}
 
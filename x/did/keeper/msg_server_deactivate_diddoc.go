@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,7 +12,7 @@ func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactiv
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Get sign bytes before modifying payload
-	signBytes := msg.Payload.GetSignBytes()
+	payloadSignBytes := msg.Payload.GetSignBytes()
 
 	// Normalize UUID identifiers
 	msg.Normalize()
@@ -22,8 +23,7 @@ func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactiv
 	}
 
 	// Validate namespaces
-	namespace := k.GetDidNamespace(&ctx)
-	err := msg.Validate([]string{namespace})
+	err := msg.Validate(k.GetNamespacesAllowedForDid(&ctx, msg.Payload.Id))
 	if err != nil {
 		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
@@ -39,16 +39,30 @@ func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactiv
 		return nil, types.ErrDIDDocDeactivated.Wrap(msg.Payload.Id)
 	}
 
+	// Validate the deactivation is based on the latest version
+	err = ValidatePreviousVersion(didDoc, msg.Payload.PreviousVersionId)
+	if err != nil {
+		return nil, err
+	}
+
 	// We neither create dids nor update
 	inMemoryDids := map[string]types.DidDocWithMetadata{}
 
 	// Verify signatures
-	signers := GetSignerDIDsForDIDCreation(*didDoc.DidDoc)
-	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, &ctx, inMemoryDids, signBytes, signers, msg.Signatures)
+	signBytes := GetAcceptedSignBytes(&k.Keeper, &ctx, msg.Payload.Id, payloadSignBytes, didDoc.Metadata.VersionId)
+	requiredSigners, policies := SplitSignersByControllerThreshold(GetVerificationMethodSignerDIDsForDIDCreation(*didDoc.DidDoc), GetControllerPolicy(didDoc.DidDoc))
+	err = VerifyAllSignersHaveAllValidSignatures(&k.Keeper, &ctx, inMemoryDids, signBytes, requiredSigners, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholdSigners, err := VerifyControllerPolicies(&k.Keeper, &ctx, inMemoryDids, signBytes, policies, msg.Signatures, "", "")
 	if err != nil {
 		return nil, err
 	}
 
+	signers := utils.UniqueSorted(append(requiredSigners, thresholdSigners...))
+
 	// Update metadata
 	didDoc.Metadata.Deactivated = true
 	didDoc.Metadata.Update(ctx, msg.Payload.VersionId)
@@ -77,6 +91,17 @@ func (k MsgServer) DeactivateDidDoc(goCtx context.Context, msg *types.MsgDeactiv
 		return nil, types.ErrInternal.Wrapf(iterationErr.Error())
 	}
 
+	// Emit event
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDidDocDeactivated{
+		Id:                didDoc.DidDoc.Id,
+		VersionId:         didDoc.Metadata.VersionId,
+		PreviousVersionId: didDoc.Metadata.PreviousVersionId,
+		Signers:           signers,
+	})
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
 	// Build and return response
 	return &types.MsgDeactivateDidDocResponse{
 		Value: &didDoc,
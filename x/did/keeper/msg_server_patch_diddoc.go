@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k MsgServer) PatchDidDoc(goCtx context.Context, msg *types.MsgPatchDidDoc) (*types.MsgPatchDidDocResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get sign bytes before modifying payload
+	payloadSignBytes := msg.Payload.GetSignBytes()
+
+	// Normalize UUID identifiers
+	msg.Normalize()
+
+	// Validate namespaces
+	allowedNamespaces := k.GetNamespacesAllowedForDid(&ctx, msg.Payload.Id)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Check if DID exists and get latest version
+	existingDidDocWithMetadata, err := k.GetLatestDidDoc(&ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, types.ErrDidDocNotFound.Wrap(err.Error())
+	}
+
+	// Validate DID is not deactivated
+	if existingDidDocWithMetadata.Metadata.Deactivated {
+		return nil, types.ErrDIDDocDeactivated.Wrap(msg.Payload.Id)
+	}
+
+	// Validate the patch is based on the latest version
+	err = ValidatePreviousVersion(existingDidDocWithMetadata, msg.Payload.PreviousVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply patch and validate the result
+	patchedDidDoc, err := existingDidDocWithMetadata.DidDoc.ApplyPatch(msg.Payload.Operations)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrap(err.Error())
+	}
+
+	err = patchedDidDoc.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrBasicValidation.Wrapf("patched DID Doc is invalid: %s", err.Error())
+	}
+
+	patchedDidDocWithMetadata, err := k.applyDidDocUpdate(&ctx, existingDidDocWithMetadata, patchedDidDoc, msg.Payload.VersionId, msg.Payload.NextKeyCommitment, payloadSignBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgPatchDidDocResponse{
+		Value: &patchedDidDocWithMetadata,
+	}, nil
+}
@@ -3,26 +3,25 @@ package keeper
 import (
 	"context"
 	"reflect"
+	"sort"
 
 	"github.com/canow-co/cheqd-node/x/did/types"
 	"github.com/canow-co/cheqd-node/x/did/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
-const UpdatedPostfix string = "-updated"
-
 func (k MsgServer) UpdateDidDoc(goCtx context.Context, msg *types.MsgUpdateDidDoc) (*types.MsgUpdateDidDocResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Get sign bytes before modifying payload
-	signBytes := msg.Payload.GetSignBytes()
+	payloadSignBytes := msg.Payload.GetSignBytes()
 
 	// Normalize UUID identifiers
 	msg.Normalize()
 
 	// Validate namespaces
-	namespace := k.GetDidNamespace(&ctx)
-	err := msg.Validate([]string{namespace})
+	err := msg.Validate(k.GetNamespacesAllowedForDid(&ctx, msg.Payload.Id))
 	if err != nil {
 		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
@@ -33,100 +32,271 @@ func (k MsgServer) UpdateDidDoc(goCtx context.Context, msg *types.MsgUpdateDidDo
 		return nil, types.ErrDidDocNotFound.Wrap(err.Error())
 	}
 
-	existingDidDoc := existingDidDocWithMetadata.DidDoc
-
 	// Validate DID is not deactivated
 	if existingDidDocWithMetadata.Metadata.Deactivated {
 		return nil, types.ErrDIDDocDeactivated.Wrap(msg.Payload.Id)
 	}
 
-	// Construct the new version of the DID and temporary rename it and its self references
-	// in order to consider old and new versions different DIDs during signatures validation
-	updatedDidDoc := msg.Payload.ToDidDoc()
-	updatedDidDoc.ReplaceDids(updatedDidDoc.Id, updatedDidDoc.Id+UpdatedPostfix)
+	// Validate the update is based on the latest version
+	err = ValidatePreviousVersion(existingDidDocWithMetadata, msg.Payload.PreviousVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDidDocWithMetadata, err := k.applyDidDocUpdate(&ctx, existingDidDocWithMetadata, msg.Payload.ToDidDoc(), msg.Payload.VersionId, msg.Payload.NextKeyCommitment, payloadSignBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgUpdateDidDocResponse{
+		Value: &updatedDidDocWithMetadata,
+	}, nil
+}
+
+// applyDidDocUpdate verifies signatures for replacing the existing version of a diddoc with the updated one
+// and stores the updated version
+func (k MsgServer) applyDidDocUpdate(
+	ctx *sdk.Context,
+	existingDidDocWithMetadata types.DidDocWithMetadata,
+	updatedDidDoc types.DidDoc,
+	versionID string,
+	nextKeyCommitment string,
+	payloadSignBytes []byte,
+	signatures []*types.SignInfo,
+) (types.DidDocWithMetadata, error) {
+	existingDidDoc := existingDidDocWithMetadata.DidDoc
+
+	// Check contexts of verification method and service types introduced by the update
+	err := validation.Validate(updatedDidDoc.Context, types.HasRequiredContextsRule(&updatedDidDoc, existingDidDoc))
+	if err != nil {
+		return types.DidDocWithMetadata{}, types.ErrBasicValidation.Wrap(err.Error())
+	}
 
 	updatedMetadata := *existingDidDocWithMetadata.Metadata
-	updatedMetadata.Update(ctx, msg.Payload.VersionId)
+	updatedMetadata.Update(*ctx, versionID)
+
+	// Check authentication keys against the pre-rotation commitment
+	updatedNextKeyCommitment, err := VerifyNextKeyCommitment(existingDidDocWithMetadata, &updatedDidDoc, nextKeyCommitment)
+	if err != nil {
+		return types.DidDocWithMetadata{}, err
+	}
+	updatedMetadata.NextKeyCommitment = updatedNextKeyCommitment
 
 	updatedDidDocWithMetadata := types.NewDidDocWithMetadata(&updatedDidDoc, &updatedMetadata)
 
-	// Consider the new version of the DID a separate DID
+	// Consider the new version of the DID. The existing version is still available in the state.
 	inMemoryDids := map[string]types.DidDocWithMetadata{updatedDidDoc.Id: updatedDidDocWithMetadata}
 
 	// Check controllers existence
 	controllers := updatedDidDoc.AllControllerDids()
 	for _, controller := range controllers {
-		_, err := MustFindDidDoc(&k.Keeper, &ctx, inMemoryDids, controller)
+		_, err := MustFindDidDoc(&k.Keeper, ctx, inMemoryDids, controller)
 		if err != nil {
-			return nil, err
+			return types.DidDocWithMetadata{}, err
 		}
 	}
 
 	// Verify signatures
-	// Duplicate signatures that reference the old version, make them reference a new (in memory) version
-	// We can't use VerifySignatures because we can't uniquely identify a verification method corresponding to a given signInfo.
-	// In other words if a signature belongs to the did being updated, there is no way to know which did version it belongs to: old or new.
-	// To eliminate this problem we have to add pubkey to the signInfo in future.
-	signers := GetSignerDIDsForDIDUpdate(*existingDidDoc, updatedDidDoc)
-	extendedSignatures := DuplicateSignatures(msg.Signatures, existingDidDocWithMetadata.DidDoc.Id, updatedDidDoc.Id)
-	err = VerifyAllSignersHaveAtLeastOneValidSignature(&k.Keeper, &ctx, inMemoryDids, signBytes, signers, extendedSignatures, existingDidDoc.Id, updatedDidDoc.Id)
-	if err != nil {
-		return nil, err
+	signBytes := GetAcceptedSignBytes(&k.Keeper, ctx, existingDidDocWithMetadata.DidDoc.Id, payloadSignBytes, existingDidDocWithMetadata.Metadata.VersionId)
+
+	// Updates of services only can be authorized by capability invocation methods instead of controllers
+	var signers []string
+	if types.IsServiceOnlyChange(existingDidDoc, &updatedDidDoc) && updatedMetadata.NextKeyCommitment == existingDidDocWithMetadata.Metadata.NextKeyCommitment {
+		signers = FindCapabilityInvocationSigners(existingDidDoc, signBytes, signatures)
 	}
 
-	// Return original id
-	updatedDidDoc.ReplaceDids(updatedDidDoc.Id, existingDidDoc.Id)
+	if len(signers) == 0 {
+		signers, err = k.verifyControllerSignaturesForUpdate(ctx, inMemoryDids, existingDidDocWithMetadata, updatedDidDocWithMetadata, signBytes, signatures)
+		if err != nil {
+			return types.DidDocWithMetadata{}, err
+		}
+	}
 
 	// Update state
-	err = k.AddNewDidDocVersion(&ctx, &updatedDidDocWithMetadata)
+	err = k.AddNewDidDocVersion(ctx, &updatedDidDocWithMetadata)
 	if err != nil {
-		return nil, types.ErrInternal.Wrapf(err.Error())
+		return types.DidDocWithMetadata{}, types.ErrInternal.Wrapf(err.Error())
 	}
 
-	// Build and return response
-	return &types.MsgUpdateDidDocResponse{
-		Value: &updatedDidDocWithMetadata,
-	}, nil
+	// Emit event. Signers are reported without version bindings.
+	diff := types.NewDidDocDiff(existingDidDoc, &updatedDidDoc)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDidDocUpdated{
+		Id:                existingDidDoc.Id,
+		VersionId:         updatedMetadata.VersionId,
+		PreviousVersionId: updatedMetadata.PreviousVersionId,
+		Signers:           GetSignerDIDs(signers),
+		Diff:              &diff,
+	})
+	if err != nil {
+		return types.DidDocWithMetadata{}, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	return updatedDidDocWithMetadata, nil
 }
 
-func GetSignerIDForErrorMessage(signerID string, existingVersionID string, updatedVersionID string) interface{} {
-	if signerID == existingVersionID { // oldDid->id
-		return existingVersionID + " (old version)"
+// verifyControllerSignaturesForUpdate verifies signatures of controllers and verification method controllers required for the update.
+// The DID being updated signs both as the existing and as the updated version of itself.
+// Its signatures are verified against the version selected by the sign info or against both versions if not selected.
+func (k MsgServer) verifyControllerSignaturesForUpdate(
+	ctx *sdk.Context,
+	inMemoryDids map[string]types.DidDocWithMetadata,
+	existingDidDocWithMetadata types.DidDocWithMetadata,
+	updatedDidDocWithMetadata types.DidDocWithMetadata,
+	signBytes [][]byte,
+	signatures []*types.SignInfo,
+) ([]string, error) {
+	existingDidDoc := existingDidDocWithMetadata.DidDoc
+	updatedDidDoc := updatedDidDocWithMetadata.DidDoc
+
+	existingVersionSigner := VersionedSigner(existingDidDoc.Id, existingDidDocWithMetadata.Metadata.VersionId)
+	updatedVersionSigner := VersionedSigner(updatedDidDoc.Id, updatedDidDocWithMetadata.Metadata.VersionId)
+
+	requiredSigners, policies := SplitSignersByControllerThreshold(
+		GetVerificationMethodSignerDIDsForDIDUpdate(existingDidDocWithMetadata, updatedDidDocWithMetadata),
+		GetControllerPolicy(existingDidDoc).BindToVersion(existingDidDoc.Id, existingDidDocWithMetadata.Metadata.VersionId),
+		GetControllerPolicy(updatedDidDoc).BindToVersion(updatedDidDoc.Id, updatedDidDocWithMetadata.Metadata.VersionId),
+	)
+	sortSignersForUpdate(requiredSigners, updatedVersionSigner)
+
+	err := VerifyAllSignersHaveAtLeastOneValidSignature(&k.Keeper, ctx, inMemoryDids, signBytes, requiredSigners, signatures, existingVersionSigner, updatedVersionSigner)
+	if err != nil {
+		return nil, err
 	}
 
-	if signerID == updatedVersionID { // oldDid->id + UpdatedPrefix
-		return existingVersionID + " (new version)"
+	thresholdSigners, err := VerifyControllerPolicies(&k.Keeper, ctx, inMemoryDids, signBytes, policies, signatures, existingVersionSigner, updatedVersionSigner)
+	if err != nil {
+		return nil, err
 	}
 
-	return signerID
+	return append(requiredSigners, thresholdSigners...), nil
 }
 
-func DuplicateSignatures(signatures []*types.SignInfo, didToDuplicate string, newDid string) []*types.SignInfo {
-	result := make([]*types.SignInfo, 0, len(signatures))
+// FindCapabilityInvocationSigners returns controllers of the capability invocation methods of the diddoc which made valid signatures.
+// Verification methods are taken from the existing version of the diddoc. It has the same keys as the updated version
+// because only updates of services can be authorized this way.
+func FindCapabilityInvocationSigners(didDoc *types.DidDoc, messages [][]byte, signatures []*types.SignInfo) []string {
+	var signers []string
+
+	capabilityInvocationMethods := types.FilterSigningVerificationMethods(didDoc.CapabilityInvocationMethods())
 
 	for _, signature := range signatures {
-		result = append(result, signature)
+		vm, found := types.FindVerificationMethod(capabilityInvocationMethods, signature.VerificationMethodId)
+		if !found {
+			continue
+		}
 
-		did, path, query, fragment := utils.MustSplitDIDUrl(signature.VerificationMethodId)
-		if did == didToDuplicate {
-			duplicate := types.SignInfo{
-				VerificationMethodId: utils.JoinDIDUrl(newDid, path, query, fragment),
-				Signature:            signature.Signature,
+		for _, message := range messages {
+			if types.VerifySignature(*vm, message, signature.Signature) == nil {
+				signers = append(signers, vm.Controller)
+				break
 			}
+		}
+	}
+
+	return utils.UniqueSorted(signers)
+}
 
-			result = append(result, &duplicate)
+// VerifyNextKeyCommitment checks that the authentication keys of the updated diddoc match the next key commitment
+// of the existing version if the keys are rotated. Changing controllers or the controller threshold is a rotation too,
+// otherwise a compromised current key could hand the control over without the committed keys.
+// Returns the commitment for the updated version:
+//   - the existing commitment is kept until the committed keys are used. Only the same commitment can be provided;
+//   - the provided commitment, possibly empty, replaces the existing one when the committed keys are used;
+//   - the provided commitment is set if the existing version has no commitment.
+func VerifyNextKeyCommitment(existingDidDocWithMetadata types.DidDocWithMetadata, updatedDidDoc *types.DidDoc, nextKeyCommitment string) (string, error) {
+	existingCommitment := existingDidDocWithMetadata.Metadata.NextKeyCommitment
+	if existingCommitment == "" {
+		return nextKeyCommitment, nil
+	}
+
+	existingKeys, err := existingDidDocWithMetadata.DidDoc.GetAuthenticationKeyFingerprints()
+	if err != nil {
+		return "", types.ErrInternal.Wrapf("can't get authentication keys of the existing version: %s", err.Error())
+	}
+
+	updatedKeys, err := updatedDidDoc.GetAuthenticationKeyFingerprints()
+	if err != nil {
+		return "", types.ErrBasicValidation.Wrapf("can't get authentication keys of the updated version: %s", err.Error())
+	}
+
+	controllersChanged := !reflect.DeepEqual(
+		utils.UniqueSorted(existingDidDocWithMetadata.DidDoc.GetControllersOrSubject()),
+		utils.UniqueSorted(updatedDidDoc.GetControllersOrSubject()),
+	) || existingDidDocWithMetadata.DidDoc.ControllerThreshold != updatedDidDoc.ControllerThreshold
+
+	// Keys are not rotated
+	if reflect.DeepEqual(existingKeys, updatedKeys) && !controllersChanged {
+		if nextKeyCommitment != "" && nextKeyCommitment != existingCommitment {
+			return "", types.ErrNextKeyCommitmentMismatch.Wrap("the next key commitment can be replaced only together with rotating the committed keys")
 		}
+
+		return existingCommitment, nil
 	}
 
-	return result
+	// Keys are rotated
+	if types.GetNextKeyCommitment(updatedKeys) != existingCommitment {
+		if controllersChanged {
+			return "", types.ErrNextKeyCommitmentMismatch.Wrapf("controllers can be changed only together with rotating to the keys of the commitment %s", existingCommitment)
+		}
+
+		return "", types.ErrNextKeyCommitmentMismatch.Wrapf("authentication keys of the updated version don't match the commitment %s", existingCommitment)
+	}
+
+	return nextKeyCommitment, nil
 }
 
-func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.DidDoc) []string {
-	signers := existingDidDoc.GetControllersOrSubject()
-	signers = append(signers, updatedDidDoc.GetControllersOrSubject()...)
+// sortSignersForUpdate orders the signers by DID. The existing version of the DID being updated goes before the updated one
+// regardless of the version ids, so the signers are checked in a predictable order.
+func sortSignersForUpdate(signers []string, updatedVersionSigner string) {
+	sort.SliceStable(signers, func(i, j int) bool {
+		didI, _ := SplitSigner(signers[i])
+		didJ, _ := SplitSigner(signers[j])
+
+		if didI != didJ {
+			return didI < didJ
+		}
+
+		return signers[i] != updatedVersionSigner && signers[j] == updatedVersionSigner
+	})
+}
+
+// GetSignerIDForErrorMessage replaces the signers bound to the existing and the updated versions of the DID being updated
+// with human-readable labels
+func GetSignerIDForErrorMessage(signerID string, existingVersionSigner string, updatedVersionSigner string) interface{} {
+	did, _ := SplitSigner(signerID)
+
+	if signerID == existingVersionSigner {
+		return did + " (old version)"
+	}
+
+	if signerID == updatedVersionSigner {
+		return did + " (new version)"
+	}
+
+	return signerID
+}
+
+func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDocWithMetadata, updatedDidDoc types.DidDocWithMetadata) []string {
+	signers := GetControllerPolicy(existingDidDoc.DidDoc).BindToVersion(existingDidDoc.DidDoc.Id, existingDidDoc.Metadata.VersionId).Controllers
+	signers = append(signers, GetControllerPolicy(updatedDidDoc.DidDoc).BindToVersion(updatedDidDoc.DidDoc.Id, updatedDidDoc.Metadata.VersionId).Controllers...)
+	signers = append(signers, GetVerificationMethodSignerDIDsForDIDUpdate(existingDidDoc, updatedDidDoc)...)
+
+	return utils.UniqueSorted(signers)
+}
+
+// GetVerificationMethodSignerDIDsForDIDUpdate returns controllers of the authentication and capability invocation methods
+// added, changed or removed by the update.
+// If the DID being updated controls its methods, it's bound to the version the methods are taken from.
+func GetVerificationMethodSignerDIDsForDIDUpdate(existingDidDoc types.DidDocWithMetadata, updatedDidDoc types.DidDocWithMetadata) []string {
+	var signers []string
+
+	did := existingDidDoc.DidDoc.Id
+	existingVersionID := existingDidDoc.Metadata.VersionId
+	updatedVersionID := updatedDidDoc.Metadata.VersionId
 
-	existingVMs := getEffectiveAuthenticationMethods(&existingDidDoc)
-	updatedVMs := getEffectiveAuthenticationMethods(&updatedDidDoc)
+	existingVMs := existingDidDoc.DidDoc.AuthorizationMethods()
+	updatedVMs := updatedDidDoc.DidDoc.AuthorizationMethods()
 
 	existingVMMap := types.VerificationMethodListToMapByFragment(existingVMs)
 	updatedVMMap := types.VerificationMethodListToMapByFragment(updatedVMs)
@@ -137,19 +307,15 @@ func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.
 
 		// VM added
 		if !found {
-			signers = append(signers, updatedVM.Controller)
+			signers = append(signers, BindSignerToVersion(updatedVM.Controller, did, updatedVersionID))
 			continue
 		}
 
 		// VM updated
-		// We have to revert renaming before comparing veriifcation methods.
-		// Otherwise we will detect id and controller change
-		// for non changed VMs because of `-updated` postfix.
-		originalUpdatedVM := *updatedVM
-		originalUpdatedVM.ReplaceDids(updatedDidDoc.Id, existingDidDoc.Id)
-
-		if !reflect.DeepEqual(existingVM, originalUpdatedVM) {
-			signers = append(signers, existingVM.Controller, updatedVM.Controller)
+		if !reflect.DeepEqual(existingVM, *updatedVM) {
+			signers = append(signers,
+				BindSignerToVersion(existingVM.Controller, did, existingVersionID),
+				BindSignerToVersion(updatedVM.Controller, did, updatedVersionID))
 			continue
 		}
 
@@ -162,7 +328,7 @@ func GetSignerDIDsForDIDUpdate(existingDidDoc types.DidDoc, updatedDidDoc types.
 
 		// VM removed
 		if !found {
-			signers = append(signers, existingVM.Controller)
+			signers = append(signers, BindSignerToVersion(existingVM.Controller, did, existingVersionID))
 			continue
 		}
 	}
@@ -13,3 +13,55 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.FeeParams) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeParams, &params)
 	return params
 }
+
+func (k Keeper) SetSigningParams(ctx sdk.Context, params types.SigningParams) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeySigningParams, &params)
+}
+
+// GetSigningParams returns the signing params or the defaults if they haven't been set yet, e.g. before an upgrade
+func (k Keeper) GetSigningParams(ctx sdk.Context) (params types.SigningParams) {
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeySigningParams) {
+		return *types.DefaultSigningParams()
+	}
+
+	k.paramSpace.Get(ctx, types.ParamStoreKeySigningParams, &params)
+	return params
+}
+
+func (k Keeper) SetNamespaceParams(ctx sdk.Context, params types.NamespaceParams) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyNamespaceParams, &params)
+}
+
+// GetNamespaceParams returns the namespace params or the defaults if they haven't been set yet, e.g. before an upgrade
+func (k Keeper) GetNamespaceParams(ctx sdk.Context) (params types.NamespaceParams) {
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyNamespaceParams) {
+		return *types.DefaultNamespaceParams()
+	}
+
+	k.paramSpace.Get(ctx, types.ParamStoreKeyNamespaceParams, &params)
+	return params
+}
+
+// GetNamespaceFeeParams returns fee params of the namespace if they are overridden by the namespace params
+func (k Keeper) GetNamespaceFeeParams(ctx sdk.Context, namespace string) (types.FeeParams, bool) {
+	namespaceParams := k.GetNamespaceParams(ctx)
+
+	feeParams, found := namespaceParams.GetFeeParams(namespace)
+	if !found {
+		return types.FeeParams{}, false
+	}
+
+	return *feeParams, true
+}
+
+// GetNamespaceResourceFeeParams returns resource fee params of the namespace if they are overridden by the namespace params
+func (k Keeper) GetNamespaceResourceFeeParams(ctx sdk.Context, namespace string) (types.ResourceFeeParams, bool) {
+	namespaceParams := k.GetNamespaceParams(ctx)
+
+	feeParams, found := namespaceParams.GetResourceFeeParams(namespace)
+	if !found {
+		return types.ResourceFeeParams{}, false
+	}
+
+	return *feeParams, true
+}
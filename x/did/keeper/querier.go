@@ -23,6 +23,16 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 			return getAllDidDocVersionsMetadata(ctx, path[1], k, legacyQuerierCdc)
 		case types.QueryGetDidDocVersion:
 			return getDidDocVersion(ctx, path[1], path[2], k, legacyQuerierCdc)
+		case types.QueryResolveDid:
+			return resolveDid(ctx, path[1], k, legacyQuerierCdc)
+		case types.QueryGetDidDocAtTime:
+			return getDidDocAtTime(ctx, path[1], path[2], k, legacyQuerierCdc)
+		case types.QueryGetDidDocsByController:
+			return getDidDocsByController(ctx, path[1], k, legacyQuerierCdc)
+		case types.QueryGetDidDocsByPublicKey:
+			return getDidDocsByPublicKey(ctx, path[1], k, legacyQuerierCdc)
+		case types.QueryGetAllDidDocs:
+			return getAllDidDocs(ctx, k, legacyQuerierCdc)
 
 		default:
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func getDidDocsByPublicKey(ctx sdk.Context, fingerprint string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	queryServer := NewQueryServer(keeper)
+
+	resp, err := queryServer.DidDocsByPublicKey(sdk.WrapSDKContext(ctx), &types.QueryDidDocsByPublicKeyRequest{PublicKey: fingerprint})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AllDidDocs(c context.Context, req *types.QueryAllDidDocsRequest) (*types.QueryAllDidDocsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	filter, err := req.GetFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	didDocs, pageResponse, err := k.GetFilteredDidDocs(&ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllDidDocsResponse{
+		DidDocs:    didDocs,
+		Pagination: pageResponse,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidDocAtTime(c context.Context, req *types.QueryDidDocAtTimeRequest) (*types.QueryDidDocAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, req.Timestamp)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrapf("invalid timestamp: %s", err.Error())
+	}
+
+	req.Normalize()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDidDoc(&ctx, req.Id) {
+		return nil, types.ErrDidDocNotFound.Wrap(req.Id)
+	}
+
+	didDoc, found := k.GetDidDocVersionAtTime(&ctx, req.Id, timestamp)
+	if !found {
+		return nil, types.ErrDidDocNotCreatedYet.Wrapf("%s at %s", req.Id, req.Timestamp)
+	}
+
+	return &types.QueryDidDocAtTimeResponse{Value: &didDoc}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidDocsByController(c context.Context, req *types.QueryDidDocsByControllerRequest) (*types.QueryDidDocsByControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Validate before normalization because normalization expects a well-formed DID
+	err := types.ValidateControllerDID(req.Controller, nil)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrapf("invalid controller: %s", err.Error())
+	}
+
+	req.Normalize()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dids, pageResponse, err := k.GetDidsByController(&ctx, req.Controller, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	didDocs := make([]*types.DidDocWithMetadata, 0, len(dids))
+	for _, did := range dids {
+		didDoc, err := k.GetLatestDidDoc(&ctx, did)
+		if err != nil {
+			return nil, err
+		}
+
+		didDocs = append(didDocs, &didDoc)
+	}
+
+	return &types.QueryDidDocsByControllerResponse{
+		DidDocs:    didDocs,
+		Pagination: pageResponse,
+	}, nil
+}
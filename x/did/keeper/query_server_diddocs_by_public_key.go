@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidDocsByPublicKey(c context.Context, req *types.QueryDidDocsByPublicKeyRequest) (*types.QueryDidDocsByPublicKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fingerprint, err := req.GetFingerprint()
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrapf("invalid public key: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dids, pageResponse, err := k.GetDidsByPublicKey(&ctx, fingerprint, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	didDocs := make([]*types.DidDocWithMetadata, 0, len(dids))
+	for _, did := range dids {
+		didDoc, err := k.GetLatestDidDoc(&ctx, did)
+		if err != nil {
+			return nil, err
+		}
+
+		didDocs = append(didDocs, &didDoc)
+	}
+
+	return &types.QueryDidDocsByPublicKeyResponse{
+		DidDocs:    didDocs,
+		Pagination: pageResponse,
+	}, nil
+}
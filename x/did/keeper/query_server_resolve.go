@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Resolve resolves a DID according to the W3C DID Resolution specification.
+// Resolution errors are reported in the resolution metadata instead of being returned as query errors.
+func (k Keeper) Resolve(c context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retrieved := ctx.BlockTime()
+
+	contentType, supported := types.NormalizeDIDContentType(req.Accept)
+	if !supported {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorRepresentationNotSupported, retrieved), nil
+	}
+
+	// Validate DID before normalization because normalization expects a well-formed DID
+	method, _, _, err := utils.TrySplitDID(req.Id)
+	if err != nil {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorInvalidDid, retrieved), nil
+	}
+
+	if method != types.DidMethod {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorMethodNotSupported, retrieved), nil
+	}
+
+	if !utils.IsValidDID(req.Id, types.DidMethod, nil) {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorInvalidDid, retrieved), nil
+	}
+
+	if req.VersionId != "" && !utils.IsValidUUID(req.VersionId) {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorInvalidOptions, retrieved), nil
+	}
+
+	req.Normalize()
+
+	didDoc, found, errorCode := k.ResolveDidDocVersion(&ctx, req.Id, req.VersionId, req.VersionTime)
+	if errorCode != "" {
+		return newResolveErrorResponse(req.Id, errorCode, retrieved), nil
+	}
+
+	// DIDs from other networks can't be found on this ledger. DIDs in namespaces which aren't allowed anymore still can.
+	if !found {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorNotFound, retrieved), nil
+	}
+
+	didDocBytes, err := didDoc.DidDoc.MarshalW3CJSON(contentType)
+	if err != nil {
+		return newResolveErrorResponse(req.Id, types.ResolutionErrorInternalError, retrieved), nil
+	}
+
+	resolutionMetadata := types.NewDidResolutionMetadata(req.Id, contentType, retrieved)
+
+	return &types.QueryResolveResponse{
+		DidResolutionMetadata: &resolutionMetadata,
+		DidDocument:           string(didDocBytes),
+		DidDocumentMetadata:   didDoc.Metadata,
+	}, nil
+}
+
+// ResolveDidDocVersion selects the DID Document version requested by the versionId and versionTime resolution options.
+// Returns a resolution error code if the options are invalid.
+func (k Keeper) ResolveDidDocVersion(ctx *sdk.Context, did, versionID, versionTime string) (didDoc types.DidDocWithMetadata, found bool, errorCode string) {
+	switch {
+	case versionID != "" && versionTime != "":
+		return types.DidDocWithMetadata{}, false, types.ResolutionErrorInvalidOptions
+
+	case versionID != "":
+		if !k.HasDidDocVersion(ctx, did, versionID) {
+			return types.DidDocWithMetadata{}, false, ""
+		}
+
+		didDoc, err := k.GetDidDocVersion(ctx, did, versionID)
+		if err != nil {
+			return types.DidDocWithMetadata{}, false, types.ResolutionErrorInternalError
+		}
+
+		return didDoc, true, ""
+
+	case versionTime != "":
+		at, err := time.Parse(time.RFC3339Nano, versionTime)
+		if err != nil {
+			return types.DidDocWithMetadata{}, false, types.ResolutionErrorInvalidOptions
+		}
+
+		didDoc, found := k.GetDidDocVersionAtTime(ctx, did, at)
+		return didDoc, found, ""
+
+	default:
+		if !k.HasDidDoc(ctx, did) {
+			return types.DidDocWithMetadata{}, false, ""
+		}
+
+		didDoc, err := k.GetLatestDidDoc(ctx, did)
+		if err != nil {
+			return types.DidDocWithMetadata{}, false, types.ResolutionErrorInternalError
+		}
+
+		return didDoc, true, ""
+	}
+}
+
+func newResolveErrorResponse(did string, errorCode string, retrieved time.Time) *types.QueryResolveResponse {
+	resolutionMetadata := types.NewDidResolutionMetadata(did, "", retrieved)
+	resolutionMetadata.Error = errorCode
+
+	return &types.QueryResolveResponse{
+		DidResolutionMetadata: &resolutionMetadata,
+	}
+}
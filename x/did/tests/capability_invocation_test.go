@@ -0,0 +1,219 @@
+package tests
+
+import (
+	"fmt"
+
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc capability invocation", func() {
+	var setup TestSetup
+	var org CreatedDidDocInfo
+	var opsKeyID string
+	var opsKeyPair KeyPair
+	var opsSignInput SignInput
+
+	BeforeEach(func() {
+		setup = Setup()
+
+		// Methods referenced only from capabilityInvocation are restricted to services once governance disables full updates
+		params := setup.Keeper.GetSigningParams(setup.SdkCtx)
+		params.AllowCapabilityInvocationFullUpdates = false
+		setup.Keeper.SetSigningParams(setup.SdkCtx, params)
+
+		info := setup.BuildSimpleDidDoc()
+		opsKeyID = info.Did + "#ops"
+		opsKeyPair = GenerateKeyPair()
+		opsSignInput = SignInput{
+			VerificationMethodID: opsKeyID,
+			Key:                  opsKeyPair.Private,
+		}
+
+		info.Msg.VerificationMethod = append(info.Msg.VerificationMethod, &types.VerificationMethod{
+			Id:                     opsKeyID,
+			VerificationMethodType: types.Ed25519VerificationKey2020Type,
+			Controller:             info.Did,
+			VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(opsKeyPair.Public),
+		})
+		info.Msg.CapabilityInvocation = []*types.VerificationRelationship{
+			{
+				VerificationMethodId: opsKeyID,
+			},
+		}
+
+		org = setup.CreateCustomDidDoc(info)
+	})
+
+	// Builds the update of the latest version with the given services
+	buildUpdateMsg := func(services ...*types.Service) *types.MsgUpdateDidDocPayload {
+		latest, err := setup.QueryDidDoc(org.Did)
+		Expect(err).To(BeNil())
+
+		didDoc := latest.Value.DidDoc
+
+		return &types.MsgUpdateDidDocPayload{
+			Context:              didDoc.Context,
+			Id:                   didDoc.Id,
+			Controller:           didDoc.Controller,
+			VerificationMethod:   didDoc.VerificationMethod,
+			Authentication:       didDoc.Authentication,
+			AssertionMethod:      didDoc.AssertionMethod,
+			CapabilityInvocation: didDoc.CapabilityInvocation,
+			CapabilityDelegation: didDoc.CapabilityDelegation,
+			KeyAgreement:         didDoc.KeyAgreement,
+			AlsoKnownAs:          didDoc.AlsoKnownAs,
+			Service:              services,
+			ControllerThreshold:  didDoc.ControllerThreshold,
+			VersionId:            uuid.NewString(),
+		}
+	}
+
+	service := &types.Service{
+		Id:              "#service-1",
+		ServiceType:     "DIDCommMessaging",
+		ServiceEndpoint: []string{"https://example.com/endpoint"},
+	}
+
+	withDid := func(service *types.Service) *types.Service {
+		res := *service
+		res.Id = org.Did + service.Id
+		return &res
+	}
+
+	It("Updates services", func() {
+		msg := buildUpdateMsg(withDid(service))
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(BeNil())
+
+		updated, err := setup.QueryDidDoc(org.Did)
+		Expect(err).To(BeNil())
+		Expect(updated.Value.DidDoc.Service).To(Equal(msg.Service))
+
+		// And removes them
+		msg = buildUpdateMsg()
+
+		_, err = setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Patches services", func() {
+		msg := &types.MsgPatchDidDocPayload{
+			Id: org.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpAdd, Path: "/service/-", Service: withDid(service)},
+			},
+			PreviousVersionId: org.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Doesn't update anything else", func() {
+		msg := buildUpdateMsg(withDid(service))
+		msg.AlsoKnownAs = []string{"https://example.com/org"}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version), signatures by %s are not valid", org.Did, opsKeyID)))
+	})
+
+	It("Doesn't change keys", func() {
+		msg := buildUpdateMsg(withDid(service))
+		msg.VerificationMethod[1].VerificationMaterial = GenerateEd25519VerificationKey2020VerificationMaterial(GenerateKeyPair().Public)
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version), signatures by %s are not valid", org.Did, opsKeyID)))
+	})
+
+	It("Doesn't set the next key commitment", func() {
+		msg := buildUpdateMsg(withDid(service))
+		msg.NextKeyCommitment = types.GetNextKeyCommitment([]string{"z6MkszZtxCmA2Ce4vUV132PCuLQmwnaDD5mw2L23fGNnsiX3"})
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Doesn't deactivate the DIDDoc", func() {
+		payload := &types.MsgDeactivateDidDocPayload{
+			Id:        org.Did,
+			VersionId: uuid.NewString(),
+		}
+
+		_, err := setup.DeactivateDidDoc(payload, []SignInput{opsSignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%s: authentication method not found", opsKeyID)))
+	})
+
+	It("Doesn't accept signatures by other keys of the DIDDoc", func() {
+		msg := buildUpdateMsg(withDid(service))
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{{VerificationMethodID: opsKeyID, Key: GenerateKeyPair().Private}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Keeps authentication keys able to update services", func() {
+		msg := buildUpdateMsg(withDid(service))
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Doesn't update anything else by methods referenced from other verification relationships too", func() {
+		msg := buildUpdateMsg()
+		msg.AssertionMethod = []*types.VerificationRelationship{{VerificationMethodId: opsKeyID}}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(BeNil())
+
+		msg = buildUpdateMsg()
+		msg.AlsoKnownAs = []string{"https://example.com/org"}
+
+		_, err = setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version), signatures by %s are not valid", org.Did, opsKeyID)))
+	})
+
+	It("Keeps methods referenced from both authentication and capability invocation able to update everything", func() {
+		msg := buildUpdateMsg()
+		msg.Authentication = append(msg.Authentication, &types.VerificationRelationship{VerificationMethodId: opsKeyID})
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(BeNil())
+
+		msg = buildUpdateMsg()
+		msg.AlsoKnownAs = []string{"https://example.com/org"}
+
+		_, err = setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Keeps methods referenced only from capability invocation able to update everything while governance allows it", func() {
+		params := setup.Keeper.GetSigningParams(setup.SdkCtx)
+		params.AllowCapabilityInvocationFullUpdates = true
+		setup.Keeper.SetSigningParams(setup.SdkCtx, params)
+
+		msg := buildUpdateMsg(withDid(service))
+		msg.AlsoKnownAs = []string{"https://example.com/org"}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{opsSignInput})
+		Expect(err).To(BeNil())
+
+		payload := &types.MsgDeactivateDidDocPayload{
+			Id:        org.Did,
+			VersionId: uuid.NewString(),
+		}
+
+		_, err = setup.DeactivateDidDoc(payload, []SignInput{opsSignInput})
+		Expect(err).To(BeNil())
+	})
+})
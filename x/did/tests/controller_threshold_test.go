@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"fmt"
+
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc controller threshold", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var bob CreatedDidDocInfo
+	var carol CreatedDidDocInfo
+	var org DidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+
+		alice = setup.CreateSimpleDid()
+		bob = setup.CreateSimpleDid()
+		carol = setup.CreateSimpleDid()
+
+		org = setup.BuildSimpleDidDoc()
+		org.Msg.Controller = []string{alice.Did, bob.Did, carol.Did}
+		org.Msg.ControllerThreshold = 2
+	})
+
+	// Builds a new message every time so that each update gets its own version id
+	buildUpdateMsg := func() *types.MsgUpdateDidDocPayload {
+		return &types.MsgUpdateDidDocPayload{
+			Id:         org.Did,
+			Controller: []string{alice.Did, bob.Did, carol.Did},
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     org.KeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             org.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(org.KeyPair.Public),
+				},
+			},
+			Authentication: []*types.VerificationRelationship{
+				{
+					VerificationMethodId: org.KeyID,
+				},
+			},
+			AlsoKnownAs:         []string{"https://example.com/org"},
+			ControllerThreshold: 2,
+			VersionId:           uuid.NewString(),
+		}
+	}
+
+	Describe("Create", func() {
+		It("Works with signatures of threshold controllers", func() {
+			_, err := setup.CreateDid(org.Msg, []SignInput{org.SignInput, alice.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+
+			created, err := setup.QueryDidDoc(org.Did)
+			Expect(err).To(BeNil())
+			Expect(created.Value.DidDoc.ControllerThreshold).To(Equal(uint32(2)))
+		})
+
+		It("Doesn't work when less than threshold controllers signed", func() {
+			_, err := setup.CreateDid(org.Msg, []SignInput{org.SignInput, bob.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				fmt.Sprintf("1 of 3 controllers signed, at least 2 required. valid signatures are missing by: %s, %s: controller threshold not met", alice.Did, carol.Did)))
+		})
+
+		It("Still requires signatures of verification method controllers", func() {
+			_, err := setup.CreateDid(org.Msg, []SignInput{alice.SignInput, bob.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("signer: %s: signature is required but not found", org.Did)))
+		})
+
+		It("Doesn't allow threshold greater than the number of controllers", func() {
+			org.Msg.ControllerThreshold = 4
+
+			_, err := setup.CreateDid(org.Msg, []SignInput{org.SignInput, alice.SignInput, bob.SignInput, carol.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("controller_threshold: must be no greater than 3"))
+		})
+	})
+
+	Describe("Update and deactivate", func() {
+		var created CreatedDidDocInfo
+
+		BeforeEach(func() {
+			_, err := setup.CreateDid(org.Msg, []SignInput{org.SignInput, alice.SignInput, bob.SignInput})
+			Expect(err).To(BeNil())
+
+			created = CreatedDidDocInfo{DidDocInfo: org}
+		})
+
+		It("Updates with signatures of threshold controllers", func() {
+			msg := buildUpdateMsg()
+
+			_, err := setup.UpdateDidDoc(msg, []SignInput{bob.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+
+			updated, err := setup.QueryDidDoc(created.Did)
+			Expect(err).To(BeNil())
+			Expect(updated.Value.DidDoc.AlsoKnownAs).To(Equal(msg.AlsoKnownAs))
+		})
+
+		It("Reports missing signatures when the threshold is not met", func() {
+			msg := buildUpdateMsg()
+
+			_, err := setup.UpdateDidDoc(msg, []SignInput{carol.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				fmt.Sprintf("valid signatures are missing by: %s, %s: controller threshold not met", alice.Did, bob.Did)))
+		})
+
+		It("Applies thresholds of both existing and updated versions", func() {
+			msg := buildUpdateMsg()
+			msg.Controller = []string{alice.Did, bob.Did}
+
+			// 2-of-3 existing controllers, but only 1-of-2 updated ones
+			_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, carol.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				fmt.Sprintf("1 of 2 controllers signed, at least 2 required. valid signatures are missing by: %s", bob.Did)))
+
+			msg = buildUpdateMsg()
+			msg.Controller = []string{alice.Did, bob.Did}
+
+			_, err = setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, bob.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Requires all controllers after the threshold is removed", func() {
+			msg := buildUpdateMsg()
+			msg.ControllerThreshold = 0
+
+			_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, bob.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one signature by %s", carol.Did)))
+
+			msg = buildUpdateMsg()
+			msg.ControllerThreshold = 0
+
+			_, err = setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, bob.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Deactivates with signatures of threshold controllers", func() {
+			payload := &types.MsgDeactivateDidDocPayload{
+				Id:        created.Did,
+				VersionId: uuid.NewString(),
+			}
+
+			_, err := setup.DeactivateDidDoc(payload, []SignInput{org.SignInput, alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("controller threshold not met"))
+
+			_, err = setup.DeactivateDidDoc(payload, []SignInput{org.SignInput, alice.SignInput, carol.SignInput})
+			Expect(err).To(BeNil())
+
+			deactivated, err := setup.QueryDidDoc(created.Did)
+			Expect(err).To(BeNil())
+			Expect(deactivated.Value.Metadata.Deactivated).To(BeTrue())
+		})
+	})
+})
@@ -537,6 +537,54 @@ var _ = Describe("Create DID tests", func() {
 		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%s: authentication method not found", keyID)))
 	})
 
+	It("Not Valid: Signature by key agreement method", func() {
+		did := testsetup.GenerateDID(testsetup.Base58_16bytes)
+		keypair := testsetup.GenerateKeyPair()
+		keyID := did + "#key-1"
+		keyAgreementKeyID := did + "#key-agreement-1"
+
+		// Ed25519 public key bytes are a valid X25519 key too, so the signature would pass if the method was used as is
+		msg := &types.MsgCreateDidDocPayload{
+			Id: did,
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     keyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(keypair.Public),
+				},
+				{
+					Id:                     keyAgreementKeyID,
+					VerificationMethodType: types.X25519KeyAgreementKey2019Type,
+					Controller:             did,
+					VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2018VerificationMaterial(keypair.Public),
+				},
+			},
+			KeyAgreement: []*types.VerificationRelationship{
+				{
+					VerificationMethodId: keyAgreementKeyID,
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		signatures := []testsetup.SignInput{
+			{
+				VerificationMethodID: keyAgreementKeyID,
+				Key:                  keypair.Private,
+			},
+		}
+
+		_, err := setup.CreateDid(msg, signatures)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%s: authentication method not found", keyAgreementKeyID)))
+
+		signatures[0].VerificationMethodID = keyID
+
+		_, err = setup.CreateDid(msg, signatures)
+		Expect(err).To(BeNil())
+	})
+
 	It("Not Valid: Second controller did not sign request", func() {
 		// Alice
 		alice := setup.CreateSimpleDid()
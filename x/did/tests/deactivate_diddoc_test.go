@@ -100,4 +100,35 @@ var _ = Describe("Deactivate DID tests", func() {
 			Expect(err.Error()).To(Equal(fmt.Sprintf("signer: %s: signature is required but not found", alice.DidDocInfo.Did)))
 		})
 	})
+
+	When("Previous version is not the latest", func() {
+		It("Should return a version conflict error", func() {
+			alice := setup.CreateSimpleDid()
+
+			update := &types.MsgUpdateDidDocPayload{
+				Id:                 alice.Did,
+				VerificationMethod: alice.Msg.VerificationMethod,
+				Authentication:     alice.Msg.Authentication,
+				AlsoKnownAs:        []string{"https://example.com"},
+				VersionId:          uuid.NewString(),
+			}
+
+			_, err := setup.UpdateDidDoc(update, []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			msg := &types.MsgDeactivateDidDocPayload{
+				Id:                alice.Did,
+				VersionId:         uuid.NewString(),
+				PreviousVersionId: alice.VersionID,
+			}
+
+			_, err = setup.DeactivateDidDoc(msg, []testsetup.SignInput{alice.SignInput})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(types.ErrVersionConflict.Error()))
+
+			msg.PreviousVersionId = update.VersionId
+			_, err = setup.DeactivateDidDoc(msg, []testsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+		})
+	})
 })
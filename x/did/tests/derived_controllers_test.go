@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"encoding/base64"
+
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("did:key and did:jwk controllers", func() {
+	var setup TestSetup
+
+	BeforeEach(func() {
+		setup = Setup()
+	})
+
+	didKeySigner := func(keyPair KeyPair) (string, SignInput) {
+		multibaseKey := GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public)
+		did := "did:key:" + multibaseKey
+
+		return did, SignInput{VerificationMethodID: did + "#" + multibaseKey, Key: keyPair.Private}
+	}
+
+	didJWKSigner := func(keyPair KeyPair) (string, SignInput) {
+		jwk := GenerateJSONWebKey2020VerificationMaterial(keyPair.Public)
+		did := "did:jwk:" + base64.RawURLEncoding.EncodeToString([]byte(jwk))
+
+		return did, SignInput{VerificationMethodID: did + "#0", Key: keyPair.Private}
+	}
+
+	updatePayload := func(didDoc CreatedDidDocInfo) *types.MsgUpdateDidDocPayload {
+		return &types.MsgUpdateDidDocPayload{
+			Id:                 didDoc.Did,
+			Controller:         didDoc.Msg.Controller,
+			VerificationMethod: didDoc.Msg.VerificationMethod,
+			Authentication:     didDoc.Msg.Authentication,
+			AssertionMethod:    didDoc.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+	}
+
+	DescribeTable("Control DIDDocs without being written to the ledger", func(signer func(KeyPair) (string, SignInput)) {
+		keyPair := GenerateKeyPair()
+		controller, controllerSignInput := signer(keyPair)
+
+		alice := setup.CreateDidDocWithExternalDocAndMethodsController(controller, controllerSignInput)
+
+		created, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(created.Value.DidDoc.Controller).To(Equal([]string{controller}))
+
+		// Only the controller signs updates
+		_, err = setup.UpdateDidDoc(updatePayload(alice), []SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrSignatureNotFound.Error()))
+
+		_, err = setup.UpdateDidDoc(updatePayload(alice), []SignInput{controllerSignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{Id: alice.Did, VersionId: uuid.NewString()}, []SignInput{controllerSignInput})
+		Expect(err).To(BeNil())
+
+		// Derived DIDs are never written to the ledger
+		Expect(setup.Keeper.HasDidDoc(&setup.SdkCtx, controller)).To(BeFalse())
+	},
+		Entry("did:key", didKeySigner),
+		Entry("did:jwk", didJWKSigner),
+	)
+
+	It("Doesn't accept signatures by another key", func() {
+		controller, controllerSignInput := didKeySigner(GenerateKeyPair())
+		controllerSignInput.Key = GenerateKeyPair().Private
+
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Controller = []string{controller}
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput, controllerSignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidSignature.Error()))
+	})
+
+	It("Doesn't accept signatures bound to a version of a derived DID Document", func() {
+		controller, controllerSignInput := didKeySigner(GenerateKeyPair())
+		controllerSignInput.VersionID = uuid.NewString()
+
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Controller = []string{controller, didDoc.Did}
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput, controllerSignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrAuthenticationMethodNotFound.Error()))
+	})
+
+	It("Doesn't accept invalid derived DIDs as controllers", func() {
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Controller = []string{"did:key:z6LSshsJaW2SRRvspgQEx9a748YwXTaRWptdqqKEDPqZNSmV"}
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unsupported multicodec: 0xec"))
+	})
+
+	It("Finds DIDDocs controlled by a did:key", func() {
+		controller, controllerSignInput := didKeySigner(GenerateKeyPair())
+		alice := setup.CreateDidDocWithExternalDocAndMethodsController(controller, controllerSignInput)
+
+		res, err := setup.QueryDidDocsByController(controller, nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(HaveLen(1))
+		Expect(res.DidDocs[0].DidDoc.Id).To(Equal(alice.Did))
+	})
+})
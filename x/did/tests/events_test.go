@@ -0,0 +1,101 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DID Doc lifecycle events", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+	})
+
+	// lastTypedEvent returns the last emitted typed event
+	lastTypedEvent := func() proto.Message {
+		events := setup.SdkCtx.EventManager().ABCIEvents()
+		Expect(events).NotTo(BeEmpty())
+
+		event, err := sdk.ParseTypedEvent(events[len(events)-1])
+		Expect(err).To(BeNil())
+
+		return event
+	}
+
+	It("Emits EventDidDocCreated", func() {
+		event, ok := lastTypedEvent().(*types.EventDidDocCreated)
+		Expect(ok).To(BeTrue())
+
+		Expect(event.Id).To(Equal(alice.Did))
+		Expect(event.VersionId).To(Equal(alice.VersionID))
+		Expect(event.Signers).To(Equal([]string{alice.Did}))
+		Expect(event.Diff.AddedVerificationMethods).To(Equal([]string{alice.KeyID}))
+		Expect(event.Diff.RemovedVerificationMethods).To(BeEmpty())
+		Expect(event.Diff.AddedServices).To(BeEmpty())
+	})
+
+	It("Emits EventDidDocUpdated with the diff", func() {
+		newKeyPair := GenerateKeyPair()
+		newKeyID := alice.Did + "#key-2"
+
+		msg := &types.MsgUpdateDidDocPayload{
+			Id: alice.Did,
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     newKeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             alice.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(newKeyPair.Public),
+				},
+			},
+			Authentication: []*types.VerificationRelationship{{VerificationMethodId: newKeyID}},
+			Service: []*types.Service{
+				{
+					Id:              alice.Did + "#linked-domain",
+					ServiceType:     "LinkedDomains",
+					ServiceEndpoint: []string{"https://example.com"},
+				},
+			},
+			VersionId: uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, {VerificationMethodID: newKeyID, Key: newKeyPair.Private}})
+		Expect(err).To(BeNil())
+
+		event, ok := lastTypedEvent().(*types.EventDidDocUpdated)
+		Expect(ok).To(BeTrue())
+
+		Expect(event.Id).To(Equal(alice.Did))
+		Expect(event.VersionId).To(Equal(msg.VersionId))
+		Expect(event.PreviousVersionId).To(Equal(alice.VersionID))
+		Expect(event.Signers).To(Equal([]string{alice.Did}))
+		Expect(event.Diff.AddedVerificationMethods).To(Equal([]string{newKeyID}))
+		Expect(event.Diff.RemovedVerificationMethods).To(Equal([]string{alice.KeyID}))
+		Expect(event.Diff.ChangedVerificationMethods).To(BeEmpty())
+		Expect(event.Diff.AddedServices).To(Equal([]string{alice.Did + "#linked-domain"}))
+	})
+
+	It("Emits EventDidDocDeactivated", func() {
+		versionID := uuid.NewString()
+		_, err := setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{Id: alice.Did, VersionId: versionID}, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		event, ok := lastTypedEvent().(*types.EventDidDocDeactivated)
+		Expect(ok).To(BeTrue())
+
+		Expect(event.Id).To(Equal(alice.Did))
+		Expect(event.VersionId).To(Equal(versionID))
+		Expect(event.PreviousVersionId).To(Equal(alice.VersionID))
+		Expect(event.Signers).To(Equal([]string{alice.Did}))
+	})
+})
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+var _ = Describe("DID namespaces", func() {
+	const partnersNamespace = "partners"
+
+	var setup TestSetup
+
+	BeforeEach(func() {
+		setup = Setup()
+		setup.Keeper.SetNamespaceParams(setup.SdkCtx, types.NamespaceParams{
+			Namespaces: []*types.NamespaceConfig{
+				{Namespace: partnersNamespace},
+			},
+		})
+	})
+
+	didInNamespace := func(namespace string) string {
+		return utils.JoinDID(types.DidMethod, namespace, uuid.NewString())
+	}
+
+	It("Allows the primary namespace and the configured ones", func() {
+		Expect(setup.Keeper.GetAllowedNamespaces(&setup.SdkCtx)).To(Equal([]string{DidNamespace, partnersNamespace}))
+		Expect(setup.Keeper.IsAllowedNamespace(&setup.SdkCtx, partnersNamespace)).To(BeTrue())
+		Expect(setup.Keeper.IsAllowedNamespace(&setup.SdkCtx, "mainnet")).To(BeFalse())
+	})
+
+	It("Creates, updates and deactivates DIDDocs in an additional namespace", func() {
+		alice := setup.CreateCustomDidDoc(setup.BuildDidDocWithCustomDID(didInNamespace(partnersNamespace)))
+
+		created, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(created.Value.DidDoc.Id).To(Equal(alice.Did))
+
+		updatePayload := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			AssertionMethod:    alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err = setup.UpdateDidDoc(updatePayload, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		deactivatePayload := &types.MsgDeactivateDidDocPayload{
+			Id:        alice.Did,
+			VersionId: uuid.NewString(),
+		}
+
+		_, err = setup.DeactivateDidDoc(deactivatePayload, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		deactivated, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(deactivated.Value.Metadata.Deactivated).To(BeTrue())
+	})
+
+	It("Doesn't create DIDDocs in a namespace which is not allowed", func() {
+		didDoc := setup.BuildDidDocWithCustomDID(didInNamespace("mainnet"))
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("did namespace must be one of: " + DidNamespace + ", " + partnersNamespace))
+	})
+
+	It("Doesn't create DIDDocs with a unique id used in another namespace", func() {
+		alice := setup.CreateSimpleDid()
+		_, _, id := utils.MustSplitDID(alice.Did)
+
+		didDoc := setup.BuildDidDocWithCustomDID(utils.JoinDID(types.DidMethod, partnersNamespace, id))
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrDidDocExists.Error()))
+		Expect(err.Error()).To(ContainSubstring("unique id of " + didDoc.Did + " is used by " + alice.Did))
+	})
+
+	It("Finds DIDs by unique id across namespaces", func() {
+		alice := setup.CreateCustomDidDoc(setup.BuildDidDocWithCustomDID(didInNamespace(partnersNamespace)))
+		_, _, id := utils.MustSplitDID(alice.Did)
+
+		did, found := setup.Keeper.FindDidByUniqueID(&setup.SdkCtx, id)
+		Expect(found).To(BeTrue())
+		Expect(did).To(Equal(alice.Did))
+	})
+
+	It("Updates and deactivates DIDDocs in a namespace which is not allowed anymore", func() {
+		alice := setup.CreateCustomDidDoc(setup.BuildDidDocWithCustomDID(didInNamespace(partnersNamespace)))
+
+		setup.Keeper.SetNamespaceParams(setup.SdkCtx, *types.DefaultNamespaceParams())
+
+		// New DIDDocs can't be created in the namespace
+		didDoc := setup.BuildDidDocWithCustomDID(didInNamespace(partnersNamespace))
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("did namespace must be one of: " + DidNamespace))
+
+		// Existing ones are still found, resolved, updated and deactivated
+		_, _, id := utils.MustSplitDID(alice.Did)
+		did, found := setup.Keeper.FindDidByUniqueID(&setup.SdkCtx, id)
+		Expect(found).To(BeTrue())
+		Expect(did).To(Equal(alice.Did))
+
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(res.DidResolutionMetadata.Error).To(BeEmpty())
+
+		updatePayload := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			AssertionMethod:    alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err = setup.UpdateDidDoc(updatePayload, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		deactivatePayload := &types.MsgDeactivateDidDocPayload{
+			Id:        alice.Did,
+			VersionId: uuid.NewString(),
+		}
+
+		_, err = setup.DeactivateDidDoc(deactivatePayload, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		deactivated, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(deactivated.Value.Metadata.Deactivated).To(BeTrue())
+	})
+
+	It("Doesn't resolve DIDs of other networks", func() {
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: didInNamespace("mainnet")})
+		Expect(err).To(BeNil())
+		Expect(res.DidResolutionMetadata.Error).To(Equal(types.ResolutionErrorNotFound))
+	})
+
+	It("Resolves DIDDocs in an additional namespace", func() {
+		alice := setup.CreateCustomDidDoc(setup.BuildDidDocWithCustomDID(didInNamespace(partnersNamespace)))
+
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(res.DidDocument).To(ContainSubstring(alice.Did))
+	})
+})
@@ -0,0 +1,214 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc next key commitment", func() {
+	var setup TestSetup
+	var org DidDocInfo
+	var nextKeyPair KeyPair
+	var otherKeyPair KeyPair
+	var commitment string
+
+	buildVerificationMethod := func(keyPair KeyPair) *types.VerificationMethod {
+		return &types.VerificationMethod{
+			Id:                     org.KeyID,
+			VerificationMethodType: types.Ed25519VerificationKey2020Type,
+			Controller:             org.Did,
+			VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public),
+		}
+	}
+
+	commitTo := func(keyPair KeyPair) string {
+		didDoc := types.DidDoc{
+			Id:                 org.Did,
+			VerificationMethod: []*types.VerificationMethod{buildVerificationMethod(keyPair)},
+		}
+
+		nextKeyCommitment, err := didDoc.GetNextKeyCommitment()
+		Expect(err).To(BeNil())
+
+		return nextKeyCommitment
+	}
+
+	buildUpdateMsg := func(keyPair KeyPair) *types.MsgUpdateDidDocPayload {
+		return &types.MsgUpdateDidDocPayload{
+			Id:                 org.Did,
+			VerificationMethod: []*types.VerificationMethod{buildVerificationMethod(keyPair)},
+			Authentication: []*types.VerificationRelationship{
+				{
+					VerificationMethodId: org.KeyID,
+				},
+			},
+			AlsoKnownAs: []string{"https://example.com/org"},
+			VersionId:   uuid.NewString(),
+		}
+	}
+
+	signInputFor := func(keyPair KeyPair) SignInput {
+		return SignInput{
+			VerificationMethodID: org.KeyID,
+			Key:                  keyPair.Private,
+		}
+	}
+
+	BeforeEach(func() {
+		setup = Setup()
+
+		org = setup.BuildSimpleDidDoc()
+		nextKeyPair = GenerateKeyPair()
+		otherKeyPair = GenerateKeyPair()
+
+		commitment = commitTo(nextKeyPair)
+		org.Msg.NextKeyCommitment = commitment
+
+		_, err := setup.CreateDid(org.Msg, []SignInput{org.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Is stored in the metadata", func() {
+		created, err := setup.QueryDidDoc(org.Did)
+		Expect(err).To(BeNil())
+		Expect(created.Value.Metadata.NextKeyCommitment).To(Equal(commitment))
+	})
+
+	It("Allows rotation to the committed keys", func() {
+		msg := buildUpdateMsg(nextKeyPair)
+		msg.NextKeyCommitment = commitTo(otherKeyPair)
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput, signInputFor(nextKeyPair)})
+		Expect(err).To(BeNil())
+
+		updated, err := setup.QueryDidDoc(org.Did)
+		Expect(err).To(BeNil())
+		Expect(updated.Value.DidDoc.VerificationMethod[0].VerificationMaterial).To(Equal(msg.VerificationMethod[0].VerificationMaterial))
+		Expect(updated.Value.Metadata.NextKeyCommitment).To(Equal(msg.NextKeyCommitment))
+	})
+
+	It("Doesn't depend on the representation of the committed keys", func() {
+		msg := buildUpdateMsg(nextKeyPair)
+		msg.VerificationMethod[0].VerificationMethodType = types.Ed25519VerificationKey2018Type
+		msg.VerificationMethod[0].VerificationMaterial = GenerateEd25519VerificationKey2018VerificationMaterial(nextKeyPair.Public)
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput, signInputFor(nextKeyPair)})
+		Expect(err).To(BeNil())
+	})
+
+	It("Rejects rotation to other keys even if they sign", func() {
+		msg := buildUpdateMsg(otherKeyPair)
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput, signInputFor(otherKeyPair)})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("authentication keys of the updated version don't match the commitment " + commitment + ": next key commitment mismatch"))
+	})
+
+	It("Rejects adding keys next to the existing ones", func() {
+		msg := buildUpdateMsg(org.KeyPair)
+		msg.VerificationMethod = append(msg.VerificationMethod, &types.VerificationMethod{
+			Id:                     org.Did + "#key-2",
+			VerificationMethodType: types.Ed25519VerificationKey2020Type,
+			Controller:             org.Did,
+			VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(otherKeyPair.Public),
+		})
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput, {VerificationMethodID: org.Did + "#key-2", Key: otherKeyPair.Private}})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("next key commitment mismatch"))
+	})
+
+	It("Is kept by updates which don't change the keys", func() {
+		msg := buildUpdateMsg(org.KeyPair)
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(BeNil())
+
+		updated, err := setup.QueryDidDoc(org.Did)
+		Expect(err).To(BeNil())
+		Expect(updated.Value.Metadata.NextKeyCommitment).To(Equal(commitment))
+
+		// The committed keys can still be used
+		msg = buildUpdateMsg(nextKeyPair)
+
+		_, err = setup.UpdateDidDoc(msg, []SignInput{org.SignInput, signInputFor(nextKeyPair)})
+		Expect(err).To(BeNil())
+	})
+
+	It("Can't be replaced without rotating the committed keys", func() {
+		msg := buildUpdateMsg(org.KeyPair)
+		msg.NextKeyCommitment = commitTo(otherKeyPair)
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("the next key commitment can be replaced only together with rotating the committed keys"))
+	})
+
+	It("Rejects a controller swap signed by the current key", func() {
+		mallory := setup.CreateSimpleDid()
+
+		msg := buildUpdateMsg(org.KeyPair)
+		msg.Controller = []string{mallory.Did}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput, mallory.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("controllers can be changed only together with rotating to the keys of the commitment " + commitment + ": next key commitment mismatch"))
+	})
+
+	It("Rejects a controller threshold change signed by the current key", func() {
+		msg := buildUpdateMsg(org.KeyPair)
+		msg.ControllerThreshold = 1
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("next key commitment mismatch"))
+	})
+
+	It("Allows changing controllers together with rotation to the committed keys", func() {
+		alice := setup.CreateSimpleDid()
+
+		msg := buildUpdateMsg(nextKeyPair)
+		msg.Controller = []string{org.Did, alice.Did}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput, signInputFor(nextKeyPair), alice.SignInput})
+		Expect(err).To(BeNil())
+
+		updated, err := setup.QueryDidDoc(org.Did)
+		Expect(err).To(BeNil())
+		Expect(updated.Value.DidDoc.Controller).To(Equal([]string{org.Did, alice.Did}))
+	})
+
+	It("Applies to patches", func() {
+		msg := &types.MsgPatchDidDocPayload{
+			Id: org.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpReplace, Path: "/verificationMethod/0", VerificationMethod: buildVerificationMethod(otherKeyPair)},
+			},
+			PreviousVersionId: org.Msg.VersionId,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{org.SignInput, signInputFor(otherKeyPair)})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("next key commitment mismatch"))
+
+		msg.Operations[0].VerificationMethod = buildVerificationMethod(nextKeyPair)
+
+		_, err = setup.PatchDidDoc(msg, []SignInput{org.SignInput, signInputFor(nextKeyPair)})
+		Expect(err).To(BeNil())
+	})
+
+	It("Rejects malformed commitments", func() {
+		msg := buildUpdateMsg(org.KeyPair)
+		msg.NextKeyCommitment = "zQmInvalid"
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{org.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("next_key_commitment"))
+	})
+})
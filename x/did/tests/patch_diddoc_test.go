@@ -0,0 +1,170 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc patch", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+	})
+
+	It("Applies operations to the latest version", func() {
+		service := &types.Service{
+			Id:              alice.Did + "#linked-domain",
+			ServiceType:     "LinkedDomains",
+			ServiceEndpoint: []string{"https://example.com"},
+		}
+
+		msg := &types.MsgPatchDidDocPayload{
+			Id: alice.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpAdd, Path: "/service/-", Service: service},
+				{Op: types.PatchOpAdd, Path: "/assertionMethod/-", VerificationRelationship: &types.VerificationRelationship{VerificationMethodId: alice.KeyID}},
+				{Op: types.PatchOpAdd, Path: "/alsoKnownAs/-", AlsoKnownAs: "https://example.com"},
+			},
+			PreviousVersionId: alice.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		res, err := setup.PatchDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.PreviousVersionId).To(Equal(alice.VersionID))
+
+		patched, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(patched.Value.Metadata.VersionId).To(Equal(msg.VersionId))
+		Expect(patched.Value.DidDoc.VerificationMethod).To(Equal(alice.Msg.VerificationMethod))
+		Expect(patched.Value.DidDoc.Service).To(Equal([]*types.Service{service}))
+		Expect(patched.Value.DidDoc.AssertionMethod).To(Equal([]*types.VerificationRelationship{{VerificationMethodId: alice.KeyID}}))
+		Expect(patched.Value.DidDoc.AlsoKnownAs).To(Equal([]string{"https://example.com"}))
+	})
+
+	It("Adds a verification method of a new type together with its context", func() {
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Context = []string{types.DIDCoreContext, types.Ed25519Signature2020Context}
+		bob := setup.CreateCustomDidDoc(didDoc)
+
+		jwkKeyPair := GenerateKeyPair()
+		jwkVM := &types.VerificationMethod{
+			Id:                     bob.Did + "#key-jwk",
+			VerificationMethodType: types.JSONWebKey2020Type,
+			Controller:             bob.Did,
+			VerificationMaterial:   GenerateJSONWebKey2020VerificationMaterial(jwkKeyPair.Public),
+		}
+
+		msg := &types.MsgPatchDidDocPayload{
+			Id: bob.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpAdd, Path: "/verificationMethod/-", VerificationMethod: jwkVM},
+			},
+			PreviousVersionId: bob.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{bob.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.JSONWebSignature2020Context))
+
+		msg.Operations = append(msg.Operations, &types.PatchOperation{Op: types.PatchOpAdd, Path: "/context/-", Context: types.JSONWebSignature2020Context})
+
+		_, err = setup.PatchDidDoc(msg, []SignInput{bob.SignInput})
+		Expect(err).To(BeNil())
+
+		patched, err := setup.QueryDidDoc(bob.Did)
+		Expect(err).To(BeNil())
+		Expect(patched.Value.DidDoc.Context).To(Equal([]string{types.DIDCoreContext, types.Ed25519Signature2020Context, types.JSONWebSignature2020Context}))
+		Expect(patched.Value.DidDoc.VerificationMethod).To(ContainElement(jwkVM))
+	})
+
+	It("Rotates a key with signatures of both the old and the new key", func() {
+		newKeyPair := GenerateKeyPair()
+		newVM := &types.VerificationMethod{
+			Id:                     alice.KeyID,
+			VerificationMethodType: types.Ed25519VerificationKey2020Type,
+			Controller:             alice.Did,
+			VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(newKeyPair.Public),
+		}
+
+		msg := &types.MsgPatchDidDocPayload{
+			Id: alice.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpReplace, Path: "/verificationMethod/0", VerificationMethod: newVM},
+			},
+			PreviousVersionId: alice.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("signature"))
+
+		_, err = setup.PatchDidDoc(msg, []SignInput{alice.SignInput, {VerificationMethodID: alice.KeyID, Key: newKeyPair.Private}})
+		Expect(err).To(BeNil())
+
+		patched, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+		Expect(patched.Value.DidDoc.VerificationMethod).To(Equal([]*types.VerificationMethod{newVM}))
+	})
+
+	It("Rejects a patch based on an outdated version", func() {
+		msg := &types.MsgPatchDidDocPayload{
+			Id: alice.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpAdd, Path: "/alsoKnownAs/-", AlsoKnownAs: "https://example.com"},
+			},
+			PreviousVersionId: alice.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		msg.VersionId = uuid.NewString()
+		_, err = setup.PatchDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(types.ErrVersionConflict.Error()))
+	})
+
+	It("Rejects a patch producing an invalid DID Doc", func() {
+		// Authentication still references the removed verification method
+		msg := &types.MsgPatchDidDocPayload{
+			Id: alice.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpRemove, Path: "/verificationMethod/0"},
+			},
+			PreviousVersionId: alice.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("patched DID Doc is invalid"))
+	})
+
+	It("Rejects a patch not signed by the controller", func() {
+		bob := setup.CreateSimpleDid()
+
+		msg := &types.MsgPatchDidDocPayload{
+			Id: alice.Did,
+			Operations: []*types.PatchOperation{
+				{Op: types.PatchOpAdd, Path: "/alsoKnownAs/-", AlsoKnownAs: "https://example.com"},
+			},
+			PreviousVersionId: alice.VersionID,
+			VersionId:         uuid.NewString(),
+		}
+
+		_, err := setup.PatchDidDoc(msg, []SignInput{bob.SignInput})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cheqd "github.com/canow-co/cheqd-node/x/did"
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+var _ = Describe("Query all DID Docs", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var bob DidDocInfo
+	var carol CreatedDidDocInfo
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		setup = Setup()
+
+		setup.SetBlockHeight(10)
+		setup.SetBlockTime(start)
+		alice = setup.CreateSimpleDid()
+
+		setup.SetBlockHeight(20)
+		setup.SetBlockTime(start.Add(time.Hour))
+		bob = setup.BuildSimpleDidDoc()
+		bob.Msg.VerificationMethod[0].VerificationMethodType = types.JSONWebKey2020Type
+		bob.Msg.VerificationMethod[0].VerificationMaterial = GenerateJSONWebKey2020VerificationMaterial(bob.KeyPair.Public)
+		bob.Msg.Service = []*types.Service{
+			{
+				Id:              bob.Did + "#linked-domain",
+				ServiceType:     "LinkedDomains",
+				ServiceEndpoint: []string{"https://example.com"},
+			},
+		}
+		setup.CreateCustomDidDoc(bob)
+
+		setup.SetBlockHeight(30)
+		setup.SetBlockTime(start.Add(2 * time.Hour))
+		carol = setup.CreateSimpleDid()
+
+		_, err := setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{Id: carol.Did, VersionId: uuid.NewString()}, []SignInput{carol.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	getIds := func(res *types.QueryAllDidDocsResponse) []string {
+		var ids []string
+		for _, didDoc := range res.DidDocs {
+			ids = append(ids, didDoc.DidDoc.Id)
+		}
+
+		return ids
+	}
+
+	It("Returns all DIDs without filters", func() {
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did, carol.Did))
+		Expect(res.Pagination.Total).To(Equal(uint64(3)))
+	})
+
+	It("Filters by deactivation status", func() {
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{Deactivated: types.DEACTIVATION_FILTER_ACTIVE}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did))
+
+		res, err = setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{Deactivated: types.DEACTIVATION_FILTER_DEACTIVATED}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(carol.Did))
+	})
+
+	It("Filters by creation height", func() {
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{CreatedHeightFrom: 15, CreatedHeightTo: 30}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(bob.Did, carol.Did))
+
+		res, err = setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{CreatedHeightTo: 20}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did))
+	})
+
+	It("Filters by creation time", func() {
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{
+			CreatedAfter:  start.Add(time.Hour).Format(time.RFC3339),
+			CreatedBefore: start.Add(90 * time.Minute).Format(time.RFC3339),
+		}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(bob.Did))
+	})
+
+	It("Filters by verification method type", func() {
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{VerificationMethodType: types.JSONWebKey2020Type}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(bob.Did))
+	})
+
+	It("Filters by service type", func() {
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{ServiceType: "LinkedDomains"}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(bob.Did))
+
+		res, err = setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{ServiceType: "DIDCommMessaging"}, nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(BeEmpty())
+	})
+
+	It("Paginates filtered results", func() {
+		req := &types.QueryAllDidDocsRequest{Deactivated: types.DEACTIVATION_FILTER_ACTIVE}
+
+		first, err := setup.QueryAllDidDocs(req, &query.PageRequest{Limit: 1})
+		Expect(err).To(BeNil())
+		Expect(first.DidDocs).To(HaveLen(1))
+		Expect(first.Pagination.NextKey).NotTo(BeNil())
+
+		second, err := setup.QueryAllDidDocs(req, &query.PageRequest{Key: first.Pagination.NextKey, Limit: 1})
+		Expect(err).To(BeNil())
+		Expect(second.DidDocs).To(HaveLen(1))
+
+		Expect(append(getIds(first), getIds(second)...)).To(ConsistOf(alice.Did, bob.Did))
+	})
+
+	It("Filters by namespace", func() {
+		setup.Keeper.SetNamespaceParams(setup.SdkCtx, types.NamespaceParams{
+			Namespaces: []*types.NamespaceConfig{
+				{Namespace: "partners"},
+			},
+		})
+		dave := setup.CreateCustomDidDoc(setup.BuildDidDocWithCustomDID(utils.JoinDID(types.DidMethod, "partners", uuid.NewString())))
+
+		res, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{Namespace: "partners"}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(dave.Did))
+		Expect(res.Pagination.Total).To(Equal(uint64(1)))
+
+		res, err = setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{Namespace: DidNamespace, CreatedHeightTo: 20}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did))
+
+		res, err = setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{Namespace: "mainnet"}, nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(BeEmpty())
+	})
+
+	It("Keeps creation heights after genesis export and import", func() {
+		setup.Keeper.SetParams(setup.SdkCtx, *types.DefaultFeeParams())
+		genesis := cheqd.ExportGenesis(setup.SdkCtx, setup.Keeper)
+		Expect(genesis.CreatedHeights).To(HaveLen(3))
+		Expect(genesis.Validate()).To(Succeed())
+
+		imported := Setup()
+		imported.SetBlockHeight(100)
+		cheqd.InitGenesis(imported.SdkCtx, imported.Keeper, genesis)
+
+		res, err := imported.QueryAllDidDocs(&types.QueryAllDidDocsRequest{CreatedHeightFrom: 15, CreatedHeightTo: 30}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(bob.Did, carol.Did))
+	})
+
+	It("Indexes DIDs of genesis files without creation heights at the initial height", func() {
+		setup.Keeper.SetParams(setup.SdkCtx, *types.DefaultFeeParams())
+		genesis := cheqd.ExportGenesis(setup.SdkCtx, setup.Keeper)
+		genesis.CreatedHeights = nil
+
+		imported := Setup()
+		imported.SetBlockHeight(100)
+		cheqd.InitGenesis(imported.SdkCtx, imported.Keeper, genesis)
+
+		res, err := imported.QueryAllDidDocs(&types.QueryAllDidDocsRequest{CreatedHeightTo: 100}, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did, carol.Did))
+	})
+
+	It("Returns an error for a malformed creation time", func() {
+		_, err := setup.QueryAllDidDocs(&types.QueryAllDidDocsRequest{CreatedAfter: "yesterday"}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid created_after"))
+	})
+})
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Query DID Doc at time", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var created time.Time
+
+	BeforeEach(func() {
+		setup = Setup()
+		created = setup.SdkCtx.BlockTime()
+		alice = setup.CreateSimpleDid()
+	})
+
+	updateAlice := func() string {
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		return msg.VersionId
+	}
+
+	It("Returns the version active at the given time", func() {
+		setup.SetBlockTime(created.Add(time.Hour))
+		secondVersion := updateAlice()
+
+		setup.SetBlockTime(created.Add(2 * time.Hour))
+		thirdVersion := updateAlice()
+
+		res, err := setup.QueryDidDocAtTime(alice.Did, created.Format(time.RFC3339))
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.VersionId).To(Equal(alice.VersionID))
+
+		res, err = setup.QueryDidDocAtTime(alice.Did, created.Add(90*time.Minute).Format(time.RFC3339))
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.VersionId).To(Equal(secondVersion))
+
+		res, err = setup.QueryDidDocAtTime(alice.Did, created.Add(2*time.Hour).Format(time.RFC3339))
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.VersionId).To(Equal(thirdVersion))
+	})
+
+	It("Returns the last version of the block", func() {
+		setup.SetBlockTime(created.Add(time.Hour))
+		updateAlice()
+		lastVersion := updateAlice()
+
+		res, err := setup.QueryDidDocAtTime(alice.Did, created.Add(time.Hour).Format(time.RFC3339))
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.VersionId).To(Equal(lastVersion))
+	})
+
+	It("Returns the same versions after the index is rebuilt from stored versions", func() {
+		setup.SetBlockTime(created.Add(time.Hour))
+		updateAlice()
+		lastVersion := updateAlice()
+
+		// Import versions without the index the way genesis does
+		imported := Setup()
+		versions, err := setup.Keeper.GetAllDidDocVersions(&setup.SdkCtx, alice.Did)
+		Expect(err).To(BeNil())
+
+		for _, metadata := range versions {
+			version, err := setup.Keeper.GetDidDocVersion(&setup.SdkCtx, alice.Did, metadata.VersionId)
+			Expect(err).To(BeNil())
+			Expect(imported.Keeper.SetDidDocVersion(&imported.SdkCtx, &version, false)).To(Succeed())
+		}
+		Expect(imported.Keeper.SetLatestDidDocVersion(&imported.SdkCtx, alice.Did, lastVersion)).To(Succeed())
+
+		Expect(imported.Keeper.IndexAllDidDocVersionTimes(&imported.SdkCtx)).To(Succeed())
+
+		res, err := imported.QueryDidDocAtTime(alice.Did, created.Format(time.RFC3339))
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.VersionId).To(Equal(alice.VersionID))
+
+		res, err = imported.QueryDidDocAtTime(alice.Did, created.Add(time.Hour).Format(time.RFC3339))
+		Expect(err).To(BeNil())
+		Expect(res.Value.Metadata.VersionId).To(Equal(lastVersion))
+	})
+
+	It("Returns an error if the DID Doc was not yet created", func() {
+		_, err := setup.QueryDidDocAtTime(alice.Did, created.Add(-time.Second).Format(time.RFC3339))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrDidDocNotCreatedYet.Error()))
+	})
+
+	It("Returns an error if the DID Doc does not exist", func() {
+		_, err := setup.QueryDidDocAtTime(GenerateDID(Base58_16bytes), created.Format(time.RFC3339))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrDidDocNotFound.Error()))
+	})
+
+	It("Returns an error if the timestamp is malformed", func() {
+		_, err := setup.QueryDidDocAtTime(alice.Did, "yesterday")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid timestamp"))
+	})
+})
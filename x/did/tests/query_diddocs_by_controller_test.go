@@ -0,0 +1,108 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Query DID Docs by controller", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var bob CreatedDidDocInfo
+	var carol CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+		bob = setup.CreateDidDocWithExternalDocControllers([]string{alice.Did}, []SignInput{alice.SignInput})
+		carol = setup.CreateDidDocWithExternalDocAndMethodsController(alice.Did, alice.SignInput)
+	})
+
+	getIds := func(res *types.QueryDidDocsByControllerResponse) []string {
+		var ids []string
+		for _, didDoc := range res.DidDocs {
+			ids = append(ids, didDoc.DidDoc.Id)
+		}
+
+		return ids
+	}
+
+	It("Returns DIDs controlled directly and through verification methods", func() {
+		res, err := setup.QueryDidDocsByController(alice.Did, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did, carol.Did))
+	})
+
+	It("Doesn't return DIDs that are not controlled anymore", func() {
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 bob.Did,
+			VerificationMethod: bob.Msg.VerificationMethod,
+			Authentication:     bob.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, bob.SignInput})
+		Expect(err).To(BeNil())
+
+		res, err := setup.QueryDidDocsByController(alice.Did, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, carol.Did))
+
+		res, err = setup.QueryDidDocsByController(bob.Did, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(bob.Did))
+		Expect(res.DidDocs[0].Metadata.VersionId).To(Equal(msg.VersionId))
+	})
+
+	It("Paginates the result", func() {
+		res, err := setup.QueryDidDocsByController(alice.Did, &query.PageRequest{Limit: 2, CountTotal: true})
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(HaveLen(2))
+		Expect(res.Pagination.Total).To(Equal(uint64(3)))
+
+		firstPage := getIds(res)
+
+		res, err = setup.QueryDidDocsByController(alice.Did, &query.PageRequest{Key: res.Pagination.NextKey})
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(HaveLen(1))
+		Expect(append(firstPage, getIds(res)...)).To(ConsistOf(alice.Did, bob.Did, carol.Did))
+	})
+
+	It("Returns DIDs after the index is rebuilt", func() {
+		imported := Setup()
+		for _, did := range []string{alice.Did, bob.Did, carol.Did} {
+			didDoc, err := setup.Keeper.GetLatestDidDoc(&setup.SdkCtx, did)
+			Expect(err).To(BeNil())
+			Expect(imported.Keeper.SetDidDocVersion(&imported.SdkCtx, &didDoc, false)).To(Succeed())
+			Expect(imported.Keeper.SetLatestDidDocVersion(&imported.SdkCtx, did, didDoc.Metadata.VersionId)).To(Succeed())
+		}
+
+		res, err := imported.QueryDidDocsByController(alice.Did, nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(BeEmpty())
+
+		Expect(imported.Keeper.IndexAllDidDocControllers(&imported.SdkCtx)).To(Succeed())
+
+		res, err = imported.QueryDidDocsByController(alice.Did, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did, carol.Did))
+	})
+
+	It("Returns an empty list for an unknown controller", func() {
+		res, err := setup.QueryDidDocsByController(GenerateDID(Base58_16bytes), nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(BeEmpty())
+	})
+
+	It("Returns an error for a malformed controller", func() {
+		_, err := setup.QueryDidDocsByController("not-a-did", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid controller"))
+	})
+})
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Query DID Docs by public key", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var aliceFingerprint string
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		var err error
+		aliceFingerprint, err = alice.Msg.VerificationMethod[0].PublicKeyFingerprint()
+		Expect(err).To(BeNil())
+	})
+
+	getIds := func(res *types.QueryDidDocsByPublicKeyResponse) []string {
+		var ids []string
+		for _, didDoc := range res.DidDocs {
+			ids = append(ids, didDoc.DidDoc.Id)
+		}
+
+		return ids
+	}
+
+	It("Finds the DID by the key fingerprint", func() {
+		res, err := setup.QueryDidDocsByPublicKey(aliceFingerprint, "", nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did))
+	})
+
+	It("Finds the DID by verification material of another type", func() {
+		jwk := GenerateJSONWebKey2020VerificationMaterial(alice.KeyPair.Public)
+
+		res, err := setup.QueryDidDocsByPublicKey(jwk, types.JSONWebKey2020Type, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did))
+	})
+
+	It("Finds all DIDs sharing the key", func() {
+		bob := setup.BuildSimpleDidDoc()
+		bob.Msg.VerificationMethod[0].VerificationMethodType = types.Ed25519VerificationKey2018Type
+		bob.Msg.VerificationMethod[0].VerificationMaterial = GenerateEd25519VerificationKey2018VerificationMaterial(alice.KeyPair.Public)
+		bob.SignInput.Key = alice.KeyPair.Private
+		setup.CreateCustomDidDoc(bob)
+
+		res, err := setup.QueryDidDocsByPublicKey(aliceFingerprint, "", nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did, bob.Did))
+	})
+
+	It("Follows key rotation", func() {
+		newKeyPair := GenerateKeyPair()
+		msg := &types.MsgUpdateDidDocPayload{
+			Id: alice.Did,
+			VerificationMethod: []*types.VerificationMethod{
+				{
+					Id:                     alice.KeyID,
+					VerificationMethodType: types.Ed25519VerificationKey2020Type,
+					Controller:             alice.Did,
+					VerificationMaterial:   GenerateEd25519VerificationKey2020VerificationMaterial(newKeyPair.Public),
+				},
+			},
+			Authentication: alice.Msg.Authentication,
+			VersionId:      uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput, {VerificationMethodID: alice.KeyID, Key: newKeyPair.Private}})
+		Expect(err).To(BeNil())
+
+		res, err := setup.QueryDidDocsByPublicKey(aliceFingerprint, "", nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(BeEmpty())
+
+		res, err = setup.QueryDidDocsByPublicKey(msg.VerificationMethod[0].VerificationMaterial, types.Ed25519VerificationKey2020Type, nil)
+		Expect(err).To(BeNil())
+		Expect(getIds(res)).To(ConsistOf(alice.Did))
+	})
+
+	It("Doesn't find deactivated DIDs", func() {
+		_, err := setup.DeactivateDidDoc(&types.MsgDeactivateDidDocPayload{Id: alice.Did, VersionId: uuid.NewString()}, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		res, err := setup.QueryDidDocsByPublicKey(aliceFingerprint, "", nil)
+		Expect(err).To(BeNil())
+		Expect(res.DidDocs).To(BeEmpty())
+	})
+
+	It("Returns an error for a malformed public key", func() {
+		_, err := setup.QueryDidDocsByPublicKey("not-multibase", "", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid public key"))
+	})
+})
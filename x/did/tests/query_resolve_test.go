@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DID resolution", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+	})
+
+	It("Resolves the latest version as JSON-LD by default", func() {
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+
+		Expect(res.DidResolutionMetadata.Error).To(BeEmpty())
+		Expect(res.DidResolutionMetadata.ContentType).To(Equal(types.DIDJSONLDContentType))
+		Expect(res.DidResolutionMetadata.Retrieved).To(Equal(setup.SdkCtx.BlockTime()))
+		Expect(res.DidResolutionMetadata.Did.DidString).To(Equal(alice.Did))
+		Expect(res.DidResolutionMetadata.Did.Method).To(Equal(types.DidMethod))
+		Expect(res.DidResolutionMetadata.Did.MethodSpecificId).To(Equal(DidNamespace + ":" + alice.CollectionID))
+		Expect(res.DidDocumentMetadata.VersionId).To(Equal(alice.VersionID))
+
+		didDoc, err := ParseJSONToMap(res.DidDocument)
+		Expect(err).To(BeNil())
+		Expect(didDoc["@context"]).To(Equal([]any{types.DIDCoreContext}))
+		Expect(didDoc["id"]).To(Equal(alice.Did))
+		Expect(didDoc["authentication"]).To(Equal([]any{alice.KeyID}))
+		Expect(didDoc["verificationMethod"]).To(Equal([]any{
+			map[string]any{
+				"id":                 alice.KeyID,
+				"type":               types.Ed25519VerificationKey2020Type,
+				"controller":         alice.Did,
+				"publicKeyMultibase": GenerateEd25519VerificationKey2020VerificationMaterial(alice.KeyPair.Public),
+			},
+		}))
+	})
+
+	It("Resolves as plain JSON without @context", func() {
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: alice.Did, Accept: types.DIDJSONContentType})
+		Expect(err).To(BeNil())
+		Expect(res.DidResolutionMetadata.ContentType).To(Equal(types.DIDJSONContentType))
+
+		didDoc, err := ParseJSONToMap(res.DidDocument)
+		Expect(err).To(BeNil())
+		Expect(didDoc).ToNot(HaveKey("@context"))
+		Expect(didDoc["id"]).To(Equal(alice.Did))
+	})
+
+	It("Resolves a JsonWebKey2020 verification method as publicKeyJwk", func() {
+		bob := setup.BuildSimpleDidDoc()
+		bob.Msg.VerificationMethod[0].VerificationMethodType = types.JSONWebKey2020Type
+		bob.Msg.VerificationMethod[0].VerificationMaterial = GenerateJSONWebKey2020VerificationMaterial(bob.KeyPair.Public)
+		setup.CreateCustomDidDoc(bob)
+
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: bob.Did})
+		Expect(err).To(BeNil())
+
+		var didDoc struct {
+			VerificationMethod []map[string]json.RawMessage `json:"verificationMethod"`
+		}
+		Expect(json.Unmarshal([]byte(res.DidDocument), &didDoc)).To(Succeed())
+		Expect(didDoc.VerificationMethod).To(HaveLen(1))
+		Expect(didDoc.VerificationMethod[0]).To(HaveKey("publicKeyJwk"))
+		Expect(didDoc.VerificationMethod[0]).ToNot(HaveKey("publicKeyMultibase"))
+	})
+
+	It("Resolves the requested version", func() {
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			AlsoKnownAs:        []string{"did:example:alice"},
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: alice.Did, VersionId: alice.VersionID})
+		Expect(err).To(BeNil())
+		Expect(res.DidResolutionMetadata.Error).To(BeEmpty())
+		Expect(res.DidDocumentMetadata.VersionId).To(Equal(alice.VersionID))
+		Expect(res.DidDocumentMetadata.NextVersionId).To(Equal(msg.VersionId))
+
+		res, err = setup.Resolve(&types.QueryResolveRequest{Id: alice.Did})
+		Expect(err).To(BeNil())
+		Expect(res.DidDocumentMetadata.VersionId).To(Equal(msg.VersionId))
+		Expect(res.DidDocument).To(ContainSubstring(`"alsoKnownAs":["did:example:alice"]`))
+	})
+
+	It("Resolves the version active at the given time", func() {
+		created := setup.SdkCtx.BlockTime()
+
+		setup.SetBlockTime(created.Add(time.Hour))
+		msg := &types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			VersionId:          uuid.NewString(),
+		}
+
+		_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		res, err := setup.Resolve(&types.QueryResolveRequest{Id: alice.Did, VersionTime: created.Add(time.Minute).Format(time.RFC3339)})
+		Expect(err).To(BeNil())
+		Expect(res.DidDocumentMetadata.VersionId).To(Equal(alice.VersionID))
+
+		res, err = setup.Resolve(&types.QueryResolveRequest{Id: alice.Did, VersionTime: created.Add(2 * time.Hour).Format(time.RFC3339)})
+		Expect(err).To(BeNil())
+		Expect(res.DidDocumentMetadata.VersionId).To(Equal(msg.VersionId))
+
+		res, err = setup.Resolve(&types.QueryResolveRequest{Id: alice.Did, VersionTime: created.Add(-time.Minute).Format(time.RFC3339)})
+		Expect(err).To(BeNil())
+		Expect(res.DidResolutionMetadata.Error).To(Equal(types.ResolutionErrorNotFound))
+	})
+
+	DescribeTable("Reports resolution errors in metadata",
+		func(req func() *types.QueryResolveRequest, expectedError string) {
+			res, err := setup.Resolve(req())
+			Expect(err).To(BeNil())
+			Expect(res.DidResolutionMetadata.Error).To(Equal(expectedError))
+			Expect(res.DidResolutionMetadata.ContentType).To(BeEmpty())
+			Expect(res.DidDocument).To(BeEmpty())
+			Expect(res.DidDocumentMetadata).To(BeNil())
+		},
+
+		Entry("Malformed DID", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: "not-a-did"}
+		}, types.ResolutionErrorInvalidDid),
+
+		Entry("Invalid unique identifier", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: "did:canow:" + DidNamespace + ":!!!"}
+		}, types.ResolutionErrorInvalidDid),
+
+		Entry("Other DID method", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"}
+		}, types.ResolutionErrorMethodNotSupported),
+
+		Entry("Other namespace", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: "did:canow:mainnet:" + alice.CollectionID}
+		}, types.ResolutionErrorNotFound),
+
+		Entry("Unknown DID", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: GenerateDID(Base58_16bytes)}
+		}, types.ResolutionErrorNotFound),
+
+		Entry("Unknown version", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: alice.Did, VersionId: uuid.NewString()}
+		}, types.ResolutionErrorNotFound),
+
+		Entry("Unsupported representation", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: alice.Did, Accept: "application/xml"}
+		}, types.ResolutionErrorRepresentationNotSupported),
+
+		Entry("Malformed version time", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: alice.Did, VersionTime: "yesterday"}
+		}, types.ResolutionErrorInvalidOptions),
+
+		Entry("Both version id and version time", func() *types.QueryResolveRequest {
+			return &types.QueryResolveRequest{Id: alice.Did, VersionId: alice.VersionID, VersionTime: "2021-01-01T00:00:00Z"}
+		}, types.ResolutionErrorInvalidOptions),
+	)
+})
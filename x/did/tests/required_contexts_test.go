@@ -0,0 +1,86 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("DIDDoc required contexts", func() {
+	var setup TestSetup
+
+	BeforeEach(func() {
+		setup = Setup()
+	})
+
+	It("Creates a DIDDoc declaring contexts of the used types", func() {
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Context = []string{types.DIDCoreContext, types.Ed25519Signature2020Context}
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Doesn't create a DIDDoc missing contexts of the used types", func() {
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Context = []string{types.DIDCoreContext}
+
+		_, err := setup.CreateDid(didDoc.Msg, []SignInput{didDoc.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("contexts required by the used verification method and service types are missing: " + types.Ed25519Signature2020Context))
+	})
+
+	Describe("DIDDoc created before the contexts were checked", func() {
+		var alice DidDocInfo
+		var msg *types.MsgUpdateDidDocPayload
+
+		BeforeEach(func() {
+			alice = setup.BuildSimpleDidDoc()
+			alice.Msg.Context = []string{types.DIDCoreContext}
+
+			didDoc := alice.Msg.ToDidDoc()
+			metadata := types.NewMetadataFromContext(setup.SdkCtx, alice.Msg.VersionId)
+			didDocWithMetadata := types.NewDidDocWithMetadata(&didDoc, &metadata)
+			Expect(setup.Keeper.AddNewDidDocVersion(&setup.SdkCtx, &didDocWithMetadata)).To(Succeed())
+
+			msg = &types.MsgUpdateDidDocPayload{
+				Context:            alice.Msg.Context,
+				Id:                 alice.Did,
+				VerificationMethod: alice.Msg.VerificationMethod,
+				Authentication:     alice.Msg.Authentication,
+				VersionId:          uuid.NewString(),
+			}
+		})
+
+		It("Can be updated without introducing new types", func() {
+			msg.AlsoKnownAs = []string{"https://example.com/alice"}
+
+			_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		It("Can't be updated with a new type missing its context", func() {
+			msg.Service = []*types.Service{
+				{
+					Id:              alice.Did + "#linked-domain",
+					ServiceType:     types.LinkedDomainsServiceType,
+					ServiceEndpoint: []string{"https://example.com"},
+				},
+			}
+
+			_, err := setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(types.LinkedDomainsContext))
+			Expect(err.Error()).NotTo(ContainSubstring(types.Ed25519Signature2020Context))
+
+			msg.Context = append(msg.Context, types.LinkedDomainsContext)
+
+			_, err = setup.UpdateDidDoc(msg, []SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -46,12 +46,15 @@ func Setup() TestSetup {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	dbStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
 
-	_ = dbStore.LoadLatestVersion()
-
 	// Init ParamsKeeper KVStore
 	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
+	dbStore.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
+
+	_ = dbStore.LoadLatestVersion()
+
 	// Init Keepers
 	paramsKeeper := initParamsKeeper(Cdc, aminoCdc, paramsStoreKey, paramsTStoreKey)
 	newKeeper := keeper.NewKeeper(Cdc, storeKey, getSubspace(types.ModuleName, paramsKeeper))
@@ -89,7 +92,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
 
 	// set params subspaces
-	paramsKeeper.Subspace(types.ModuleName)
+	paramsKeeper.Subspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())
 
 	return paramsKeeper
 }
@@ -98,3 +101,8 @@ func getSubspace(moduleName string, paramsKeeper paramskeeper.Keeper) paramstype
 	subspace, _ := paramsKeeper.GetSubspace(moduleName)
 	return subspace
 }
+
+func (s *TestSetup) SetBlockTime(blockTime time.Time) {
+	s.SdkCtx = s.SdkCtx.WithBlockTime(blockTime)
+	s.StdCtx = sdk.WrapSDKContext(s.SdkCtx)
+}
@@ -1,29 +1,17 @@
 package setup
 
 import (
-	"crypto/ed25519"
-
 	"github.com/canow-co/cheqd-node/x/did/types"
 	"github.com/canow-co/cheqd-node/x/did/utils"
 	"github.com/google/uuid"
 )
 
 func (s *TestSetup) CreateDid(payload *types.MsgCreateDidDocPayload, signInputs []SignInput) (*types.MsgCreateDidDocResponse, error) {
-	signBytes := payload.GetSignBytes()
-	signatures := make([]*types.SignInfo, 0, len(signInputs))
-
-	for _, input := range signInputs {
-		signature := ed25519.Sign(input.Key, signBytes)
-
-		signatures = append(signatures, &types.SignInfo{
-			VerificationMethodId: input.VerificationMethodID,
-			Signature:            signature,
-		})
-	}
+	signBytes := s.GetEnvelopeSignBytes(payload.Id, payload.GetSignBytes())
 
 	msg := &types.MsgCreateDidDoc{
 		Payload:    payload,
-		Signatures: signatures,
+		Signatures: Sign(signBytes, signInputs),
 	}
 
 	return s.MsgServer.CreateDidDoc(s.StdCtx, msg)
@@ -1,27 +1,15 @@
 package setup
 
 import (
-	"crypto/ed25519"
-
 	"github.com/canow-co/cheqd-node/x/did/types"
 )
 
 func (s *TestSetup) DeactivateDidDoc(payload *types.MsgDeactivateDidDocPayload, signInputs []SignInput) (*types.MsgDeactivateDidDocResponse, error) {
-	signBytes := payload.GetSignBytes()
-	signatures := make([]*types.SignInfo, 0, len(signInputs))
-
-	for _, input := range signInputs {
-		signature := ed25519.Sign(input.Key, signBytes)
-
-		signatures = append(signatures, &types.SignInfo{
-			VerificationMethodId: input.VerificationMethodID,
-			Signature:            signature,
-		})
-	}
+	signBytes := s.GetEnvelopeSignBytes(payload.Id, payload.GetSignBytes())
 
 	msg := &types.MsgDeactivateDidDoc{
 		Payload:    payload,
-		Signatures: signatures,
+		Signatures: Sign(signBytes, signInputs),
 	}
 
 	return s.MsgServer.DeactivateDidDoc(s.StdCtx, msg)
@@ -0,0 +1,16 @@
+package setup
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+func (s *TestSetup) PatchDidDoc(payload *types.MsgPatchDidDocPayload, signInputs []SignInput) (*types.MsgPatchDidDocResponse, error) {
+	signBytes := s.GetEnvelopeSignBytes(payload.Id, payload.GetSignBytes())
+
+	msg := &types.MsgPatchDidDoc{
+		Payload:    payload,
+		Signatures: Sign(signBytes, signInputs),
+	}
+
+	return s.MsgServer.PatchDidDoc(s.StdCtx, msg)
+}
@@ -0,0 +1,18 @@
+package setup
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *TestSetup) SetBlockHeight(height int64) {
+	s.SdkCtx = s.SdkCtx.WithBlockHeight(height)
+	s.StdCtx = sdk.WrapSDKContext(s.SdkCtx)
+}
+
+func (s *TestSetup) QueryAllDidDocs(req *types.QueryAllDidDocsRequest, pagination *query.PageRequest) (*types.QueryAllDidDocsResponse, error) {
+	req.Pagination = pagination
+
+	return s.QueryServer.AllDidDocs(s.StdCtx, req)
+}
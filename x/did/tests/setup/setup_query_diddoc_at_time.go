@@ -0,0 +1,12 @@
+package setup
+
+import "github.com/canow-co/cheqd-node/x/did/types"
+
+func (s *TestSetup) QueryDidDocAtTime(did, timestamp string) (*types.QueryDidDocAtTimeResponse, error) {
+	req := &types.QueryDidDocAtTimeRequest{
+		Id:        did,
+		Timestamp: timestamp,
+	}
+
+	return s.QueryServer.DidDocAtTime(s.StdCtx, req)
+}
@@ -0,0 +1,15 @@
+package setup
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *TestSetup) QueryDidDocsByController(controller string, pagination *query.PageRequest) (*types.QueryDidDocsByControllerResponse, error) {
+	req := &types.QueryDidDocsByControllerRequest{
+		Controller: controller,
+		Pagination: pagination,
+	}
+
+	return s.QueryServer.DidDocsByController(s.StdCtx, req)
+}
@@ -0,0 +1,16 @@
+package setup
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *TestSetup) QueryDidDocsByPublicKey(publicKey, vmType string, pagination *query.PageRequest) (*types.QueryDidDocsByPublicKeyResponse, error) {
+	req := &types.QueryDidDocsByPublicKeyRequest{
+		PublicKey:              publicKey,
+		VerificationMethodType: vmType,
+		Pagination:             pagination,
+	}
+
+	return s.QueryServer.DidDocsByPublicKey(s.StdCtx, req)
+}
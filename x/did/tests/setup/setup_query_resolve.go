@@ -0,0 +1,7 @@
+package setup
+
+import "github.com/canow-co/cheqd-node/x/did/types"
+
+func (s *TestSetup) Resolve(req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	return s.QueryServer.Resolve(s.StdCtx, req)
+}
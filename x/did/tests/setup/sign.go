@@ -0,0 +1,47 @@
+package setup
+
+import (
+	"crypto/ed25519"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+// GetEnvelopeSignBytes wraps the payload sign bytes into the signing envelope of the test chain and the DID namespace.
+// The current version is the latest version of the DID Document, empty if it doesn't exist yet.
+func (s *TestSetup) GetEnvelopeSignBytes(did string, payloadSignBytes []byte) []byte {
+	currentVersionID := ""
+
+	if s.Keeper.HasDidDoc(&s.SdkCtx, did) {
+		latest, err := s.Keeper.GetLatestDidDoc(&s.SdkCtx, did)
+		if err != nil {
+			panic(err)
+		}
+
+		currentVersionID = latest.Metadata.VersionId
+	}
+
+	_, namespace, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		namespace = s.Keeper.GetDidNamespace(&s.SdkCtx)
+	}
+
+	return types.GetEnvelopeSignBytes(s.SdkCtx.ChainID(), namespace, currentVersionID, payloadSignBytes)
+}
+
+// Sign signs the message with each of the sign inputs
+func Sign(signBytes []byte, signInputs []SignInput) []*types.SignInfo {
+	signatures := make([]*types.SignInfo, 0, len(signInputs))
+
+	for _, input := range signInputs {
+		signature := ed25519.Sign(input.Key, signBytes)
+
+		signatures = append(signatures, &types.SignInfo{
+			VerificationMethodId: input.VerificationMethodID,
+			Signature:            signature,
+			VersionId:            input.VersionID,
+		})
+	}
+
+	return signatures
+}
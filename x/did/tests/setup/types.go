@@ -14,6 +14,7 @@ type KeyPair struct {
 type SignInput struct {
 	VerificationMethodID string
 	Key                  ed25519.PrivateKey
+	VersionID            string
 }
 
 type DidDocInfo struct {
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/canow-co/cheqd-node/x/did/types"
+)
+
+var _ = Describe("Signing envelope", func() {
+	var setup TestSetup
+	var alice CreatedDidDocInfo
+	var payload *types.MsgDeactivateDidDocPayload
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		payload = &types.MsgDeactivateDidDocPayload{
+			Id:        alice.Did,
+			VersionId: uuid.NewString(),
+		}
+	})
+
+	deactivate := func(signBytes []byte) error {
+		msg := &types.MsgDeactivateDidDoc{
+			Payload:    payload,
+			Signatures: Sign(signBytes, []SignInput{alice.SignInput}),
+		}
+
+		_, err := setup.MsgServer.DeactivateDidDoc(setup.StdCtx, msg)
+		return err
+	}
+
+	latestVersionID := func() string {
+		latest, err := setup.QueryDidDoc(alice.Did)
+		Expect(err).To(BeNil())
+
+		return latest.Value.Metadata.VersionId
+	}
+
+	It("Accepts signatures over the envelope", func() {
+		signBytes := types.GetEnvelopeSignBytes("test", DidNamespace, latestVersionID(), payload.GetSignBytes())
+
+		err := deactivate(signBytes)
+		Expect(err).To(BeNil())
+	})
+
+	It("Accepts legacy signatures while they are allowed", func() {
+		err := deactivate(payload.GetSignBytes())
+		Expect(err).To(BeNil())
+	})
+
+	It("Doesn't accept legacy signatures after they are disallowed", func() {
+		params := setup.Keeper.GetSigningParams(setup.SdkCtx)
+		params.AllowLegacySignatures = false
+		setup.Keeper.SetSigningParams(setup.SdkCtx, params)
+
+		err := deactivate(payload.GetSignBytes())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidSignature.Error()))
+
+		signBytes := types.GetEnvelopeSignBytes("test", DidNamespace, latestVersionID(), payload.GetSignBytes())
+
+		err = deactivate(signBytes)
+		Expect(err).To(BeNil())
+	})
+
+	It("Doesn't accept signatures made for another chain", func() {
+		signBytes := types.GetEnvelopeSignBytes("other-chain", DidNamespace, latestVersionID(), payload.GetSignBytes())
+
+		err := deactivate(signBytes)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidSignature.Error()))
+	})
+
+	It("Doesn't accept signatures made for another namespace", func() {
+		signBytes := types.GetEnvelopeSignBytes("test", "mainnet", latestVersionID(), payload.GetSignBytes())
+
+		err := deactivate(signBytes)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidSignature.Error()))
+	})
+
+	It("Doesn't accept signatures made for another version of the DID Doc", func() {
+		signBytes := types.GetEnvelopeSignBytes("test", DidNamespace, latestVersionID(), payload.GetSignBytes())
+
+		// The DID Doc is changed after the deactivation is signed
+		_, err := setup.UpdateDidDoc(&types.MsgUpdateDidDocPayload{
+			Id:                 alice.Did,
+			VerificationMethod: alice.Msg.VerificationMethod,
+			Authentication:     alice.Msg.Authentication,
+			AlsoKnownAs:        []string{"https://example.com/alice"},
+			VersionId:          uuid.NewString(),
+		}, []SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		err = deactivate(signBytes)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidSignature.Error()))
+	})
+})
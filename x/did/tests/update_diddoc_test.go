@@ -315,7 +315,7 @@ var _ = Describe("DIDDoc update", func() {
 			signatures := []SignInput{did.SignInput}
 
 			_, err := setup.UpdateDidDoc(msg, signatures)
-			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version): invalid signature detected", did.Did)))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version), signatures by %s are not valid: invalid signature detected", did.Did, did.KeyID)))
 		})
 
 		It("Doesn't work without old signature", func() {
@@ -325,7 +325,44 @@ var _ = Describe("DIDDoc update", func() {
 			}}
 
 			_, err := setup.UpdateDidDoc(msg, signatures)
-			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version): invalid signature detected", did.Did)))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version), signatures by %s are not valid: invalid signature detected", did.Did, did.KeyID)))
+		})
+
+		It("Works with signatures selecting DIDDoc versions", func() {
+			signatures := []SignInput{
+				{
+					VerificationMethodID: did.KeyID,
+					Key:                  did.KeyPair.Private,
+					VersionID:            did.VersionID,
+				},
+				{
+					VerificationMethodID: did.KeyID,
+					Key:                  newKeyPair.Private,
+					VersionID:            msg.VersionId,
+				},
+			}
+
+			_, err := setup.UpdateDidDoc(msg, signatures)
+			Expect(err).To(BeNil())
+		})
+
+		It("Doesn't work when old signature selects the new version", func() {
+			signatures := []SignInput{
+				{
+					VerificationMethodID: did.KeyID,
+					Key:                  did.KeyPair.Private,
+					VersionID:            msg.VersionId,
+				},
+				{
+					VerificationMethodID: did.KeyID,
+					Key:                  newKeyPair.Private,
+					VersionID:            msg.VersionId,
+				},
+			}
+
+			_, err := setup.UpdateDidDoc(msg, signatures)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one signature by %s (old version)", did.Did)))
 		})
 	})
 
@@ -416,7 +453,7 @@ var _ = Describe("DIDDoc update", func() {
 			signatures := []SignInput{alice.SignInput}
 
 			_, err := setup.UpdateDidDoc(msg, signatures)
-			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version): invalid signature detected", alice.Did)))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version), signatures by %s are not valid: invalid signature detected", alice.Did, alice.KeyID)))
 		})
 
 		It("Doesn't work without old verification method signature", func() {
@@ -428,7 +465,7 @@ var _ = Describe("DIDDoc update", func() {
 			}
 
 			_, err := setup.UpdateDidDoc(msg, signatures)
-			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version): invalid signature detected", alice.Did)))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version), signatures by %s are not valid: invalid signature detected", alice.Did, newKeyID)))
 		})
 
 		It("Works with new and old verification method signature", func() {
@@ -510,7 +547,7 @@ var _ = Describe("DIDDoc update", func() {
 			}
 
 			_, err := setup.UpdateDidDoc(msg, signatures)
-			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version): invalid signature detected", alice.Did)))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (old version), signatures by %s are not valid: invalid signature detected", alice.Did, newKeyID)))
 		})
 	})
 
@@ -595,7 +632,7 @@ var _ = Describe("DIDDoc update", func() {
 			signatures := []SignInput{secondSignInput}
 
 			_, err := setup.UpdateDidDoc(msg, signatures)
-			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version): invalid signature detected", alice.Did)))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("there should be at least one valid signature by %s (new version), signatures by %s are not valid: invalid signature detected", alice.Did, secondSignInput.VerificationMethodID)))
 		})
 	})
 
@@ -789,4 +826,46 @@ var _ = Describe("DIDDoc update", func() {
 			Expect(*created).ToNot(Equal(msg.ToDidDoc()))
 		})
 	})
+
+	Describe("DIDDoc: Optimistic concurrency", func() {
+		var alice CreatedDidDocInfo
+
+		BeforeEach(func() {
+			alice = setup.CreateSimpleDid()
+		})
+
+		buildUpdate := func(alsoKnownAs string, previousVersionID string) *types.MsgUpdateDidDocPayload {
+			return &types.MsgUpdateDidDocPayload{
+				Id:                 alice.Did,
+				VerificationMethod: alice.Msg.VerificationMethod,
+				Authentication:     alice.Msg.Authentication,
+				AlsoKnownAs:        []string{alsoKnownAs},
+				VersionId:          uuid.NewString(),
+				PreviousVersionId:  previousVersionID,
+			}
+		}
+
+		It("Rejects the second of two concurrent updates", func() {
+			first := buildUpdate("https://first.example.com", alice.VersionID)
+			_, err := setup.UpdateDidDoc(first, []SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			second := buildUpdate("https://second.example.com", alice.VersionID)
+			_, err = setup.UpdateDidDoc(second, []SignInput{alice.SignInput})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(types.ErrVersionConflict.Error()))
+
+			latest, err := setup.QueryDidDoc(alice.Did)
+			Expect(err).To(BeNil())
+			Expect(latest.Value.DidDoc.AlsoKnownAs).To(Equal(first.AlsoKnownAs))
+		})
+
+		It("Skips the check if previous version is not set", func() {
+			_, err := setup.UpdateDidDoc(buildUpdate("https://first.example.com", ""), []SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			_, err = setup.UpdateDidDoc(buildUpdate("https://second.example.com", ""), []SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+		})
+	})
 })
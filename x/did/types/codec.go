@@ -12,6 +12,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateDidDoc{}, "did/CreateDidDoc", nil)
 	cdc.RegisterConcrete(&MsgUpdateDidDoc{}, "did/UpdateDidDoc", nil)
 	cdc.RegisterConcrete(&MsgDeactivateDidDoc{}, "did/DeleteDidDoc", nil)
+	cdc.RegisterConcrete(&MsgPatchDidDoc{}, "did/PatchDidDoc", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -20,6 +21,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateDidDoc{},
 		&MsgUpdateDidDoc{},
 		&MsgDeactivateDidDoc{},
+		&MsgPatchDidDoc{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -71,6 +71,21 @@ var ValidBls12381G2JWK = TestJWK{
 	X:   "rvdKcdkxwlj0Y-XZsFpz1hDPJGjnLN27IJipbmaLlaKdYfICGG6dzakG6EkdcvW0AtVV6hXBSKtdFnKQKmmD759tMYYuvKYf5o2cZnROLN5iWQ2H6vp6FlLi71a_AE5I",
 }
 
+var ValidSecp256k1JWK = TestJWK{
+	Kty: "EC",
+	Crv: "secp256k1",
+	X:   "8N6tUNfjWXByrJzcyJR3AYJL5r939SnMMXKkPbeOpKo",
+	Y:   "P0K1UnXC8rt0--RaUroYgRDEPL22puEEb_Oj7_Y2d7M",
+}
+
+// The point is not on the secp256k1 curve
+var InvalidSecp256k1JWK = TestJWK{
+	Kty: "EC",
+	Crv: "secp256k1",
+	X:   "8N6tUNfjWXByrJzcyJR3AYJL5r939SnMMXKkPbeOpKo",
+	Y:   "4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM",
+}
+
 var InvalidJWK = TestJWK{
 	Kty: "SomeOtherKeyType",
 	N:   "o76AudS2rsCvlz_3D47sFkpuz3NJxgLbXr1cHdmbo9xOMttPMJI97f0rHiSl9stltMi87KIOEEVQWUgMLaWQNaIZThgI1seWDAGRw59AO5sctgM1wPVZYt40fj2Qw4KT7m4RLMsZV1M5NYyXSd1lAAywM4FT25N0RLhkm3u8Hehw2Szj_2lm-rmcbDXzvjeXkodOUszFiOqzqBIS0Bv3c2zj2sytnozaG7aXa14OiUMSwJb4gmBC7I0BjPv5T85CH88VOcFDV51sO9zPJaBQnNBRUWNLh1vQUbkmspIANTzj2sN62cTSoxRhSdnjZQ9E_jraKYEW5oizE9Dtow4EvQ",
@@ -87,13 +102,15 @@ var InvalidOkpJWK = TestJWK{
 }
 
 var (
-	ValidPublicKeyJWK, _           = json.Marshal(ValidJWK)
-	ValidEcPublicKeyJWK, _         = json.Marshal(ValidEcJWK)
-	ValidRsaPublicKeyJWK, _        = json.Marshal(ValidRsaJWK)
-	ValidEd25519PublicKeyJWK, _    = json.Marshal(ValidEd25519JWK)
-	ValidBls12381G2PublicKeyJWK, _ = json.Marshal(ValidBls12381G2JWK)
-	InvalidPublicKeyJWK, _         = json.Marshal(InvalidJWK)
-	InvalidOkpPublicKeyJWK, _      = json.Marshal(InvalidOkpJWK)
+	ValidPublicKeyJWK, _            = json.Marshal(ValidJWK)
+	ValidEcPublicKeyJWK, _          = json.Marshal(ValidEcJWK)
+	ValidRsaPublicKeyJWK, _         = json.Marshal(ValidRsaJWK)
+	ValidEd25519PublicKeyJWK, _     = json.Marshal(ValidEd25519JWK)
+	ValidBls12381G2PublicKeyJWK, _  = json.Marshal(ValidBls12381G2JWK)
+	ValidSecp256k1PublicKeyJWK, _   = json.Marshal(ValidSecp256k1JWK)
+	InvalidSecp256k1PublicKeyJWK, _ = json.Marshal(InvalidSecp256k1JWK)
+	InvalidPublicKeyJWK, _          = json.Marshal(InvalidJWK)
+	InvalidOkpPublicKeyJWK, _       = json.Marshal(InvalidOkpJWK)
 )
 
 var (
@@ -130,3 +147,50 @@ var (
 	InvalidJwkVerificationMaterial         = string(InvalidPublicKeyJWK)
 	InvalidOkpJwkVerificationMaterial      = string(InvalidOkpPublicKeyJWK)
 )
+
+var (
+	// bytes in hex: e70103f0dead50d7e3597072ac9cdcc8947701824be6bf77f529cc3172a43db78ea4aa
+	ValidSecp256k1MultibaseVerificationMaterial = "zQ3shvrMupNXPk9N4c6zkKNzJJ8jfxmhkfMM3gvZRTWTuhSAV"
+
+	// bytes in hex: e70105f0dead50d7e3597072ac9cdcc8947701824be6bf77f529cc3172a43db78ea4aa
+	InvalidSecp256k1MultibaseVerificationMaterialBadFormat = "zQ3siXJzeox2bLEqbkGZamjLtgaLiTkzQ9mVEf2U5BMKSjLf3"
+
+	ValidSecp256k1JwkVerificationMaterial   = string(ValidSecp256k1PublicKeyJWK)
+	InvalidSecp256k1JwkVerificationMaterial = string(InvalidSecp256k1PublicKeyJWK)
+)
+
+var (
+	// bytes in hex: 80240330a0424cd21c2944838a2d75c92b37e76ea20d9f00893a3b4eee8a3c0aafec3e (ValidEcJWK)
+	ValidP256MultikeyVerificationMaterial = "zDnaekw6iisW1j4ronMuZagbvVehJK4unit6kvZ8UqJ2LSG1j"
+
+	// bytes in hex: 812402e96b806568f15c4379edb9fd4e567162066fed026806b200e7a209b56513083d05cd724ef73533d7415faef2be522a35
+	ValidP384MultikeyVerificationMaterial = "z82Lku5kAoCmsu2LzmJ57yX7UW5zoRDKYW7S5c1Vw52SVT1NrezhXQQNGEsr1Nnk7GuUATv"
+
+	// P-521 keys are not supported in Multikey
+	InvalidMultikeyVerificationMaterialUnsupportedCodec = "z2J9gaZ6vpnjdajsRhtvcCNz88TAXLsTmFEcTvm5jJXiDhSYt8EmArUJgAV4a7tqRjnMoLfjzADeJTfWiY7Pse8dPg9DzW5V"
+
+	// Uncompressed secp256k1 public key
+	InvalidMultikeyVerificationMaterialUncompressed = "z7r8ophNAPxonTr45PE6EyP75bKsN24YbPqYEGzqw21oyTQWYGXvFNJECf6q3rdYWheKKd8ENWafzyfLTdwokK9aRLhho"
+
+	// ValidP256MultikeyVerificationMaterial in base64 multibase encoding
+	InvalidMultikeyVerificationMaterialBadEncoding = "mgCQDMKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4"
+)
+
+var (
+	// bytes in hex: ee29f4f15416001d3cc10ffb0892c2a2332364b29a537ad5182ba9fcdd553060
+	ValidX25519KeyAgreementKey2019VerificationMaterial = "H2h94CDaKyD8jJ2URW49jYLTgK3JpDiUxrbYiwC2f4zj"
+
+	// bytes in hex: ee29f4f15416001d3cc10ffb0892c2a2332364b29a537ad5182ba9fcdd5530
+	InvalidX25519KeyAgreementKey2019VerificationMaterialBadLength = "4ddjcALceNoFSScE1Zs74J4xSDhQ4uGk3EW8BrtSJEb"
+
+	// All-zero point is of low order
+	InvalidX25519KeyAgreementKey2019VerificationMaterialLowOrder = "11111111111111111111111111111111"
+
+	// bytes in hex: ec01ee29f4f15416001d3cc10ffb0892c2a2332364b29a537ad5182ba9fcdd553060
+	ValidX25519KeyAgreementKey2020VerificationMaterial = "z6LSshsJaW2SRRvspgQEx9a748YwXTaRWptdqqKEDPqZNSmV"
+
+	// bytes in hex: ec010000000000000000000000000000000000000000000000000000000000000000
+	InvalidX25519KeyAgreementKey2020VerificationMaterialLowOrder = "z6LSbgBAXJos6Tik6PNmXeWxKbDUr9Y7hcB9syigVTeXiNmm"
+
+	ValidX25519JwkVerificationMaterial = `{"kty":"OKP","crv":"X25519","x":"7in08VQWAB08wQ_7CJLCojMjZLKaU3rVGCup_N1VMGA"}`
+)
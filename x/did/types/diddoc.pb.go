@@ -37,6 +37,8 @@ type DidDoc struct {
 	// Format: did:canow:<namespace>:<unique-identifier>
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// controller is a list of DIDs that are allowed to control the DID document.
+	// Besides did:canow DIDs of the ledger, did:key and did:jwk DIDs are allowed. Their DID documents
+	// are derived from the DIDs and aren't written to the ledger.
 	Controller []string `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
 	// verificationMethod is a list of verification methods that can be used to
 	// verify a digital signature or cryptographic proof.
@@ -60,6 +62,10 @@ type DidDoc struct {
 	Service []*Service `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
 	// alsoKnownAs is a list of DIDs that are known to refer to the same DID subject.
 	AlsoKnownAs []string `protobuf:"bytes,11,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// controllerThreshold is the minimal number of controllers (m-of-n) that have to sign
+	// creation, update and deactivation of the DID document.
+	// Default: 0, which means that all controllers have to sign.
+	ControllerThreshold uint32 `protobuf:"varint,12,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
 }
 
 func (m *DidDoc) Reset()         { *m = DidDoc{} }
@@ -172,6 +178,13 @@ func (m *DidDoc) GetAlsoKnownAs() []string {
 	return nil
 }
 
+func (m *DidDoc) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
 // VerificationMethod defines a verification method, as defined in the DID Core specification.
 // Documentation: https://www.w3.org/TR/did-core/#verification-methods
 type VerificationMethod struct {
@@ -182,7 +195,7 @@ type VerificationMethod struct {
 	// Example: Ed25519VerificationKey2020
 	VerificationMethodType string `protobuf:"bytes,2,opt,name=verification_method_type,json=verificationMethodType,proto3" json:"type,omitempty"`
 	// controller is the DID of the controller of the verification method.
-	// Format: did:canow:<namespace>:<unique-identifier>
+	// Format: did:canow:<namespace>:<unique-identifier>, did:key:<multibase-key> or did:jwk:<base64url-jwk>
 	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
 	// verification_material is the public key of the verification method.
 	// Commonly used verification material types: publicJwk, publicKeyBase58, publicKeyMultibase
@@ -304,6 +317,12 @@ func (m *VerificationRelationship) GetVerificationMethod() *VerificationMethod {
 
 // Service defines a service, as defined in the DID Core specification.
 // Documentation: https://www.w3.org/TR/did-core/#services
+//
+// The DID Core form of the endpoint is added to the v2 message as the endpoint field instead of a new v3 Service.
+// A v3 Service would need v3 versions of every message and query embedding DID Documents. Fields 1-5 keep
+// their meaning, so v2 clients keep decoding services. Services with the endpoint set are rendered in the DID Core form.
+// Services without it, including all services stored before it was introduced, are rendered from service_endpoint,
+// accept and routing_keys exactly as before.
 type Service struct {
 	// id is the unique identifier of the service.
 	// Format: did:canow:<namespace>:<unique-identifier>#<service-id>
@@ -311,11 +330,19 @@ type Service struct {
 	// type is the type of the service.
 	// Example: LinkedResource
 	ServiceType string `protobuf:"bytes,2,opt,name=service_type,json=serviceType,proto3" json:"type,omitempty"`
-	// serviceEndpoint is the endpoint of the service.
+	// serviceEndpoint is the legacy representation of the endpoint of the service: a list of URIs.
+	// It's derived from the endpoint field if the endpoint can be represented this way and kept for existing clients.
 	// Example: https://example.com/endpoint
 	ServiceEndpoint []string `protobuf:"bytes,3,rep,name=service_endpoint,json=serviceEndpoint,proto3" json:"service_endpoint,omitempty"`
-	Accept          []string `protobuf:"bytes,4,rep,name=accept,proto3" json:"accept,omitempty"`
-	RoutingKeys     []string `protobuf:"bytes,5,rep,name=routing_keys,json=routingKeys,proto3" json:"routing_keys,omitempty"`
+	// accept is the legacy list of DIDComm media types accepted by all the service endpoints.
+	// It's derived from the endpoint field together with service_endpoint.
+	Accept []string `protobuf:"bytes,4,rep,name=accept,proto3" json:"accept,omitempty"`
+	// routing_keys is the legacy list of DIDComm routing keys of all the service endpoints.
+	// It's derived from the endpoint field together with service_endpoint.
+	RoutingKeys []string `protobuf:"bytes,5,rep,name=routing_keys,json=routingKeys,proto3" json:"routing_keys,omitempty"`
+	// endpoint is the endpoint of the service in the DID Core form: a URI, a map or an ordered set of URIs and maps.
+	// Services with only the legacy fields set aren't converted into this form.
+	Endpoint *ServiceEndpoint `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
 func (m *Service) Reset()         { *m = Service{} }
@@ -386,6 +413,137 @@ func (m *Service) GetRoutingKeys() []string {
 	return nil
 }
 
+func (m *Service) GetEndpoint() *ServiceEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+// ServiceEndpoint defines a service endpoint, as defined in the DID Core specification.
+// Exactly one of the fields is set.
+// Documentation: https://www.w3.org/TR/did-core/#dfn-serviceendpoint
+type ServiceEndpoint struct {
+	// uri is the endpoint represented by a single URI.
+	// Example: https://example.com/endpoint
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// map is the endpoint represented by a JSON object, e.g. a DIDComm v2 endpoint.
+	// Stored in the canonical form: keys are sorted, no insignificant whitespace.
+	// Example: {"accept":["didcomm/v2"],"routingKeys":["did:example:HPXoCUSjrSvWC54SLWQjsm#key-1"],"uri":"https://example.com/didcomm"}
+	Map string `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+	// items is the endpoint represented by an ordered set of URIs and maps.
+	Items []*ServiceEndpointItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (m *ServiceEndpoint) Reset()         { *m = ServiceEndpoint{} }
+func (m *ServiceEndpoint) String() string { return proto.CompactTextString(m) }
+func (*ServiceEndpoint) ProtoMessage()    {}
+func (*ServiceEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7b058eff1719454, []int{4}
+}
+func (m *ServiceEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEndpoint.Merge(m, src)
+}
+func (m *ServiceEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEndpoint proto.InternalMessageInfo
+
+func (m *ServiceEndpoint) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *ServiceEndpoint) GetMap() string {
+	if m != nil {
+		return m.Map
+	}
+	return ""
+}
+
+func (m *ServiceEndpoint) GetItems() []*ServiceEndpointItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// ServiceEndpointItem defines an item of a service endpoint ordered set: either a URI or a map.
+type ServiceEndpointItem struct {
+	// uri is the item represented by a single URI.
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// map is the item represented by a JSON object in the canonical form.
+	Map string `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+}
+
+func (m *ServiceEndpointItem) Reset()         { *m = ServiceEndpointItem{} }
+func (m *ServiceEndpointItem) String() string { return proto.CompactTextString(m) }
+func (*ServiceEndpointItem) ProtoMessage()    {}
+func (*ServiceEndpointItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7b058eff1719454, []int{5}
+}
+func (m *ServiceEndpointItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceEndpointItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceEndpointItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceEndpointItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEndpointItem.Merge(m, src)
+}
+func (m *ServiceEndpointItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceEndpointItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEndpointItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEndpointItem proto.InternalMessageInfo
+
+func (m *ServiceEndpointItem) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *ServiceEndpointItem) GetMap() string {
+	if m != nil {
+		return m.Map
+	}
+	return ""
+}
+
 // DidDocWithMetadata defines a DID Document with metadata, as defined in the DID Core specification.
 // Contains the DID Document, as well as DID Document metadata.
 type DidDocWithMetadata struct {
@@ -399,7 +557,7 @@ func (m *DidDocWithMetadata) Reset()         { *m = DidDocWithMetadata{} }
 func (m *DidDocWithMetadata) String() string { return proto.CompactTextString(m) }
 func (*DidDocWithMetadata) ProtoMessage()    {}
 func (*DidDocWithMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7b058eff1719454, []int{4}
+	return fileDescriptor_b7b058eff1719454, []int{6}
 }
 func (m *DidDocWithMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,13 +626,18 @@ type Metadata struct {
 	// Format: UUID
 	// Example: 123e4567-e89b-12d3-a456-426655440000
 	PreviousVersionId string `protobuf:"bytes,6,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// next_key_commitment is the pre-rotation commitment to the next authentication key set of the DID Document.
+	// Changes of the authentication keys are accepted only if the new keys match the commitment.
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	// Example: zQmdcSjGo5hMSaRsru16U4mgRaPiMZRkgw5nTY6bZdDd8K4
+	NextKeyCommitment string `protobuf:"bytes,7,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7b058eff1719454, []int{5}
+	return fileDescriptor_b7b058eff1719454, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,11 +708,20 @@ func (m *Metadata) GetPreviousVersionId() string {
 	return ""
 }
 
+func (m *Metadata) GetNextKeyCommitment() string {
+	if m != nil {
+		return m.NextKeyCommitment
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DidDoc)(nil), "cheqd.did.v2.DidDoc")
 	proto.RegisterType((*VerificationMethod)(nil), "cheqd.did.v2.VerificationMethod")
 	proto.RegisterType((*VerificationRelationship)(nil), "cheqd.did.v2.VerificationRelationship")
 	proto.RegisterType((*Service)(nil), "cheqd.did.v2.Service")
+	proto.RegisterType((*ServiceEndpoint)(nil), "cheqd.did.v2.ServiceEndpoint")
+	proto.RegisterType((*ServiceEndpointItem)(nil), "cheqd.did.v2.ServiceEndpointItem")
 	proto.RegisterType((*DidDocWithMetadata)(nil), "cheqd.did.v2.DidDocWithMetadata")
 	proto.RegisterType((*Metadata)(nil), "cheqd.did.v2.Metadata")
 }
@@ -557,58 +729,65 @@ func init() {
 func init() { proto.RegisterFile("cheqd/did/v2/diddoc.proto", fileDescriptor_b7b058eff1719454) }
 
 var fileDescriptor_b7b058eff1719454 = []byte{
-	// 811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0x37, 0xc9, 0xfe, 0x79, 0x9b, 0x3f, 0x65, 0xb2, 0x09, 0x26, 0x12, 0xbb, 0xcb, 0x1e,
-	0x50, 0x40, 0xad, 0x2d, 0xa5, 0xe5, 0x8a, 0xd4, 0x55, 0x38, 0x44, 0xa1, 0x48, 0x35, 0x55, 0x91,
-	0xe0, 0x60, 0x4d, 0x3c, 0xaf, 0xde, 0x51, 0x6c, 0x8f, 0xb1, 0x67, 0xdd, 0xf8, 0x5b, 0xf4, 0x2b,
-	0x20, 0xb8, 0x73, 0xe3, 0x2b, 0xd0, 0x63, 0x8f, 0x9c, 0x02, 0x4a, 0x6e, 0xf9, 0x14, 0xc8, 0xe3,
-	0xb1, 0xf1, 0x66, 0x1b, 0xd1, 0xe4, 0x64, 0xcf, 0x7b, 0xbf, 0xdf, 0x6f, 0xde, 0xbc, 0xf9, 0xf9,
-	0x19, 0x3e, 0xf1, 0x66, 0xf8, 0x33, 0xb3, 0x19, 0x67, 0x76, 0x76, 0x58, 0x3c, 0x98, 0xf0, 0xac,
-	0x38, 0x11, 0x52, 0x90, 0x0d, 0x95, 0xb2, 0x18, 0x67, 0x56, 0x76, 0xb8, 0x3f, 0xf0, 0x85, 0x2f,
-	0x54, 0xc2, 0x2e, 0xde, 0x4a, 0xcc, 0xfe, 0xc8, 0x17, 0xc2, 0x0f, 0xd0, 0x56, 0xab, 0xd3, 0xf9,
-	0x2b, 0x5b, 0xf2, 0x10, 0x53, 0x49, 0xc3, 0xb8, 0x04, 0x4c, 0x7e, 0x5b, 0x87, 0xf6, 0x11, 0x67,
-	0x47, 0xc2, 0x23, 0x26, 0x74, 0x3c, 0x11, 0x49, 0x3c, 0x97, 0xa6, 0x31, 0x5e, 0x3d, 0xe8, 0x39,
-	0xd5, 0x92, 0x6c, 0x41, 0x8b, 0x33, 0xb3, 0x35, 0x36, 0x0e, 0x7a, 0x4e, 0x8b, 0x33, 0x32, 0x04,
-	0x28, 0x52, 0x89, 0x08, 0x02, 0x4c, 0xcc, 0x55, 0x05, 0x6e, 0x44, 0xc8, 0x73, 0xd8, 0xc9, 0x30,
-	0xe1, 0xaf, 0xb8, 0x47, 0x25, 0x17, 0x91, 0x1b, 0xa2, 0x9c, 0x09, 0x66, 0xae, 0x8d, 0x57, 0x0f,
-	0xfa, 0x87, 0x63, 0xab, 0x59, 0xb7, 0xf5, 0xb2, 0x01, 0x7c, 0xa6, 0x70, 0x0e, 0xc9, 0x96, 0x62,
-	0xe4, 0x3b, 0xd8, 0xa2, 0x73, 0x39, 0xc3, 0x48, 0xea, 0xb8, 0xb9, 0xae, 0xd4, 0x3e, 0xbf, 0x5d,
-	0xcd, 0xc1, 0x40, 0x3d, 0xd3, 0x19, 0x8f, 0x9d, 0x1b, 0x6c, 0xf2, 0x1c, 0x1e, 0xd0, 0x34, 0xc5,
-	0xa4, 0x59, 0x5f, 0xfb, 0x4e, 0x8a, 0xdb, 0x35, 0x5f, 0x97, 0xf8, 0x13, 0xec, 0x7a, 0x34, 0xa6,
-	0xa7, 0x3c, 0xe0, 0x32, 0x77, 0x79, 0x94, 0x09, 0x5d, 0x69, 0xe7, 0x4e, 0xba, 0x83, 0xff, 0x44,
-	0x8e, 0x6b, 0x8d, 0x1b, 0xe2, 0x0c, 0x03, 0xf4, 0x4b, 0xf1, 0xee, 0x7d, 0xc5, 0x8f, 0x6a, 0x0d,
-	0x72, 0x02, 0x9b, 0x67, 0x98, 0xbb, 0xd4, 0x4f, 0x10, 0x43, 0x8c, 0xa4, 0xd9, 0xbb, 0x93, 0xe8,
-	0xc6, 0x19, 0xe6, 0x4f, 0x2b, 0x2e, 0xb1, 0xa1, 0x93, 0x62, 0x92, 0x71, 0x0f, 0x4d, 0x50, 0x32,
-	0xbb, 0x8b, 0x32, 0xdf, 0x97, 0x49, 0xa7, 0x42, 0x91, 0x09, 0x6c, 0xd2, 0x20, 0x15, 0xee, 0x59,
-	0x24, 0x5e, 0x47, 0x2e, 0x4d, 0xcd, 0xbe, 0x32, 0x54, 0xbf, 0x08, 0x9e, 0x14, 0xb1, 0xa7, 0xe9,
-	0xe4, 0x4f, 0x03, 0xc8, 0xb2, 0x53, 0xb4, 0x31, 0x8d, 0xda, 0x98, 0xdf, 0x82, 0xf9, 0x1e, 0xe3,
-	0xb9, 0x32, 0x8f, 0xb1, 0xb4, 0xef, 0x94, 0x5c, 0x5f, 0x8c, 0xb6, 0x8a, 0xf5, 0x43, 0x11, 0x72,
-	0x89, 0x61, 0x2c, 0x73, 0x67, 0x6f, 0xd9, 0x6f, 0x2f, 0xf2, 0x18, 0x97, 0x6c, 0x6e, 0xdc, 0xb0,
-	0xf9, 0x63, 0xd8, 0x5d, 0xdc, 0x8d, 0x4a, 0x4c, 0x38, 0x0d, 0xcc, 0x35, 0x05, 0x1d, 0x2c, 0xc8,
-	0xea, 0xdc, 0xe4, 0x57, 0x03, 0xcc, 0xdb, 0x3a, 0x49, 0x9e, 0xc0, 0xde, 0xfb, 0xea, 0xaf, 0xcf,
-	0x38, 0x58, 0xae, 0xf4, 0x98, 0xdd, 0xf6, 0xb9, 0x15, 0x07, 0xbe, 0xe7, 0xe7, 0x36, 0xf9, 0xc3,
-	0x80, 0x8e, 0xbe, 0xa8, 0xa5, 0x26, 0x7f, 0x05, 0x1b, 0xfa, 0xea, 0xfe, 0xaf, 0xb1, 0x7d, 0x8d,
-	0x53, 0xdd, 0xfc, 0x02, 0x1e, 0x54, 0x34, 0x8c, 0x58, 0x2c, 0x78, 0x24, 0xf5, 0xe8, 0xd8, 0xd6,
-	0xf1, 0x6f, 0x74, 0x98, 0xec, 0x41, 0x9b, 0x7a, 0x1e, 0xc6, 0x52, 0x8d, 0x8c, 0x9e, 0xa3, 0x57,
-	0xe4, 0x33, 0xd8, 0x48, 0xc4, 0x5c, 0xf2, 0xc8, 0x77, 0xcf, 0x30, 0x4f, 0xd5, 0x08, 0xe8, 0x39,
-	0x7d, 0x1d, 0x3b, 0xc1, 0x3c, 0x9d, 0xfc, 0x62, 0x00, 0x29, 0xe7, 0xd9, 0x0f, 0x5c, 0xce, 0x9e,
-	0xa1, 0xa4, 0x8c, 0x4a, 0x4a, 0xbe, 0x86, 0x0e, 0xe3, 0xcc, 0x65, 0xc2, 0x53, 0x07, 0xe9, 0x1f,
-	0x0e, 0x16, 0xdb, 0x52, 0x52, 0xa6, 0xdb, 0xd7, 0x17, 0xa3, 0x3e, 0x53, 0xef, 0xf3, 0xc2, 0xcc,
-	0x4e, 0xbb, 0x5c, 0x90, 0x13, 0xe8, 0x86, 0x5a, 0x4b, 0xf7, 0x75, 0x6f, 0x51, 0xa0, 0xda, 0x69,
-	0xfa, 0xf1, 0xf5, 0xc5, 0x68, 0xa7, 0x21, 0x51, 0x25, 0x9c, 0x5a, 0x60, 0xf2, 0x7b, 0x0b, 0xba,
-	0xcd, 0xca, 0xbc, 0x04, 0xa9, 0x44, 0xa6, 0x2b, 0xdb, 0xb7, 0xca, 0x99, 0x6d, 0x55, 0x33, 0xdb,
-	0x7a, 0x51, 0xcd, 0xec, 0x69, 0xf7, 0xed, 0xc5, 0x68, 0xe5, 0xcd, 0xdf, 0x23, 0xc3, 0xa9, 0x48,
-	0x05, 0x7f, 0x1e, 0x33, 0xc5, 0x6f, 0x7d, 0x10, 0xdf, 0x28, 0xf9, 0x9a, 0x44, 0xc6, 0xd0, 0x67,
-	0x48, 0x3d, 0xc9, 0x33, 0xa5, 0x51, 0xb8, 0xbc, 0xeb, 0x34, 0x43, 0xe4, 0x53, 0x80, 0x0c, 0x93,
-	0xb4, 0x70, 0x16, 0x67, 0xda, 0xdb, 0x3d, 0x1d, 0x39, 0x66, 0xe4, 0x21, 0x6c, 0x47, 0x78, 0x2e,
-	0xdd, 0x06, 0x66, 0x5d, 0x39, 0x62, 0xad, 0xd8, 0xcc, 0xd9, 0x2c, 0x92, 0x2f, 0x6b, 0xf4, 0x13,
-	0xd8, 0x89, 0x13, 0xcc, 0xb8, 0x98, 0xa7, 0x4d, 0x46, 0xbb, 0xc1, 0xf8, 0xa8, 0x02, 0xd4, 0xac,
-	0xe9, 0xd1, 0xdb, 0xcb, 0xa1, 0xf1, 0xee, 0x72, 0x68, 0xfc, 0x73, 0x39, 0x34, 0xde, 0x5c, 0x0d,
-	0x57, 0xde, 0x5d, 0x0d, 0x57, 0xfe, 0xba, 0x1a, 0xae, 0xfc, 0xf8, 0xa5, 0xcf, 0xe5, 0x6c, 0x7e,
-	0x6a, 0x79, 0x22, 0xb4, 0x3d, 0x1a, 0x89, 0xd7, 0x8f, 0x3c, 0x61, 0xab, 0x9b, 0x79, 0x14, 0x09,
-	0x86, 0xf6, 0xb9, 0xfa, 0x75, 0x16, 0xbe, 0x4c, 0x4f, 0xdb, 0xaa, 0x23, 0x8f, 0xff, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0xb0, 0x05, 0x03, 0x54, 0x07, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xfd, 0xa3, 0x9f, 0x91, 0xff, 0xb2, 0x92, 0x5d, 0xd6, 0x40, 0x24, 0x45, 0x87, 0xc2,
+	0x2d, 0x12, 0x0a, 0x55, 0x52, 0x14, 0xb9, 0x14, 0x88, 0xea, 0x1e, 0x0c, 0x35, 0x05, 0xc2, 0x1a,
+	0x29, 0xd0, 0x1e, 0x88, 0x35, 0x77, 0x22, 0x2d, 0x2c, 0x72, 0x59, 0x72, 0xa5, 0x58, 0x6f, 0x91,
+	0x57, 0x28, 0xfa, 0x30, 0xcd, 0x31, 0xb7, 0xf6, 0xe4, 0x14, 0xf6, 0xcd, 0xb7, 0xbe, 0x41, 0xb1,
+	0xcb, 0x25, 0x4d, 0x59, 0x76, 0x1b, 0xe7, 0xa4, 0xdd, 0x99, 0xef, 0xfb, 0x66, 0x76, 0x39, 0x33,
+	0x2b, 0xf8, 0xd4, 0x1f, 0xe1, 0xaf, 0xac, 0xcb, 0x38, 0xeb, 0x4e, 0x7b, 0xea, 0x87, 0x09, 0xdf,
+	0x89, 0x62, 0x21, 0x05, 0x59, 0xd7, 0x2e, 0x87, 0x71, 0xe6, 0x4c, 0x7b, 0x7b, 0x8d, 0xa1, 0x18,
+	0x0a, 0xed, 0xe8, 0xaa, 0x55, 0x8a, 0xd9, 0x6b, 0x0d, 0x85, 0x18, 0x8e, 0xb1, 0xab, 0x77, 0xc7,
+	0x93, 0x57, 0x5d, 0xc9, 0x03, 0x4c, 0x24, 0x0d, 0xa2, 0x14, 0xd0, 0xf9, 0x73, 0x0d, 0x4a, 0x07,
+	0x9c, 0x1d, 0x08, 0x9f, 0xd8, 0x50, 0xf6, 0x45, 0x28, 0xf1, 0x54, 0xda, 0x56, 0x7b, 0x65, 0xbf,
+	0xea, 0x66, 0x5b, 0xb2, 0x09, 0xcb, 0x9c, 0xd9, 0xcb, 0x6d, 0x6b, 0xbf, 0xea, 0x2e, 0x73, 0x46,
+	0x9a, 0x00, 0xca, 0x15, 0x8b, 0xf1, 0x18, 0x63, 0x7b, 0x45, 0x83, 0x0b, 0x16, 0xf2, 0x02, 0xea,
+	0x53, 0x8c, 0xf9, 0x2b, 0xee, 0x53, 0xc9, 0x45, 0xe8, 0x05, 0x28, 0x47, 0x82, 0xd9, 0xab, 0xed,
+	0x95, 0xfd, 0x5a, 0xaf, 0xed, 0x14, 0xf3, 0x76, 0x5e, 0x16, 0x80, 0xcf, 0x35, 0xce, 0x25, 0xd3,
+	0x05, 0x1b, 0xf9, 0x01, 0x36, 0xe9, 0x44, 0x8e, 0x30, 0x94, 0xc6, 0x6e, 0xaf, 0x69, 0xb5, 0xcf,
+	0x6e, 0x57, 0x73, 0x71, 0xac, 0x7f, 0x93, 0x11, 0x8f, 0xdc, 0x6b, 0x6c, 0xf2, 0x02, 0xb6, 0x69,
+	0x92, 0x60, 0x5c, 0xcc, 0xaf, 0x74, 0x27, 0xc5, 0xad, 0x9c, 0x6f, 0x52, 0xfc, 0x05, 0x76, 0x7c,
+	0x1a, 0xd1, 0x63, 0x3e, 0xe6, 0x72, 0xe6, 0xf1, 0x70, 0x2a, 0x4c, 0xa6, 0xe5, 0x3b, 0xe9, 0x36,
+	0xae, 0x44, 0x0e, 0x73, 0x8d, 0x6b, 0xe2, 0x0c, 0xc7, 0x38, 0x4c, 0xc5, 0x2b, 0x1f, 0x2b, 0x7e,
+	0x90, 0x6b, 0x90, 0x01, 0x6c, 0x9c, 0xe0, 0xcc, 0xa3, 0xc3, 0x18, 0x31, 0xc0, 0x50, 0xda, 0xd5,
+	0x3b, 0x89, 0xae, 0x9f, 0xe0, 0xec, 0x59, 0xc6, 0x25, 0x5d, 0x28, 0x27, 0x18, 0x4f, 0xb9, 0x8f,
+	0x36, 0x68, 0x99, 0x9d, 0x79, 0x99, 0x1f, 0x53, 0xa7, 0x9b, 0xa1, 0x48, 0x07, 0x36, 0xe8, 0x38,
+	0x11, 0xde, 0x49, 0x28, 0x5e, 0x87, 0x1e, 0x4d, 0xec, 0x9a, 0x2e, 0xa8, 0x9a, 0x32, 0x0e, 0x94,
+	0xed, 0x59, 0x42, 0xbe, 0x84, 0xc6, 0x55, 0x7d, 0x79, 0x72, 0x14, 0x63, 0x32, 0x12, 0x63, 0x66,
+	0xaf, 0xb7, 0xad, 0xfd, 0x0d, 0xb7, 0x7e, 0xe5, 0x3b, 0xca, 0x5c, 0x9d, 0x3f, 0x2c, 0x20, 0x8b,
+	0xc5, 0x65, 0x6a, 0xd9, 0xca, 0x6b, 0xf9, 0x7b, 0xb0, 0x6f, 0xa8, 0x55, 0x4f, 0xce, 0x22, 0x4c,
+	0x2b, 0xbe, 0x4f, 0x2e, 0xcf, 0x5a, 0x9b, 0x6a, 0xff, 0x50, 0x04, 0x5c, 0x62, 0x10, 0xc9, 0x99,
+	0xbb, 0xbb, 0x58, 0xa2, 0x47, 0xb3, 0x08, 0x17, 0x3a, 0xc3, 0xba, 0xd6, 0x19, 0x8f, 0x61, 0x67,
+	0x3e, 0x1a, 0x95, 0x18, 0x73, 0x3a, 0xb6, 0x57, 0x35, 0xb4, 0x31, 0x27, 0x6b, 0x7c, 0x9d, 0xdf,
+	0x2d, 0xb0, 0x6f, 0xbb, 0x7c, 0xf2, 0x04, 0x76, 0x6f, 0xca, 0x3f, 0x3f, 0x63, 0x63, 0x31, 0xd3,
+	0x43, 0x76, 0x5b, 0x87, 0xaa, 0x03, 0x7f, 0x64, 0x87, 0x76, 0xfe, 0xb1, 0xa0, 0x6c, 0xbe, 0xed,
+	0xc2, 0x25, 0x7f, 0x05, 0xeb, 0xe6, 0x6b, 0xff, 0xdf, 0xc5, 0xd6, 0x0c, 0x4e, 0xdf, 0xe6, 0xe7,
+	0xb0, 0x9d, 0xd1, 0x30, 0x64, 0x91, 0xe0, 0xa1, 0x34, 0xd3, 0x66, 0xcb, 0xd8, 0xbf, 0x33, 0x66,
+	0xb2, 0x0b, 0x25, 0xea, 0xfb, 0x18, 0x49, 0x3d, 0x65, 0xaa, 0xae, 0xd9, 0x91, 0x07, 0xb0, 0x1e,
+	0x8b, 0x89, 0xe4, 0xe1, 0xd0, 0x3b, 0xc1, 0x59, 0xa2, 0xa7, 0x46, 0xd5, 0xad, 0x19, 0xdb, 0x00,
+	0x67, 0x09, 0x79, 0x0a, 0x95, 0x5c, 0xbd, 0xa4, 0x2f, 0xe0, 0xfe, 0x8d, 0x15, 0x9b, 0xc5, 0x72,
+	0x73, 0x78, 0x27, 0x84, 0xad, 0x6b, 0x4e, 0xb2, 0x0d, 0x2b, 0x93, 0x98, 0x9b, 0xb3, 0xab, 0xa5,
+	0xb2, 0x04, 0x34, 0x32, 0xe3, 0x53, 0x2d, 0xc9, 0xd7, 0xb0, 0xa6, 0xce, 0x9b, 0xe8, 0xc3, 0xd4,
+	0x7a, 0x0f, 0xfe, 0x33, 0xdc, 0xa1, 0xc4, 0xc0, 0x4d, 0xf1, 0x9d, 0xa7, 0x50, 0xbf, 0xc1, 0xfb,
+	0x21, 0x31, 0x3b, 0xbf, 0x59, 0x40, 0xd2, 0x41, 0xff, 0x13, 0x97, 0xa3, 0xe7, 0x28, 0x29, 0xa3,
+	0x92, 0x92, 0x6f, 0xa0, 0xcc, 0x38, 0xf3, 0x98, 0xf0, 0x35, 0xbd, 0xd6, 0x6b, 0xcc, 0x27, 0x93,
+	0x52, 0xfa, 0x5b, 0x97, 0x67, 0xad, 0x1a, 0xd3, 0xeb, 0x89, 0xea, 0x72, 0xb7, 0x94, 0x6e, 0xc8,
+	0x00, 0x2a, 0x81, 0xd1, 0x32, 0xd5, 0xb3, 0x3b, 0x2f, 0x90, 0x45, 0xea, 0x7f, 0x72, 0x79, 0xd6,
+	0xaa, 0x17, 0x24, 0x32, 0x87, 0x9b, 0x0b, 0x74, 0xde, 0x2f, 0x43, 0xa5, 0x98, 0x99, 0x1f, 0x23,
+	0x95, 0xc8, 0x4c, 0x66, 0x7b, 0x4e, 0xfa, 0x98, 0x39, 0xd9, 0x63, 0xe6, 0x1c, 0x65, 0x8f, 0x59,
+	0xbf, 0xf2, 0xf6, 0xac, 0xb5, 0xf4, 0xe6, 0x7d, 0xcb, 0x72, 0x33, 0x92, 0xe2, 0x4f, 0x22, 0xa6,
+	0xf9, 0xcb, 0x1f, 0xc4, 0xb7, 0x52, 0xbe, 0x21, 0x91, 0x36, 0xd4, 0x18, 0x52, 0x5f, 0xf2, 0xa9,
+	0xd6, 0x50, 0xbd, 0x5c, 0x71, 0x8b, 0x26, 0x72, 0x1f, 0x60, 0x8a, 0x71, 0xa2, 0xfa, 0x87, 0x33,
+	0xd3, 0xc1, 0x55, 0x63, 0x39, 0x64, 0xe4, 0x21, 0x6c, 0x85, 0x78, 0x2a, 0xbd, 0x02, 0x66, 0x4d,
+	0xd7, 0xfd, 0xaa, 0x0a, 0xe6, 0x6e, 0x28, 0xe7, 0xcb, 0x1c, 0xfd, 0x04, 0xea, 0x51, 0x8c, 0x53,
+	0x2e, 0x26, 0x49, 0x91, 0x51, 0x2a, 0x30, 0xee, 0x65, 0x80, 0x2b, 0x96, 0x03, 0x75, 0x1d, 0x43,
+	0x8d, 0x6f, 0x5f, 0x04, 0x01, 0x97, 0x7a, 0x7e, 0x97, 0x75, 0x2e, 0xf7, 0x94, 0x6b, 0x80, 0xb3,
+	0x6f, 0x73, 0x47, 0xff, 0xe0, 0xed, 0x79, 0xd3, 0x7a, 0x77, 0xde, 0xb4, 0xfe, 0x3e, 0x6f, 0x5a,
+	0x6f, 0x2e, 0x9a, 0x4b, 0xef, 0x2e, 0x9a, 0x4b, 0x7f, 0x5d, 0x34, 0x97, 0x7e, 0xfe, 0x62, 0xc8,
+	0xe5, 0x68, 0x72, 0xec, 0xf8, 0x22, 0xe8, 0xfa, 0x34, 0x14, 0xaf, 0x1f, 0xf9, 0xa2, 0xab, 0xbf,
+	0xe4, 0xa3, 0x50, 0x30, 0xec, 0x9e, 0xea, 0xff, 0x20, 0xaa, 0x5b, 0x93, 0xe3, 0x92, 0xbe, 0xc1,
+	0xc7, 0xff, 0x0e, 0x00, 0x60, 0xef, 0xfd, 0x74, 0x9d, 0x08, 0x00, 0x00,
 }
 
 func (m *DidDoc) Marshal() (dAtA []byte, err error) {
@@ -631,6 +810,11 @@ func (m *DidDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintDiddoc(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.AlsoKnownAs) > 0 {
 		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AlsoKnownAs[iNdEx])
@@ -879,6 +1063,18 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Endpoint != nil {
+		{
+			size, err := m.Endpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDiddoc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.RoutingKeys) > 0 {
 		for iNdEx := len(m.RoutingKeys) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.RoutingKeys[iNdEx])
@@ -923,6 +1119,94 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ServiceEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiddoc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Map) > 0 {
+		i -= len(m.Map)
+		copy(dAtA[i:], m.Map)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Map)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceEndpointItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceEndpointItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceEndpointItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Map) > 0 {
+		i -= len(m.Map)
+		copy(dAtA[i:], m.Map)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Map)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DidDocWithMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -990,6 +1274,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextKeyCommitment) > 0 {
+		i -= len(m.NextKeyCommitment)
+		copy(dAtA[i:], m.NextKeyCommitment)
+		i = encodeVarintDiddoc(dAtA, i, uint64(len(m.NextKeyCommitment)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.PreviousVersionId) > 0 {
 		i -= len(m.PreviousVersionId)
 		copy(dAtA[i:], m.PreviousVersionId)
@@ -1022,21 +1313,21 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if m.Updated != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Updated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Updated):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Updated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Updated):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintDiddoc(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintDiddoc(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x12
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintDiddoc(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintDiddoc(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1123,6 +1414,9 @@ func (m *DidDoc) Size() (n int) {
 			n += 1 + l + sovDiddoc(uint64(l))
 		}
 	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovDiddoc(uint64(m.ControllerThreshold))
+	}
 	return n
 }
 
@@ -1200,6 +1494,50 @@ func (m *Service) Size() (n int) {
 			n += 1 + l + sovDiddoc(uint64(l))
 		}
 	}
+	if m.Endpoint != nil {
+		l = m.Endpoint.Size()
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	l = len(m.Map)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovDiddoc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceEndpointItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
+	l = len(m.Map)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
 	return n
 }
 
@@ -1247,6 +1585,10 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDiddoc(uint64(l))
 	}
+	l = len(m.NextKeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovDiddoc(uint64(l))
+	}
 	return n
 }
 
@@ -1651,6 +1993,25 @@ func (m *DidDoc) Unmarshal(dAtA []byte) error {
 			}
 			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+			}
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDiddoc(dAtA[iNdEx:])
@@ -2157,6 +2518,42 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			}
 			m.RoutingKeys = append(m.RoutingKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Endpoint == nil {
+				m.Endpoint = &ServiceEndpoint{}
+			}
+			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDiddoc(dAtA[iNdEx:])
@@ -2178,7 +2575,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
+func (m *ServiceEndpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,17 +2598,17 @@ func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DidDocWithMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceEndpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DidDocWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDoc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDiddoc
@@ -2221,12 +2618,274 @@ func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Map = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ServiceEndpointItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceEndpointItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceEndpointItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceEndpointItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Map = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiddoc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidDocWithMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiddoc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDocWithMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDocWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDoc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthDiddoc
 			}
 			postIndex := iNdEx + msglen
@@ -2514,6 +3173,38 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiddoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiddoc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDiddoc(dAtA[iNdEx:])
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+// JSON-LD contexts defining verification method and service types
+const (
+	Ed25519Signature2020Context        = "https://w3id.org/security/suites/ed25519-2020/v1"
+	Ed25519Signature2018Context        = "https://w3id.org/security/suites/ed25519-2018/v1"
+	JSONWebSignature2020Context        = "https://w3id.org/security/suites/jws-2020/v1"
+	BbsBlsSignature2020Context         = "https://w3id.org/security/suites/bls12381-2020/v1"
+	EcdsaSecp256k1Signature2019Context = "https://w3id.org/security/suites/secp256k1-2019/v1"
+	MultikeyContext                    = "https://w3id.org/security/multikey/v1"
+	JSONWebKeyContext                  = "https://w3id.org/security/jwk/v1"
+	ControlledIdentifierContext        = "https://www.w3.org/ns/cid/v1"
+	X25519KeyAgreement2019Context      = "https://w3id.org/security/suites/x25519-2019/v1"
+	X25519KeyAgreement2020Context      = "https://w3id.org/security/suites/x25519-2020/v1"
+
+	LinkedDomainsContext    = "https://identity.foundation/.well-known/did-configuration/v1"
+	DIDCommMessagingContext = "https://didcomm.org/messaging/contexts/v2"
+)
+
+// Service types with well-known JSON-LD contexts
+const (
+	LinkedDomainsServiceType    = "LinkedDomains"
+	DIDCommMessagingServiceType = "DIDCommMessaging"
+)
+
+// VerificationMethodTypeContexts lists contexts defining each verification method type. Any of them is enough,
+// the first one is added by auto-completion.
+var VerificationMethodTypeContexts = map[string][]string{
+	Ed25519VerificationKey2020Type:        {Ed25519Signature2020Context},
+	Ed25519VerificationKey2018Type:        {Ed25519Signature2018Context},
+	JSONWebKey2020Type:                    {JSONWebSignature2020Context},
+	Bls12381G2Key2020Type:                 {BbsBlsSignature2020Context},
+	EcdsaSecp256k1VerificationKey2019Type: {EcdsaSecp256k1Signature2019Context},
+	MultikeyType:                          {MultikeyContext, ControlledIdentifierContext},
+	JSONWebKeyType:                        {JSONWebKeyContext, ControlledIdentifierContext},
+	X25519KeyAgreementKey2019Type:         {X25519KeyAgreement2019Context},
+	X25519KeyAgreementKey2020Type:         {X25519KeyAgreement2020Context},
+}
+
+// ServiceTypeContexts lists contexts defining well-known service types. Other service types are not checked.
+var ServiceTypeContexts = map[string][]string{
+	LinkedDomainsServiceType:    {LinkedDomainsContext},
+	DIDCommMessagingServiceType: {DIDCommMessagingContext},
+}
+
+// MissingContexts returns contexts required by verification method and service types used in the diddoc
+// but not declared in its context list. For each type, the first of its contexts is returned.
+func (didDoc *DidDoc) MissingContexts() []string {
+	return didDoc.MissingContextsOfNewTypes(nil)
+}
+
+// MissingContextsOfNewTypes is the same as MissingContexts but only checks verification method and service types
+// which are not used in the previous version of the diddoc. All types are checked if there is no previous version.
+func (didDoc *DidDoc) MissingContextsOfNewTypes(previous *DidDoc) []string {
+	var missing []string
+
+	check := func(contexts []string) {
+		if len(contexts) == 0 {
+			return
+		}
+
+		for _, context := range contexts {
+			if utils.Contains(didDoc.Context, context) {
+				return
+			}
+		}
+
+		if !utils.Contains(missing, contexts[0]) {
+			missing = append(missing, contexts[0])
+		}
+	}
+
+	for _, vm := range didDoc.AllVerificationMethods() {
+		if previous != nil && previous.HasVerificationMethodType(vm.VerificationMethodType) {
+			continue
+		}
+
+		check(VerificationMethodTypeContexts[vm.VerificationMethodType])
+	}
+
+	for _, service := range didDoc.Service {
+		if previous != nil && previous.HasServiceType(service.ServiceType) {
+			continue
+		}
+
+		check(ServiceTypeContexts[service.ServiceType])
+	}
+
+	return missing
+}
+
+// AutoCompleteContext adds the contexts required by the used verification method and service types.
+// DID Core context is added first if the context list is empty.
+func (didDoc *DidDoc) AutoCompleteContext() {
+	missing := didDoc.MissingContexts()
+
+	if len(didDoc.Context) == 0 {
+		didDoc.Context = []string{DIDCoreContext}
+	}
+
+	didDoc.Context = append(didDoc.Context, missing...)
+}
+
+// HasRequiredContextsRule checks that the diddoc declares contexts for the used verification method and service types.
+// Only types which are not used in the previous version of the diddoc are checked, so that diddocs created before
+// the check was introduced stay updatable. Diddocs without DID Core context are plain JSON documents and are not checked.
+func HasRequiredContextsRule(didDoc *DidDoc, previous *DidDoc) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		if !utils.Contains(didDoc.Context, DIDCoreContext) {
+			return nil
+		}
+
+		missing := didDoc.MissingContextsOfNewTypes(previous)
+		if len(missing) > 0 {
+			return fmt.Errorf("contexts required by the used verification method and service types are missing: %s", strings.Join(missing, ", "))
+		}
+
+		return nil
+	})
+}
@@ -39,21 +39,137 @@ func NewDidDoc(
 func (didDoc *DidDoc) AllControllerDids() []string {
 	result := didDoc.Controller
 
-	var allVerificationMethods []*VerificationMethod
-	allVerificationMethods = append(allVerificationMethods, didDoc.VerificationMethod...)
-	allVerificationMethods = append(allVerificationMethods, FilterEmbeddedVerificationMethods(didDoc.Authentication)...)
-	allVerificationMethods = append(allVerificationMethods, FilterEmbeddedVerificationMethods(didDoc.AssertionMethod)...)
-	allVerificationMethods = append(allVerificationMethods, FilterEmbeddedVerificationMethods(didDoc.CapabilityInvocation)...)
-	allVerificationMethods = append(allVerificationMethods, FilterEmbeddedVerificationMethods(didDoc.CapabilityDelegation)...)
-	allVerificationMethods = append(allVerificationMethods, FilterEmbeddedVerificationMethods(didDoc.KeyAgreement)...)
-
-	for _, vm := range allVerificationMethods {
+	for _, vm := range didDoc.AllVerificationMethods() {
 		result = append(result, vm.Controller)
 	}
 
 	return utils.UniqueSorted(result)
 }
 
+// AllVerificationMethods returns verification methods from did.verification_method
+// and the ones embedded into verification relationships
+func (didDoc *DidDoc) AllVerificationMethods() []*VerificationMethod {
+	var result []*VerificationMethod
+	result = append(result, didDoc.VerificationMethod...)
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.Authentication)...)
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.AssertionMethod)...)
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.CapabilityInvocation)...)
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.CapabilityDelegation)...)
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.KeyAgreement)...)
+
+	return result
+}
+
+// AuthenticationMethods returns verification methods the diddoc can be authenticated with.
+// In the current implementation, when searching for a given authentication method,
+// we fall back into `verificationMethod` list in case the method is not found in `authentication` list.
+// Methods referenced by `capabilityInvocation` but not by `authentication` are not considered,
+// they only authorize service updates.
+//
+// NOTE: Before capability invocation was enforced, such methods could authorize any change of the diddoc.
+// They keep doing so while the AllowCapabilityInvocationFullUpdates signing param is enabled. Diddocs relying on that
+// need their methods referenced from `authentication` before governance disables it, see CapabilityInvocationOnlyMethodIDs.
+func (didDoc *DidDoc) AuthenticationMethods() []*VerificationMethod {
+	result := FilterEmbeddedVerificationMethods(didDoc.Authentication)
+
+	invocationOnlyIds := didDoc.CapabilityInvocationOnlyMethodIDs()
+	for _, vm := range didDoc.VerificationMethod {
+		if !utils.Contains(invocationOnlyIds, vm.Id) {
+			result = append(result, vm)
+		}
+	}
+
+	return result
+}
+
+// CapabilityInvocationOnlyMethodIDs returns ids of methods from `verificationMethod` list which are referenced
+// by `capabilityInvocation` but not by `authentication`. They authorize service updates only.
+func (didDoc *DidDoc) CapabilityInvocationOnlyMethodIDs() []string {
+	var result []string
+
+	for _, vr := range didDoc.CapabilityInvocation {
+		if vr.VerificationMethodId == "" || utils.Contains(result, vr.VerificationMethodId) {
+			continue
+		}
+
+		referencedFromAuthentication := false
+		for _, authVr := range didDoc.Authentication {
+			if authVr.VerificationMethodId == vr.VerificationMethodId {
+				referencedFromAuthentication = true
+				break
+			}
+		}
+
+		if !referencedFromAuthentication {
+			result = append(result, vr.VerificationMethodId)
+		}
+	}
+
+	return result
+}
+
+// CapabilityInvocationMethods returns verification methods from `capabilityInvocation` list:
+// embedded ones and the ones referenced from `verificationMethod` list
+func (didDoc *DidDoc) CapabilityInvocationMethods() []*VerificationMethod {
+	return didDoc.resolveVerificationRelationships(didDoc.CapabilityInvocation)
+}
+
+// AssertionMethods returns verification methods from `assertionMethod` list:
+// embedded ones and the ones referenced from `verificationMethod` list
+func (didDoc *DidDoc) AssertionMethods() []*VerificationMethod {
+	return didDoc.resolveVerificationRelationships(didDoc.AssertionMethod)
+}
+
+func (didDoc *DidDoc) resolveVerificationRelationships(vrs []*VerificationRelationship) []*VerificationMethod {
+	result := FilterEmbeddedVerificationMethods(vrs)
+
+	for _, vr := range vrs {
+		if vr.VerificationMethodId == "" {
+			continue
+		}
+
+		vm, found := FindVerificationMethod(didDoc.VerificationMethod, vr.VerificationMethodId)
+		if found {
+			result = append(result, vm)
+		}
+	}
+
+	return result
+}
+
+// AuthorizationMethods returns verification methods which can authorize changes of the diddoc, fully or partially:
+// authentication methods and capability invocation methods
+func (didDoc *DidDoc) AuthorizationMethods() []*VerificationMethod {
+	var result []*VerificationMethod
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.Authentication)...)
+	result = append(result, FilterEmbeddedVerificationMethods(didDoc.CapabilityInvocation)...)
+	result = append(result, didDoc.VerificationMethod...)
+
+	return result
+}
+
+// HasVerificationMethodType checks whether the diddoc contains a verification method of the given type
+func (didDoc *DidDoc) HasVerificationMethodType(vmType string) bool {
+	for _, vm := range didDoc.AllVerificationMethods() {
+		if vm.VerificationMethodType == vmType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasServiceType checks whether the diddoc contains a service of the given type
+func (didDoc *DidDoc) HasServiceType(serviceType string) bool {
+	for _, service := range didDoc.Service {
+		if service.ServiceType == serviceType {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ReplaceDids replaces ids in all controller and id fields
 func (didDoc *DidDoc) ReplaceDids(old, new string) {
 	// Controllers
@@ -111,25 +227,38 @@ func (didDoc *DidDoc) GetControllersOrSubject() []string {
 func (didDoc DidDoc) Validate(allowedNamespaces []string) error {
 	err := validation.ValidateStruct(&didDoc,
 		validation.Field(&didDoc.Id, validation.Required, IsDID(allowedNamespaces)),
-		validation.Field(&didDoc.Controller, IsUniqueStrList(), validation.Each(IsDID(allowedNamespaces))),
+		validation.Field(&didDoc.Controller, IsUniqueStrList(), validation.Each(IsControllerDID(allowedNamespaces))),
+		validation.Field(&didDoc.ControllerThreshold, validation.Max(uint32(len(didDoc.GetControllersOrSubject())))),
 		validation.Field(&didDoc.VerificationMethod,
 			IsUniqueVerificationMethodListByIDRule(), validation.Each(ValidVerificationMethodRule(didDoc.Id, allowedNamespaces)),
 		),
 
 		validation.Field(&didDoc.Authentication,
-			validation.Each(ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod)),
+			validation.Each(
+				ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod),
+				IsNotKeyAgreementOnlyRule(didDoc.VerificationMethod),
+			),
 			IsUniqueVerificationRelationshipListByIDRule(),
 		),
 		validation.Field(&didDoc.AssertionMethod,
-			validation.Each(ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod)),
+			validation.Each(
+				ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod),
+				IsNotKeyAgreementOnlyRule(didDoc.VerificationMethod),
+			),
 			IsUniqueVerificationRelationshipListByIDRule(),
 		),
 		validation.Field(&didDoc.CapabilityInvocation,
-			validation.Each(ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod)),
+			validation.Each(
+				ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod),
+				IsNotKeyAgreementOnlyRule(didDoc.VerificationMethod),
+			),
 			IsUniqueVerificationRelationshipListByIDRule(),
 		),
 		validation.Field(&didDoc.CapabilityDelegation,
-			validation.Each(ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod)),
+			validation.Each(
+				ValidVerificationRelationshipRule(didDoc.Id, allowedNamespaces, didDoc.VerificationMethod),
+				IsNotKeyAgreementOnlyRule(didDoc.VerificationMethod),
+			),
 			IsUniqueVerificationRelationshipListByIDRule(),
 		),
 		validation.Field(&didDoc.KeyAgreement,
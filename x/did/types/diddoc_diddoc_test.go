@@ -3,6 +3,8 @@ package types_test
 import (
 	"fmt"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -564,4 +566,207 @@ var _ = DescribeTable("DIDDoc Validation tests", func(testCase DIDDocTestCase) {
 			isValid:  false,
 			errorMsg: "authentication: (1: can't resolve verification method reference: did:canow:testnet:zABCDEFG123456789abcd#fragment1.).",
 		}),
+	Entry(
+		"X25519 key in keyAgreement",
+		DIDDocTestCase{
+			didDoc: &DidDoc{
+				Id: ValidTestDID,
+				VerificationMethod: []*VerificationMethod{
+					{
+						Id:                     fmt.Sprintf("%s#fragment0", ValidTestDID),
+						VerificationMethodType: "X25519KeyAgreementKey2020",
+						Controller:             ValidTestDID,
+						VerificationMaterial:   ValidX25519KeyAgreementKey2020VerificationMaterial,
+					},
+				},
+				KeyAgreement: []*VerificationRelationship{
+					{
+						VerificationMethodId: fmt.Sprintf("%s#fragment0", ValidTestDID),
+					},
+					{
+						VerificationMethod: &VerificationMethod{
+							Id:                     fmt.Sprintf("%s#fragment1", ValidTestDID),
+							VerificationMethodType: "X25519KeyAgreementKey2019",
+							Controller:             ValidTestDID,
+							VerificationMaterial:   ValidX25519KeyAgreementKey2019VerificationMaterial,
+						},
+					},
+				},
+			},
+			isValid: true,
+		}),
+	Entry(
+		"X25519 key referenced from authentication",
+		DIDDocTestCase{
+			didDoc: &DidDoc{
+				Id: ValidTestDID,
+				VerificationMethod: []*VerificationMethod{
+					{
+						Id:                     fmt.Sprintf("%s#fragment0", ValidTestDID),
+						VerificationMethodType: "X25519KeyAgreementKey2020",
+						Controller:             ValidTestDID,
+						VerificationMaterial:   ValidX25519KeyAgreementKey2020VerificationMaterial,
+					},
+				},
+				Authentication: []*VerificationRelationship{
+					{
+						VerificationMethodId: fmt.Sprintf("%s#fragment0", ValidTestDID),
+					},
+				},
+			},
+			isValid:  false,
+			errorMsg: "authentication: (0: did:canow:testnet:zABCDEFG123456789abcd#fragment0: key agreement keys can be used in keyAgreement verification relationship only.).",
+		}),
+	Entry(
+		"X25519 JWK embedded into assertionMethod",
+		DIDDocTestCase{
+			didDoc: &DidDoc{
+				Id: ValidTestDID,
+				AssertionMethod: []*VerificationRelationship{
+					{
+						VerificationMethod: &VerificationMethod{
+							Id:                     fmt.Sprintf("%s#fragment0", ValidTestDID),
+							VerificationMethodType: "JsonWebKey2020",
+							Controller:             ValidTestDID,
+							VerificationMaterial:   ValidX25519JwkVerificationMaterial,
+						},
+					},
+				},
+			},
+			isValid:  false,
+			errorMsg: "assertion_method: (0: did:canow:testnet:zABCDEFG123456789abcd#fragment0: key agreement keys can be used in keyAgreement verification relationship only.).",
+		}),
 )
+
+var _ = Describe("DIDDoc required contexts", func() {
+	var didDoc DidDoc
+
+	BeforeEach(func() {
+		didDoc = DidDoc{
+			Context: []string{DIDCoreContext, Ed25519Signature2020Context, ControlledIdentifierContext},
+			Id:      ValidTestDID,
+			VerificationMethod: []*VerificationMethod{
+				{
+					Id:                     fmt.Sprintf("%s#fragment0", ValidTestDID),
+					VerificationMethodType: "Ed25519VerificationKey2020",
+					Controller:             ValidTestDID,
+					VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+				},
+			},
+			AssertionMethod: []*VerificationRelationship{
+				{
+					VerificationMethod: &VerificationMethod{
+						Id:                     fmt.Sprintf("%s#fragment1", ValidTestDID),
+						VerificationMethodType: "Multikey",
+						Controller:             ValidTestDID,
+						VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+					},
+				},
+			},
+			Service: []*Service{
+				{
+					Id:              fmt.Sprintf("%s#service-1", ValidTestDID),
+					ServiceType:     "CustomService",
+					ServiceEndpoint: []string{"https://example.com"},
+				},
+			},
+		}
+	})
+
+	addBlsKeyAndLinkedDomains := func(didDoc *DidDoc) {
+		didDoc.VerificationMethod = append(didDoc.VerificationMethod, &VerificationMethod{
+			Id:                     fmt.Sprintf("%s#fragment2", ValidTestDID),
+			VerificationMethodType: "Bls12381G2Key2020",
+			Controller:             ValidTestDID,
+			VerificationMaterial:   ValidBls12381G2MultibaseVerificationMaterial,
+		})
+		didDoc.Service = append(didDoc.Service, &Service{
+			Id:              fmt.Sprintf("%s#service-2", ValidTestDID),
+			ServiceType:     "LinkedDomains",
+			ServiceEndpoint: []string{"https://example.com"},
+		})
+	}
+
+	It("Accepts declared contexts of the used types", func() {
+		Expect(validation.Validate(didDoc.Context, HasRequiredContextsRule(&didDoc, nil))).To(Succeed())
+	})
+
+	It("Rejects missing contexts of the used types", func() {
+		addBlsKeyAndLinkedDomains(&didDoc)
+
+		err := validation.Validate(didDoc.Context, HasRequiredContextsRule(&didDoc, nil))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("contexts required by the used verification method and service types are missing: https://w3id.org/security/suites/bls12381-2020/v1, https://identity.foundation/.well-known/did-configuration/v1"))
+	})
+
+	It("Doesn't check documents without DID Core context", func() {
+		addBlsKeyAndLinkedDomains(&didDoc)
+		didDoc.Context = []string{"abc", "def"}
+
+		Expect(validation.Validate(didDoc.Context, HasRequiredContextsRule(&didDoc, nil))).To(Succeed())
+	})
+
+	It("Checks only types introduced since the previous version", func() {
+		previous := didDoc
+		previous.Context = []string{DIDCoreContext}
+
+		updated := previous
+		updated.Service = append([]*Service{}, previous.Service...)
+		updated.Service = append(updated.Service, &Service{
+			Id:              fmt.Sprintf("%s#service-2", ValidTestDID),
+			ServiceType:     "CustomService",
+			ServiceEndpoint: []string{"https://example.com"},
+		})
+		Expect(validation.Validate(updated.Context, HasRequiredContextsRule(&updated, &previous))).To(Succeed())
+
+		addBlsKeyAndLinkedDomains(&updated)
+		err := validation.Validate(updated.Context, HasRequiredContextsRule(&updated, &previous))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(BbsBlsSignature2020Context))
+		Expect(err.Error()).NotTo(ContainSubstring(Ed25519Signature2020Context))
+	})
+})
+
+var _ = Describe("DIDDoc context auto-completion", func() {
+	It("Adds DID Core context and the contexts of the used types", func() {
+		didDoc := DidDoc{
+			Id: ValidTestDID,
+			VerificationMethod: []*VerificationMethod{
+				{
+					Id:                     fmt.Sprintf("%s#fragment0", ValidTestDID),
+					VerificationMethodType: "JsonWebKey2020",
+					Controller:             ValidTestDID,
+					VerificationMaterial:   ValidJWK2020VerificationMaterial,
+				},
+				{
+					Id:                     fmt.Sprintf("%s#fragment1", ValidTestDID),
+					VerificationMethodType: "JsonWebKey2020",
+					Controller:             ValidTestDID,
+					VerificationMaterial:   ValidJWK2020VerificationMaterial,
+				},
+			},
+		}
+
+		didDoc.AutoCompleteContext()
+		Expect(didDoc.Context).To(Equal([]string{DIDCoreContext, JSONWebSignature2020Context}))
+		Expect(didDoc.Validate(nil)).To(BeNil())
+	})
+
+	It("Keeps the declared contexts", func() {
+		didDoc := DidDoc{
+			Context: []string{"https://example.com/context/v1", MultikeyContext},
+			Id:      ValidTestDID,
+			VerificationMethod: []*VerificationMethod{
+				{
+					Id:                     fmt.Sprintf("%s#fragment0", ValidTestDID),
+					VerificationMethodType: "Multikey",
+					Controller:             ValidTestDID,
+					VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+				},
+			},
+		}
+
+		didDoc.AutoCompleteContext()
+		Expect(didDoc.Context).To(Equal([]string{"https://example.com/context/v1", MultikeyContext}))
+	})
+})
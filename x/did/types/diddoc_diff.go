@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+)
+
+// NewDidDocDiff summarizes changes of verification methods and services between two versions of a diddoc.
+// Pass nil as the old version to list everything in the new version as added.
+func NewDidDocDiff(old *DidDoc, new *DidDoc) DidDocDiff {
+	var diff DidDocDiff
+
+	oldVMs := map[string]interface{}{}
+	oldServices := map[string]interface{}{}
+
+	if old != nil {
+		for _, vm := range old.AllVerificationMethods() {
+			oldVMs[vm.Id] = vm
+		}
+
+		for _, service := range old.Service {
+			oldServices[service.Id] = service
+		}
+	}
+
+	newVMs := map[string]interface{}{}
+	for _, vm := range new.AllVerificationMethods() {
+		newVMs[vm.Id] = vm
+	}
+
+	newServices := map[string]interface{}{}
+	for _, service := range new.Service {
+		newServices[service.Id] = service
+	}
+
+	diff.AddedVerificationMethods, diff.RemovedVerificationMethods, diff.ChangedVerificationMethods = diffByID(oldVMs, newVMs)
+	diff.AddedServices, diff.RemovedServices, diff.ChangedServices = diffByID(oldServices, newServices)
+
+	return diff
+}
+
+func diffByID(old map[string]interface{}, new map[string]interface{}) (added []string, removed []string, changed []string) {
+	for id, newItem := range new {
+		oldItem, found := old[id]
+
+		switch {
+		case !found:
+			added = append(added, id)
+		case !reflect.DeepEqual(oldItem, newItem):
+			changed = append(changed, id)
+		}
+	}
+
+	for id := range old {
+		if _, found := new[id]; !found {
+			removed = append(removed, id)
+		}
+	}
+
+	// Map iteration order is random, but events have to be deterministic
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+
+	return added, removed, changed
+}
+
+// IsServiceOnlyChange checks whether two versions of a diddoc differ in services only
+func IsServiceOnlyChange(old *DidDoc, new *DidDoc) bool {
+	oldWithoutServices := *old
+	oldWithoutServices.Service = nil
+
+	newWithoutServices := *new
+	newWithoutServices.Service = nil
+
+	// Compare encoded versions, so that nil and empty lists are treated as equal
+	oldBytes, err := oldWithoutServices.Marshal()
+	if err != nil {
+		return false
+	}
+
+	newBytes, err := newWithoutServices.Marshal()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(oldBytes, newBytes)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/multiformats/go-multibase"
+)
+
+// GetAuthenticationKeyFingerprints returns unique fingerprints of the authentication keys of the diddoc.
+// Keys that can't be used for signing, e.g. key agreement ones, are skipped.
+func (didDoc *DidDoc) GetAuthenticationKeyFingerprints() ([]string, error) {
+	var fingerprints []string
+
+	for _, vm := range FilterSigningVerificationMethods(didDoc.AuthenticationMethods()) {
+		fingerprint, err := vm.PublicKeyFingerprint()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", vm.Id, err.Error())
+		}
+
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	return utils.UniqueSorted(fingerprints), nil
+}
+
+// GetNextKeyCommitment returns the pre-rotation commitment to the authentication key set of the diddoc.
+// It's expected to be published in a previous version of the diddoc, before the keys are used.
+func (didDoc *DidDoc) GetNextKeyCommitment() (string, error) {
+	fingerprints, err := didDoc.GetAuthenticationKeyFingerprints()
+	if err != nil {
+		return "", err
+	}
+
+	return GetNextKeyCommitment(fingerprints), nil
+}
+
+// GetNextKeyCommitment returns multibase (base58btc) encoded sha2-256 multihash of the sorted comma separated key fingerprints.
+// The commitment doesn't depend on the order of the keys and on the verification method types they are represented with.
+func GetNextKeyCommitment(fingerprints []string) string {
+	fingerprints = utils.UniqueSorted(fingerprints)
+	multihash := utils.Sha256Multihash([]byte(strings.Join(fingerprints, ",")))
+
+	commitment, err := multibase.Encode(multibase.Base58BTC, multihash)
+	if err != nil {
+		panic(err)
+	}
+
+	return commitment
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	testsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	. "github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Next key commitment", func() {
+	firstKey := &VerificationMethod{
+		Id:                     ValidTestDID + "#key-1",
+		VerificationMethodType: Ed25519VerificationKey2020Type,
+		Controller:             ValidTestDID,
+		VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+	}
+	secondKey := &VerificationMethod{
+		Id:                     ValidTestDID + "#key-2",
+		VerificationMethodType: EcdsaSecp256k1VerificationKey2019Type,
+		Controller:             ValidTestDID,
+		VerificationMaterial:   ValidSecp256k1MultibaseVerificationMaterial,
+	}
+
+	mustCommit := func(didDoc DidDoc) string {
+		commitment, err := didDoc.GetNextKeyCommitment()
+		Expect(err).To(BeNil())
+
+		return commitment
+	}
+
+	It("Is a multibase encoded sha2-256 multihash", func() {
+		didDoc := DidDoc{Id: ValidTestDID, VerificationMethod: []*VerificationMethod{firstKey}}
+
+		commitment, err := didDoc.GetNextKeyCommitment()
+		Expect(err).To(BeNil())
+		Expect(utils.ValidateMultibaseSha256Multihash(commitment)).To(BeNil())
+	})
+
+	It("Doesn't depend on the order of the keys and where they are defined", func() {
+		didDoc := DidDoc{Id: ValidTestDID, VerificationMethod: []*VerificationMethod{firstKey, secondKey}}
+		reordered := DidDoc{
+			Id:                 ValidTestDID,
+			VerificationMethod: []*VerificationMethod{secondKey},
+			Authentication:     []*VerificationRelationship{{VerificationMethod: firstKey}},
+		}
+
+		Expect(mustCommit(didDoc)).To(Equal(mustCommit(reordered)))
+	})
+
+	It("Skips key agreement keys", func() {
+		didDoc := DidDoc{Id: ValidTestDID, VerificationMethod: []*VerificationMethod{firstKey}}
+		withKeyAgreement := DidDoc{
+			Id: ValidTestDID,
+			VerificationMethod: []*VerificationMethod{firstKey, {
+				Id:                     ValidTestDID + "#key-agreement",
+				VerificationMethodType: X25519KeyAgreementKey2019Type,
+				Controller:             ValidTestDID,
+				VerificationMaterial:   testsetup.GenerateEd25519VerificationKey2018VerificationMaterial(testsetup.GenerateKeyPair().Public),
+			}},
+		}
+
+		Expect(mustCommit(didDoc)).To(Equal(mustCommit(withKeyAgreement)))
+	})
+
+	It("Changes with the keys", func() {
+		didDoc := DidDoc{Id: ValidTestDID, VerificationMethod: []*VerificationMethod{firstKey}}
+		withSecondKey := DidDoc{Id: ValidTestDID, VerificationMethod: []*VerificationMethod{firstKey, secondKey}}
+
+		Expect(mustCommit(didDoc)).ToNot(Equal(mustCommit(withSecondKey)))
+	})
+})
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,3 +17,12 @@ func (m *Metadata) Update(ctx sdk.Context, version string) {
 	m.Updated = &updated
 	m.VersionId = version
 }
+
+// Timestamp returns the moment the version described by the metadata became active
+func (m Metadata) Timestamp() time.Time {
+	if m.Updated != nil {
+		return *m.Updated
+	}
+
+	return m.Created
+}
@@ -0,0 +1,255 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+
+	// PatchIndexEnd refers to the position after the last item of a list
+	PatchIndexEnd = "-"
+)
+
+const (
+	PatchPropertyVerificationMethod   = "verificationMethod"
+	PatchPropertyAuthentication       = "authentication"
+	PatchPropertyAssertionMethod      = "assertionMethod"
+	PatchPropertyCapabilityInvocation = "capabilityInvocation"
+	PatchPropertyCapabilityDelegation = "capabilityDelegation"
+	PatchPropertyKeyAgreement         = "keyAgreement"
+	PatchPropertyService              = "service"
+	PatchPropertyAlsoKnownAs          = "alsoKnownAs"
+	PatchPropertyContext              = "context"
+)
+
+// ParsePath splits the path of the operation into the DID Document property and the item index
+func (op PatchOperation) ParsePath() (property string, index string, err error) {
+	parts := strings.Split(op.Path, "/")
+	if len(parts) != 3 || parts[0] != "" {
+		return "", "", fmt.Errorf("path must have the form /<property>/<index>: %s", op.Path)
+	}
+
+	property, index = parts[1], parts[2]
+
+	if index != PatchIndexEnd {
+		if _, err := strconv.ParseUint(index, 10, 32); err != nil {
+			return "", "", fmt.Errorf("invalid index in path: %s", op.Path)
+		}
+	}
+
+	return property, index, nil
+}
+
+// Validate checks that the operation is well-formed. Validity of the resulting DID Document is checked separately.
+func (op PatchOperation) Validate() error {
+	property, index, err := op.ParsePath()
+	if err != nil {
+		return err
+	}
+
+	switch op.Op {
+	case PatchOpAdd:
+	case PatchOpRemove, PatchOpReplace:
+		if index == PatchIndexEnd {
+			return fmt.Errorf("index %s can be used only with %s operation", PatchIndexEnd, PatchOpAdd)
+		}
+	default:
+		return fmt.Errorf("unsupported operation: %s", op.Op)
+	}
+
+	value, err := op.value(property)
+	if err != nil {
+		return err
+	}
+
+	if op.valueCount() > 1 {
+		return errors.New("only one value must be set")
+	}
+
+	if op.Op == PatchOpRemove {
+		if op.valueCount() != 0 {
+			return errors.New("value must not be set for remove operation")
+		}
+
+		return nil
+	}
+
+	if reflect.ValueOf(value).IsZero() {
+		return fmt.Errorf("value for %s is required", property)
+	}
+
+	return nil
+}
+
+// Apply applies the operation to the DID Document
+func (op PatchOperation) Apply(didDoc *DidDoc) error {
+	property, index, err := op.ParsePath()
+	if err != nil {
+		return err
+	}
+
+	list, err := didDoc.patchableList(property)
+	if err != nil {
+		return err
+	}
+
+	value, err := op.value(property)
+	if err != nil {
+		return err
+	}
+
+	items := reflect.ValueOf(list).Elem()
+	length := items.Len()
+
+	position := length
+	if index != PatchIndexEnd {
+		parsed, _ := strconv.Atoi(index)
+		position = parsed
+	}
+
+	switch op.Op {
+	case PatchOpAdd:
+		if position > length {
+			return fmt.Errorf("index out of range: %s", op.Path)
+		}
+
+		// Insert value at the position
+		result := reflect.MakeSlice(items.Type(), 0, length+1)
+		result = reflect.AppendSlice(result, items.Slice(0, position))
+		result = reflect.Append(result, reflect.ValueOf(value))
+		result = reflect.AppendSlice(result, items.Slice(position, length))
+		items.Set(result)
+	case PatchOpRemove:
+		if position >= length {
+			return fmt.Errorf("index out of range: %s", op.Path)
+		}
+
+		result := reflect.MakeSlice(items.Type(), 0, length-1)
+		result = reflect.AppendSlice(result, items.Slice(0, position))
+		result = reflect.AppendSlice(result, items.Slice(position+1, length))
+		items.Set(result)
+	case PatchOpReplace:
+		if position >= length {
+			return fmt.Errorf("index out of range: %s", op.Path)
+		}
+
+		items.Index(position).Set(reflect.ValueOf(value))
+	default:
+		return fmt.Errorf("unsupported operation: %s", op.Op)
+	}
+
+	return nil
+}
+
+// value returns the value of the operation matching the property
+func (op PatchOperation) value(property string) (interface{}, error) {
+	switch property {
+	case PatchPropertyVerificationMethod:
+		return op.VerificationMethod, nil
+	case PatchPropertyAuthentication, PatchPropertyAssertionMethod, PatchPropertyCapabilityInvocation,
+		PatchPropertyCapabilityDelegation, PatchPropertyKeyAgreement:
+		return op.VerificationRelationship, nil
+	case PatchPropertyService:
+		return op.Service, nil
+	case PatchPropertyAlsoKnownAs:
+		return op.AlsoKnownAs, nil
+	case PatchPropertyContext:
+		return op.Context, nil
+	default:
+		return nil, fmt.Errorf("unsupported property: %s", property)
+	}
+}
+
+func (op PatchOperation) valueCount() int {
+	count := 0
+
+	if op.VerificationMethod != nil {
+		count++
+	}
+
+	if op.VerificationRelationship != nil {
+		count++
+	}
+
+	if op.Service != nil {
+		count++
+	}
+
+	if op.AlsoKnownAs != "" {
+		count++
+	}
+
+	if op.Context != "" {
+		count++
+	}
+
+	return count
+}
+
+// patchableList returns a pointer to the list property of the DID Document
+func (didDoc *DidDoc) patchableList(property string) (interface{}, error) {
+	switch property {
+	case PatchPropertyVerificationMethod:
+		return &didDoc.VerificationMethod, nil
+	case PatchPropertyAuthentication:
+		return &didDoc.Authentication, nil
+	case PatchPropertyAssertionMethod:
+		return &didDoc.AssertionMethod, nil
+	case PatchPropertyCapabilityInvocation:
+		return &didDoc.CapabilityInvocation, nil
+	case PatchPropertyCapabilityDelegation:
+		return &didDoc.CapabilityDelegation, nil
+	case PatchPropertyKeyAgreement:
+		return &didDoc.KeyAgreement, nil
+	case PatchPropertyService:
+		return &didDoc.Service, nil
+	case PatchPropertyAlsoKnownAs:
+		return &didDoc.AlsoKnownAs, nil
+	case PatchPropertyContext:
+		return &didDoc.Context, nil
+	default:
+		return nil, fmt.Errorf("unsupported property: %s", property)
+	}
+}
+
+// ApplyPatch returns a copy of the DID Document with the operations applied in order.
+// Neither the DID Document nor the operations are modified.
+func (didDoc DidDoc) ApplyPatch(operations []*PatchOperation) (DidDoc, error) {
+	result, err := didDoc.clone()
+	if err != nil {
+		return DidDoc{}, err
+	}
+
+	for i, op := range operations {
+		err := op.Apply(&result)
+		if err != nil {
+			return DidDoc{}, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	// Values of the operations are shared with the result until it is cloned
+	return result.clone()
+}
+
+// clone returns a deep copy of the DID Document
+func (didDoc DidDoc) clone() (DidDoc, error) {
+	bz, err := didDoc.Marshal()
+	if err != nil {
+		return DidDoc{}, err
+	}
+
+	var result DidDoc
+	err = result.Unmarshal(bz)
+	if err != nil {
+		return DidDoc{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,137 @@
+package types_test
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DID Doc patch tests", func() {
+	const did = "did:canow:testnet:zABCDEFG123456789abcd"
+
+	var didDoc DidDoc
+
+	BeforeEach(func() {
+		didDoc = DidDoc{
+			Id: did,
+			VerificationMethod: []*VerificationMethod{
+				{Id: did + "#key-1", VerificationMethodType: Ed25519VerificationKey2020Type, Controller: did},
+			},
+			Authentication: []*VerificationRelationship{
+				{VerificationMethodId: did + "#key-1"},
+			},
+			AlsoKnownAs: []string{"https://a.example.com", "https://b.example.com"},
+		}
+	})
+
+	type TestCaseValidatePatchOperation struct {
+		op       PatchOperation
+		isValid  bool
+		errorMsg string
+	}
+
+	DescribeTable("Operation validation tests", func(testCase TestCaseValidatePatchOperation) {
+		err := testCase.op.Validate()
+
+		if testCase.isValid {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(testCase.errorMsg))
+		}
+	},
+
+		Entry(
+			"Valid: add to the end",
+			TestCaseValidatePatchOperation{
+				op:      PatchOperation{Op: PatchOpAdd, Path: "/alsoKnownAs/-", AlsoKnownAs: "https://c.example.com"},
+				isValid: true,
+			}),
+
+		Entry(
+			"Valid: add a context",
+			TestCaseValidatePatchOperation{
+				op:      PatchOperation{Op: PatchOpAdd, Path: "/context/-", Context: JSONWebSignature2020Context},
+				isValid: true,
+			}),
+
+		Entry(
+			"Valid: remove by index",
+			TestCaseValidatePatchOperation{
+				op:      PatchOperation{Op: PatchOpRemove, Path: "/service/1"},
+				isValid: true,
+			}),
+
+		Entry(
+			"Not valid: unsupported operation",
+			TestCaseValidatePatchOperation{
+				op:       PatchOperation{Op: "move", Path: "/alsoKnownAs/0", AlsoKnownAs: "https://c.example.com"},
+				isValid:  false,
+				errorMsg: "unsupported operation",
+			}),
+
+		Entry(
+			"Not valid: unsupported property",
+			TestCaseValidatePatchOperation{
+				op:       PatchOperation{Op: PatchOpRemove, Path: "/controller/0"},
+				isValid:  false,
+				errorMsg: "unsupported property",
+			}),
+
+		Entry(
+			"Not valid: whole list path",
+			TestCaseValidatePatchOperation{
+				op:       PatchOperation{Op: PatchOpRemove, Path: "/service"},
+				isValid:  false,
+				errorMsg: "path must have the form",
+			}),
+
+		Entry(
+			"Not valid: end index in replace",
+			TestCaseValidatePatchOperation{
+				op:       PatchOperation{Op: PatchOpReplace, Path: "/alsoKnownAs/-", AlsoKnownAs: "https://c.example.com"},
+				isValid:  false,
+				errorMsg: "can be used only with add",
+			}),
+
+		Entry(
+			"Not valid: value doesn't match the path",
+			TestCaseValidatePatchOperation{
+				op:       PatchOperation{Op: PatchOpAdd, Path: "/service/-", AlsoKnownAs: "https://c.example.com"},
+				isValid:  false,
+				errorMsg: "value for service is required",
+			}),
+
+		Entry(
+			"Not valid: value in remove",
+			TestCaseValidatePatchOperation{
+				op:       PatchOperation{Op: PatchOpRemove, Path: "/alsoKnownAs/0", AlsoKnownAs: "https://c.example.com"},
+				isValid:  false,
+				errorMsg: "value must not be set",
+			}),
+	)
+
+	It("Applies operations in order without modifying the original", func() {
+		patched, err := didDoc.ApplyPatch([]*PatchOperation{
+			{Op: PatchOpAdd, Path: "/alsoKnownAs/0", AlsoKnownAs: "https://c.example.com"},
+			{Op: PatchOpRemove, Path: "/alsoKnownAs/1"},
+			{Op: PatchOpReplace, Path: "/alsoKnownAs/1", AlsoKnownAs: "https://d.example.com"},
+			{Op: PatchOpAdd, Path: "/assertionMethod/-", VerificationRelationship: &VerificationRelationship{VerificationMethodId: did + "#key-1"}},
+		})
+		Expect(err).To(BeNil())
+
+		Expect(patched.AlsoKnownAs).To(Equal([]string{"https://c.example.com", "https://d.example.com"}))
+		Expect(patched.AssertionMethod).To(HaveLen(1))
+
+		Expect(didDoc.AlsoKnownAs).To(Equal([]string{"https://a.example.com", "https://b.example.com"}))
+		Expect(didDoc.AssertionMethod).To(BeEmpty())
+	})
+
+	It("Fails on out of range index", func() {
+		_, err := didDoc.ApplyPatch([]*PatchOperation{
+			{Op: PatchOpRemove, Path: "/verificationMethod/1"},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("operation 0: index out of range"))
+	})
+})
@@ -0,0 +1,273 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+const (
+	DIDCoreContext = "https://www.w3.org/ns/did/v1"
+
+	// Representations of a DID Document
+	DIDJSONLDContentType = "application/did+ld+json"
+	DIDJSONContentType   = "application/did+json"
+	JSONLDContentType    = "application/ld+json"
+	AnyContentType       = "*/*"
+)
+
+// Error codes of the DID resolution process.
+// Documentation: https://www.w3.org/TR/did-spec-registries/#error
+const (
+	ResolutionErrorInvalidDid                 = "invalidDid"
+	ResolutionErrorInvalidDidURL              = "invalidDidUrl"
+	ResolutionErrorNotFound                   = "notFound"
+	ResolutionErrorRepresentationNotSupported = "representationNotSupported"
+	ResolutionErrorMethodNotSupported         = "methodNotSupported"
+	ResolutionErrorInvalidOptions             = "invalidOptions"
+	ResolutionErrorInternalError              = "internalError"
+)
+
+var SupportedDIDContentTypes = []string{
+	DIDJSONLDContentType,
+	DIDJSONContentType,
+}
+
+// NormalizeDIDContentType maps an accept value to one of SupportedDIDContentTypes.
+// Returns false if the requested representation is not supported.
+func NormalizeDIDContentType(accept string) (string, bool) {
+	// Media type parameters (e.g. profile) don't affect the DID Document representation
+	mediaType := strings.TrimSpace(strings.Split(accept, ";")[0])
+
+	switch mediaType {
+	case "", AnyContentType, DIDJSONLDContentType, JSONLDContentType:
+		return DIDJSONLDContentType, true
+	case DIDJSONContentType:
+		return DIDJSONContentType, true
+	default:
+		return "", false
+	}
+}
+
+func NewDidResolutionMetadata(did string, contentType string, retrieved time.Time) DidResolutionMetadata {
+	metadata := DidResolutionMetadata{
+		ContentType: contentType,
+		Retrieved:   retrieved,
+	}
+
+	method, namespace, id, err := utils.TrySplitDID(did)
+	if err == nil {
+		methodSpecificID := id
+		if namespace != "" {
+			methodSpecificID = namespace + ":" + id
+		}
+
+		metadata.Did = &DidProperties{
+			DidString:        did,
+			MethodSpecificId: methodSpecificID,
+			Method:           method,
+		}
+	}
+
+	return metadata
+}
+
+// W3C representation
+
+type w3cDidDoc struct {
+	Context              []string                `json:"@context,omitempty"`
+	ID                   string                  `json:"id"`
+	Controller           []string                `json:"controller,omitempty"`
+	VerificationMethod   []w3cVerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication       []interface{}           `json:"authentication,omitempty"`
+	AssertionMethod      []interface{}           `json:"assertionMethod,omitempty"`
+	CapabilityInvocation []interface{}           `json:"capabilityInvocation,omitempty"`
+	CapabilityDelegation []interface{}           `json:"capabilityDelegation,omitempty"`
+	KeyAgreement         []interface{}           `json:"keyAgreement,omitempty"`
+	Service              []w3cService            `json:"service,omitempty"`
+	AlsoKnownAs          []string                `json:"alsoKnownAs,omitempty"`
+	ControllerThreshold  uint32                  `json:"controllerThreshold,omitempty"`
+}
+
+type w3cVerificationMethod struct {
+	ID                 string          `json:"id"`
+	Type               string          `json:"type"`
+	Controller         string          `json:"controller"`
+	PublicKeyBase58    string          `json:"publicKeyBase58,omitempty"`
+	PublicKeyMultibase string          `json:"publicKeyMultibase,omitempty"`
+	PublicKeyJwk       json.RawMessage `json:"publicKeyJwk,omitempty"`
+}
+
+type w3cService struct {
+	ID              string          `json:"id"`
+	Type            string          `json:"type"`
+	ServiceEndpoint json.RawMessage `json:"serviceEndpoint"`
+	Accept          []string        `json:"accept,omitempty"`
+	RoutingKeys     []string        `json:"routingKeys,omitempty"`
+}
+
+// MarshalW3CJSON serializes the DID Document according to the DID Core specification
+// in one of SupportedDIDContentTypes representations.
+func (didDoc DidDoc) MarshalW3CJSON(contentType string) ([]byte, error) {
+	doc := w3cDidDoc{
+		ID:                   didDoc.Id,
+		Controller:           didDoc.Controller,
+		Authentication:       toW3CVerificationRelationships(didDoc.Authentication),
+		AssertionMethod:      toW3CVerificationRelationships(didDoc.AssertionMethod),
+		CapabilityInvocation: toW3CVerificationRelationships(didDoc.CapabilityInvocation),
+		CapabilityDelegation: toW3CVerificationRelationships(didDoc.CapabilityDelegation),
+		KeyAgreement:         toW3CVerificationRelationships(didDoc.KeyAgreement),
+		AlsoKnownAs:          didDoc.AlsoKnownAs,
+		ControllerThreshold:  didDoc.ControllerThreshold,
+	}
+
+	switch contentType {
+	case DIDJSONLDContentType:
+		doc.Context = didDoc.Context
+		if !utils.Contains(doc.Context, DIDCoreContext) {
+			doc.Context = append([]string{DIDCoreContext}, doc.Context...)
+		}
+	case DIDJSONContentType:
+		// Plain JSON representation doesn't have @context
+	default:
+		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+	}
+
+	for _, vm := range didDoc.VerificationMethod {
+		doc.VerificationMethod = append(doc.VerificationMethod, toW3CVerificationMethod(vm))
+	}
+
+	for _, service := range didDoc.Service {
+		w3cService, err := toW3CService(service)
+		if err != nil {
+			return nil, err
+		}
+
+		doc.Service = append(doc.Service, w3cService)
+	}
+
+	return json.Marshal(doc)
+}
+
+// MarshalW3CJSON serializes the verification method according to the DID Core specification.
+func (vm VerificationMethod) MarshalW3CJSON() ([]byte, error) {
+	return json.Marshal(toW3CVerificationMethod(&vm))
+}
+
+// MarshalW3CJSON serializes the service according to the DID Core specification.
+func (s Service) MarshalW3CJSON() ([]byte, error) {
+	w3cService, err := toW3CService(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(w3cService)
+}
+
+// UnmarshalW3CJSON deserializes the service represented according to the DID Core specification.
+// A list of URIs as serviceEndpoint, optionally with the legacy accept and routingKeys properties, is kept in the legacy fields,
+// so such services are stored and rendered as before the endpoint was introduced.
+func (s *Service) UnmarshalW3CJSON(data []byte) error {
+	var w3cService w3cService
+
+	err := json.Unmarshal(data, &w3cService)
+	if err != nil {
+		return err
+	}
+
+	*s = Service{
+		Id:          w3cService.ID,
+		ServiceType: w3cService.Type,
+		Accept:      w3cService.Accept,
+		RoutingKeys: w3cService.RoutingKeys,
+	}
+
+	var uris []string
+	if json.Unmarshal(w3cService.ServiceEndpoint, &uris) == nil {
+		s.ServiceEndpoint = uris
+	} else if len(s.Accept) > 0 || len(s.RoutingKeys) > 0 {
+		return fmt.Errorf("accept and routingKeys must be set in the serviceEndpoint map if it isn't a list of URIs")
+	} else {
+		s.Endpoint, err = ParseServiceEndpointJSON(w3cService.ServiceEndpoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	NormalizeService(s)
+
+	return nil
+}
+
+func toW3CVerificationMethod(vm *VerificationMethod) w3cVerificationMethod {
+	result := w3cVerificationMethod{
+		ID:         vm.Id,
+		Type:       vm.VerificationMethodType,
+		Controller: vm.Controller,
+	}
+
+	switch vm.VerificationMethodType {
+	case Ed25519VerificationKey2018Type, X25519KeyAgreementKey2019Type:
+		result.PublicKeyBase58 = vm.VerificationMaterial
+	case JSONWebKey2020Type, JSONWebKeyType:
+		result.PublicKeyJwk = json.RawMessage(vm.VerificationMaterial)
+	case EcdsaSecp256k1VerificationKey2019Type:
+		if utils.IsJSONObject(vm.VerificationMaterial) {
+			result.PublicKeyJwk = json.RawMessage(vm.VerificationMaterial)
+		} else {
+			result.PublicKeyMultibase = vm.VerificationMaterial
+		}
+	default:
+		result.PublicKeyMultibase = vm.VerificationMaterial
+	}
+
+	return result
+}
+
+func toW3CVerificationRelationships(vrs []*VerificationRelationship) []interface{} {
+	var result []interface{}
+
+	for _, vr := range vrs {
+		if vr.VerificationMethod != nil {
+			result = append(result, toW3CVerificationMethod(vr.VerificationMethod))
+		} else {
+			result = append(result, vr.VerificationMethodId)
+		}
+	}
+
+	return result
+}
+
+// toW3CService renders the endpoint in the DID Core form. Services without the endpoint are rendered from the legacy fields
+// exactly as before the endpoint was introduced.
+func toW3CService(service *Service) (w3cService, error) {
+	result := w3cService{
+		ID:   service.Id,
+		Type: service.ServiceType,
+	}
+
+	if service.Endpoint != nil {
+		endpoint, err := service.Endpoint.MarshalW3CJSON()
+		if err != nil {
+			return w3cService{}, err
+		}
+
+		result.ServiceEndpoint = endpoint
+
+		return result, nil
+	}
+
+	endpoint, err := json.Marshal(service.ServiceEndpoint)
+	if err != nil {
+		return w3cService{}, err
+	}
+
+	result.ServiceEndpoint = endpoint
+	result.Accept = service.Accept
+	result.RoutingKeys = service.RoutingKeys
+
+	return result, nil
+}
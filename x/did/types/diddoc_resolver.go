@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+// DidResolver derives DID Documents of a DID method in-process, without looking them up on the ledger.
+// Derived DID Documents can act as controllers and signers of DID Documents on the ledger.
+type DidResolver interface {
+	// Method is the DID method the resolver supports, e.g. key
+	Method() string
+	// Resolve deterministically derives the DID Document from the DID. The DID must be of the resolver's method.
+	Resolve(did string) (*DidDoc, error)
+}
+
+// derivedDidResolvers are the resolvers of DID methods which DID Documents are derived from the DIDs themselves
+var derivedDidResolvers = map[string]DidResolver{
+	DidKeyMethod: DidKeyResolver{},
+	DidJWKMethod: DidJWKResolver{},
+}
+
+// GetDerivedDidResolver returns the resolver of the DID method if its DID Documents are derived in-process
+func GetDerivedDidResolver(method string) (DidResolver, bool) {
+	resolver, found := derivedDidResolvers[method]
+	return resolver, found
+}
+
+// IsDerivedDid checks whether the DID Document of the DID is derived in-process instead of being stored on the ledger.
+// It doesn't check that the DID itself is valid.
+func IsDerivedDid(did string) bool {
+	method, _, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		return false
+	}
+
+	_, found := GetDerivedDidResolver(method)
+	return found
+}
+
+// ResolveDerivedDid derives the DID Document of the DID in-process
+func ResolveDerivedDid(did string) (*DidDoc, error) {
+	method, _, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, found := GetDerivedDidResolver(method)
+	if !found {
+		return nil, fmt.Errorf("did method %s is not resolved in-process", method)
+	}
+
+	return resolver.Resolve(did)
+}
+
+// ValidateControllerDID accepts DIDs of the ledger in the allowed namespaces and DIDs which DID Documents are derived in-process
+func ValidateControllerDID(did string, allowedNamespaces []string) error {
+	if IsDerivedDid(did) {
+		_, err := ResolveDerivedDid(did)
+		return err
+	}
+
+	return utils.ValidateDID(did, DidMethod, allowedNamespaces)
+}
+
+// ValidateSignerDIDUrl accepts verification method ids of DIDs of the ledger in the allowed namespaces
+// and of DIDs which DID Documents are derived in-process
+func ValidateSignerDIDUrl(didURL string, allowedNamespaces []string) error {
+	did, path, query, fragment, err := utils.TrySplitDIDUrl(didURL)
+	if err != nil {
+		return err
+	}
+
+	if !IsDerivedDid(did) {
+		return validateDIDUrl(didURL, DidMethod, allowedNamespaces, Empty, Empty, Required)
+	}
+
+	if path != "" || query != "" || fragment == "" {
+		return fmt.Errorf("verification method id of %s must consist of the did and a fragment", did)
+	}
+
+	didDoc, err := ResolveDerivedDid(did)
+	if err != nil {
+		return err
+	}
+
+	if _, found := FindVerificationMethod(didDoc.VerificationMethod, didURL); !found {
+		return fmt.Errorf("%s is not a verification method of %s", didURL, did)
+	}
+
+	return nil
+}
+
+func newDerivedDidDoc(did string, context string, vm *VerificationMethod, signing bool, keyAgreement bool) *DidDoc {
+	didDoc := &DidDoc{
+		Context:            []string{DIDCoreContext, context},
+		Id:                 did,
+		VerificationMethod: []*VerificationMethod{vm},
+	}
+
+	reference := func() []*VerificationRelationship {
+		return []*VerificationRelationship{{VerificationMethodId: vm.Id}}
+	}
+
+	if signing {
+		didDoc.Authentication = reference()
+		didDoc.AssertionMethod = reference()
+		didDoc.CapabilityInvocation = reference()
+		didDoc.CapabilityDelegation = reference()
+	}
+
+	if keyAgreement {
+		didDoc.KeyAgreement = reference()
+	}
+
+	return didDoc
+}
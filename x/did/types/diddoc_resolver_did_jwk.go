@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+// DidJWKMethod is the did:jwk method. The DID is the base64url encoded public JWK.
+// Documentation: https://github.com/quartzjer/did-jwk/blob/main/spec.md
+const DidJWKMethod = "jwk"
+
+// DidJWKVerificationMethodFragment is the fragment of the only verification method of did:jwk DID Documents
+const DidJWKVerificationMethodFragment = "0"
+
+// DidJWKResolver derives did:jwk DID Documents with a single JsonWebKey2020 verification method.
+// The "use" member of the JWK limits the verification relationships: "sig" keys can't be used for key agreement
+// and "enc" keys are used for key agreement only. Without "use", X25519 keys are used for key agreement
+// and other keys for signing.
+type DidJWKResolver struct{}
+
+var _ DidResolver = DidJWKResolver{}
+
+func (DidJWKResolver) Method() string {
+	return DidJWKMethod
+}
+
+func (DidJWKResolver) Resolve(did string) (*DidDoc, error) {
+	method, namespace, encodedJWK, err := utils.TrySplitDID(did)
+	if err != nil {
+		return nil, err
+	}
+
+	if method != DidJWKMethod || namespace != "" {
+		return nil, fmt.Errorf("not a did:jwk: %s", did)
+	}
+
+	decodedJWK, err := base64.RawURLEncoding.DecodeString(encodedJWK)
+	if err != nil {
+		return nil, fmt.Errorf("invalid did:jwk %s: %w", did, err)
+	}
+
+	var members struct {
+		Use string  `json:"use"`
+		D   *string `json:"d"`
+	}
+
+	err = json.Unmarshal(decodedJWK, &members)
+	if err != nil || !utils.IsJSONObject(string(decodedJWK)) {
+		return nil, fmt.Errorf("invalid did:jwk %s: jwk must be a JSON object", did)
+	}
+
+	if members.D != nil {
+		return nil, fmt.Errorf("invalid did:jwk %s: jwk must not contain a private key", did)
+	}
+
+	err = utils.ValidateJWK(string(decodedJWK))
+	if err != nil {
+		return nil, fmt.Errorf("invalid did:jwk %s: %w", did, err)
+	}
+
+	vm := &VerificationMethod{
+		Id:                     did + "#" + DidJWKVerificationMethodFragment,
+		VerificationMethodType: JSONWebKey2020Type,
+		Controller:             did,
+		VerificationMaterial:   string(decodedJWK),
+	}
+
+	isKeyAgreementKey := utils.IsX25519JWK(string(decodedJWK))
+	signing := members.Use != "enc" && !isKeyAgreementKey
+	keyAgreement := members.Use == "enc" || (members.Use != "sig" && isKeyAgreementKey)
+
+	return newDerivedDidDoc(did, JSONWebSignature2020Context, vm, signing, keyAgreement), nil
+}
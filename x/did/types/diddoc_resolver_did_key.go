@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/canow-co/cheqd-node/x/did/utils/bls12381g2"
+)
+
+// DidKeyMethod is the did:key method. The DID is the multibase encoded multicodec public key.
+// Documentation: https://w3c-ccg.github.io/did-method-key/
+const DidKeyMethod = "key"
+
+// DidKeyResolver derives did:key DID Documents with a single Multikey verification method.
+// Supported keys are ed25519, secp256k1, P-256 and BLS12-381 G2. EC keys must be compressed.
+type DidKeyResolver struct{}
+
+var _ DidResolver = DidKeyResolver{}
+
+func (DidKeyResolver) Method() string {
+	return DidKeyMethod
+}
+
+func (DidKeyResolver) Resolve(did string) (*DidDoc, error) {
+	method, namespace, multibaseKey, err := utils.TrySplitDID(did)
+	if err != nil {
+		return nil, err
+	}
+
+	if method != DidKeyMethod || namespace != "" {
+		return nil, fmt.Errorf("not a did:key: %s", did)
+	}
+
+	code, _, err := utils.ParseMultikey(multibaseKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid did:key %s: %w", did, err)
+	}
+
+	switch code {
+	case utils.Ed25519PubCode, utils.Secp256k1PubCode, utils.P256PubCode, bls12381g2.Bls12381G2PubCode:
+	default:
+		return nil, fmt.Errorf("invalid did:key %s: unsupported multicodec: 0x%x", did, code)
+	}
+
+	vm := &VerificationMethod{
+		Id:                     did + "#" + multibaseKey,
+		VerificationMethodType: MultikeyType,
+		Controller:             did,
+		VerificationMaterial:   multibaseKey,
+	}
+
+	return newDerivedDidDoc(did, MultikeyContext, vm, true, false), nil
+}
@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"encoding/base64"
+
+	testsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	. "github.com/canow-co/cheqd-node/x/did/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Derived DID resolvers", func() {
+	didJWK := func(jwk string) string {
+		return "did:jwk:" + base64.RawURLEncoding.EncodeToString([]byte(jwk))
+	}
+
+	DescribeTable("did:key", func(multibaseKey string) {
+		did := "did:key:" + multibaseKey
+
+		didDoc, err := ResolveDerivedDid(did)
+		Expect(err).To(BeNil())
+
+		vm := &VerificationMethod{
+			Id:                     did + "#" + multibaseKey,
+			VerificationMethodType: MultikeyType,
+			Controller:             did,
+			VerificationMaterial:   multibaseKey,
+		}
+		reference := []*VerificationRelationship{{VerificationMethodId: vm.Id}}
+
+		Expect(didDoc).To(Equal(&DidDoc{
+			Context:              []string{DIDCoreContext, MultikeyContext},
+			Id:                   did,
+			VerificationMethod:   []*VerificationMethod{vm},
+			Authentication:       reference,
+			AssertionMethod:      reference,
+			CapabilityInvocation: reference,
+			CapabilityDelegation: reference,
+		}))
+	},
+		Entry("ed25519", ValidEd25519VerificationKey2020VerificationMaterial),
+		Entry("secp256k1", ValidSecp256k1MultibaseVerificationMaterial),
+		Entry("P-256", ValidP256MultikeyVerificationMaterial),
+		Entry("BLS12-381 G2", ValidBls12381G2MultibaseVerificationMaterial),
+	)
+
+	DescribeTable("Invalid DIDs", func(did string, errorMsg string) {
+		_, err := ResolveDerivedDid(did)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(errorMsg))
+	},
+		Entry("did:key with unsupported key", "did:key:"+ValidP384MultikeyVerificationMaterial, "unsupported multicodec: 0x1201"),
+		Entry("did:key with key agreement key", "did:key:"+ValidX25519KeyAgreementKey2020VerificationMaterial, "unsupported multicodec: 0xec"),
+		Entry("did:key with uncompressed key", "did:key:"+InvalidMultikeyVerificationMaterialUncompressed, "bad compressed public key length"),
+		Entry("did:key with namespace", "did:key:testnet:"+ValidEd25519VerificationKey2020VerificationMaterial, "not a did:key"),
+		Entry("did:jwk with private key", didJWK(`{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","d":"nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A"}`), "must not contain a private key"),
+		Entry("did:jwk with invalid jwk", didJWK(InvalidJwkVerificationMaterial), "invalid did:jwk"),
+		Entry("did:jwk which is not base64url", "did:jwk:e30=", "invalid did:jwk"),
+		Entry("did:jwk which is not an object", didJWK(`[]`), "jwk must be a JSON object"),
+		Entry("Not derived", ValidTestDID, "did method canow is not resolved in-process"),
+	)
+
+	DescribeTable("did:jwk", func(jwk string, signing bool, keyAgreement bool) {
+		did := didJWK(jwk)
+
+		didDoc, err := ResolveDerivedDid(did)
+		Expect(err).To(BeNil())
+
+		Expect(didDoc.Id).To(Equal(did))
+		Expect(didDoc.VerificationMethod).To(Equal([]*VerificationMethod{{
+			Id:                     did + "#0",
+			VerificationMethodType: JSONWebKey2020Type,
+			Controller:             did,
+			VerificationMaterial:   jwk,
+		}}))
+		Expect(didDoc.Authentication != nil).To(Equal(signing))
+		Expect(didDoc.CapabilityInvocation != nil).To(Equal(signing))
+		Expect(didDoc.KeyAgreement != nil).To(Equal(keyAgreement))
+	},
+		Entry("Ed25519", ValidEd25519JwkVerificationMaterial, true, false),
+		Entry("secp256k1", ValidSecp256k1JwkVerificationMaterial, true, false),
+		Entry("RSA for signing", ValidRsaJwkVerificationMaterial, true, false),
+		Entry("P-256 for encryption", ValidEcJwkVerificationMaterial, false, true),
+		Entry("X25519", ValidX25519JwkVerificationMaterial, false, true),
+	)
+
+	It("Accepts derived DIDs as controllers", func() {
+		Expect(ValidateControllerDID("did:key:"+ValidEd25519VerificationKey2020VerificationMaterial, []string{"testnet"})).To(Succeed())
+		Expect(ValidateControllerDID(didJWK(ValidEd25519JwkVerificationMaterial), []string{"testnet"})).To(Succeed())
+		Expect(ValidateControllerDID(ValidTestDID, []string{"testnet"})).To(Succeed())
+
+		Expect(ValidateControllerDID("did:key:"+ValidP384MultikeyVerificationMaterial, nil)).NotTo(Succeed())
+		Expect(ValidateControllerDID(ValidTestDID, []string{"mainnet"})).NotTo(Succeed())
+		Expect(ValidateControllerDID("did:example:"+ValidEd25519VerificationKey2020VerificationMaterial, nil)).NotTo(Succeed())
+	})
+
+	DescribeTable("Signer DID URLs", func(didURL string, errorMsg string) {
+		err := ValidateSignerDIDUrl(didURL, []string{"testnet"})
+		if errorMsg == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(errorMsg))
+		}
+	},
+		Entry("did:key verification method",
+			"did:key:"+ValidEd25519VerificationKey2020VerificationMaterial+"#"+ValidEd25519VerificationKey2020VerificationMaterial, ""),
+		Entry("did:jwk verification method", didJWK(ValidEd25519JwkVerificationMaterial)+"#0", ""),
+		Entry("Ledger DID verification method", ValidTestDID+"#key-1", ""),
+		Entry("Unknown did:jwk verification method", didJWK(ValidEd25519JwkVerificationMaterial)+"#1", "is not a verification method of"),
+		Entry("did:key without fragment", "did:key:"+ValidEd25519VerificationKey2020VerificationMaterial, "must consist of the did and a fragment"),
+		Entry("Ledger DID in another namespace", "did:canow:mainnet:zABCDEFG123456789abcd#key-1", "did namespace must be one of: testnet"),
+	)
+
+	It("Verifies signatures of did:key verification methods", func() {
+		keyPair := testsetup.GenerateKeyPair()
+		did := "did:key:" + testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public)
+
+		didDoc, err := ResolveDerivedDid(did)
+		Expect(err).To(BeNil())
+
+		message := []byte("message")
+		signature := testsetup.Sign(message, []testsetup.SignInput{{VerificationMethodID: didDoc.VerificationMethod[0].Id, Key: keyPair.Private}})
+
+		Expect(VerifySignature(*didDoc.VerificationMethod[0], message, signature[0].Signature)).To(Succeed())
+		Expect(VerifySignature(*didDoc.VerificationMethod[0], []byte("other message"), signature[0].Signature)).NotTo(Succeed())
+	})
+})
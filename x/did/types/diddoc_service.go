@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
+	"reflect"
 
 	"github.com/canow-co/cheqd-node/x/did/utils"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -15,6 +17,19 @@ func NewService(id string, serviceType string, serviceEndpoint []string) *Servic
 	}
 }
 
+// NewServiceWithEndpoint builds a service with the endpoint in the DID Core form
+func NewServiceWithEndpoint(id string, serviceType string, endpoint *ServiceEndpoint) *Service {
+	service := &Service{
+		Id:          id,
+		ServiceType: serviceType,
+		Endpoint:    endpoint,
+	}
+
+	NormalizeService(service)
+
+	return service
+}
+
 // ReplaceDids replaces ids in all fields
 func (s *Service) ReplaceDids(old, new string) {
 	// Id
@@ -33,6 +48,48 @@ func GetServiceIds(vms []*Service) []string {
 	return res
 }
 
+// EndpointURIs returns the URIs the service is available at
+func (s Service) EndpointURIs() []string {
+	if s.Endpoint != nil {
+		return s.Endpoint.URIs()
+	}
+
+	return s.ServiceEndpoint
+}
+
+// LinkedDomainsOrigins returns the origins published by LinkedDomains services of the diddoc in the order of publishing.
+// Endpoints are either URIs or maps with the list of origins.
+func (didDoc *DidDoc) LinkedDomainsOrigins() []string {
+	var origins []string
+
+	for _, service := range didDoc.Service {
+		if service.ServiceType != LinkedDomainsServiceType {
+			continue
+		}
+
+		if service.Endpoint == nil {
+			origins = append(origins, service.ServiceEndpoint...)
+			continue
+		}
+
+		for _, item := range service.Endpoint.AsItems() {
+			if item.Map == "" {
+				origins = append(origins, item.Uri)
+				continue
+			}
+
+			var endpoint struct {
+				Origins []string `json:"origins"`
+			}
+			if json.Unmarshal([]byte(item.Map), &endpoint) == nil {
+				origins = append(origins, endpoint.Origins...)
+			}
+		}
+	}
+
+	return utils.UniqueOrdered(origins)
+}
+
 // Validation
 
 func (s Service) Validate(baseDid string, allowedNamespaces []string) error {
@@ -42,9 +99,43 @@ func (s Service) Validate(baseDid string, allowedNamespaces []string) error {
 		validation.Field(&s.ServiceEndpoint, validation.Each(validation.Required)),
 		validation.Field(&s.Accept, validation.Each(validation.Required, validation.Length(1, 255))),
 		validation.Field(&s.RoutingKeys, IsUniqueStrList(), validation.Each(validation.Required, IsDIDUrl("", []string{}, Empty, Empty, Required))),
+		validation.Field(&s.Endpoint, ValidServiceEndpointRule(s.ServiceType), IsConsistentWithLegacyFieldsRule(&s)),
 	)
 }
 
+// IsConsistentWithLegacyFieldsRule checks that the legacy fields, if set, represent the same endpoint
+func IsConsistentWithLegacyFieldsRule(s *Service) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*ServiceEndpoint)
+		if !ok {
+			panic("IsConsistentWithLegacyFieldsRule must be only applied on service endpoints")
+		}
+
+		if casted == nil || (len(s.ServiceEndpoint) == 0 && len(s.Accept) == 0 && len(s.RoutingKeys) == 0) {
+			return nil
+		}
+
+		serviceEndpoint, accept, routingKeys, representable := casted.ToLegacy()
+		if !representable {
+			return errors.New("can't be represented by serviceEndpoint, accept and routingKeys which must be empty")
+		}
+
+		if !reflect.DeepEqual(serviceEndpoint, s.ServiceEndpoint) || !equalOrEmpty(accept, s.Accept) || !equalOrEmpty(routingKeys, s.RoutingKeys) {
+			return errors.New("must match serviceEndpoint, accept and routingKeys")
+		}
+
+		return nil
+	})
+}
+
+func equalOrEmpty(a, b []string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
 func ValidServiceRule(baseDid string, allowedNamespaces []string) *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(Service)
@@ -0,0 +1,389 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// didCommEndpoint is the map form of a DIDComm v2 service endpoint.
+// Documentation: https://identity.foundation/didcomm-messaging/spec/v2.0/#service-endpoint
+type didCommEndpoint struct {
+	URI         string   `json:"uri"`
+	Accept      []string `json:"accept,omitempty"`
+	RoutingKeys []string `json:"routingKeys,omitempty"`
+}
+
+func NewURIServiceEndpoint(uri string) *ServiceEndpoint {
+	return &ServiceEndpoint{
+		Uri: uri,
+	}
+}
+
+func NewMapServiceEndpoint(jsonObject string) *ServiceEndpoint {
+	return &ServiceEndpoint{
+		Map: jsonObject,
+	}
+}
+
+func NewSetServiceEndpoint(items ...*ServiceEndpointItem) *ServiceEndpoint {
+	return &ServiceEndpoint{
+		Items: items,
+	}
+}
+
+func NewURIServiceEndpointItem(uri string) *ServiceEndpointItem {
+	return &ServiceEndpointItem{
+		Uri: uri,
+	}
+}
+
+func NewMapServiceEndpointItem(jsonObject string) *ServiceEndpointItem {
+	return &ServiceEndpointItem{
+		Map: jsonObject,
+	}
+}
+
+// NewDIDCommServiceEndpointItem builds the map form of a DIDComm v2 service endpoint
+func NewDIDCommServiceEndpointItem(uri string, accept []string, routingKeys []string) *ServiceEndpointItem {
+	object := map[string]interface{}{
+		"uri": uri,
+	}
+
+	if len(accept) > 0 {
+		object["accept"] = accept
+	}
+
+	if len(routingKeys) > 0 {
+		object["routingKeys"] = routingKeys
+	}
+
+	encoded, err := utils.EncodeCanonicalJSON(object)
+	if err != nil {
+		panic(err)
+	}
+
+	return NewMapServiceEndpointItem(encoded)
+}
+
+// ParseServiceEndpointJSON parses the serviceEndpoint property of a DID Core service:
+// a string, a map or an ordered set of strings and maps.
+func ParseServiceEndpointJSON(data []byte) (*ServiceEndpoint, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
+
+	if trimmed[0] == '[' {
+		var rawItems []json.RawMessage
+
+		err := json.Unmarshal(trimmed, &rawItems)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*ServiceEndpointItem, 0, len(rawItems))
+
+		for _, rawItem := range rawItems {
+			item, err := parseServiceEndpointItemJSON(rawItem)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, item)
+		}
+
+		return NewSetServiceEndpoint(items...), nil
+	}
+
+	item, err := parseServiceEndpointItemJSON(trimmed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceEndpoint{Uri: item.Uri, Map: item.Map}, nil
+}
+
+func parseServiceEndpointItemJSON(data []byte) (*ServiceEndpointItem, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	switch {
+	case len(trimmed) > 0 && trimmed[0] == '"':
+		var uri string
+
+		err := json.Unmarshal(trimmed, &uri)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewURIServiceEndpointItem(uri), nil
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		canonical, err := utils.CanonicalizeJSONObject(string(trimmed))
+		if err != nil {
+			return nil, err
+		}
+
+		return NewMapServiceEndpointItem(canonical), nil
+	default:
+		return nil, fmt.Errorf("service endpoint must be a string, a map or a list of strings and maps: %s", string(trimmed))
+	}
+}
+
+// MarshalW3CJSON serializes the service endpoint according to the DID Core specification
+func (e ServiceEndpoint) MarshalW3CJSON() (json.RawMessage, error) {
+	switch {
+	case e.Uri != "":
+		return json.Marshal(e.Uri)
+	case e.Map != "":
+		return json.RawMessage(e.Map), nil
+	default:
+		encoded := make([]string, 0, len(e.Items))
+
+		for _, item := range e.Items {
+			if item.Map != "" {
+				encoded = append(encoded, item.Map)
+				continue
+			}
+
+			uri, err := json.Marshal(item.Uri)
+			if err != nil {
+				return nil, err
+			}
+
+			encoded = append(encoded, string(uri))
+		}
+
+		return json.RawMessage("[" + strings.Join(encoded, ",") + "]"), nil
+	}
+}
+
+// Helpers
+
+// AsItems returns the service endpoint as an ordered set regardless of its form
+func (e ServiceEndpoint) AsItems() []*ServiceEndpointItem {
+	switch {
+	case e.Uri != "":
+		return []*ServiceEndpointItem{NewURIServiceEndpointItem(e.Uri)}
+	case e.Map != "":
+		return []*ServiceEndpointItem{NewMapServiceEndpointItem(e.Map)}
+	default:
+		return e.Items
+	}
+}
+
+// URIs returns the URIs of the service endpoint: plain URIs and URIs of DIDComm v2 endpoint maps
+func (e ServiceEndpoint) URIs() []string {
+	var uris []string
+
+	for _, item := range e.AsItems() {
+		if item.Map == "" {
+			uris = append(uris, item.Uri)
+			continue
+		}
+
+		endpoint, err := parseDIDCommEndpoint(item.Map)
+		if err == nil && endpoint.URI != "" {
+			uris = append(uris, endpoint.URI)
+		}
+	}
+
+	return uris
+}
+
+// ToLegacy converts the service endpoint into the legacy representation.
+// Only ordered sets of URIs and of DIDComm v2 endpoint maps sharing accept and routing keys are representable,
+// ok is false for other endpoints.
+func (e ServiceEndpoint) ToLegacy() (serviceEndpoint []string, accept []string, routingKeys []string, ok bool) {
+	items := e.AsItems()
+	if len(items) == 0 {
+		return nil, nil, nil, false
+	}
+
+	if items[0].Map == "" {
+		for _, item := range items {
+			if item.Map != "" {
+				return nil, nil, nil, false
+			}
+
+			serviceEndpoint = append(serviceEndpoint, item.Uri)
+		}
+
+		return serviceEndpoint, nil, nil, true
+	}
+
+	for i, item := range items {
+		if item.Map == "" {
+			return nil, nil, nil, false
+		}
+
+		endpoint, err := parseDIDCommEndpoint(item.Map)
+		if err != nil || endpoint.URI == "" {
+			return nil, nil, nil, false
+		}
+
+		if i == 0 {
+			accept, routingKeys = endpoint.Accept, endpoint.RoutingKeys
+		} else if !reflect.DeepEqual(accept, endpoint.Accept) || !reflect.DeepEqual(routingKeys, endpoint.RoutingKeys) {
+			return nil, nil, nil, false
+		}
+
+		serviceEndpoint = append(serviceEndpoint, endpoint.URI)
+	}
+
+	return serviceEndpoint, accept, routingKeys, true
+}
+
+// parseDIDCommEndpoint parses a DIDComm v2 endpoint map. Maps with other members are rejected.
+func parseDIDCommEndpoint(jsonObject string) (didCommEndpoint, error) {
+	var endpoint didCommEndpoint
+
+	decoder := json.NewDecoder(strings.NewReader(jsonObject))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(&endpoint)
+
+	return endpoint, err
+}
+
+// Validation
+
+func (e ServiceEndpoint) Validate(serviceType string) error {
+	set := 0
+	for _, isSet := range []bool{e.Uri != "", e.Map != "", len(e.Items) > 0} {
+		if isSet {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return errors.New("exactly one of uri, map and items must be set")
+	}
+
+	return validation.ValidateStruct(&e,
+		validation.Field(&e.Uri, validation.When(e.Uri != "", IsURI())),
+		validation.Field(&e.Map, validation.When(e.Map != "", IsServiceEndpointMap(serviceType))),
+		validation.Field(&e.Items, IsUniqueServiceEndpointItemsRule(), validation.Each(ValidServiceEndpointItemRule(serviceType))),
+	)
+}
+
+func (i ServiceEndpointItem) Validate(serviceType string) error {
+	if (i.Uri == "") == (i.Map == "") {
+		return errors.New("exactly one of uri and map must be set")
+	}
+
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Uri, validation.When(i.Uri != "", IsURI())),
+		validation.Field(&i.Map, validation.When(i.Map != "", IsServiceEndpointMap(serviceType))),
+	)
+}
+
+func ValidServiceEndpointRule(serviceType string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*ServiceEndpoint)
+		if !ok {
+			panic("ValidServiceEndpointRule must be only applied on service endpoints")
+		}
+
+		if casted == nil {
+			return nil
+		}
+
+		return casted.Validate(serviceType)
+	})
+}
+
+func ValidServiceEndpointItemRule(serviceType string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(ServiceEndpointItem)
+		if !ok {
+			panic("ValidServiceEndpointItemRule must be only applied on service endpoint items")
+		}
+
+		return casted.Validate(serviceType)
+	})
+}
+
+func IsUniqueServiceEndpointItemsRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*ServiceEndpointItem)
+		if !ok {
+			panic("IsUniqueServiceEndpointItemsRule must be only applied on service endpoint item lists")
+		}
+
+		keys := make([]string, len(casted))
+		for i, item := range casted {
+			keys[i] = item.Uri + "\n" + item.Map
+		}
+
+		if !utils.IsUnique(keys) {
+			return errors.New("there are service endpoint duplicates")
+		}
+
+		return nil
+	})
+}
+
+// IsServiceEndpointMap checks that the value is a JSON object in the canonical form.
+// Maps of DIDCommMessaging services must be DIDComm v2 endpoints.
+func IsServiceEndpointMap(serviceType string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsServiceEndpointMap must be only applied on string properties")
+		}
+
+		canonical, err := utils.CanonicalizeJSONObject(casted)
+		if err != nil {
+			return fmt.Errorf("must be a JSON object: %w", err)
+		}
+
+		if canonical != casted {
+			return fmt.Errorf("must be in the canonical form: %s", canonical)
+		}
+
+		if serviceType != DIDCommMessagingServiceType {
+			return nil
+		}
+
+		endpoint, err := parseDIDCommEndpoint(casted)
+		if err != nil {
+			return fmt.Errorf("must be a DIDComm endpoint: %w", err)
+		}
+
+		return validation.ValidateStruct(&endpoint,
+			validation.Field(&endpoint.URI, validation.Required, IsURI()),
+			validation.Field(&endpoint.Accept, validation.Each(validation.Required, validation.Length(1, 255))),
+			validation.Field(&endpoint.RoutingKeys, IsUniqueStrList(), validation.Each(validation.Required, IsDIDUrl("", []string{}, Empty, Empty, Required))),
+		)
+	})
+}
+
+// NormalizeServiceEndpoint brings the service endpoint to the canonical form
+func NormalizeServiceEndpoint(e *ServiceEndpoint) {
+	if e.Map != "" {
+		e.Map = canonicalizeIfValid(e.Map)
+	}
+
+	for _, item := range e.Items {
+		if item.Map != "" {
+			item.Map = canonicalizeIfValid(item.Map)
+		}
+	}
+}
+
+// canonicalizeIfValid leaves invalid JSON as is to be reported by validation
+func canonicalizeIfValid(jsonObject string) string {
+	canonical, err := utils.CanonicalizeJSONObject(jsonObject)
+	if err != nil {
+		return jsonObject
+	}
+
+	return canonical
+}
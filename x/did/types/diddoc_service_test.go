@@ -83,5 +83,278 @@ var _ = Describe("Service tests", func() {
 				isValid:  false,
 				errorMsg: "unable to split did into method, namespace and id",
 			}),
+
+		Entry(
+			"Valid DIDComm v2 endpoint map",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "DIDCommMessaging",
+					Endpoint:    NewMapServiceEndpoint(`{"accept":["didcomm/v2"],"routingKeys":["did:example:HPXoCUSjrSvWC54SLWQjsm#somekey"],"uri":"https://example.com/didcomm"}`),
+				},
+				baseDid: "did:canow:zABCDEFG123456789abcd",
+				isValid: true,
+			}),
+
+		Entry(
+			"Valid ordered set of URIs and maps",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					Endpoint: NewSetServiceEndpoint(
+						NewURIServiceEndpointItem("https://example.com"),
+						NewMapServiceEndpointItem(`{"origins":["https://example.org"]}`),
+					),
+				},
+				baseDid: "did:canow:zABCDEFG123456789abcd",
+				isValid: true,
+			}),
+
+		Entry(
+			"Endpoint with several forms set",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					Endpoint:    &ServiceEndpoint{Uri: "https://example.com", Map: `{"origins":[]}`},
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "endpoint: exactly one of uri, map and items must be set",
+			}),
+
+		Entry(
+			"Endpoint map is not a JSON object",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					Endpoint:    NewMapServiceEndpoint(`["https://example.com"]`),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "must be a JSON object",
+			}),
+
+		Entry(
+			"Endpoint map is not in the canonical form",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					Endpoint:    NewMapServiceEndpoint(`{"b": 1, "a": 2}`),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: `must be in the canonical form: {"a":2,"b":1}`,
+			}),
+
+		Entry(
+			"DIDComm endpoint map without uri",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "DIDCommMessaging",
+					Endpoint:    NewMapServiceEndpoint(`{"accept":["didcomm/v2"]}`),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "uri: cannot be blank",
+			}),
+
+		Entry(
+			"DIDComm endpoint map with invalid routing key",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "DIDCommMessaging",
+					Endpoint:    NewMapServiceEndpoint(`{"routingKeys":["invalid key"],"uri":"https://example.com/didcomm"}`),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "routingKeys: (0: unable to split did into method, namespace and id.)",
+			}),
+
+		Entry(
+			"Empty ordered set item",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					Endpoint:    NewSetServiceEndpoint(NewURIServiceEndpointItem("https://example.com"), &ServiceEndpointItem{}),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "exactly one of uri and map must be set",
+			}),
+
+		Entry(
+			"Ordered set with duplicates",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:          "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType: "LinkedDomains",
+					Endpoint:    NewSetServiceEndpoint(NewURIServiceEndpointItem("https://example.com"), NewURIServiceEndpointItem("https://example.com")),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "there are service endpoint duplicates",
+			}),
+
+		Entry(
+			"Legacy fields don't match the endpoint",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:              "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType:     "LinkedDomains",
+					ServiceEndpoint: []string{"https://example.org"},
+					Endpoint:        NewURIServiceEndpoint("https://example.com"),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "must match serviceEndpoint, accept and routingKeys",
+			}),
+
+		Entry(
+			"Legacy fields set for an endpoint they can't represent",
+			TestCaseServiceStruct{
+				service: &Service{
+					Id:              "did:canow:zABCDEFG123456789abcd#service1",
+					ServiceType:     "LinkedDomains",
+					ServiceEndpoint: []string{"https://example.com"},
+					Endpoint:        NewMapServiceEndpoint(`{"origins":["https://example.com"]}`),
+				},
+				baseDid:  "did:canow:zABCDEFG123456789abcd",
+				isValid:  false,
+				errorMsg: "can't be represented by serviceEndpoint, accept and routingKeys",
+			}),
 	)
+
+	Describe("Service normalization", func() {
+		It("Leaves services with only the legacy fields without the endpoint", func() {
+			service := &Service{
+				Id:              "did:canow:zABCDEFG123456789abcd#service1",
+				ServiceType:     "DIDCommMessaging",
+				ServiceEndpoint: []string{"https://example.com/1", "https://example.com/2"},
+				Accept:          []string{"didcomm/v2"},
+				RoutingKeys:     []string{"did:example:HPXoCUSjrSvWC54SLWQjsm#somekey"},
+			}
+			NormalizeService(service)
+
+			Expect(service.Endpoint).To(BeNil())
+			Expect(service.EndpointURIs()).To(Equal([]string{"https://example.com/1", "https://example.com/2"}))
+			Expect(service.Validate("did:canow:zABCDEFG123456789abcd", nil)).To(Succeed())
+		})
+
+		It("Fills in the legacy fields from a representable endpoint", func() {
+			service := NewServiceWithEndpoint(
+				"did:canow:zABCDEFG123456789abcd#service1",
+				"DIDCommMessaging",
+				NewMapServiceEndpoint(`{"uri": "https://example.com/didcomm", "accept": ["didcomm/v2"]}`),
+			)
+
+			Expect(service.Endpoint.Map).To(Equal(`{"accept":["didcomm/v2"],"uri":"https://example.com/didcomm"}`))
+			Expect(service.ServiceEndpoint).To(Equal([]string{"https://example.com/didcomm"}))
+			Expect(service.Accept).To(Equal([]string{"didcomm/v2"}))
+			Expect(service.RoutingKeys).To(BeEmpty())
+			Expect(service.EndpointURIs()).To(Equal([]string{"https://example.com/didcomm"}))
+		})
+
+		It("Leaves the legacy fields empty for an endpoint they can't represent", func() {
+			service := NewServiceWithEndpoint(
+				"did:canow:zABCDEFG123456789abcd#service1",
+				"LinkedDomains",
+				NewMapServiceEndpoint(`{"origins":["https://example.com"]}`),
+			)
+
+			Expect(service.ServiceEndpoint).To(BeEmpty())
+			Expect(service.Validate("did:canow:zABCDEFG123456789abcd", nil)).To(Succeed())
+		})
+	})
+
+	DescribeTable("Service DID Core JSON round trip", func(w3cJSON string) {
+		var service Service
+		Expect(service.UnmarshalW3CJSON([]byte(w3cJSON))).To(Succeed())
+		Expect(service.Validate("did:canow:zABCDEFG123456789abcd", nil)).To(Succeed())
+
+		marshalled, err := service.MarshalW3CJSON()
+		Expect(err).To(BeNil())
+		Expect(marshalled).To(MatchJSON(w3cJSON))
+	},
+		Entry("URI", `{"id":"did:canow:zABCDEFG123456789abcd#service1","type":"LinkedDomains","serviceEndpoint":"https://example.com"}`),
+		Entry("Map", `{"id":"did:canow:zABCDEFG123456789abcd#service1","type":"LinkedDomains","serviceEndpoint":{"origins":["https://example.com"],"weight":1.50}}`),
+		Entry("Ordered set", `{"id":"did:canow:zABCDEFG123456789abcd#service1","type":"LinkedDomains","serviceEndpoint":["https://example.com",{"origins":["https://example.org"]}]}`),
+		Entry("DIDComm v2", `{"id":"did:canow:zABCDEFG123456789abcd#service1","type":"DIDCommMessaging","serviceEndpoint":{"uri":"https://example.com/didcomm","accept":["didcomm/v2"],"routingKeys":["did:example:HPXoCUSjrSvWC54SLWQjsm#somekey"]}}`),
+	)
+
+	It("Keeps lists of URIs with the legacy DIDComm properties in the legacy fields", func() {
+		var service Service
+		Expect(service.UnmarshalW3CJSON([]byte(`{"id":"did:canow:zABCDEFG123456789abcd#service1","type":"DIDCommMessaging","serviceEndpoint":["https://example.com/didcomm"],"accept":["didcomm/v2"]}`))).To(Succeed())
+
+		Expect(service.Endpoint).To(BeNil())
+		Expect(service.ServiceEndpoint).To(Equal([]string{"https://example.com/didcomm"}))
+		Expect(service.Accept).To(Equal([]string{"didcomm/v2"}))
+	})
+
+	It("Doesn't accept the legacy DIDComm properties along with an endpoint in the DID Core form", func() {
+		var service Service
+		err := service.UnmarshalW3CJSON([]byte(`{"id":"did:canow:zABCDEFG123456789abcd#service1","type":"DIDCommMessaging","serviceEndpoint":"https://example.com/didcomm","accept":["didcomm/v2"]}`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("accept and routingKeys must be set in the serviceEndpoint map"))
+	})
+
+	// The output of DID Documents stored before the endpoint was introduced must stay byte-identical
+	It("Renders services without the endpoint as before", func() {
+		didDoc := DidDoc{
+			Context: []string{DIDCoreContext},
+			Id:      "did:canow:testnet:zABCDEFG123456789abcd",
+			Service: []*Service{
+				NewService("did:canow:testnet:zABCDEFG123456789abcd#domains", LinkedDomainsServiceType, []string{"https://example.com", "https://example.org"}),
+				{
+					Id:              "did:canow:testnet:zABCDEFG123456789abcd#didcomm",
+					ServiceType:     DIDCommMessagingServiceType,
+					ServiceEndpoint: []string{"https://example.com/didcomm"},
+					Accept:          []string{"didcomm/v2", "didcomm/aip2;env=rfc587"},
+					RoutingKeys:     []string{"did:canow:testnet:zABCDEFG123456789abcd#key-1"},
+				},
+				NewService("did:canow:testnet:zABCDEFG123456789abcd#empty", "LinkedResource", nil),
+			},
+		}
+
+		for _, service := range didDoc.Service {
+			NormalizeService(service)
+		}
+
+		marshalled, err := didDoc.MarshalW3CJSON(DIDJSONLDContentType)
+		Expect(err).To(BeNil())
+		Expect(string(marshalled)).To(Equal(`{"@context":["https://www.w3.org/ns/did/v1"],"id":"did:canow:testnet:zABCDEFG123456789abcd","service":[` +
+			`{"id":"did:canow:testnet:zABCDEFG123456789abcd#domains","type":"LinkedDomains","serviceEndpoint":["https://example.com","https://example.org"]},` +
+			`{"id":"did:canow:testnet:zABCDEFG123456789abcd#didcomm","type":"DIDCommMessaging","serviceEndpoint":["https://example.com/didcomm"],"accept":["didcomm/v2","didcomm/aip2;env=rfc587"],"routingKeys":["did:canow:testnet:zABCDEFG123456789abcd#key-1"]},` +
+			`{"id":"did:canow:testnet:zABCDEFG123456789abcd#empty","type":"LinkedResource","serviceEndpoint":null}]}`))
+	})
+
+	It("Returns origins of LinkedDomains services in the order of publishing", func() {
+		didDoc := DidDoc{
+			Id: ValidTestDID,
+			Service: []*Service{
+				NewServiceWithEndpoint(ValidTestDID+"#domains", LinkedDomainsServiceType, NewSetServiceEndpoint(
+					NewURIServiceEndpointItem("https://example.org"),
+					NewMapServiceEndpointItem(`{"origins":["https://example.net","https://example.org"]}`),
+				)),
+				NewServiceWithEndpoint(ValidTestDID+"#agent", DIDCommMessagingServiceType, NewURIServiceEndpoint("https://agent.example.com")),
+				NewService(ValidTestDID+"#legacy-domains", LinkedDomainsServiceType, []string{"https://example.com"}),
+			},
+		}
+
+		Expect(didDoc.LinkedDomainsOrigins()).To(Equal([]string{"https://example.org", "https://example.net", "https://example.com"}))
+	})
+
+	It("Rejects service endpoints of other JSON types", func() {
+		_, err := ParseServiceEndpointJSON([]byte(`42`))
+		Expect(err).To(HaveOccurred())
+
+		_, err = ParseServiceEndpointJSON([]byte(`["https://example.com", true]`))
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -22,6 +22,14 @@ const (
 	Ed25519VerificationKey2020Type = "Ed25519VerificationKey2020"
 	Ed25519VerificationKey2018Type = "Ed25519VerificationKey2018"
 	Bls12381G2Key2020Type          = "Bls12381G2Key2020"
+
+	EcdsaSecp256k1VerificationKey2019Type = "EcdsaSecp256k1VerificationKey2019"
+
+	MultikeyType   = "Multikey"
+	JSONWebKeyType = "JsonWebKey"
+
+	X25519KeyAgreementKey2019Type = "X25519KeyAgreementKey2019"
+	X25519KeyAgreementKey2020Type = "X25519KeyAgreementKey2020"
 )
 
 var SupportedMethodTypes = []string{
@@ -29,6 +37,11 @@ var SupportedMethodTypes = []string{
 	Ed25519VerificationKey2020Type,
 	Ed25519VerificationKey2018Type,
 	Bls12381G2Key2020Type,
+	EcdsaSecp256k1VerificationKey2019Type,
+	MultikeyType,
+	JSONWebKeyType,
+	X25519KeyAgreementKey2019Type,
+	X25519KeyAgreementKey2020Type,
 }
 
 func NewVerificationMethod(id string, vmType string, controller string, verificationMaterial string) *VerificationMethod {
@@ -42,6 +55,34 @@ func NewVerificationMethod(id string, vmType string, controller string, verifica
 
 // Helpers
 
+// IsKeyAgreementOnly checks whether the verification method holds a X25519 key,
+// which can be used for key agreement only and never for signature verification
+func (vm VerificationMethod) IsKeyAgreementOnly() bool {
+	switch vm.VerificationMethodType {
+	case X25519KeyAgreementKey2019Type, X25519KeyAgreementKey2020Type:
+		return true
+	case JSONWebKey2020Type, JSONWebKeyType:
+		return utils.IsX25519JWK(vm.VerificationMaterial)
+	case MultikeyType:
+		return utils.IsX25519Multikey(vm.VerificationMaterial)
+	default:
+		return false
+	}
+}
+
+// FilterSigningVerificationMethods returns verification methods which can be used for signature verification
+func FilterSigningVerificationMethods(vms []*VerificationMethod) []*VerificationMethod {
+	var result []*VerificationMethod
+
+	for _, vm := range vms {
+		if !vm.IsKeyAgreementOnly() {
+			result = append(result, vm)
+		}
+	}
+
+	return result
+}
+
 func FindVerificationMethod(vms []*VerificationMethod, id string) (*VerificationMethod, bool) {
 	for _, vm := range vms {
 		if vm.Id == id {
@@ -98,7 +139,31 @@ func VerifySignature(vm VerificationMethod, message []byte, signature []byte) er
 
 		verificationError = utils.VerifyBLS12381G2Signature(keyBytes, message, signature)
 
-	case JSONWebKey2020Type:
+	case EcdsaSecp256k1VerificationKey2019Type:
+		pubKey, err := utils.ParseSecp256k1VerificationMaterial(vm.VerificationMaterial)
+		if err != nil {
+			return err
+		}
+
+		verificationError = utils.VerifySecp256k1Signature(pubKey, message, signature)
+
+	case MultikeyType:
+		verificationError = utils.VerifyMultikeySignature(vm.VerificationMaterial, message, signature)
+
+	case X25519KeyAgreementKey2019Type, X25519KeyAgreementKey2020Type:
+		verificationError = utils.ErrKeyAgreementOnlyKey
+
+	case JSONWebKey2020Type, JSONWebKeyType:
+		if utils.IsSecp256k1JWK(vm.VerificationMaterial) {
+			pubKey, err := utils.ParseSecp256k1JWK(vm.VerificationMaterial)
+			if err != nil {
+				return err
+			}
+
+			verificationError = utils.VerifySecp256k1Signature(pubKey, message, signature)
+			break
+		}
+
 		key, err := jwk.ParseKey([]byte(vm.VerificationMaterial))
 		if err != nil {
 			return fmt.Errorf("can't parse jwk: %s", err.Error())
@@ -135,6 +200,8 @@ func VerifySignature(vm VerificationMethod, message []byte, signature []byte) er
 			case bls12381g2.Bls12381G2:
 				bls12381G2PubKey := bls12381g2.PublicKey(okpPubKey.X())
 				verificationError = utils.VerifyBLS12381G2Signature(bls12381G2PubKey, message, signature)
+			case jwa.X25519:
+				verificationError = utils.ErrKeyAgreementOnlyKey
 			default:
 				panic("unsupported jwk cryptographic curve") // This should have been checked during basic validation
 			}
@@ -188,7 +255,7 @@ func (vm *VerificationMethod) ReplaceDids(old, new string) {
 func (vm VerificationMethod) Validate(baseDid string, allowedNamespaces []string) error {
 	return validation.ValidateStruct(&vm,
 		validation.Field(&vm.Id, validation.Required, IsSpecificDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(baseDid)),
-		validation.Field(&vm.Controller, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&vm.Controller, validation.Required, IsControllerDID(allowedNamespaces)),
 		validation.Field(&vm.VerificationMethodType, validation.Required, validation.In(utils.ToInterfaces(SupportedMethodTypes)...)),
 		validation.Field(&vm.VerificationMaterial,
 			validation.When(vm.VerificationMethodType == Ed25519VerificationKey2020Type, validation.Required, IsMultibaseEd25519VerificationKey2020()),
@@ -200,7 +267,19 @@ func (vm VerificationMethod) Validate(baseDid string, allowedNamespaces []string
 			validation.When(vm.VerificationMethodType == Ed25519VerificationKey2018Type, validation.Required, IsBase58Ed25519VerificationKey2018()),
 		),
 		validation.Field(&vm.VerificationMaterial,
-			validation.When(vm.VerificationMethodType == JSONWebKey2020Type, validation.Required, IsJWK()),
+			validation.When(vm.VerificationMethodType == JSONWebKey2020Type || vm.VerificationMethodType == JSONWebKeyType, validation.Required, IsJWK()),
+		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == EcdsaSecp256k1VerificationKey2019Type, validation.Required, IsSecp256k1VerificationMaterial()),
+		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == MultikeyType, validation.Required, IsMultikey()),
+		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == X25519KeyAgreementKey2019Type, validation.Required, IsBase58X25519KeyAgreementKey2019()),
+		),
+		validation.Field(&vm.VerificationMaterial,
+			validation.When(vm.VerificationMethodType == X25519KeyAgreementKey2020Type, validation.Required, IsMultibaseX25519KeyAgreementKey2020()),
 		),
 	)
 }
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/canow-co/cheqd-node/x/did/utils/bls12381g2"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/mr-tron/base58"
+	"github.com/multiformats/go-multibase"
+)
+
+// PublicKeyFingerprint returns a canonical representation of the public key of the verification method:
+// multibase (base58btc) encoded multicodec prefixed public key bytes, the same as used in did:key.
+// The fingerprint doesn't depend on the verification method type the key is represented with.
+func (vm VerificationMethod) PublicKeyFingerprint() (string, error) {
+	return GetPublicKeyFingerprint(vm.VerificationMethodType, vm.VerificationMaterial)
+}
+
+// GetPublicKeyFingerprint returns the fingerprint of the verification material of the given verification method type
+func GetPublicKeyFingerprint(vmType string, verificationMaterial string) (string, error) {
+	var multicodec []byte
+
+	switch vmType {
+	case Ed25519VerificationKey2020Type, Bls12381G2Key2020Type, MultikeyType, X25519KeyAgreementKey2020Type:
+		// Verification material is already multicodec prefixed
+		_, keyBytes, err := multibase.Decode(verificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		multicodec = keyBytes
+
+	case Ed25519VerificationKey2018Type:
+		keyBytes, err := base58.Decode(verificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		multicodec = utils.AddMulticodecPrefix(utils.Ed25519PubCode, keyBytes)
+
+	case X25519KeyAgreementKey2019Type:
+		keyBytes, err := base58.Decode(verificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		multicodec = utils.AddMulticodecPrefix(utils.X25519PubCode, keyBytes)
+
+	case EcdsaSecp256k1VerificationKey2019Type:
+		pubKey, err := utils.ParseSecp256k1VerificationMaterial(verificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		multicodec = utils.AddMulticodecPrefix(utils.Secp256k1PubCode, pubKey.SerializeCompressed())
+
+	case JSONWebKey2020Type, JSONWebKeyType:
+		keyBytes, err := getJWKMulticodec(verificationMaterial)
+		if err != nil {
+			return "", err
+		}
+
+		multicodec = keyBytes
+
+	default:
+		return "", fmt.Errorf("unsupported verification method type: %s", vmType)
+	}
+
+	return multibase.Encode(multibase.Base58BTC, multicodec)
+}
+
+func getJWKMulticodec(jwkString string) ([]byte, error) {
+	if utils.IsSecp256k1JWK(jwkString) {
+		pubKey, err := utils.ParseSecp256k1JWK(jwkString)
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.AddMulticodecPrefix(utils.Secp256k1PubCode, pubKey.SerializeCompressed()), nil
+	}
+
+	key, err := jwk.ParseKey([]byte(jwkString))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse jwk: %s", err.Error())
+	}
+
+	switch key.KeyType() {
+	case jwa.RSA:
+		var rsaPubKey rsa.PublicKey
+		err := key.Raw(&rsaPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("can't convert jwk to %T: %s", rsaPubKey, err.Error())
+		}
+
+		return utils.AddMulticodecPrefix(utils.RSAPubCode, x509.MarshalPKCS1PublicKey(&rsaPubKey)), nil
+
+	case jwa.EC:
+		var ecPubKey ecdsa.PublicKey
+		err := key.Raw(&ecPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("can't convert jwk to %T: %s", ecPubKey, err.Error())
+		}
+
+		var code uint64
+		switch ecPubKey.Curve {
+		case elliptic.P256():
+			code = utils.P256PubCode
+		case elliptic.P384():
+			code = utils.P384PubCode
+		case elliptic.P521():
+			code = utils.P521PubCode
+		default:
+			return nil, fmt.Errorf("unsupported jwk cryptographic curve: %s", ecPubKey.Curve.Params().Name)
+		}
+
+		return utils.AddMulticodecPrefix(code, elliptic.MarshalCompressed(ecPubKey.Curve, ecPubKey.X, ecPubKey.Y)), nil
+
+	case jwa.OKP:
+		okpPubKey, ok := key.(jwk.OKPPublicKey)
+		if !ok {
+			return nil, fmt.Errorf("jwk with kty=\"OKP\" is not actually OKP public key")
+		}
+
+		switch okpPubKey.Crv() {
+		case jwa.Ed25519:
+			var ed25519PubKey ed25519.PublicKey
+			err := okpPubKey.Raw(&ed25519PubKey)
+			if err != nil {
+				return nil, fmt.Errorf("can't convert jwk to %T: %s", ed25519PubKey, err.Error())
+			}
+
+			return utils.AddMulticodecPrefix(utils.Ed25519PubCode, ed25519PubKey), nil
+
+		case jwa.X25519:
+			return utils.AddMulticodecPrefix(utils.X25519PubCode, okpPubKey.X()), nil
+
+		case bls12381g2.Bls12381G2:
+			return utils.AddMulticodecPrefix(bls12381g2.Bls12381G2PubCode, okpPubKey.X()), nil
+
+		default:
+			return nil, fmt.Errorf("unsupported jwk cryptographic curve: %s", okpPubKey.Crv())
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported jwk key type: %s", key.KeyType())
+	}
+}
+
+// GetPublicKeyFingerprints returns unique fingerprints of all verification methods of the diddoc,
+// including the ones embedded into verification relationships. Verification methods with unsupported keys are skipped.
+func (didDoc *DidDoc) GetPublicKeyFingerprints() []string {
+	var fingerprints []string
+
+	for _, vm := range didDoc.AllVerificationMethods() {
+		fingerprint, err := vm.PublicKeyFingerprint()
+		if err != nil {
+			continue
+		}
+
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	return utils.UniqueSorted(fingerprints)
+}
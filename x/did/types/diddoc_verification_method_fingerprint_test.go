@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+
+	testsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/multiformats/go-multibase"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Public key fingerprint", func() {
+	keyPair := testsetup.GenerateKeyPair()
+	expectedFingerprint, _ := multibase.Encode(multibase.Base58BTC, utils.AddMulticodecPrefix(utils.Ed25519PubCode, keyPair.Public))
+
+	DescribeTable("Ed25519 key has the same fingerprint in all representations",
+		func(vmType string, verificationMaterial string) {
+			fingerprint, err := didtypes.GetPublicKeyFingerprint(vmType, verificationMaterial)
+			Expect(err).To(BeNil())
+			Expect(fingerprint).To(Equal(expectedFingerprint))
+		},
+		Entry("Ed25519VerificationKey2020", didtypes.Ed25519VerificationKey2020Type, testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public)),
+		Entry("Ed25519VerificationKey2018", didtypes.Ed25519VerificationKey2018Type, testsetup.GenerateEd25519VerificationKey2018VerificationMaterial(keyPair.Public)),
+		Entry("JsonWebKey2020", didtypes.JSONWebKey2020Type, testsetup.GenerateJSONWebKey2020VerificationMaterial(keyPair.Public)),
+		Entry("Multikey", didtypes.MultikeyType, testsetup.GenerateEd25519VerificationKey2020VerificationMaterial(keyPair.Public)),
+		Entry("JsonWebKey", didtypes.JSONWebKeyType, testsetup.GenerateJSONWebKey2020VerificationMaterial(keyPair.Public)),
+	)
+
+	It("Uses multicodec of the curve for EC JWKs", func() {
+		privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+
+		pubKeyJwk, err := jwk.New(privKey.PublicKey)
+		Expect(err).To(BeNil())
+
+		fingerprint, err := didtypes.GetPublicKeyFingerprint(didtypes.JSONWebKey2020Type, utils.MustEncodeJSON(pubKeyJwk))
+		Expect(err).To(BeNil())
+
+		expected, _ := multibase.Encode(multibase.Base58BTC, utils.AddMulticodecPrefix(utils.P256PubCode,
+			elliptic.MarshalCompressed(elliptic.P256(), privKey.PublicKey.X, privKey.PublicKey.Y)))
+		Expect(fingerprint).To(Equal(expected))
+	})
+
+	DescribeTable("secp256k1 key has the same fingerprint in all representations",
+		func(vmType string, verificationMaterial string) {
+			fingerprint, err := didtypes.GetPublicKeyFingerprint(vmType, verificationMaterial)
+			Expect(err).To(BeNil())
+			Expect(fingerprint).To(Equal(ValidSecp256k1MultibaseVerificationMaterial))
+		},
+		Entry("EcdsaSecp256k1VerificationKey2019 multibase", didtypes.EcdsaSecp256k1VerificationKey2019Type, ValidSecp256k1MultibaseVerificationMaterial),
+		Entry("EcdsaSecp256k1VerificationKey2019 JWK", didtypes.EcdsaSecp256k1VerificationKey2019Type, ValidSecp256k1JwkVerificationMaterial),
+		Entry("JsonWebKey2020", didtypes.JSONWebKey2020Type, ValidSecp256k1JwkVerificationMaterial),
+		Entry("Multikey", didtypes.MultikeyType, ValidSecp256k1MultibaseVerificationMaterial),
+	)
+
+	It("Fails for unsupported verification method types", func() {
+		_, err := didtypes.GetPublicKeyFingerprint("UnknownType", "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK")
+		Expect(err).To(HaveOccurred())
+	})
+})
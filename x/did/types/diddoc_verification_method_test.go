@@ -14,7 +14,11 @@ import (
 
 	testsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
 	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/did/utils"
 	"github.com/canow-co/cheqd-node/x/did/utils/bls12381g2"
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/hyperledger/aries-framework-go/pkg/crypto/primitive/bbs12381g2pub"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/multiformats/go-multibase"
@@ -211,6 +215,160 @@ var _ = DescribeTable("Verification Method validation tests", func(testCase Veri
 			},
 			isValid: true,
 		}),
+	Entry(
+		"Valid multibase EcdsaSecp256k1VerificationKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidSecp256k1MultibaseVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid JWK EcdsaSecp256k1VerificationKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidSecp256k1JwkVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid secp256k1 JsonWebKey2020 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "JsonWebKey2020",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidSecp256k1JwkVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid ed25519 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid secp256k1 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidSecp256k1MultibaseVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid P-256 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidP256MultikeyVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid P-384 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidP384MultikeyVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid Bls12381G2 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidBls12381G2MultibaseVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid EC JsonWebKey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "JsonWebKey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEcJwkVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid Ed25519 JsonWebKey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "JsonWebKey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEd25519JwkVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid X25519KeyAgreementKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "X25519KeyAgreementKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidX25519KeyAgreementKey2019VerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid X25519KeyAgreementKey2020 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "X25519KeyAgreementKey2020",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidX25519KeyAgreementKey2020VerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid X25519 JsonWebKey2020 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "JsonWebKey2020",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidX25519JwkVerificationMaterial,
+			},
+			isValid: true,
+		}),
+	Entry(
+		"Valid X25519 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidX25519KeyAgreementKey2020VerificationMaterial,
+			},
+			isValid: true,
+		}),
 	Entry(
 		"Invalid Ed25519VerificationKey2018 verification method",
 		VerificationMethodTestCase{
@@ -261,6 +419,162 @@ var _ = DescribeTable("Verification Method validation tests", func(testCase Veri
 			isValid:  false,
 			errorMsg: "verification_material: can't parse jwk: invalid key type from JSON (SomeOtherKeyType)",
 		}),
+	Entry(
+		"Invalid multibase EcdsaSecp256k1VerificationKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidSecp256k1MultibaseVerificationMaterialBadFormat,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: secp256k1: invalid public key",
+		}),
+	Entry(
+		"Invalid JWK EcdsaSecp256k1VerificationKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidSecp256k1JwkVerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: secp256k1: invalid public key",
+		}),
+	Entry(
+		"Not a secp256k1 key in EcdsaSecp256k1VerificationKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: not a secp256k1 public key",
+		}),
+	Entry(
+		"Invalid secp256k1 JsonWebKey2020 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "JsonWebKey2020",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidSecp256k1JwkVerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: secp256k1: invalid public key",
+		}),
+	Entry(
+		"Multikey verification method with unsupported multicodec",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidMultikeyVerificationMaterialUnsupportedCodec,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: unsupported Multikey multicodec: 0x1202",
+		}),
+	Entry(
+		"Multikey verification method with uncompressed key",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidMultikeyVerificationMaterialUncompressed,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: secp256k1: bad compressed public key length: 65",
+		}),
+	Entry(
+		"Multikey verification method with non base58btc encoding",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidMultikeyVerificationMaterialBadEncoding,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: invalid encoding for Multikey. expected: base58btc actual: base64",
+		}),
+	Entry(
+		"Invalid Ed25519 Multikey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "Multikey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidEd25519VerificationKey2020VerificationMaterialBadlength,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: ed25519: bad public key length: 27",
+		}),
+	Entry(
+		"Invalid JsonWebKey verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "JsonWebKey",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidJwkVerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: can't parse jwk: invalid key type from JSON (SomeOtherKeyType)",
+		}),
+	Entry(
+		"Invalid X25519KeyAgreementKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "X25519KeyAgreementKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidX25519KeyAgreementKey2019VerificationMaterialBadLength,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: x25519: bad public key length: 31",
+		}),
+	Entry(
+		"Low order X25519KeyAgreementKey2019 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "X25519KeyAgreementKey2019",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidX25519KeyAgreementKey2019VerificationMaterialLowOrder,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: x25519: low order public key",
+		}),
+	Entry(
+		"Low order X25519KeyAgreementKey2020 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "X25519KeyAgreementKey2020",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   InvalidX25519KeyAgreementKey2020VerificationMaterialLowOrder,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: x25519: low order public key",
+		}),
+	Entry(
+		"Not a X25519 key in X25519KeyAgreementKey2020 verification method",
+		VerificationMethodTestCase{
+			vm: didtypes.VerificationMethod{
+				Id:                     "did:canow:zABCDEFG123456789abcd#qwe",
+				VerificationMethodType: "X25519KeyAgreementKey2020",
+				Controller:             "did:canow:zABCDEFG987654321abcd",
+				VerificationMaterial:   ValidEd25519VerificationKey2020VerificationMaterial,
+			},
+			isValid:  false,
+			errorMsg: "verification_material: not a X25519 public key",
+		}),
 )
 
 var _ = Describe("Validation ed25519 Signature in verification method", func() {
@@ -427,6 +741,213 @@ var _ = Describe("Validation ECDSA Signature in verification method", func() {
 	})
 })
 
+var _ = Describe("Validation ES256K Signature in verification method", func() {
+	message := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod " +
+		"tempor incididunt ut labore et dolore magna aliqua."
+	msgBytes := []byte(message)
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	Expect(err).To(BeNil())
+
+	pubKey := privKey.PubKey()
+	msgDigest := sha256.Sum256(msgBytes)
+
+	// Compact signature is recovery byte followed by R || S
+	signature := secp256k1ecdsa.SignCompact(privKey, msgDigest[:], true)[1:]
+
+	pubKeyMultibase, err := multibase.Encode(multibase.Base58BTC, utils.AddMulticodecPrefix(utils.Secp256k1PubCode, pubKey.SerializeCompressed()))
+	Expect(err).To(BeNil())
+
+	uncompressed := pubKey.SerializeUncompressed()
+	pubKeyJwk := "{\"kty\": \"EC\", \"crv\": \"secp256k1\", " +
+		"\"x\": \"" + base64.RawURLEncoding.EncodeToString(uncompressed[1:33]) + "\", " +
+		"\"y\": \"" + base64.RawURLEncoding.EncodeToString(uncompressed[33:]) + "\"}"
+
+	DescribeTable("is valid",
+		func(vmType string, verificationMaterial string) {
+			vm := didtypes.VerificationMethod{
+				Id:                     "",
+				VerificationMethodType: vmType,
+				Controller:             "",
+				VerificationMaterial:   verificationMaterial,
+			}
+
+			err := didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(BeNil())
+		},
+		Entry("with EcdsaSecp256k1VerificationKey2019 multibase material", "EcdsaSecp256k1VerificationKey2019", pubKeyMultibase),
+		Entry("with EcdsaSecp256k1VerificationKey2019 JWK material", "EcdsaSecp256k1VerificationKey2019", pubKeyJwk),
+		Entry("with JsonWebKey2020 material", "JsonWebKey2020", pubKeyJwk),
+	)
+
+	It("accepts signatures of Cosmos SDK account keys", func() {
+		accountKey := cosmossecp256k1.GenPrivKey()
+		accountSignature, err := accountKey.Sign(msgBytes)
+		Expect(err).To(BeNil())
+
+		accountPubKeyMultibase, err := multibase.Encode(multibase.Base58BTC, utils.AddMulticodecPrefix(utils.Secp256k1PubCode, accountKey.PubKey().Bytes()))
+		Expect(err).To(BeNil())
+
+		vm := didtypes.VerificationMethod{
+			Id:                     "",
+			VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+			Controller:             "",
+			VerificationMaterial:   accountPubKeyMultibase,
+		}
+
+		err = didtypes.VerifySignature(vm, msgBytes, accountSignature)
+		Expect(err).To(BeNil())
+	})
+
+	It("rejects signatures with high S value", func() {
+		var s secp256k1.ModNScalar
+		s.SetByteSlice(signature[32:])
+		s.Negate()
+		sBytes := s.Bytes()
+		malleated := append(append([]byte{}, signature[:32]...), sBytes[:]...)
+
+		vm := didtypes.VerificationMethod{
+			Id:                     "",
+			VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+			Controller:             "",
+			VerificationMaterial:   pubKeyMultibase,
+		}
+
+		err := didtypes.VerifySignature(vm, msgBytes, malleated)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("signature S value is not in lower half of the order"))
+	})
+
+	It("rejects signatures of other messages", func() {
+		vm := didtypes.VerificationMethod{
+			Id:                     "",
+			VerificationMethodType: "EcdsaSecp256k1VerificationKey2019",
+			Controller:             "",
+			VerificationMaterial:   pubKeyJwk,
+		}
+
+		err := didtypes.VerifySignature(vm, []byte("other message"), signature)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid secp256k1 signature"))
+	})
+})
+
+var _ = Describe("Validation Signature in Multikey verification method", func() {
+	message := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod " +
+		"tempor incididunt ut labore et dolore magna aliqua."
+	msgBytes := []byte(message)
+	msgDigest := sha256.Sum256(msgBytes)
+
+	ed25519PubKey, ed25519PrivKey, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).To(BeNil())
+
+	secp256k1PrivKey, err := secp256k1.GeneratePrivateKey()
+	Expect(err).To(BeNil())
+
+	p256PrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+
+	p384PrivKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	Expect(err).To(BeNil())
+
+	p256Signature, err := ecdsa.SignASN1(rand.Reader, p256PrivKey, msgDigest[:])
+	Expect(err).To(BeNil())
+
+	p384Signature, err := ecdsa.SignASN1(rand.Reader, p384PrivKey, msgDigest[:])
+	Expect(err).To(BeNil())
+
+	bls12381G2PubKey, bls12381G2PrivKey, err := bbs12381g2pub.GenerateKeyPair(sha256.New, nil)
+	Expect(err).To(BeNil())
+
+	bls12381G2PubKeyBytes, err := bls12381G2PubKey.Marshal()
+	Expect(err).To(BeNil())
+
+	bls12381G2PrivKeyBytes, err := bls12381G2PrivKey.Marshal()
+	Expect(err).To(BeNil())
+
+	bls12381G2Signature, err := bbs12381g2pub.New().Sign([][]byte{msgBytes}, bls12381G2PrivKeyBytes)
+	Expect(err).To(BeNil())
+
+	multikey := func(code uint64, keyBytes []byte) string {
+		return utils.MustEncodeMultibaseBase58(utils.AddMulticodecPrefix(code, keyBytes))
+	}
+
+	DescribeTable("is valid",
+		func(verificationMaterial string, signature []byte) {
+			vm := didtypes.VerificationMethod{
+				Id:                     "",
+				VerificationMethodType: "Multikey",
+				Controller:             "",
+				VerificationMaterial:   verificationMaterial,
+			}
+
+			err := didtypes.VerifySignature(vm, msgBytes, signature)
+			Expect(err).To(BeNil())
+		},
+		Entry("with ed25519 key",
+			multikey(utils.Ed25519PubCode, ed25519PubKey),
+			ed25519.Sign(ed25519PrivKey, msgBytes)),
+		Entry("with secp256k1 key",
+			multikey(utils.Secp256k1PubCode, secp256k1PrivKey.PubKey().SerializeCompressed()),
+			secp256k1ecdsa.SignCompact(secp256k1PrivKey, msgDigest[:], true)[1:]),
+		Entry("with P-256 key",
+			multikey(utils.P256PubCode, elliptic.MarshalCompressed(elliptic.P256(), p256PrivKey.X, p256PrivKey.Y)),
+			p256Signature),
+		Entry("with P-384 key",
+			multikey(utils.P384PubCode, elliptic.MarshalCompressed(elliptic.P384(), p384PrivKey.X, p384PrivKey.Y)),
+			p384Signature),
+		Entry("with Bls12381G2 key",
+			multikey(bls12381g2.Bls12381G2PubCode, bls12381G2PubKeyBytes),
+			bls12381G2Signature),
+	)
+
+	It("is invalid when signed by another key", func() {
+		vm := didtypes.VerificationMethod{
+			Id:                     "",
+			VerificationMethodType: "Multikey",
+			Controller:             "",
+			VerificationMaterial:   multikey(utils.P256PubCode, elliptic.MarshalCompressed(elliptic.P256(), p256PrivKey.X, p256PrivKey.Y)),
+		}
+
+		err := didtypes.VerifySignature(vm, msgBytes, p384Signature)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid ecdsa signature"))
+	})
+
+	It("is valid for JsonWebKey verification method", func() {
+		vm := didtypes.VerificationMethod{
+			Id:                     "",
+			VerificationMethodType: "JsonWebKey",
+			Controller:             "",
+			VerificationMaterial:   testsetup.GenerateJSONWebKey2020VerificationMaterial(ed25519PubKey),
+		}
+
+		err := didtypes.VerifySignature(vm, msgBytes, ed25519.Sign(ed25519PrivKey, msgBytes))
+		Expect(err).To(BeNil())
+	})
+})
+
+var _ = DescribeTable("Signature verification with X25519 key agreement keys",
+	func(vmType string, verificationMaterial string) {
+		vm := didtypes.VerificationMethod{
+			Id:                     "",
+			VerificationMethodType: vmType,
+			Controller:             "",
+			VerificationMaterial:   verificationMaterial,
+		}
+
+		Expect(vm.IsKeyAgreementOnly()).To(BeTrue())
+
+		err := didtypes.VerifySignature(vm, []byte("message"), make([]byte, ed25519.SignatureSize))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("key agreement keys can't be used to verify signatures"))
+	},
+	Entry("X25519KeyAgreementKey2019", "X25519KeyAgreementKey2019", ValidX25519KeyAgreementKey2019VerificationMaterial),
+	Entry("X25519KeyAgreementKey2020", "X25519KeyAgreementKey2020", ValidX25519KeyAgreementKey2020VerificationMaterial),
+	Entry("JsonWebKey2020", "JsonWebKey2020", ValidX25519JwkVerificationMaterial),
+	Entry("Multikey", "Multikey", ValidX25519KeyAgreementKey2020VerificationMaterial),
+)
+
 var _ = Describe("Validation RSA Signature in verification method", func() {
 	Context("RSA signature preparations and verification", func() {
 		It("is positive case", func() {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/canow-co/cheqd-node/x/did/utils"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -62,6 +63,28 @@ func ValidVerificationRelationshipRule(
 	})
 }
 
+// IsNotKeyAgreementOnlyRule checks that the verification relationship neither embeds nor references
+// a key agreement only verification method. Applied on all verification relationships except keyAgreement.
+func IsNotKeyAgreementOnlyRule(sharedVerificationMethods []*VerificationMethod) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(VerificationRelationship)
+		if !ok {
+			panic("IsNotKeyAgreementOnlyRule must be only applied on verification relationships")
+		}
+
+		vm := casted.VerificationMethod
+		if vm == nil {
+			vm, _ = FindVerificationMethod(sharedVerificationMethods, casted.VerificationMethodId)
+		}
+
+		if vm.IsKeyAgreementOnly() {
+			return fmt.Errorf("%s: key agreement keys can be used in keyAgreement verification relationship only", vm.Id)
+		}
+
+		return nil
+	})
+}
+
 func IsUniqueVerificationRelationshipListByIDRule() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.([]*VerificationRelationship)
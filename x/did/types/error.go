@@ -19,6 +19,10 @@ var (
 	ErrNamespaceValidation          = sdkerrors.Register(ModuleName, 1206, "DID namespace validation failed")
 	ErrDIDDocDeactivated            = sdkerrors.Register(ModuleName, 1207, "DID Doc already deactivated")
 	ErrAuthenticationMethodNotFound = sdkerrors.Register(ModuleName, 1208, "authentication method not found")
+	ErrDidDocNotCreatedYet          = sdkerrors.Register(ModuleName, 1209, "DID Doc not yet created")
+	ErrVersionConflict              = sdkerrors.Register(ModuleName, 1210, "DID Doc version conflict")
+	ErrControllerThresholdNotMet    = sdkerrors.Register(ModuleName, 1211, "controller threshold not met")
+	ErrNextKeyCommitmentMismatch    = sdkerrors.Register(ModuleName, 1212, "next key commitment mismatch")
 	ErrUnpackStateValue             = sdkerrors.Register(ModuleName, 1300, "invalid did state value")
 	ErrInternal                     = sdkerrors.Register(ModuleName, 1500, "internal error")
 )
@@ -7,5 +7,6 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
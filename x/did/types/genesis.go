@@ -5,19 +5,23 @@ import (
 )
 
 const (
-	DefaultDidNamespace       = "testnet"
-	DefaultCreateDidTxFee     = 50e9                   // 50 ARX or 50000000000 zarx
-	DefaultUpdateDidTxFee     = 25e9                   // 25 ARX or 25000000000 zarx
-	DefaultDeactivateDidTxFee = 10e9                   // 10 ARX or 10000000000 zarx
-	DefaultBurnFactor         = "0.500000000000000000" // 0.5 or 50%
+	DefaultDidNamespace                         = "testnet"
+	DefaultCreateDidTxFee                       = 50e9                   // 50 ARX or 50000000000 zarx
+	DefaultUpdateDidTxFee                       = 25e9                   // 25 ARX or 25000000000 zarx
+	DefaultDeactivateDidTxFee                   = 10e9                   // 10 ARX or 10000000000 zarx
+	DefaultBurnFactor                           = "0.500000000000000000" // 0.5 or 50%
+	DefaultAllowLegacySignatures                = true
+	DefaultAllowCapabilityInvocationFullUpdates = true
 )
 
 // DefaultGenesis returns the default `did` genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		VersionSets:  []*DidDocVersionSet{},
-		DidNamespace: DefaultDidNamespace,
-		FeeParams:    DefaultFeeParams(),
+		VersionSets:     []*DidDocVersionSet{},
+		DidNamespace:    DefaultDidNamespace,
+		FeeParams:       DefaultFeeParams(),
+		SigningParams:   DefaultSigningParams(),
+		NamespaceParams: DefaultNamespaceParams(),
 	}
 }
 
@@ -34,11 +38,23 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	err = gs.ValidateCreatedHeights()
+	if err != nil {
+		return err
+	}
+
 	err = gs.FeeParams.ValidateBasic()
 	if err != nil {
 		return err
 	}
 
+	if gs.NamespaceParams != nil {
+		err = gs.NamespaceParams.ValidateBasic()
+		if err != nil {
+			return err
+		}
+	}
+
 	return gs.ValidateBasic()
 }
 
@@ -89,6 +105,29 @@ func (gs GenesisState) ValidateVersionSets() error {
 	return nil
 }
 
+func (gs GenesisState) ValidateCreatedHeights() error {
+	didCache := make(map[string]bool)
+
+	for _, versionSet := range gs.VersionSets {
+		didCache[versionSet.DidDocs[0].DidDoc.Id] = false
+	}
+
+	for _, createdHeight := range gs.CreatedHeights {
+		indexed, ok := didCache[createdHeight.Did]
+		if !ok {
+			return fmt.Errorf("created height found for unknown didDoc with id %s", createdHeight.Did)
+		}
+
+		if indexed {
+			return fmt.Errorf("duplicated created height found for didDoc with id %s", createdHeight.Did)
+		}
+
+		didCache[createdHeight.Did] = true
+	}
+
+	return nil
+}
+
 func (gs GenesisState) ValidateBasic() error {
 	for _, versionSet := range gs.VersionSets {
 		for _, didDoc := range versionSet.DidDocs {
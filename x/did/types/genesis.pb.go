@@ -78,6 +78,61 @@ func (m *DidDocVersionSet) GetDidDocs() []*DidDocWithMetadata {
 	return nil
 }
 
+// DidDocCreatedHeight contains the block height a DID Document was created at.
+type DidDocCreatedHeight struct {
+	// DID of the DID Document
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Block height of the first version of the DID Document
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DidDocCreatedHeight) Reset()         { *m = DidDocCreatedHeight{} }
+func (m *DidDocCreatedHeight) String() string { return proto.CompactTextString(m) }
+func (*DidDocCreatedHeight) ProtoMessage()    {}
+func (*DidDocCreatedHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83613517e395af68, []int{1}
+}
+func (m *DidDocCreatedHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidDocCreatedHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidDocCreatedHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidDocCreatedHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidDocCreatedHeight.Merge(m, src)
+}
+func (m *DidDocCreatedHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidDocCreatedHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidDocCreatedHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidDocCreatedHeight proto.InternalMessageInfo
+
+func (m *DidDocCreatedHeight) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DidDocCreatedHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // GenesisState defines the cheqd DID module's genesis state.
 type GenesisState struct {
 	// Namespace for the DID module
@@ -88,13 +143,20 @@ type GenesisState struct {
 	// Fee parameters for the DID module
 	// Defines fixed fees and burn percentage for each DID operation type (create, update, delete)
 	FeeParams *FeeParams `protobuf:"bytes,3,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// Payload signature verification parameters for the DID and resource modules
+	SigningParams *SigningParams `protobuf:"bytes,4,opt,name=signing_params,json=signingParams,proto3" json:"signing_params,omitempty"`
+	// Namespace parameters for the DID and resource modules
+	// Defines namespaces allowed in addition to did_namespace and their fee parameters
+	NamespaceParams *NamespaceParams `protobuf:"bytes,5,opt,name=namespace_params,json=namespaceParams,proto3" json:"namespace_params,omitempty"`
+	// Creation heights of all DID Documents
+	CreatedHeights []*DidDocCreatedHeight `protobuf:"bytes,6,rep,name=created_heights,json=createdHeights,proto3" json:"created_heights,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83613517e395af68, []int{1}
+	return fileDescriptor_83613517e395af68, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,36 +206,66 @@ func (m *GenesisState) GetFeeParams() *FeeParams {
 	return nil
 }
 
+func (m *GenesisState) GetSigningParams() *SigningParams {
+	if m != nil {
+		return m.SigningParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNamespaceParams() *NamespaceParams {
+	if m != nil {
+		return m.NamespaceParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreatedHeights() []*DidDocCreatedHeight {
+	if m != nil {
+		return m.CreatedHeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DidDocVersionSet)(nil), "cheqd.did.v2.DidDocVersionSet")
+	proto.RegisterType((*DidDocCreatedHeight)(nil), "cheqd.did.v2.DidDocCreatedHeight")
 	proto.RegisterType((*GenesisState)(nil), "cheqd.did.v2.GenesisState")
 }
 
 func init() { proto.RegisterFile("cheqd/did/v2/genesis.proto", fileDescriptor_83613517e395af68) }
 
 var fileDescriptor_83613517e395af68 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xbb, 0x16, 0xd4, 0x6e, 0x53, 0x91, 0x1c, 0xb4, 0xf6, 0xb0, 0x84, 0x8a, 0x50, 0x84,
-	0x26, 0x10, 0xc1, 0x8b, 0x27, 0xa5, 0xe8, 0x49, 0x91, 0x14, 0x14, 0xbc, 0x84, 0xed, 0xce, 0xb4,
-	0x5d, 0xb0, 0xd9, 0xd8, 0x1d, 0xa3, 0xbe, 0x85, 0x4f, 0xe2, 0x73, 0x78, 0xec, 0xd1, 0xa3, 0xb4,
-	0x2f, 0x22, 0x26, 0xad, 0x1a, 0xf0, 0x36, 0x7c, 0xff, 0xcf, 0xff, 0xcf, 0x0c, 0x6f, 0xa9, 0x31,
-	0x3e, 0x40, 0x00, 0x1a, 0x82, 0x2c, 0x0c, 0x46, 0x98, 0xa0, 0xd5, 0xd6, 0x4f, 0xa7, 0x86, 0x8c,
-	0xeb, 0xe4, 0x9a, 0x0f, 0x1a, 0xfc, 0x2c, 0x6c, 0xed, 0x95, 0x9c, 0xa0, 0x01, 0x8c, 0x2a, 0x8c,
-	0xad, 0x9d, 0x92, 0x34, 0x44, 0x2c, 0x78, 0x3b, 0xe3, 0xdb, 0x3d, 0x0d, 0x3d, 0xa3, 0x6e, 0x70,
-	0x6a, 0xb5, 0x49, 0xfa, 0x48, 0xee, 0x01, 0xdf, 0xba, 0x97, 0x84, 0x96, 0xe2, 0xac, 0x80, 0x4d,
-	0xe6, 0xb1, 0x4e, 0x2d, 0x6a, 0x14, 0x74, 0xe9, 0x74, 0x4f, 0xf8, 0x26, 0x68, 0x88, 0xc1, 0x28,
-	0xdb, 0x5c, 0xf3, 0xaa, 0x9d, 0x7a, 0xe8, 0xf9, 0x7f, 0xd7, 0xf1, 0x8b, 0xe0, 0x5b, 0x4d, 0xe3,
-	0x4b, 0x24, 0x09, 0x92, 0x64, 0xb4, 0x01, 0x39, 0xb3, 0xed, 0x37, 0xc6, 0x9d, 0x8b, 0xe2, 0x94,
-	0x3e, 0x49, 0x42, 0x77, 0x9f, 0x37, 0xbe, 0xd3, 0x12, 0x39, 0x41, 0x9b, 0x4a, 0x85, 0xcb, 0x4e,
-	0x07, 0x34, 0x5c, 0xad, 0x98, 0x7b, 0xca, 0x9d, 0xe5, 0x4a, 0xb1, 0x45, 0x5a, 0xd5, 0x8a, 0xff,
-	0x6a, 0x7f, 0xef, 0x89, 0xea, 0xd9, 0xcf, 0x6c, 0xdd, 0x63, 0xce, 0x87, 0x88, 0x71, 0x2a, 0xa7,
-	0x72, 0x62, 0x9b, 0x55, 0x8f, 0x75, 0xea, 0xe1, 0x6e, 0x39, 0xe0, 0x1c, 0xf1, 0x3a, 0x97, 0xa3,
-	0xda, 0x70, 0x35, 0x9e, 0xf5, 0xde, 0xe7, 0x82, 0xcd, 0xe6, 0x82, 0x7d, 0xce, 0x05, 0x7b, 0x5d,
-	0x88, 0xca, 0x6c, 0x21, 0x2a, 0x1f, 0x0b, 0x51, 0xb9, 0x3b, 0x1c, 0x69, 0x1a, 0x3f, 0x0e, 0x7c,
-	0x65, 0x26, 0x81, 0x92, 0x89, 0x79, 0xea, 0x2a, 0x13, 0xe4, 0x81, 0xdd, 0xc4, 0x00, 0x06, 0xcf,
-	0xf9, 0xd7, 0xe9, 0x25, 0x45, 0x3b, 0x58, 0xcf, 0xbf, 0x7e, 0xf4, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x7b, 0x33, 0x7e, 0xff, 0xd4, 0x01, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x5f, 0x8b, 0xd3, 0x4e,
+	0x14, 0x6d, 0xb6, 0xfb, 0xeb, 0xcf, 0x9d, 0xfe, 0xd9, 0x32, 0xc2, 0x1a, 0xab, 0x86, 0x58, 0x11,
+	0x8a, 0xb0, 0x09, 0x54, 0xf0, 0xc5, 0x07, 0x71, 0x2d, 0xba, 0x08, 0x8a, 0xa4, 0xa0, 0xe0, 0x4b,
+	0x98, 0x9d, 0x7b, 0x9b, 0x0c, 0xd8, 0x4c, 0xed, 0x8c, 0x51, 0xbf, 0x81, 0x8f, 0x7e, 0x2c, 0x1f,
+	0xf7, 0xd1, 0x47, 0x69, 0xbf, 0x88, 0xec, 0xcc, 0xa4, 0x76, 0x60, 0xdf, 0xee, 0x3d, 0xe7, 0xde,
+	0x93, 0x7b, 0x4e, 0x86, 0x8c, 0x78, 0x89, 0x9f, 0x21, 0x05, 0x01, 0x69, 0x3d, 0x4d, 0x0b, 0xac,
+	0x50, 0x09, 0x95, 0xac, 0xd6, 0x52, 0x4b, 0xda, 0x33, 0x5c, 0x02, 0x02, 0x92, 0x7a, 0x3a, 0xba,
+	0xed, 0x4d, 0x82, 0x00, 0x90, 0xdc, 0x0e, 0x8e, 0x4e, 0x3c, 0x6a, 0x81, 0xe8, 0xf0, 0xbb, 0x1e,
+	0x5e, 0xb1, 0x25, 0xaa, 0x15, 0xe3, 0x0d, 0xeb, 0x7f, 0x5a, 0x89, 0xa2, 0x12, 0x55, 0x61, 0xb9,
+	0x71, 0x4d, 0x86, 0x33, 0x01, 0x33, 0xc9, 0xdf, 0xe3, 0x5a, 0x09, 0x59, 0xcd, 0x51, 0xd3, 0x87,
+	0x64, 0xf0, 0x89, 0x69, 0x54, 0x3a, 0xaf, 0x2d, 0x18, 0x06, 0x71, 0x30, 0x39, 0xca, 0xfa, 0x16,
+	0x75, 0x93, 0xf4, 0x29, 0xb9, 0x01, 0x02, 0x72, 0x90, 0x5c, 0x85, 0x07, 0x71, 0x7b, 0xd2, 0x9d,
+	0xc6, 0xc9, 0xbe, 0x91, 0xc4, 0x0a, 0x7f, 0x10, 0xba, 0x7c, 0x83, 0x9a, 0x01, 0xd3, 0x2c, 0xfb,
+	0x1f, 0x0c, 0xa6, 0xc6, 0xcf, 0xc8, 0x4d, 0x4b, 0xbf, 0x58, 0x23, 0xd3, 0x08, 0xe7, 0x28, 0x8a,
+	0x52, 0xd3, 0x21, 0x69, 0x83, 0x00, 0xf7, 0xbd, 0xab, 0x92, 0x9e, 0x90, 0x4e, 0x69, 0xb8, 0xf0,
+	0x20, 0x0e, 0x26, 0x87, 0x99, 0xeb, 0xc6, 0x3f, 0xda, 0xa4, 0xf7, 0xca, 0xa6, 0x38, 0xd7, 0x4c,
+	0x23, 0x7d, 0x40, 0xfa, 0x57, 0xe7, 0xec, 0xcc, 0x3b, 0x91, 0x1e, 0x08, 0x78, 0xdb, 0x60, 0xf4,
+	0x39, 0xe9, 0x39, 0x4f, 0xb9, 0x42, 0xdd, 0xdc, 0x1d, 0x5d, 0x77, 0xf7, 0xbf, 0x40, 0xb2, 0x6e,
+	0xbd, 0xab, 0x15, 0x7d, 0x42, 0xc8, 0x02, 0x31, 0x5f, 0xb1, 0x35, 0x5b, 0xaa, 0xb0, 0x1d, 0x07,
+	0x93, 0xee, 0xf4, 0x96, 0x2f, 0xf0, 0x12, 0xf1, 0x9d, 0xa1, 0xb3, 0xa3, 0x45, 0x53, 0xd2, 0x33,
+	0x32, 0x70, 0xd1, 0x37, 0xbb, 0x87, 0x66, 0xf7, 0x8e, 0xbf, 0x3b, 0xb7, 0x33, 0x6e, 0xbf, 0xaf,
+	0xf6, 0x5b, 0x7a, 0x4e, 0x86, 0x3b, 0x7f, 0x8d, 0xca, 0x7f, 0x46, 0xe5, 0x9e, 0xaf, 0xb2, 0x73,
+	0xec, 0x74, 0x8e, 0x2b, 0x1f, 0xa0, 0xaf, 0xc9, 0x31, 0xb7, 0xc9, 0xe7, 0x36, 0x50, 0x15, 0x76,
+	0x4c, 0x16, 0xf7, 0xaf, 0xcb, 0xc2, 0xfb, 0x49, 0xd9, 0x80, 0xef, 0xb7, 0xea, 0x6c, 0xf6, 0x6b,
+	0x13, 0x05, 0x97, 0x9b, 0x28, 0xf8, 0xb3, 0x89, 0x82, 0x9f, 0xdb, 0xa8, 0x75, 0xb9, 0x8d, 0x5a,
+	0xbf, 0xb7, 0x51, 0xeb, 0xe3, 0xa3, 0x42, 0xe8, 0xf2, 0xcb, 0x45, 0xc2, 0xe5, 0x32, 0xe5, 0xac,
+	0x92, 0x5f, 0x4f, 0xb9, 0x4c, 0x8d, 0xfe, 0x69, 0x25, 0x01, 0xd3, 0x6f, 0xe6, 0x51, 0xea, 0xef,
+	0x2b, 0x54, 0x17, 0x1d, 0xf3, 0x20, 0x1f, 0xff, 0x1d, 0x00, 0x44, 0x30, 0x20, 0x72, 0x29, 0x03,
+	0x00, 0x00,
 }
 
 func (m *DidDocVersionSet) Marshal() (dAtA []byte, err error) {
@@ -220,6 +312,41 @@ func (m *DidDocVersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DidDocCreatedHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidDocCreatedHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidDocCreatedHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -240,6 +367,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreatedHeights) > 0 {
+		for iNdEx := len(m.CreatedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NamespaceParams != nil {
+		{
+			size, err := m.NamespaceParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SigningParams != nil {
+		{
+			size, err := m.SigningParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.FeeParams != nil {
 		{
 			size, err := m.FeeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -306,6 +471,22 @@ func (m *DidDocVersionSet) Size() (n int) {
 	return n
 }
 
+func (m *DidDocCreatedHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -326,6 +507,20 @@ func (m *GenesisState) Size() (n int) {
 		l = m.FeeParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.SigningParams != nil {
+		l = m.SigningParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.NamespaceParams != nil {
+		l = m.NamespaceParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CreatedHeights) > 0 {
+		for _, e := range m.CreatedHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -451,6 +646,107 @@ func (m *DidDocVersionSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DidDocCreatedHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDocCreatedHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDocCreatedHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -582,6 +878,112 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SigningParams == nil {
+				m.SigningParams = &SigningParams{}
+			}
+			if err := m.SigningParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceParams == nil {
+				m.NamespaceParams = &NamespaceParams{}
+			}
+			if err := m.NamespaceParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedHeights = append(m.CreatedHeights, &DidDocCreatedHeight{})
+			if err := m.CreatedHeights[len(m.CreatedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "cheqd"
@@ -21,12 +27,22 @@ const (
 // did-count: -> <did-count>
 // did-latest:<did> -> <latest-version>
 // did-version:<did>:<version> -> <did-doc>
+// did-time:<did>:<timestamp> -> <version>
+// did-controller:<controller>:<did> -> <did>
+// did-public-key:<fingerprint>:<did> -> <did>
+// did-created-height:<did> -> <height>
+// did-unique-id:<unique-id> -> <did>
 
 const (
 	LatestDidDocVersionKey = "did-latest:"
 	DidDocVersionKey       = "did-version:"
 	DidDocCountKey         = "did-count:"
 	DidNamespaceKey        = "did-namespace:"
+	DidDocVersionTimeKey   = "did-time:"
+	DidDocControllerKey    = "did-controller:"
+	DidDocPublicKeyKey     = "did-public-key:"
+	DidDocCreatedHeightKey = "did-created-height:"
+	DidUniqueIDKey         = "did-unique-id:"
 )
 
 func GetLatestDidDocVersionKey(did string) []byte {
@@ -44,3 +60,37 @@ func GetLatestDidDocVersionPrefix() []byte {
 func GetDidDocVersionsPrefix(did string) []byte {
 	return []byte(DidDocVersionKey + did + ":")
 }
+
+// GetDidDocVersionTimeKey returns the key of the time index. Timestamps are encoded so that
+// lexicographical order of the keys matches chronological order.
+func GetDidDocVersionTimeKey(did string, timestamp time.Time) []byte {
+	return append(GetDidDocVersionTimePrefix(did), sdk.FormatTimeBytes(timestamp)...)
+}
+
+func GetDidDocVersionTimePrefix(did string) []byte {
+	return []byte(DidDocVersionTimeKey + did + ":")
+}
+
+func GetDidDocControllerKey(controller string, did string) []byte {
+	return []byte(DidDocControllerKey + controller + ":" + did)
+}
+
+func GetDidDocControllerPrefix(controller string) []byte {
+	return []byte(DidDocControllerKey + controller + ":")
+}
+
+func GetDidDocPublicKeyKey(fingerprint string, did string) []byte {
+	return []byte(DidDocPublicKeyKey + fingerprint + ":" + did)
+}
+
+func GetDidDocPublicKeyPrefix(fingerprint string) []byte {
+	return []byte(DidDocPublicKeyKey + fingerprint + ":")
+}
+
+func GetDidDocCreatedHeightKey(did string) []byte {
+	return []byte(DidDocCreatedHeightKey + did)
+}
+
+func GetDidUniqueIDKey(uniqueID string) []byte {
+	return []byte(DidUniqueIDKey + uniqueID)
+}
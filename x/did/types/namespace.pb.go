@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/did/v2/namespace.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NamespaceParams defines the DID namespaces allowed on the chain in addition to its DID namespace.
+// Each namespace is a separate sub-registry which may have its own fee schedule.
+// Unique ids of DIDs are unique across namespaces because resource collections are identified by the unique id only.
+// DIDs of a namespace which is removed from the allowed ones can still be resolved, updated and deactivated,
+// but new DIDs and resources can't be created in it.
+type NamespaceParams struct {
+	// Additional allowed namespaces
+	// Default: empty, only the chain's DID namespace is allowed
+	Namespaces []*NamespaceConfig `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (m *NamespaceParams) Reset()         { *m = NamespaceParams{} }
+func (m *NamespaceParams) String() string { return proto.CompactTextString(m) }
+func (*NamespaceParams) ProtoMessage()    {}
+func (*NamespaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa967fa602d7fc74, []int{0}
+}
+func (m *NamespaceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceParams.Merge(m, src)
+}
+func (m *NamespaceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceParams proto.InternalMessageInfo
+
+func (m *NamespaceParams) GetNamespaces() []*NamespaceConfig {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+// NamespaceConfig defines an allowed DID namespace
+type NamespaceConfig struct {
+	// Namespace of DIDs
+	// Example: mainnet, partners
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Fee parameters for operations on DIDs of the namespace.
+	// If not set, the fee parameters of the DID module are used.
+	FeeParams *FeeParams `protobuf:"bytes,2,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// Fee parameters for operations on resources linked to DIDs of the namespace.
+	// If not set, the fee parameters of the resource module are used.
+	ResourceFeeParams *ResourceFeeParams `protobuf:"bytes,3,opt,name=resource_fee_params,json=resourceFeeParams,proto3" json:"resource_fee_params,omitempty"`
+}
+
+func (m *NamespaceConfig) Reset()         { *m = NamespaceConfig{} }
+func (m *NamespaceConfig) String() string { return proto.CompactTextString(m) }
+func (*NamespaceConfig) ProtoMessage()    {}
+func (*NamespaceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa967fa602d7fc74, []int{1}
+}
+func (m *NamespaceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceConfig.Merge(m, src)
+}
+func (m *NamespaceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceConfig proto.InternalMessageInfo
+
+func (m *NamespaceConfig) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *NamespaceConfig) GetFeeParams() *FeeParams {
+	if m != nil {
+		return m.FeeParams
+	}
+	return nil
+}
+
+func (m *NamespaceConfig) GetResourceFeeParams() *ResourceFeeParams {
+	if m != nil {
+		return m.ResourceFeeParams
+	}
+	return nil
+}
+
+// ResourceFeeParams defines the fixed fees for operations on resources of a namespace.
+// It mirrors the fee and upload parameters of the resource module.
+type ResourceFeeParams struct {
+	// Fixed fee for creating a resource with media type 'image/*'
+	Image types.Coin `protobuf:"bytes,1,opt,name=image,proto3" json:"image"`
+	// Fixed fee for creating a resource with media type 'application/json'
+	Json types.Coin `protobuf:"bytes,2,opt,name=json,proto3" json:"json"`
+	// Fixed fee for creating a resource with all other media types
+	Default types.Coin `protobuf:"bytes,3,opt,name=default,proto3" json:"default"`
+	// Fixed fee for staging a chunk of a resource uploaded in chunks
+	Chunk types.Coin `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk"`
+	// Percentage of the fixed fee that will be burned
+	BurnFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=burn_factor,json=burnFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_factor"`
+}
+
+func (m *ResourceFeeParams) Reset()         { *m = ResourceFeeParams{} }
+func (m *ResourceFeeParams) String() string { return proto.CompactTextString(m) }
+func (*ResourceFeeParams) ProtoMessage()    {}
+func (*ResourceFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa967fa602d7fc74, []int{2}
+}
+func (m *ResourceFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceFeeParams.Merge(m, src)
+}
+func (m *ResourceFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceFeeParams proto.InternalMessageInfo
+
+func (m *ResourceFeeParams) GetImage() types.Coin {
+	if m != nil {
+		return m.Image
+	}
+	return types.Coin{}
+}
+
+func (m *ResourceFeeParams) GetJson() types.Coin {
+	if m != nil {
+		return m.Json
+	}
+	return types.Coin{}
+}
+
+func (m *ResourceFeeParams) GetDefault() types.Coin {
+	if m != nil {
+		return m.Default
+	}
+	return types.Coin{}
+}
+
+func (m *ResourceFeeParams) GetChunk() types.Coin {
+	if m != nil {
+		return m.Chunk
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*NamespaceParams)(nil), "cheqd.did.v2.NamespaceParams")
+	proto.RegisterType((*NamespaceConfig)(nil), "cheqd.did.v2.NamespaceConfig")
+	proto.RegisterType((*ResourceFeeParams)(nil), "cheqd.did.v2.ResourceFeeParams")
+}
+
+func init() { proto.RegisterFile("cheqd/did/v2/namespace.proto", fileDescriptor_aa967fa602d7fc74) }
+
+var fileDescriptor_aa967fa602d7fc74 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb6, 0x03, 0xd5, 0x45, 0x9a, 0x66, 0x10, 0x64, 0xd3, 0x48, 0xab, 0x1e, 0x50,
+	0x85, 0x54, 0x5b, 0xcb, 0x04, 0x12, 0x12, 0x5c, 0xba, 0x6a, 0x47, 0x98, 0x72, 0x44, 0x42, 0x95,
+	0x63, 0x3b, 0x69, 0x18, 0xf1, 0x2b, 0x71, 0x52, 0xe0, 0x5b, 0xf0, 0x61, 0x38, 0xf0, 0x11, 0x76,
+	0xac, 0x38, 0x21, 0x0e, 0x13, 0x6a, 0xbf, 0x08, 0x8a, 0xe3, 0x76, 0x6d, 0xb9, 0xf4, 0x14, 0xfb,
+	0xfd, 0x7f, 0xef, 0xff, 0xfe, 0xca, 0x33, 0x3a, 0xe5, 0x13, 0xf9, 0x59, 0x50, 0x91, 0x08, 0x3a,
+	0xf3, 0xa9, 0x62, 0xa9, 0xd4, 0x53, 0xc6, 0x25, 0x99, 0x66, 0x90, 0x03, 0x7e, 0x60, 0x54, 0x22,
+	0x12, 0x41, 0x66, 0xfe, 0xc9, 0xe3, 0x2d, 0x36, 0x92, 0x96, 0x3a, 0xf1, 0x38, 0xe8, 0x14, 0x34,
+	0x0d, 0x99, 0x96, 0x74, 0x76, 0x16, 0xca, 0x9c, 0x9d, 0x51, 0x0e, 0x89, 0xb2, 0xfa, 0x71, 0xa5,
+	0x8f, 0xcd, 0x8d, 0x56, 0x17, 0x2b, 0x3d, 0x8a, 0x21, 0x86, 0xaa, 0x5e, 0x9e, 0xaa, 0x6a, 0xef,
+	0x0a, 0x1d, 0xbe, 0x5d, 0x25, 0xb9, 0x62, 0x19, 0x4b, 0x35, 0x7e, 0x83, 0xd0, 0x3a, 0x9c, 0x76,
+	0x9d, 0x6e, 0xa3, 0xdf, 0xf6, 0x9f, 0x92, 0xcd, 0x78, 0x64, 0xdd, 0x72, 0x01, 0x2a, 0x4a, 0xe2,
+	0x60, 0xa3, 0xa1, 0xf7, 0xd3, 0x41, 0x87, 0x3b, 0x3a, 0x3e, 0x45, 0xad, 0x35, 0xe1, 0x3a, 0x5d,
+	0xa7, 0xdf, 0x0a, 0xee, 0x0a, 0xf8, 0x25, 0x42, 0x91, 0x94, 0xe3, 0xa9, 0x19, 0xef, 0xd6, 0xbb,
+	0x4e, 0xbf, 0xed, 0x3f, 0xd9, 0x1e, 0x78, 0x29, 0x6d, 0xba, 0xa0, 0x15, 0xad, 0x8e, 0xf8, 0x1d,
+	0x7a, 0x98, 0x49, 0x0d, 0x45, 0xc6, 0xe5, 0x78, 0xc3, 0xa0, 0x61, 0x0c, 0x3a, 0xdb, 0x06, 0x81,
+	0x05, 0xef, 0x8c, 0x8e, 0xb2, 0xdd, 0x52, 0x6f, 0x5e, 0x47, 0x47, 0xff, 0x81, 0xf8, 0x05, 0x3a,
+	0x48, 0x52, 0x16, 0x57, 0xc1, 0xdb, 0xfe, 0x31, 0xb1, 0xbf, 0xb5, 0xdc, 0x01, 0xb1, 0x3b, 0x20,
+	0x17, 0x90, 0xa8, 0x61, 0xf3, 0xe6, 0xb6, 0x53, 0x0b, 0x2a, 0x1a, 0x9f, 0xa3, 0xe6, 0x47, 0x0d,
+	0xca, 0xad, 0xef, 0xd7, 0x65, 0x60, 0xfc, 0x0a, 0xdd, 0x17, 0x32, 0x62, 0xc5, 0xa7, 0xdc, 0x6d,
+	0xec, 0xd7, 0xb7, 0xe2, 0xcb, 0x98, 0x7c, 0x52, 0xa8, 0x6b, 0xb7, 0xb9, 0x67, 0x4c, 0x43, 0xe3,
+	0x0f, 0xa8, 0x1d, 0x16, 0x99, 0x1a, 0x47, 0x8c, 0xe7, 0x90, 0xb9, 0x07, 0xe5, 0x72, 0x86, 0xaf,
+	0x4b, 0xe2, 0xcf, 0x6d, 0xe7, 0x59, 0x9c, 0xe4, 0x93, 0x22, 0x24, 0x1c, 0x52, 0xfb, 0x98, 0xec,
+	0x67, 0xa0, 0xc5, 0x35, 0xcd, 0xbf, 0x4d, 0xa5, 0x26, 0x23, 0xc9, 0x7f, 0xfd, 0x18, 0x20, 0x3b,
+	0x6d, 0x24, 0x79, 0x80, 0x4a, 0xc3, 0x4b, 0xe3, 0x37, 0x1c, 0xdd, 0x2c, 0x3c, 0x67, 0xbe, 0xf0,
+	0x9c, 0xbf, 0x0b, 0xcf, 0xf9, 0xbe, 0xf4, 0x6a, 0xf3, 0xa5, 0x57, 0xfb, 0xbd, 0xf4, 0x6a, 0xef,
+	0x9f, 0x6f, 0x7a, 0x33, 0x05, 0x5f, 0x06, 0x1c, 0xa8, 0xd9, 0xd9, 0x40, 0x81, 0x90, 0xf4, 0xab,
+	0x79, 0xfd, 0x66, 0x46, 0x78, 0xcf, 0x3c, 0xd6, 0xf3, 0x7f, 0x03, 0x00, 0x4c, 0xf5, 0x9b, 0xe6,
+	0x43, 0x03, 0x00, 0x00,
+}
+
+func (m *NamespaceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNamespace(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceFeeParams != nil {
+		{
+			size, err := m.ResourceFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNamespace(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FeeParams != nil {
+		{
+			size, err := m.FeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNamespace(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnFactor.Size()
+		i -= size
+		if _, err := m.BurnFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNamespace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNamespace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNamespace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Json.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNamespace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNamespace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNamespace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NamespaceParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.FeeParams != nil {
+		l = m.FeeParams.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.ResourceFeeParams != nil {
+		l = m.ResourceFeeParams.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Image.Size()
+	n += 1 + l + sovNamespace(uint64(l))
+	l = m.Json.Size()
+	n += 1 + l + sovNamespace(uint64(l))
+	l = m.Default.Size()
+	n += 1 + l + sovNamespace(uint64(l))
+	l = m.Chunk.Size()
+	n += 1 + l + sovNamespace(uint64(l))
+	l = m.BurnFactor.Size()
+	n += 1 + l + sovNamespace(uint64(l))
+	return n
+}
+
+func sovNamespace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNamespace(x uint64) (n int) {
+	return sovNamespace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NamespaceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, &NamespaceConfig{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeParams == nil {
+				m.FeeParams = &FeeParams{}
+			}
+			if err := m.FeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceFeeParams == nil {
+				m.ResourceFeeParams = &ResourceFeeParams{}
+			}
+			if err := m.ResourceFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Json.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Default.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNamespace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNamespace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNamespace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNamespace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNamespace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNamespace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNamespace = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,8 +7,23 @@ func NormalizeVerificationMethod(vm *VerificationMethod) {
 	vm.Id = utils.NormalizeDIDUrl(vm.Id)
 }
 
+// NormalizeService normalizes the id and fills in the legacy fields from the endpoint.
+// Services with only the legacy fields set are left without the endpoint, so they keep being rendered as before.
 func NormalizeService(s *Service) {
 	s.Id = utils.NormalizeDIDUrl(s.Id)
+
+	if s.Endpoint == nil {
+		return
+	}
+
+	NormalizeServiceEndpoint(s.Endpoint)
+
+	if len(s.ServiceEndpoint) == 0 && len(s.Accept) == 0 && len(s.RoutingKeys) == 0 {
+		serviceEndpoint, accept, routingKeys, ok := s.Endpoint.ToLegacy()
+		if ok {
+			s.ServiceEndpoint, s.Accept, s.RoutingKeys = serviceEndpoint, accept, routingKeys
+		}
+	}
 }
 
 func NormalizeVerificationRelationshipList(vrs []*VerificationRelationship) {
@@ -3,18 +3,24 @@ package types
 import (
 	"fmt"
 
+	"github.com/canow-co/cheqd-node/x/did/utils"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store key
 var (
-	ParamStoreKeyFeeParams = []byte("feeparams")
+	ParamStoreKeyFeeParams       = []byte("feeparams")
+	ParamStoreKeySigningParams   = []byte("signingparams")
+	ParamStoreKeyNamespaceParams = []byte("namespaceparams")
 )
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(ParamStoreKeyFeeParams, FeeParams{}, validateFeeParams),
+		paramtypes.NewParamSetPair(ParamStoreKeySigningParams, SigningParams{}, validateSigningParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyNamespaceParams, NamespaceParams{}, validateNamespaceParams),
 	)
 }
 
@@ -145,3 +151,132 @@ func validateFeeParams(i interface{}) error {
 
 	return v.ValidateBasic()
 }
+
+// DefaultSigningParams returns default payload signature verification parameters
+func DefaultSigningParams() *SigningParams {
+	return &SigningParams{
+		AllowLegacySignatures:                DefaultAllowLegacySignatures,
+		AllowCapabilityInvocationFullUpdates: DefaultAllowCapabilityInvocationFullUpdates,
+	}
+}
+
+func validateSigningParams(i interface{}) error {
+	_, ok := i.(SigningParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// DefaultNamespaceParams returns default namespace parameters: only the chain's DID namespace is allowed
+func DefaultNamespaceParams() *NamespaceParams {
+	return &NamespaceParams{
+		Namespaces: []*NamespaceConfig{},
+	}
+}
+
+// ValidateBasic performs basic validation of namespace parameters
+func (np *NamespaceParams) ValidateBasic() error {
+	namespaces := make([]string, 0, len(np.Namespaces))
+
+	for _, config := range np.Namespaces {
+		if config == nil {
+			return fmt.Errorf("namespace config must not be nil")
+		}
+
+		if config.Namespace == "" || !utils.DidNamespaceRegexp.MatchString(config.Namespace) {
+			return fmt.Errorf("invalid did namespace: %s", config.Namespace)
+		}
+
+		if config.FeeParams != nil {
+			if err := validateFeeParams(*config.FeeParams); err != nil {
+				return fmt.Errorf("invalid fee params of namespace %s: %w", config.Namespace, err)
+			}
+		}
+
+		if config.ResourceFeeParams != nil {
+			if err := config.ResourceFeeParams.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid resource fee params of namespace %s: %w", config.Namespace, err)
+			}
+		}
+
+		namespaces = append(namespaces, config.Namespace)
+	}
+
+	if !utils.IsUnique(namespaces) {
+		return fmt.Errorf("there are did namespace duplicates")
+	}
+
+	return nil
+}
+
+// AllowedNamespaces returns the chain's DID namespace followed by the additional namespaces
+func (np *NamespaceParams) AllowedNamespaces(didNamespace string) []string {
+	namespaces := []string{didNamespace}
+
+	for _, config := range np.Namespaces {
+		// Keep the order deterministic, it's a part of error messages
+		if !utils.Contains(namespaces, config.Namespace) {
+			namespaces = append(namespaces, config.Namespace)
+		}
+	}
+
+	return namespaces
+}
+
+// GetFeeParams returns fee parameters of the namespace if they are overridden
+func (np *NamespaceParams) GetFeeParams(namespace string) (*FeeParams, bool) {
+	for _, config := range np.Namespaces {
+		if config.Namespace == namespace && config.FeeParams != nil {
+			return config.FeeParams, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetResourceFeeParams returns resource fee parameters of the namespace if they are overridden
+func (np *NamespaceParams) GetResourceFeeParams(namespace string) (*ResourceFeeParams, bool) {
+	for _, config := range np.Namespaces {
+		if config.Namespace == namespace && config.ResourceFeeParams != nil {
+			return config.ResourceFeeParams, true
+		}
+	}
+
+	return nil, false
+}
+
+// ValidateBasic performs basic validation of resource fee parameters of a namespace
+func (rfp *ResourceFeeParams) ValidateBasic() error {
+	if !isPositiveBaseCoin(rfp.Image) {
+		return fmt.Errorf("invalid create resource image tx fee: %s", rfp.Image)
+	}
+
+	if !isPositiveBaseCoin(rfp.Json) {
+		return fmt.Errorf("invalid create resource json tx fee: %s", rfp.Json)
+	}
+
+	if !isPositiveBaseCoin(rfp.Default) {
+		return fmt.Errorf("invalid create resource default tx fee: %s", rfp.Default)
+	}
+
+	if !isPositiveBaseCoin(rfp.Chunk) {
+		return fmt.Errorf("invalid resource chunk tx fee: %s", rfp.Chunk)
+	}
+
+	return validateBurnFactor(rfp.BurnFactor)
+}
+
+func isPositiveBaseCoin(coin sdk.Coin) bool {
+	return !coin.IsNil() && coin.IsPositive() && coin.Denom == BaseMinimalDenom
+}
+
+func validateNamespaceParams(i interface{}) error {
+	v, ok := i.(NamespaceParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.ValidateBasic()
+}
@@ -1,7 +1,12 @@
 package types
 
 const (
-	QueryGetDidDoc            = "get-diddoc"
-	QueryGetAllDidDocVersions = "get-all-diddoc-versions"
-	QueryGetDidDocVersion     = "get-diddoc-version"
+	QueryGetDidDoc              = "get-diddoc"
+	QueryGetAllDidDocVersions   = "get-all-diddoc-versions"
+	QueryGetDidDocVersion       = "get-diddoc-version"
+	QueryResolveDid             = "resolve-did"
+	QueryGetDidDocAtTime        = "get-diddoc-at-time"
+	QueryGetDidDocsByController = "get-diddocs-by-controller"
+	QueryGetDidDocsByPublicKey  = "get-diddocs-by-public-key"
+	QueryGetAllDidDocs          = "get-all-diddocs"
 )
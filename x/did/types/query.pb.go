@@ -7,8 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	_ "github.com/cosmos/gogoproto/types"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -16,12 +19,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +34,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeactivationFilter selects DID Documents by their deactivation status
+type DeactivationFilter int32
+
+const (
+	// DEACTIVATION_FILTER_ANY selects both active and deactivated DID Documents
+	DEACTIVATION_FILTER_ANY DeactivationFilter = 0
+	// DEACTIVATION_FILTER_ACTIVE selects only active DID Documents
+	DEACTIVATION_FILTER_ACTIVE DeactivationFilter = 1
+	// DEACTIVATION_FILTER_DEACTIVATED selects only deactivated DID Documents
+	DEACTIVATION_FILTER_DEACTIVATED DeactivationFilter = 2
+)
+
+var DeactivationFilter_name = map[int32]string{
+	0: "DEACTIVATION_FILTER_ANY",
+	1: "DEACTIVATION_FILTER_ACTIVE",
+	2: "DEACTIVATION_FILTER_DEACTIVATED",
+}
+
+var DeactivationFilter_value = map[string]int32{
+	"DEACTIVATION_FILTER_ANY":         0,
+	"DEACTIVATION_FILTER_ACTIVE":      1,
+	"DEACTIVATION_FILTER_DEACTIVATED": 2,
+}
+
+func (x DeactivationFilter) String() string {
+	return proto.EnumName(DeactivationFilter_name, int32(x))
+}
+
+func (DeactivationFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{0}
+}
+
 // QueryDidDocRequest is the request type for the Query/DidDoc method
 type QueryDidDocRequest struct {
 	// DID unique identifier of the DID Document to fetch.
@@ -364,549 +401,3984 @@ func (m *QueryAllDidDocVersionsMetadataResponse) GetPagination() *query.PageResp
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDidDocRequest)(nil), "cheqd.did.v2.QueryDidDocRequest")
-	proto.RegisterType((*QueryDidDocResponse)(nil), "cheqd.did.v2.QueryDidDocResponse")
-	proto.RegisterType((*QueryDidDocVersionRequest)(nil), "cheqd.did.v2.QueryDidDocVersionRequest")
-	proto.RegisterType((*QueryDidDocVersionResponse)(nil), "cheqd.did.v2.QueryDidDocVersionResponse")
-	proto.RegisterType((*QueryAllDidDocVersionsMetadataRequest)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataRequest")
-	proto.RegisterType((*QueryAllDidDocVersionsMetadataResponse)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataResponse")
+// QueryResolveRequest is the request type for the Query/Resolve method
+type QueryResolveRequest struct {
+	// DID unique identifier of the DID Document to resolve.
+	// UUID-style DIDs as well as Indy-style DID are supported.
+	//
+	// Format: did:canow:<namespace>:<unique-identifier>
+	//
+	// Examples:
+	// - did:canow:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// accept is the requested representation of the DID Document.
+	// Supported values: application/did+ld+json, application/did+json
+	// Default: application/did+ld+json
+	Accept string `protobuf:"bytes,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	// version_id selects a specific version of the DID Document.
+	//
+	// Format: <uuid>
+	//
+	// Example: 93f2573c-eca9-4098-96cb-a1ec676a29ed
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// version_time selects the version of the DID Document that was active at the given moment.
+	//
+	// Format: RFC3339
+	//
+	// Example: 2021-03-10T15:16:17Z
+	VersionTime string `protobuf:"bytes,4,opt,name=version_time,json=versionTime,proto3" json:"version_time,omitempty"`
 }
 
-func init() { proto.RegisterFile("cheqd/did/v2/query.proto", fileDescriptor_8d818263856d0dc9) }
-
-var fileDescriptor_8d818263856d0dc9 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x4e, 0x1b, 0xe0, 0x02, 0x07, 0x83, 0x50, 0x1a, 0x20, 0x2a, 0x61, 0x74, 0xd5,
-	0xc4, 0x6c, 0x2d, 0x43, 0xdc, 0x41, 0x05, 0x4e, 0x93, 0xa0, 0x42, 0x20, 0x71, 0x73, 0x63, 0x2b,
-	0xb5, 0xd4, 0xc5, 0x69, 0xed, 0x06, 0xa6, 0x69, 0x42, 0xe2, 0x13, 0x4c, 0xe2, 0x33, 0x70, 0xe2,
-	0xc8, 0x97, 0xe0, 0x38, 0x89, 0x0b, 0x47, 0xd4, 0xf2, 0x41, 0xd0, 0x6c, 0x53, 0x1a, 0xda, 0x10,
-	0xa1, 0xdd, 0x92, 0xbc, 0xff, 0xfb, 0xbf, 0x9f, 0xdf, 0x7b, 0x31, 0xf4, 0xe2, 0x01, 0x1f, 0x31,
-	0xc2, 0x04, 0x23, 0x79, 0x44, 0x46, 0x13, 0x3e, 0x3e, 0xc4, 0xd9, 0x58, 0x6a, 0x89, 0x2e, 0x9b,
-	0x08, 0x66, 0x82, 0xe1, 0x3c, 0xf2, 0x9b, 0x05, 0x1d, 0x13, 0x8c, 0xc9, 0xd8, 0x0a, 0xfd, 0xed,
-	0x58, 0xaa, 0x03, 0xa9, 0x48, 0x9f, 0x2a, 0x6e, 0x1d, 0x48, 0xbe, 0xdb, 0xe7, 0x9a, 0xee, 0x92,
-	0x8c, 0x26, 0x22, 0xa5, 0x5a, 0xc8, 0xd4, 0x69, 0x6f, 0x25, 0x52, 0x26, 0x43, 0x4e, 0x68, 0x26,
-	0x08, 0x4d, 0x53, 0xa9, 0x4d, 0x50, 0xd9, 0x68, 0xb8, 0x09, 0xd1, 0x8b, 0xb3, 0xfc, 0xae, 0x60,
-	0x5d, 0x19, 0xf7, 0xf8, 0x68, 0xc2, 0x95, 0x46, 0x57, 0x61, 0x5d, 0x30, 0x0f, 0xb4, 0x40, 0xe7,
-	0x52, 0xaf, 0x2e, 0x58, 0xb8, 0x0f, 0xaf, 0x15, 0x54, 0x2a, 0x93, 0xa9, 0xe2, 0xe8, 0x21, 0x5c,
-	0xcf, 0xe9, 0x70, 0xc2, 0x8d, 0xb2, 0x11, 0xb5, 0xf0, 0x22, 0x3f, 0xb6, 0xe2, 0xd7, 0x42, 0x0f,
-	0xf6, 0xb9, 0xa6, 0x8c, 0x6a, 0xda, 0xb3, 0xf2, 0xf0, 0x09, 0x6c, 0x2e, 0xd8, 0xbd, 0xe2, 0x63,
-	0x25, 0x64, 0x5a, 0x52, 0x1b, 0x79, 0xf0, 0x42, 0x6e, 0x15, 0x5e, 0xdd, 0x7c, 0xfc, 0xfd, 0x1a,
-	0xbe, 0x84, 0xfe, 0x2a, 0x9b, 0x73, 0xc2, 0xbd, 0x87, 0xf7, 0x8c, 0xeb, 0xa3, 0xe1, 0xb0, 0x60,
-	0xac, 0xe6, 0xc2, 0x12, 0xd0, 0xa7, 0x10, 0xfe, 0x69, 0xbe, 0x61, 0x6d, 0x44, 0x6d, 0x6c, 0x27,
-	0x85, 0xcf, 0x26, 0x85, 0xed, 0xac, 0xdd, 0xa4, 0xf0, 0x73, 0x9a, 0x70, 0xe7, 0xd5, 0x5b, 0xc8,
-	0x0c, 0x3f, 0x01, 0xd8, 0xae, 0x22, 0x70, 0x67, 0x8c, 0xe0, 0x45, 0xd7, 0x0c, 0xe5, 0x81, 0xd6,
-	0x5a, 0xa7, 0x11, 0xdd, 0x28, 0x1e, 0x73, 0x9e, 0x31, 0xd7, 0xa1, 0x67, 0x2b, 0x30, 0xb7, 0x2a,
-	0x31, 0x6d, 0xc1, 0x45, 0xce, 0xe8, 0xcb, 0x1a, 0x5c, 0x37, 0x9c, 0x48, 0xc0, 0x0d, 0x0b, 0x8a,
-	0xfe, 0xea, 0xf2, 0xf2, 0x6a, 0xf9, 0x77, 0xfe, 0xa1, 0xb0, 0x45, 0x42, 0xff, 0xc3, 0xb7, 0x9f,
-	0x1f, 0xeb, 0xd7, 0x11, 0x22, 0x85, 0x3f, 0xe0, 0x48, 0xb0, 0x63, 0x74, 0x02, 0xe0, 0x95, 0x42,
-	0x53, 0xd0, 0x56, 0xa9, 0x61, 0x71, 0xb1, 0xfc, 0x4e, 0xb5, 0xd0, 0x01, 0xdc, 0x37, 0x00, 0x6d,
-	0xb4, 0xb9, 0x0c, 0x40, 0x5c, 0x1f, 0xc9, 0x91, 0x7b, 0x38, 0x46, 0x9f, 0x01, 0x6c, 0x96, 0x8e,
-	0x0a, 0xed, 0xad, 0xa8, 0x5a, 0xb5, 0x5a, 0xfe, 0x83, 0xff, 0x4b, 0x72, 0xd8, 0x77, 0x0d, 0xf6,
-	0x6d, 0x74, 0xb3, 0x1c, 0x5b, 0x3d, 0xee, 0x7e, 0x9d, 0x06, 0xe0, 0x74, 0x1a, 0x80, 0x1f, 0xd3,
-	0x00, 0x9c, 0xcc, 0x82, 0xda, 0xe9, 0x2c, 0xa8, 0x7d, 0x9f, 0x05, 0xb5, 0x37, 0xdb, 0x89, 0xd0,
-	0x83, 0x49, 0x1f, 0xc7, 0xf2, 0x80, 0xc4, 0x34, 0x95, 0x6f, 0x77, 0x62, 0x69, 0x9d, 0x76, 0x52,
-	0xc9, 0x38, 0x79, 0x67, 0x0c, 0xf5, 0x61, 0xc6, 0x55, 0x7f, 0xc3, 0xdc, 0x1e, 0x7b, 0xbf, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xa7, 0x4f, 0x1f, 0xdb, 0xcc, 0x04, 0x00, 0x00,
+func (m *QueryResolveRequest) Reset()         { *m = QueryResolveRequest{} }
+func (m *QueryResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveRequest) ProtoMessage()    {}
+func (*QueryResolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Fetch latest version of a DID Document for a given DID
-	DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error)
-	// Fetch specific version of a DID Document for a given DID
-	DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error)
-	// Fetch list of all versions of DID Documents for a given DID
-	AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error)
+func (m *QueryResolveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryResolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryResolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveRequest.Merge(m, src)
+}
+func (m *QueryResolveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error) {
-	out := new(QueryDidDocResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDoc", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResolveRequest proto.InternalMessageInfo
+
+func (m *QueryResolveRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error) {
-	out := new(QueryDidDocVersionResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryResolveRequest) GetAccept() string {
+	if m != nil {
+		return m.Accept
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error) {
-	out := new(QueryAllDidDocVersionsMetadataResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllDidDocVersionsMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryResolveRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Fetch latest version of a DID Document for a given DID
-	DidDoc(context.Context, *QueryDidDocRequest) (*QueryDidDocResponse, error)
-	// Fetch specific version of a DID Document for a given DID
-	DidDocVersion(context.Context, *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error)
-	// Fetch list of all versions of DID Documents for a given DID
-	AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error)
+func (m *QueryResolveRequest) GetVersionTime() string {
+	if m != nil {
+		return m.VersionTime
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryResolveResponse is the response type for the Query/Resolve method.
+// It follows the DID Resolution Result data structure.
+// Documentation: https://w3c-ccg.github.io/did-resolution/#did-resolution-result
+type QueryResolveResponse struct {
+	// didResolutionMetadata is the metadata about the resolution process.
+	DidResolutionMetadata *DidResolutionMetadata `protobuf:"bytes,1,opt,name=did_resolution_metadata,json=didResolutionMetadata,proto3" json:"didResolutionMetadata"`
+	// didDocument is the DID Document serialized in the representation from did_resolution_metadata.content_type.
+	// Empty if the resolution has failed.
+	DidDocument string `protobuf:"bytes,2,opt,name=did_document,json=didDocument,proto3" json:"didDocument"`
+	// didDocumentMetadata is the metadata of the resolved version of the DID Document.
+	// Empty if the resolution has failed.
+	DidDocumentMetadata *Metadata `protobuf:"bytes,3,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"didDocumentMetadata"`
 }
 
-func (*UnimplementedQueryServer) DidDoc(ctx context.Context, req *QueryDidDocRequest) (*QueryDidDocResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidDoc not implemented")
+func (m *QueryResolveResponse) Reset()         { *m = QueryResolveResponse{} }
+func (m *QueryResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveResponse) ProtoMessage()    {}
+func (*QueryResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{7}
 }
-func (*UnimplementedQueryServer) DidDocVersion(ctx context.Context, req *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidDocVersion not implemented")
+func (m *QueryResolveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllDidDocVersionsMetadata(ctx context.Context, req *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocVersionsMetadata not implemented")
+func (m *QueryResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveResponse.Merge(m, src)
+}
+func (m *QueryResolveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveResponse.DiscardUnknown(m)
 }
 
-func _Query_DidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDidDocRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidDoc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/DidDoc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidDoc(ctx, req.(*QueryDidDocRequest))
+var xxx_messageInfo_QueryResolveResponse proto.InternalMessageInfo
+
+func (m *QueryResolveResponse) GetDidResolutionMetadata() *DidResolutionMetadata {
+	if m != nil {
+		return m.DidResolutionMetadata
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DidDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDidDocVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidDocVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/DidDocVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidDocVersion(ctx, req.(*QueryDidDocVersionRequest))
+func (m *QueryResolveResponse) GetDidDocument() string {
+	if m != nil {
+		return m.DidDocument
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllDidDocVersionsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDidDocVersionsMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.did.v2.Query/AllDidDocVersionsMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, req.(*QueryAllDidDocVersionsMetadataRequest))
+func (m *QueryResolveResponse) GetDidDocumentMetadata() *Metadata {
+	if m != nil {
+		return m.DidDocumentMetadata
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cheqd.did.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DidDoc",
-			Handler:    _Query_DidDoc_Handler,
-		},
-		{
-			MethodName: "DidDocVersion",
-			Handler:    _Query_DidDocVersion_Handler,
-		},
-		{
-			MethodName: "AllDidDocVersionsMetadata",
-			Handler:    _Query_AllDidDocVersionsMetadata_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cheqd/did/v2/query.proto",
+// DidResolutionMetadata defines DID resolution metadata, as defined in the DID Core specification.
+// Documentation: https://www.w3.org/TR/did-core/#did-resolution-metadata
+type DidResolutionMetadata struct {
+	// contentType is the media type of the returned DID Document representation.
+	// Example: application/did+ld+json
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"contentType,omitempty"`
+	// error is the error code of the resolution process. Empty if the resolution was successful.
+	// Possible values: invalidDid, notFound, representationNotSupported, methodNotSupported, invalidOptions
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// retrieved is the time of the block the DID Document was resolved at.
+	// Format: RFC3339
+	// Example: 2021-03-10T15:16:17Z
+	Retrieved time.Time `protobuf:"bytes,3,opt,name=retrieved,proto3,stdtime" json:"retrieved"`
+	// did contains the properties of the resolved DID.
+	Did *DidProperties `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-func (m *QueryDidDocRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DidResolutionMetadata) Reset()         { *m = DidResolutionMetadata{} }
+func (m *DidResolutionMetadata) String() string { return proto.CompactTextString(m) }
+func (*DidResolutionMetadata) ProtoMessage()    {}
+func (*DidResolutionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{8}
+}
+func (m *DidResolutionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidResolutionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidResolutionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDidDocRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DidResolutionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidResolutionMetadata.Merge(m, src)
+}
+func (m *DidResolutionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidResolutionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidResolutionMetadata.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_DidResolutionMetadata proto.InternalMessageInfo
+
+func (m *DidResolutionMetadata) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDidDocResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DidResolutionMetadata) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DidResolutionMetadata) GetRetrieved() time.Time {
+	if m != nil {
+		return m.Retrieved
+	}
+	return time.Time{}
 }
 
-func (m *QueryDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *DidResolutionMetadata) GetDid() *DidProperties {
+	if m != nil {
+		return m.Did
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDidDocVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// DidProperties defines the components of a resolved DID.
+type DidProperties struct {
+	// didString is the DID that was resolved.
+	// Example: did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	DidString string `protobuf:"bytes,1,opt,name=did_string,json=didString,proto3" json:"didString"`
+	// methodSpecificId is the method specific identifier of the DID.
+	// Example: testnet:wGHEXrZvJxR8vw5P3UWH1j
+	MethodSpecificId string `protobuf:"bytes,2,opt,name=method_specific_id,json=methodSpecificId,proto3" json:"methodSpecificId"`
+	// method is the DID method.
+	// Example: canow
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method"`
 }
 
-func (m *QueryDidDocVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DidProperties) Reset()         { *m = DidProperties{} }
+func (m *DidProperties) String() string { return proto.CompactTextString(m) }
+func (*DidProperties) ProtoMessage()    {}
+func (*DidProperties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{9}
+}
+func (m *DidProperties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidProperties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidProperties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidProperties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidProperties.Merge(m, src)
+}
+func (m *DidProperties) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidProperties) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidProperties.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_DidProperties proto.InternalMessageInfo
+
+func (m *DidProperties) GetDidString() string {
+	if m != nil {
+		return m.DidString
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *DidProperties) GetMethodSpecificId() string {
+	if m != nil {
+		return m.MethodSpecificId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDidDocVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DidProperties) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDidDocVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDidDocAtTimeRequest is the request type for the Query/DidDocAtTime method
+type QueryDidDocAtTimeRequest struct {
+	// DID unique identifier of the DID Document to fetch.
+	// UUID-style DIDs as well as Indy-style DID are supported.
+	//
+	// Format: did:canow:<namespace>:<unique-identifier>
+	//
+	// Examples:
+	// - did:canow:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Moment of time the DID Document version was active at.
+	//
+	// Format: RFC3339
+	//
+	// Example: 2021-01-01T00:00:00Z
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *QueryDidDocVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDidDocAtTimeRequest) Reset()         { *m = QueryDidDocAtTimeRequest{} }
+func (m *QueryDidDocAtTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocAtTimeRequest) ProtoMessage()    {}
+func (*QueryDidDocAtTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{10}
+}
+func (m *QueryDidDocAtTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocAtTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocAtTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDidDocAtTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocAtTimeRequest.Merge(m, src)
+}
+func (m *QueryDidDocAtTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocAtTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocAtTimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDidDocAtTimeRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocAtTimeRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDidDocAtTimeRequest) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryDidDocAtTimeResponse is the response type for the Query/DidDocAtTime method
+type QueryDidDocAtTimeResponse struct {
+	// Successful resolution of the DID Document returns the following:
+	// - did_doc is the version of the DID Document active at the requested moment
+	// - metadata is DID Document metadata associated with that version of the DID Document
+	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryDidDocAtTimeResponse) Reset()         { *m = QueryDidDocAtTimeResponse{} }
+func (m *QueryDidDocAtTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocAtTimeResponse) ProtoMessage()    {}
+func (*QueryDidDocAtTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{11}
+}
+func (m *QueryDidDocAtTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocAtTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocAtTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDidDocAtTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocAtTimeResponse.Merge(m, src)
+}
+func (m *QueryDidDocAtTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocAtTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocAtTimeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDidDocAtTimeResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocAtTimeResponse) GetValue() *DidDocWithMetadata {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDidDocsByControllerRequest is the request type for the Query/DidDocsByController method
+type QueryDidDocsByControllerRequest struct {
+	// DID unique identifier of the controller.
+	// Both controllers listed in the controller field and controllers of verification methods are considered.
+	//
+	// Format: did:canow:<namespace>:<unique-identifier>
+	//
+	// Examples:
+	// - did:canow:mainnet:c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDidDocsByControllerRequest) Reset()         { *m = QueryDidDocsByControllerRequest{} }
+func (m *QueryDidDocsByControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocsByControllerRequest) ProtoMessage()    {}
+func (*QueryDidDocsByControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{12}
+}
+func (m *QueryDidDocsByControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocsByControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocsByControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryDidDocsByControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocsByControllerRequest.Merge(m, src)
+}
+func (m *QueryDidDocsByControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocsByControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocsByControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocsByControllerRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocsByControllerRequest) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDidDocsByControllerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryDidDocRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryDidDocsByControllerResponse is the response type for the Query/DidDocsByController method
+type QueryDidDocsByControllerResponse struct {
+	// did_docs is the list of latest versions of the DID Documents controlled by the requested DID
+	DidDocs []*DidDocWithMetadata `protobuf:"bytes,1,rep,name=did_docs,json=didDocs,proto3" json:"did_docs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidDocsByControllerResponse) Reset()         { *m = QueryDidDocsByControllerResponse{} }
+func (m *QueryDidDocsByControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocsByControllerResponse) ProtoMessage()    {}
+func (*QueryDidDocsByControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{13}
+}
+func (m *QueryDidDocsByControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocsByControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocsByControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDidDocsByControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocsByControllerResponse.Merge(m, src)
+}
+func (m *QueryDidDocsByControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocsByControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocsByControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocsByControllerResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocsByControllerResponse) GetDidDocs() []*DidDocWithMetadata {
+	if m != nil {
+		return m.DidDocs
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDidDocResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDidDocsByControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryDidDocsByPublicKeyRequest is the request type for the Query/DidDocsByPublicKey method
+type QueryDidDocsByPublicKeyRequest struct {
+	// Public key to look up.
+	// If verification_method_type is empty, it is a fingerprint of the key: multibase (base58btc) encoded
+	// multicodec prefixed public key bytes, the same as used in did:key.
+	// Otherwise, it is verification material of the given verification method type.
+	//
+	// Examples:
+	// - z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK
+	// - {"crv":"Ed25519","kty":"OKP","x":"..."}
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Verification method type the public key is represented with. OPTIONAL.
+	//
+	// Example: Ed25519VerificationKey2018
+	VerificationMethodType string `protobuf:"bytes,2,opt,name=verification_method_type,json=verificationMethodType,proto3" json:"verification_method_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) Reset()         { *m = QueryDidDocsByPublicKeyRequest{} }
+func (m *QueryDidDocsByPublicKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocsByPublicKeyRequest) ProtoMessage()    {}
+func (*QueryDidDocsByPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{14}
+}
+func (m *QueryDidDocsByPublicKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocsByPublicKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocsByPublicKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDidDocsByPublicKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocsByPublicKeyRequest.Merge(m, src)
+}
+func (m *QueryDidDocsByPublicKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocsByPublicKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocsByPublicKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDidDocsByPublicKeyRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocsByPublicKeyRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) GetVerificationMethodType() string {
+	if m != nil {
+		return m.VerificationMethodType
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDidDocVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryDidDocsByPublicKeyResponse is the response type for the Query/DidDocsByPublicKey method
+type QueryDidDocsByPublicKeyResponse struct {
+	// did_docs is the list of latest versions of the DID Documents having the requested public key
+	DidDocs []*DidDocWithMetadata `protobuf:"bytes,1,rep,name=did_docs,json=didDocs,proto3" json:"did_docs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidDocsByPublicKeyResponse) Reset()         { *m = QueryDidDocsByPublicKeyResponse{} }
+func (m *QueryDidDocsByPublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocsByPublicKeyResponse) ProtoMessage()    {}
+func (*QueryDidDocsByPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{15}
+}
+func (m *QueryDidDocsByPublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocsByPublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocsByPublicKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDidDocsByPublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocsByPublicKeyResponse.Merge(m, src)
+}
+func (m *QueryDidDocsByPublicKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocsByPublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocsByPublicKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocsByPublicKeyResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocsByPublicKeyResponse) GetDidDocs() []*DidDocWithMetadata {
+	if m != nil {
+		return m.DidDocs
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllDidDocVersionsMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDidDocsByPublicKeyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryAllDidDocsRequest is the request type for the Query/AllDidDocs method.
+// All filters are optional and are applied to the latest versions of DID Documents.
+type QueryAllDidDocsRequest struct {
+	// Deactivation status of DID Documents to return.
+	// Default: DEACTIVATION_FILTER_ANY
+	Deactivated DeactivationFilter `protobuf:"varint,1,opt,name=deactivated,proto3,enum=cheqd.did.v2.DeactivationFilter" json:"deactivated,omitempty"`
+	// Lowest block height (inclusive) the DID Documents were created at. Zero means no lower bound.
+	// DID Documents created before the creation height index was introduced are indexed at the upgrade height.
+	CreatedHeightFrom uint64 `protobuf:"varint,2,opt,name=created_height_from,json=createdHeightFrom,proto3" json:"created_height_from,omitempty"`
+	// Highest block height (inclusive) the DID Documents were created at. Zero means no upper bound.
+	CreatedHeightTo uint64 `protobuf:"varint,3,opt,name=created_height_to,json=createdHeightTo,proto3" json:"created_height_to,omitempty"`
+	// Earliest creation time (inclusive) of the DID Documents. Empty means no lower bound.
+	// Format: RFC3339
+	// Example: 2021-03-10T15:16:17Z
+	CreatedAfter string `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Latest creation time (inclusive) of the DID Documents. Empty means no upper bound.
+	// Format: RFC3339
+	// Example: 2021-03-10T15:16:17Z
+	CreatedBefore string `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Type of verification method the DID Documents must contain.
+	// Verification methods embedded into verification relationships are considered.
+	//
+	// Example: Ed25519VerificationKey2020
+	VerificationMethodType string `protobuf:"bytes,6,opt,name=verification_method_type,json=verificationMethodType,proto3" json:"verification_method_type,omitempty"`
+	// Type of service the DID Documents must contain.
+	//
+	// Example: LinkedDomains
+	ServiceType string `protobuf:"bytes,7,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Namespace of the DIDs. Empty means all namespaces.
+	//
+	// Example: testnet
+	Namespace string `protobuf:"bytes,9,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryAllDidDocsRequest) Reset()         { *m = QueryAllDidDocsRequest{} }
+func (m *QueryAllDidDocsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDidDocsRequest) ProtoMessage()    {}
+func (*QueryAllDidDocsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{16}
+}
+func (m *QueryAllDidDocsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDidDocsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDidDocsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAllDidDocsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDidDocsRequest.Merge(m, src)
+}
+func (m *QueryAllDidDocsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDidDocsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDidDocsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDidDocsRequest proto.InternalMessageInfo
+
+func (m *QueryAllDidDocsRequest) GetDeactivated() DeactivationFilter {
+	if m != nil {
+		return m.Deactivated
 	}
-	return n
+	return DEACTIVATION_FILTER_ANY
 }
 
-func (m *QueryAllDidDocVersionsMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllDidDocsRequest) GetCreatedHeightFrom() uint64 {
+	if m != nil {
+		return m.CreatedHeightFrom
 	}
-	var l int
-	_ = l
-	if len(m.Versions) > 0 {
-		for _, e := range m.Versions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryAllDidDocsRequest) GetCreatedHeightTo() uint64 {
+	if m != nil {
+		return m.CreatedHeightTo
+	}
+	return 0
+}
+
+func (m *QueryAllDidDocsRequest) GetCreatedAfter() string {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return ""
+}
+
+func (m *QueryAllDidDocsRequest) GetCreatedBefore() string {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return ""
+}
+
+func (m *QueryAllDidDocsRequest) GetVerificationMethodType() string {
+	if m != nil {
+		return m.VerificationMethodType
+	}
+	return ""
+}
+
+func (m *QueryAllDidDocsRequest) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+func (m *QueryAllDidDocsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllDidDocsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// QueryAllDidDocsResponse is the response type for the Query/AllDidDocs method
+type QueryAllDidDocsResponse struct {
+	// did_docs is the list of latest versions of the DID Documents matching the filters
+	DidDocs []*DidDocWithMetadata `protobuf:"bytes,1,rep,name=did_docs,json=didDocs,proto3" json:"did_docs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDidDocsResponse) Reset()         { *m = QueryAllDidDocsResponse{} }
+func (m *QueryAllDidDocsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDidDocsResponse) ProtoMessage()    {}
+func (*QueryAllDidDocsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d818263856d0dc9, []int{17}
+}
+func (m *QueryAllDidDocsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDidDocsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDidDocsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDidDocsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDidDocsResponse.Merge(m, src)
+}
+func (m *QueryAllDidDocsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDidDocsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDidDocsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDidDocsResponse proto.InternalMessageInfo
+
+func (m *QueryAllDidDocsResponse) GetDidDocs() []*DidDocWithMetadata {
+	if m != nil {
+		return m.DidDocs
+	}
+	return nil
+}
+
+func (m *QueryAllDidDocsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cheqd.did.v2.DeactivationFilter", DeactivationFilter_name, DeactivationFilter_value)
+	proto.RegisterType((*QueryDidDocRequest)(nil), "cheqd.did.v2.QueryDidDocRequest")
+	proto.RegisterType((*QueryDidDocResponse)(nil), "cheqd.did.v2.QueryDidDocResponse")
+	proto.RegisterType((*QueryDidDocVersionRequest)(nil), "cheqd.did.v2.QueryDidDocVersionRequest")
+	proto.RegisterType((*QueryDidDocVersionResponse)(nil), "cheqd.did.v2.QueryDidDocVersionResponse")
+	proto.RegisterType((*QueryAllDidDocVersionsMetadataRequest)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataRequest")
+	proto.RegisterType((*QueryAllDidDocVersionsMetadataResponse)(nil), "cheqd.did.v2.QueryAllDidDocVersionsMetadataResponse")
+	proto.RegisterType((*QueryResolveRequest)(nil), "cheqd.did.v2.QueryResolveRequest")
+	proto.RegisterType((*QueryResolveResponse)(nil), "cheqd.did.v2.QueryResolveResponse")
+	proto.RegisterType((*DidResolutionMetadata)(nil), "cheqd.did.v2.DidResolutionMetadata")
+	proto.RegisterType((*DidProperties)(nil), "cheqd.did.v2.DidProperties")
+	proto.RegisterType((*QueryDidDocAtTimeRequest)(nil), "cheqd.did.v2.QueryDidDocAtTimeRequest")
+	proto.RegisterType((*QueryDidDocAtTimeResponse)(nil), "cheqd.did.v2.QueryDidDocAtTimeResponse")
+	proto.RegisterType((*QueryDidDocsByControllerRequest)(nil), "cheqd.did.v2.QueryDidDocsByControllerRequest")
+	proto.RegisterType((*QueryDidDocsByControllerResponse)(nil), "cheqd.did.v2.QueryDidDocsByControllerResponse")
+	proto.RegisterType((*QueryDidDocsByPublicKeyRequest)(nil), "cheqd.did.v2.QueryDidDocsByPublicKeyRequest")
+	proto.RegisterType((*QueryDidDocsByPublicKeyResponse)(nil), "cheqd.did.v2.QueryDidDocsByPublicKeyResponse")
+	proto.RegisterType((*QueryAllDidDocsRequest)(nil), "cheqd.did.v2.QueryAllDidDocsRequest")
+	proto.RegisterType((*QueryAllDidDocsResponse)(nil), "cheqd.did.v2.QueryAllDidDocsResponse")
+}
+
+func init() { proto.RegisterFile("cheqd/did/v2/query.proto", fileDescriptor_8d818263856d0dc9) }
+
+var fileDescriptor_8d818263856d0dc9 = []byte{
+	// 1433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xe6, 0xbf, 0x1f, 0x27, 0x6d, 0x3a, 0x49, 0x13, 0x67, 0xdb, 0xda, 0x89, 0xd3, 0x24,
+	0x6d, 0x94, 0xec, 0xaa, 0xee, 0xab, 0x57, 0xaf, 0xf4, 0x72, 0x20, 0x6e, 0x12, 0x1a, 0x41, 0x4b,
+	0xd9, 0x5a, 0x45, 0x70, 0xb1, 0x36, 0x3b, 0x13, 0x67, 0x54, 0xdb, 0xb3, 0xdd, 0x1d, 0x1b, 0xac,
+	0x10, 0x55, 0xe2, 0x80, 0x80, 0x53, 0x25, 0x3e, 0x00, 0x48, 0x15, 0x2a, 0x12, 0x9f, 0x82, 0x13,
+	0x3d, 0x56, 0xe2, 0xc2, 0xc9, 0xa0, 0x96, 0x93, 0x3f, 0x00, 0x67, 0xb4, 0xb3, 0xb3, 0xf6, 0x6e,
+	0xbc, 0xb6, 0x4b, 0xdb, 0x43, 0x4f, 0x59, 0xff, 0x9e, 0xdf, 0xcc, 0xfc, 0x9e, 0x3f, 0xf3, 0xcc,
+	0x13, 0x48, 0x59, 0x47, 0xe4, 0x01, 0xd6, 0x31, 0xc5, 0x7a, 0x3d, 0xa7, 0x3f, 0xa8, 0x11, 0xa7,
+	0xa1, 0xd9, 0x0e, 0xe3, 0x0c, 0x4d, 0x09, 0x8b, 0x86, 0x29, 0xd6, 0xea, 0x39, 0x75, 0x31, 0xc2,
+	0xc3, 0x14, 0x63, 0x66, 0xf9, 0x44, 0x75, 0xc3, 0x62, 0x6e, 0x85, 0xb9, 0xfa, 0x81, 0xe9, 0x12,
+	0x7f, 0x07, 0xbd, 0x7e, 0xed, 0x80, 0x70, 0xf3, 0x9a, 0x6e, 0x9b, 0x25, 0x5a, 0x35, 0x39, 0x65,
+	0x55, 0xc9, 0x9d, 0x2b, 0xb1, 0x12, 0x13, 0x9f, 0xba, 0xf7, 0x25, 0xd1, 0x8b, 0x25, 0xc6, 0x4a,
+	0x65, 0xa2, 0x9b, 0x36, 0xd5, 0xcd, 0x6a, 0x95, 0x71, 0xb1, 0xc4, 0x95, 0xd6, 0x8c, 0xb4, 0x8a,
+	0x5f, 0x07, 0xb5, 0x43, 0x9d, 0xd3, 0x0a, 0x71, 0xb9, 0x59, 0xb1, 0x7d, 0x42, 0xf6, 0x32, 0xa0,
+	0x8f, 0xbc, 0x63, 0x77, 0x28, 0xde, 0x61, 0x96, 0x41, 0x1e, 0xd4, 0x88, 0xcb, 0xd1, 0x19, 0x18,
+	0xa6, 0x38, 0xa5, 0x2c, 0x29, 0x57, 0x12, 0xc6, 0x30, 0xc5, 0xd9, 0x5b, 0x30, 0x1b, 0x61, 0xb9,
+	0x36, 0xab, 0xba, 0x04, 0xfd, 0x17, 0xc6, 0xea, 0x66, 0xb9, 0x46, 0x04, 0x33, 0x99, 0x5b, 0xd2,
+	0xc2, 0x6e, 0x6b, 0x3e, 0xf9, 0x63, 0xca, 0x8f, 0x6e, 0x11, 0x6e, 0x62, 0x93, 0x9b, 0x86, 0x4f,
+	0xcf, 0xee, 0xc2, 0x62, 0x68, 0xbb, 0x7b, 0xc4, 0x71, 0x29, 0xab, 0xf6, 0x38, 0x1b, 0xa5, 0x60,
+	0xa2, 0xee, 0x33, 0x52, 0xc3, 0x02, 0x0c, 0x7e, 0x66, 0x0b, 0xa0, 0xc6, 0x6d, 0xf3, 0x9a, 0xe2,
+	0x1e, 0xc2, 0xaa, 0xd8, 0x75, 0xbb, 0x5c, 0x8e, 0x6c, 0xec, 0xb6, 0x89, 0x3d, 0x84, 0xee, 0x01,
+	0x74, 0x72, 0x26, 0xb4, 0x26, 0x73, 0x6b, 0x9a, 0x9f, 0x60, 0xcd, 0x4b, 0xb0, 0xe6, 0x97, 0x88,
+	0x4c, 0xb0, 0x76, 0xc7, 0x2c, 0x11, 0xb9, 0x97, 0x11, 0x5a, 0x99, 0xfd, 0x51, 0x81, 0xb5, 0x41,
+	0x0a, 0xa4, 0x8f, 0x39, 0x98, 0x94, 0xc1, 0x70, 0x53, 0xca, 0xd2, 0xc8, 0x95, 0x64, 0x6e, 0x3e,
+	0xea, 0x66, 0x7b, 0x45, 0x9b, 0x87, 0xde, 0x8b, 0x91, 0xb9, 0x3e, 0x50, 0xa6, 0x7f, 0x60, 0x44,
+	0xe7, 0x43, 0x59, 0x14, 0x06, 0x71, 0x59, 0xb9, 0x4e, 0x7a, 0x85, 0x65, 0x1e, 0xc6, 0x4d, 0xcb,
+	0x22, 0x36, 0x97, 0xe9, 0x93, 0xbf, 0xd0, 0x25, 0x00, 0xa9, 0xa9, 0x48, 0x71, 0x6a, 0x44, 0xd8,
+	0x12, 0x12, 0xd9, 0xc7, 0x68, 0x19, 0xa6, 0x02, 0xb3, 0x57, 0xb3, 0xa9, 0x51, 0x41, 0x48, 0x4a,
+	0xac, 0x40, 0x2b, 0x24, 0xfb, 0x64, 0x18, 0xe6, 0xa2, 0x0a, 0x64, 0x58, 0xea, 0xb0, 0x80, 0x29,
+	0x2e, 0x3a, 0x1e, 0x5c, 0xf3, 0xb4, 0x16, 0x2b, 0x32, 0x0e, 0xb2, 0x18, 0x56, 0xba, 0x8a, 0xc1,
+	0x68, 0x73, 0x83, 0x90, 0xe5, 0x17, 0x5b, 0xcd, 0xcc, 0x79, 0x1c, 0x67, 0x32, 0xe2, 0x61, 0x94,
+	0x83, 0x29, 0xef, 0x5c, 0xcc, 0xac, 0x5a, 0x85, 0x54, 0xa5, 0xc3, 0xf9, 0xb3, 0xad, 0x66, 0x26,
+	0x89, 0x45, 0x22, 0x05, 0x6c, 0x84, 0x7f, 0x20, 0x0b, 0xce, 0x87, 0xd7, 0x74, 0x94, 0x8e, 0x2c,
+	0x29, 0xbd, 0xf3, 0x99, 0x5f, 0x68, 0x35, 0x33, 0xb3, 0xa1, 0x7d, 0xda, 0xd2, 0xe2, 0xc0, 0xec,
+	0x57, 0xc3, 0x70, 0x3e, 0xd6, 0x49, 0xf4, 0x0e, 0x4c, 0x59, 0xac, 0xca, 0xbd, 0x93, 0x79, 0xc3,
+	0xf6, 0x2f, 0x4b, 0xc2, 0x77, 0x5d, 0xe2, 0x85, 0x86, 0x4d, 0x36, 0x59, 0x85, 0x72, 0x52, 0xb1,
+	0x79, 0xc3, 0x48, 0x86, 0x60, 0x74, 0x15, 0xc6, 0x88, 0xe3, 0x30, 0x47, 0x7a, 0x3a, 0xdb, 0x6a,
+	0x66, 0xce, 0x0a, 0x20, 0xb4, 0xc0, 0x67, 0xa0, 0x3c, 0x24, 0x1c, 0xc2, 0x1d, 0x4a, 0xea, 0x04,
+	0x4b, 0xdf, 0x54, 0xcd, 0xef, 0x4e, 0x5a, 0xd0, 0x9d, 0xb4, 0x42, 0xd0, 0x9d, 0xf2, 0x93, 0x4f,
+	0x9b, 0x99, 0xa1, 0x47, 0x7f, 0x64, 0x14, 0xa3, 0xb3, 0x0c, 0xbd, 0x0b, 0x23, 0x98, 0x62, 0x51,
+	0x0a, 0xc9, 0xdc, 0x85, 0xae, 0x1c, 0xde, 0x71, 0x98, 0x4d, 0x1c, 0x4e, 0x89, 0x9b, 0x3f, 0xd7,
+	0x6a, 0x66, 0xa6, 0x31, 0xc5, 0x21, 0x1d, 0xde, 0x52, 0xef, 0x6e, 0x4d, 0x47, 0x98, 0x68, 0x13,
+	0xc0, 0x8b, 0xbf, 0xcb, 0x1d, 0x5a, 0x2d, 0x49, 0xf7, 0xa7, 0x5b, 0xcd, 0x4c, 0x02, 0x53, 0x7c,
+	0x57, 0x80, 0x46, 0xe7, 0x13, 0xe5, 0x01, 0x55, 0x08, 0x3f, 0x62, 0xb8, 0xe8, 0xda, 0xc4, 0xa2,
+	0x87, 0xd4, 0xf2, 0x8a, 0xd7, 0xf7, 0x7e, 0xae, 0xd5, 0xcc, 0xcc, 0xf8, 0xd6, 0xbb, 0xd2, 0xb8,
+	0x8f, 0x8d, 0x2e, 0x04, 0x65, 0x61, 0xdc, 0xc7, 0xfc, 0xa2, 0xcf, 0x43, 0xab, 0x99, 0x91, 0x88,
+	0x21, 0xff, 0x66, 0x6f, 0x42, 0x2a, 0xd4, 0xda, 0xb6, 0xb9, 0x17, 0x98, 0x5e, 0x17, 0xec, 0x22,
+	0x24, 0xda, 0x5d, 0x5d, 0xde, 0xb1, 0x0e, 0x90, 0xbd, 0x0b, 0x8b, 0x31, 0x3b, 0xbd, 0x66, 0x8f,
+	0xfc, 0x46, 0x81, 0x4c, 0x68, 0x57, 0x37, 0xdf, 0xb8, 0xc1, 0xaa, 0xdc, 0x61, 0xe5, 0x32, 0x71,
+	0x02, 0x99, 0x69, 0x00, 0xab, 0x0d, 0x4a, 0xb9, 0x21, 0xe4, 0x8d, 0xb5, 0xcb, 0x9f, 0x14, 0x58,
+	0xea, 0xad, 0x45, 0x3a, 0xfa, 0x7f, 0x98, 0x94, 0xb7, 0x2c, 0x68, 0x94, 0x83, 0x7d, 0x9d, 0xf0,
+	0x6f, 0xd2, 0x1b, 0xec, 0x98, 0xbf, 0x28, 0x90, 0x8e, 0x4a, 0xbd, 0x53, 0x3b, 0x28, 0x53, 0xeb,
+	0x7d, 0xd2, 0x08, 0xa2, 0x76, 0x09, 0xc0, 0x16, 0x58, 0xf1, 0x3e, 0x69, 0xc8, 0xa8, 0x25, 0xec,
+	0x80, 0x85, 0xfe, 0x07, 0xa9, 0x3a, 0x71, 0xbc, 0x42, 0x32, 0x83, 0xbe, 0xe6, 0x15, 0xa3, 0xb8,
+	0xba, 0x7e, 0xea, 0xe7, 0xc3, 0xf6, 0x5b, 0xc2, 0x2c, 0xae, 0x6a, 0x34, 0xdc, 0x23, 0xaf, 0x1c,
+	0xee, 0x27, 0x5d, 0xa9, 0x0f, 0xf9, 0xf0, 0x56, 0x45, 0xfb, 0xd7, 0x11, 0x98, 0x8f, 0xbe, 0xa3,
+	0x6e, 0x10, 0xe5, 0x3c, 0x24, 0x31, 0x31, 0x2d, 0x4e, 0xeb, 0x26, 0x27, 0xfe, 0x5d, 0x3a, 0xd3,
+	0xa5, 0x31, 0x20, 0x50, 0x56, 0xdd, 0xa3, 0x65, 0x4e, 0x1c, 0x23, 0xbc, 0x08, 0x69, 0x30, 0x6b,
+	0x39, 0xc4, 0xfb, 0x2c, 0x1e, 0x11, 0x5a, 0x3a, 0xe2, 0xc5, 0x43, 0x87, 0x55, 0x84, 0xe0, 0x51,
+	0xe3, 0x9c, 0x34, 0xdd, 0x14, 0x96, 0x3d, 0x87, 0x55, 0xd0, 0x06, 0x9c, 0x3b, 0xc5, 0xe7, 0x4c,
+	0xe4, 0x61, 0xd4, 0x38, 0x1b, 0x61, 0x17, 0x18, 0x5a, 0x81, 0xe9, 0x80, 0x6b, 0x1e, 0x72, 0xe2,
+	0xc8, 0xd7, 0x6f, 0x4a, 0x82, 0xdb, 0x1e, 0x86, 0x56, 0xe1, 0x4c, 0x40, 0x3a, 0x20, 0x87, 0xcc,
+	0x21, 0xa9, 0x31, 0xc1, 0x0a, 0x96, 0xe6, 0x05, 0xd8, 0xb7, 0x64, 0xc6, 0xfb, 0x96, 0xcc, 0x32,
+	0x4c, 0xb9, 0xc4, 0xa9, 0x53, 0x8b, 0xf8, 0xec, 0x09, 0xff, 0x09, 0x96, 0x58, 0x4c, 0x55, 0x4d,
+	0xbe, 0x6a, 0x55, 0x79, 0x3d, 0xac, 0x6a, 0x56, 0x88, 0x6b, 0x9b, 0x16, 0x49, 0x25, 0xfc, 0xaa,
+	0x6f, 0x03, 0xd9, 0xef, 0x15, 0x58, 0xe8, 0xca, 0xe4, 0xdb, 0x54, 0x6b, 0x1b, 0x5f, 0x00, 0xea,
+	0xae, 0x17, 0x74, 0x01, 0x16, 0x76, 0x76, 0xb7, 0x6f, 0x14, 0xf6, 0xef, 0x6d, 0x17, 0xf6, 0x3f,
+	0xbc, 0x5d, 0xdc, 0xdb, 0xff, 0xa0, 0xb0, 0x6b, 0x14, 0xb7, 0x6f, 0x7f, 0x32, 0x33, 0x84, 0xd2,
+	0xa0, 0xc6, 0x1a, 0x3d, 0x64, 0x77, 0x46, 0x41, 0x2b, 0x90, 0x89, 0xb3, 0xb7, 0xb1, 0xdd, 0x9d,
+	0x99, 0x61, 0x75, 0xf4, 0xeb, 0xc7, 0xe9, 0xa1, 0xdc, 0xdf, 0x93, 0x30, 0x26, 0xe2, 0x83, 0x28,
+	0x8c, 0xfb, 0xfe, 0xa2, 0x53, 0x51, 0xe8, 0x1e, 0xf2, 0xd5, 0xe5, 0x3e, 0x0c, 0xdf, 0xc5, 0xac,
+	0xfa, 0xe5, 0x6f, 0x7f, 0x7d, 0x37, 0x3c, 0x87, 0x90, 0x1e, 0xf9, 0x17, 0xe6, 0x98, 0xe2, 0x13,
+	0xf4, 0xc8, 0x7f, 0x4a, 0x3b, 0xe3, 0x29, 0x5a, 0xef, 0xb9, 0x61, 0x74, 0xc4, 0x57, 0xaf, 0x0c,
+	0x26, 0x4a, 0x01, 0x9b, 0x42, 0xc0, 0x1a, 0xba, 0xdc, 0x2d, 0x40, 0x97, 0xa3, 0xa0, 0x7e, 0x2c,
+	0x3f, 0x4e, 0xd0, 0xcf, 0x0a, 0x2c, 0xf6, 0x1c, 0x9a, 0xd1, 0xf5, 0x98, 0x53, 0x07, 0x0d, 0xf9,
+	0xea, 0x7f, 0xfe, 0xdd, 0x22, 0x29, 0x7b, 0x45, 0xc8, 0xbe, 0x84, 0x2e, 0xf4, 0x96, 0xed, 0x22,
+	0x0e, 0x13, 0x72, 0x70, 0x45, 0x71, 0xa9, 0x88, 0x8e, 0xd5, 0x6a, 0xb6, 0x1f, 0x45, 0x1e, 0x9b,
+	0x15, 0xc7, 0x5e, 0x44, 0x6a, 0xcc, 0xb1, 0x8e, 0x3c, 0xea, 0x5b, 0x05, 0xa6, 0xc2, 0xb3, 0x00,
+	0x5a, 0xeb, 0x99, 0x8c, 0xc8, 0xd8, 0xa1, 0xae, 0x0f, 0xe4, 0x49, 0x15, 0x57, 0x85, 0x8a, 0x15,
+	0xb4, 0x1c, 0xa3, 0xc2, 0xe4, 0xfa, 0x71, 0x7b, 0x36, 0x39, 0x41, 0x8f, 0x15, 0x98, 0x8d, 0x79,
+	0xb6, 0xd1, 0x56, 0xcf, 0xb3, 0xe2, 0x46, 0x0d, 0x55, 0x7b, 0x59, 0xba, 0x54, 0xb8, 0x25, 0x14,
+	0xae, 0xa3, 0xd5, 0x53, 0x0a, 0x3b, 0xd3, 0xc9, 0x89, 0xde, 0xfe, 0xc6, 0xe8, 0x07, 0x05, 0x50,
+	0xf7, 0x6b, 0x87, 0x36, 0xfb, 0x9d, 0x7a, 0xfa, 0x61, 0x57, 0xb7, 0x5e, 0x92, 0xdd, 0x5f, 0xa2,
+	0x3f, 0x09, 0x6c, 0xdd, 0x27, 0x0d, 0xfd, 0xb8, 0x33, 0x27, 0x9c, 0xa0, 0x1a, 0x40, 0xa7, 0x37,
+	0xa2, 0xcb, 0xfd, 0x8a, 0x36, 0x78, 0x04, 0xd5, 0xd5, 0x01, 0xac, 0xfe, 0x3d, 0x00, 0x53, 0xec,
+	0xe6, 0x77, 0x9e, 0x3e, 0x4f, 0x2b, 0xcf, 0x9e, 0xa7, 0x95, 0x3f, 0x9f, 0xa7, 0x95, 0x47, 0x2f,
+	0xd2, 0x43, 0xcf, 0x5e, 0xa4, 0x87, 0x7e, 0x7f, 0x91, 0x1e, 0xfa, 0x74, 0xa3, 0x44, 0xf9, 0x51,
+	0xed, 0x40, 0xb3, 0x58, 0x45, 0xb7, 0xcc, 0x2a, 0xfb, 0x6c, 0xcb, 0x62, 0xfe, 0x06, 0x5b, 0x55,
+	0x86, 0x89, 0xfe, 0xb9, 0xd8, 0xc7, 0x7b, 0x56, 0xdc, 0x83, 0x71, 0x31, 0xff, 0x5f, 0xff, 0x67,
+	0x00, 0xec, 0x1d, 0x00, 0xf9, 0x50, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Fetch latest version of a DID Document for a given DID
+	DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error)
+	// Fetch specific version of a DID Document for a given DID
+	DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error)
+	// Fetch list of all versions of DID Documents for a given DID
+	AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Resolve a DID according to the W3C DID Resolution specification
+	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
+	// Fetch the version of a DID Document that was active at a given moment
+	DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error)
+	// Fetch latest versions of DID Documents controlled by a given DID
+	DidDocsByController(ctx context.Context, in *QueryDidDocsByControllerRequest, opts ...grpc.CallOption) (*QueryDidDocsByControllerResponse, error)
+	// Fetch latest versions of active DID Documents having a verification method with a given public key
+	DidDocsByPublicKey(ctx context.Context, in *QueryDidDocsByPublicKeyRequest, opts ...grpc.CallOption) (*QueryDidDocsByPublicKeyResponse, error)
+	// Fetch latest versions of all DID Documents matching the given filters
+	AllDidDocs(ctx context.Context, in *QueryAllDidDocsRequest, opts ...grpc.CallOption) (*QueryAllDidDocsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DidDoc(ctx context.Context, in *QueryDidDocRequest, opts ...grpc.CallOption) (*QueryDidDocResponse, error) {
+	out := new(QueryDidDocResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocVersion(ctx context.Context, in *QueryDidDocVersionRequest, opts ...grpc.CallOption) (*QueryDidDocVersionResponse, error) {
+	out := new(QueryDidDocVersionResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDidDocVersionsMetadata(ctx context.Context, in *QueryAllDidDocVersionsMetadataRequest, opts ...grpc.CallOption) (*QueryAllDidDocVersionsMetadataResponse, error) {
+	out := new(QueryAllDidDocVersionsMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllDidDocVersionsMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error) {
+	out := new(QueryResolveResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/Resolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocAtTime(ctx context.Context, in *QueryDidDocAtTimeRequest, opts ...grpc.CallOption) (*QueryDidDocAtTimeResponse, error) {
+	out := new(QueryDidDocAtTimeResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocAtTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocsByController(ctx context.Context, in *QueryDidDocsByControllerRequest, opts ...grpc.CallOption) (*QueryDidDocsByControllerResponse, error) {
+	out := new(QueryDidDocsByControllerResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocsByController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocsByPublicKey(ctx context.Context, in *QueryDidDocsByPublicKeyRequest, opts ...grpc.CallOption) (*QueryDidDocsByPublicKeyResponse, error) {
+	out := new(QueryDidDocsByPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/DidDocsByPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDidDocs(ctx context.Context, in *QueryAllDidDocsRequest, opts ...grpc.CallOption) (*QueryAllDidDocsResponse, error) {
+	out := new(QueryAllDidDocsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Query/AllDidDocs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Fetch latest version of a DID Document for a given DID
+	DidDoc(context.Context, *QueryDidDocRequest) (*QueryDidDocResponse, error)
+	// Fetch specific version of a DID Document for a given DID
+	DidDocVersion(context.Context, *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error)
+	// Fetch list of all versions of DID Documents for a given DID
+	AllDidDocVersionsMetadata(context.Context, *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error)
+	// Resolve a DID according to the W3C DID Resolution specification
+	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
+	// Fetch the version of a DID Document that was active at a given moment
+	DidDocAtTime(context.Context, *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error)
+	// Fetch latest versions of DID Documents controlled by a given DID
+	DidDocsByController(context.Context, *QueryDidDocsByControllerRequest) (*QueryDidDocsByControllerResponse, error)
+	// Fetch latest versions of active DID Documents having a verification method with a given public key
+	DidDocsByPublicKey(context.Context, *QueryDidDocsByPublicKeyRequest) (*QueryDidDocsByPublicKeyResponse, error)
+	// Fetch latest versions of all DID Documents matching the given filters
+	AllDidDocs(context.Context, *QueryAllDidDocsRequest) (*QueryAllDidDocsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DidDoc(ctx context.Context, req *QueryDidDocRequest) (*QueryDidDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDoc not implemented")
+}
+func (*UnimplementedQueryServer) DidDocVersion(ctx context.Context, req *QueryDidDocVersionRequest) (*QueryDidDocVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocVersion not implemented")
+}
+func (*UnimplementedQueryServer) AllDidDocVersionsMetadata(ctx context.Context, req *QueryAllDidDocVersionsMetadataRequest) (*QueryAllDidDocVersionsMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocVersionsMetadata not implemented")
+}
+func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveRequest) (*QueryResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+func (*UnimplementedQueryServer) DidDocAtTime(ctx context.Context, req *QueryDidDocAtTimeRequest) (*QueryDidDocAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocAtTime not implemented")
+}
+func (*UnimplementedQueryServer) DidDocsByController(ctx context.Context, req *QueryDidDocsByControllerRequest) (*QueryDidDocsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocsByController not implemented")
+}
+func (*UnimplementedQueryServer) DidDocsByPublicKey(ctx context.Context, req *QueryDidDocsByPublicKeyRequest) (*QueryDidDocsByPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocsByPublicKey not implemented")
+}
+func (*UnimplementedQueryServer) AllDidDocs(ctx context.Context, req *QueryAllDidDocsRequest) (*QueryAllDidDocsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDidDocs not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDoc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDoc(ctx, req.(*QueryDidDocRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDocVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocVersion(ctx, req.(*QueryDidDocVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDidDocVersionsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidDocVersionsMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/AllDidDocVersionsMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDidDocVersionsMetadata(ctx, req.(*QueryAllDidDocVersionsMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/Resolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Resolve(ctx, req.(*QueryResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDocAtTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocAtTime(ctx, req.(*QueryDidDocAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocsByController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDocsByController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocsByController(ctx, req.(*QueryDidDocsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocsByPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocsByPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocsByPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/DidDocsByPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocsByPublicKey(ctx, req.(*QueryDidDocsByPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDidDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidDocsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDidDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Query/AllDidDocs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDidDocs(ctx, req.(*QueryAllDidDocsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cheqd.did.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DidDoc",
+			Handler:    _Query_DidDoc_Handler,
+		},
+		{
+			MethodName: "DidDocVersion",
+			Handler:    _Query_DidDocVersion_Handler,
+		},
+		{
+			MethodName: "AllDidDocVersionsMetadata",
+			Handler:    _Query_AllDidDocVersionsMetadata_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Query_Resolve_Handler,
+		},
+		{
+			MethodName: "DidDocAtTime",
+			Handler:    _Query_DidDocAtTime_Handler,
+		},
+		{
+			MethodName: "DidDocsByController",
+			Handler:    _Query_DidDocsByController_Handler,
+		},
+		{
+			MethodName: "DidDocsByPublicKey",
+			Handler:    _Query_DidDocsByPublicKey_Handler,
+		},
+		{
+			MethodName: "AllDidDocs",
+			Handler:    _Query_AllDidDocs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cheqd/did/v2/query.proto",
+}
+
+func (m *QueryDidDocRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionTime) > 0 {
+		i -= len(m.VersionTime)
+		copy(dAtA[i:], m.VersionTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidDocument) > 0 {
+		i -= len(m.DidDocument)
+		copy(dAtA[i:], m.DidDocument)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidDocument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidResolutionMetadata != nil {
+		{
+			size, err := m.DidResolutionMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidResolutionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidResolutionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidResolutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Did != nil {
+		{
+			size, err := m.Did.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Retrieved, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Retrieved):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidProperties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidProperties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidProperties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MethodSpecificId) > 0 {
+		i -= len(m.MethodSpecificId)
+		copy(dAtA[i:], m.MethodSpecificId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MethodSpecificId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidString) > 0 {
+		i -= len(m.DidString)
+		copy(dAtA[i:], m.DidString)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidString)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocAtTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocAtTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocAtTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocAtTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocAtTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocAtTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocsByControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocsByControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocsByControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocsByControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocsByControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocsByControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocs) > 0 {
+		for iNdEx := len(m.DidDocs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VerificationMethodType) > 0 {
+		i -= len(m.VerificationMethodType)
+		copy(dAtA[i:], m.VerificationMethodType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationMethodType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocsByPublicKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocsByPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocsByPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocs) > 0 {
+		for iNdEx := len(m.DidDocs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VerificationMethodType) > 0 {
+		i -= len(m.VerificationMethodType)
+		copy(dAtA[i:], m.VerificationMethodType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationMethodType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedBefore) > 0 {
+		i -= len(m.CreatedBefore)
+		copy(dAtA[i:], m.CreatedBefore)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreatedBefore)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAfter) > 0 {
+		i -= len(m.CreatedAfter)
+		copy(dAtA[i:], m.CreatedAfter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreatedAfter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedHeightTo != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeightTo))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatedHeightFrom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeightFrom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Deactivated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Deactivated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocs) > 0 {
+		for iNdEx := len(m.DidDocs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDidDocRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocVersionsMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocVersionsMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidResolutionMetadata != nil {
+		l = m.DidResolutionMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DidDocument)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DidResolutionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Retrieved)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Did != nil {
+		l = m.Did.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DidProperties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidString)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MethodSpecificId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocAtTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocAtTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocsByControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocsByControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocs) > 0 {
+		for _, e := range m.DidDocs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocsByPublicKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VerificationMethodType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocsByPublicKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocs) > 0 {
+		for _, e := range m.DidDocs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deactivated != 0 {
+		n += 1 + sovQuery(uint64(m.Deactivated))
+	}
+	if m.CreatedHeightFrom != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeightFrom))
+	}
+	if m.CreatedHeightTo != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeightTo))
+	}
+	l = len(m.CreatedAfter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CreatedBefore)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VerificationMethodType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocs) > 0 {
+		for _, e := range m.DidDocs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &Metadata{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidResolutionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidResolutionMetadata == nil {
+				m.DidResolutionMetadata = &DidResolutionMetadata{}
+			}
+			if err := m.DidResolutionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &Metadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidResolutionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidResolutionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidResolutionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrieved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Retrieved, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Did == nil {
+				m.Did = &DidProperties{}
+			}
+			if err := m.Did.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidProperties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidProperties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidProperties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodSpecificId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodSpecificId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocAtTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocAtTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocAtTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDidDocsByControllerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -929,15 +4401,15 @@ func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDidDocsByControllerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDidDocsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -965,7 +4437,43 @@ func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -988,7 +4496,127 @@ func (m *QueryDidDocRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDidDocsByControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocsByControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocs = append(m.DidDocs, &DidDocWithMetadata{})
+			if err := m.DidDocs[len(m.DidDocs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocsByPublicKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,25 +4629,89 @@ func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocsByPublicKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocsByPublicKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.VerificationMethodType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1046,10 +4738,10 @@ func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &DidDocWithMetadata{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1074,7 +4766,7 @@ func (m *QueryDidDocResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDidDocsByPublicKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1097,17 +4789,17 @@ func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDidDocsByPublicKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDidDocsByPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1117,29 +4809,31 @@ func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DidDocs = append(m.DidDocs, &DidDocWithMetadata{})
+			if err := m.DidDocs[len(m.DidDocs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1149,23 +4843,27 @@ func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1188,7 +4886,7 @@ func (m *QueryDidDocVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDidDocsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,17 +4909,74 @@ func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDidDocsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDidDocsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			m.Deactivated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deactivated |= DeactivationFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeightFrom", wireType)
+			}
+			m.CreatedHeightFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeightFrom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeightTo", wireType)
+			}
+			m.CreatedHeightTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeightTo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1231,81 +4986,91 @@ func (m *QueryDidDocVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &DidDocWithMetadata{}
+			m.CreatedAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.CreatedBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodType", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1333,9 +5098,9 @@ func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1371,6 +5136,38 @@ func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1392,7 +5189,7 @@ func (m *QueryAllDidDocVersionsMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDidDocsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1415,15 +5212,15 @@ func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDidDocsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidDocVersionsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDidDocsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1450,8 +5247,8 @@ func (m *QueryAllDidDocVersionsMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, &Metadata{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DidDocs = append(m.DidDocs, &DidDocWithMetadata{})
+			if err := m.DidDocs[len(m.DidDocs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
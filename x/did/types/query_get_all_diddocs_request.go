@@ -0,0 +1,111 @@
+package types
+
+import (
+	"time"
+
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+// DidDocFilter is a parsed set of filters of the Query/AllDidDocs method
+type DidDocFilter struct {
+	Deactivated            DeactivationFilter
+	CreatedHeightFrom      uint64
+	CreatedHeightTo        uint64
+	CreatedAfter           *time.Time
+	CreatedBefore          *time.Time
+	VerificationMethodType string
+	ServiceType            string
+	Namespace              string
+}
+
+// GetFilter parses the filters of the request
+func (query *QueryAllDidDocsRequest) GetFilter() (DidDocFilter, error) {
+	filter := DidDocFilter{
+		Deactivated:            query.Deactivated,
+		CreatedHeightFrom:      query.CreatedHeightFrom,
+		CreatedHeightTo:        query.CreatedHeightTo,
+		VerificationMethodType: query.VerificationMethodType,
+		ServiceType:            query.ServiceType,
+		Namespace:              query.Namespace,
+	}
+
+	if _, ok := DeactivationFilter_name[int32(query.Deactivated)]; !ok {
+		return DidDocFilter{}, ErrBadRequest.Wrapf("unknown deactivation filter: %d", query.Deactivated)
+	}
+
+	if query.CreatedAfter != "" {
+		createdAfter, err := time.Parse(time.RFC3339Nano, query.CreatedAfter)
+		if err != nil {
+			return DidDocFilter{}, ErrBadRequest.Wrapf("invalid created_after: %s", err.Error())
+		}
+
+		filter.CreatedAfter = &createdAfter
+	}
+
+	if query.CreatedBefore != "" {
+		createdBefore, err := time.Parse(time.RFC3339Nano, query.CreatedBefore)
+		if err != nil {
+			return DidDocFilter{}, ErrBadRequest.Wrapf("invalid created_before: %s", err.Error())
+		}
+
+		filter.CreatedBefore = &createdBefore
+	}
+
+	return filter, nil
+}
+
+// GetDidPrefix returns the common prefix of the DIDs within the namespace of the filter.
+// The prefix is empty if the namespace is not set.
+func (filter DidDocFilter) GetDidPrefix() string {
+	if filter.Namespace == "" {
+		return ""
+	}
+
+	return utils.JoinDID(DidMethod, filter.Namespace, "")
+}
+
+// HasHeightRange returns true if the filter restricts the creation height
+func (filter DidDocFilter) HasHeightRange() bool {
+	return filter.CreatedHeightFrom != 0 || filter.CreatedHeightTo != 0
+}
+
+// Matches checks the latest version of a diddoc against the filter.
+// createdHeight is ignored unless the filter restricts the creation height.
+func (filter DidDocFilter) Matches(didDoc DidDocWithMetadata, createdHeight uint64) bool {
+	switch filter.Deactivated {
+	case DEACTIVATION_FILTER_ACTIVE:
+		if didDoc.Metadata.Deactivated {
+			return false
+		}
+	case DEACTIVATION_FILTER_DEACTIVATED:
+		if !didDoc.Metadata.Deactivated {
+			return false
+		}
+	}
+
+	if filter.CreatedHeightFrom != 0 && createdHeight < filter.CreatedHeightFrom {
+		return false
+	}
+
+	if filter.CreatedHeightTo != 0 && createdHeight > filter.CreatedHeightTo {
+		return false
+	}
+
+	if filter.CreatedAfter != nil && didDoc.Metadata.Created.Before(*filter.CreatedAfter) {
+		return false
+	}
+
+	if filter.CreatedBefore != nil && didDoc.Metadata.Created.After(*filter.CreatedBefore) {
+		return false
+	}
+
+	if filter.VerificationMethodType != "" && !didDoc.DidDoc.HasVerificationMethodType(filter.VerificationMethodType) {
+		return false
+	}
+
+	if filter.ServiceType != "" && !didDoc.DidDoc.HasServiceType(filter.ServiceType) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,9 @@
+package types
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+func (query *QueryDidDocsByControllerRequest) Normalize() {
+	query.Controller = utils.NormalizeDID(query.Controller)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/multiformats/go-multibase"
+)
+
+// GetFingerprint returns the canonical fingerprint of the requested public key
+func (query *QueryDidDocsByPublicKeyRequest) GetFingerprint() (string, error) {
+	if query.VerificationMethodType != "" {
+		return GetPublicKeyFingerprint(query.VerificationMethodType, query.PublicKey)
+	}
+
+	// Fingerprints are always stored in base58btc
+	_, multicodec, err := multibase.Decode(query.PublicKey)
+	if err != nil {
+		return "", err
+	}
+
+	return multibase.Encode(multibase.Base58BTC, multicodec)
+}
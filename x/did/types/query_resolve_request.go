@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+func (query *QueryResolveRequest) Normalize() {
+	query.Id = utils.NormalizeDID(query.Id)
+	query.VersionId = utils.NormalizeUUID(query.VersionId)
+}
@@ -0,0 +1,25 @@
+package types
+
+func NewSigningEnvelope(chainID string, didNamespace string, currentVersionID string, payloadSignBytes []byte) *SigningEnvelope {
+	return &SigningEnvelope{
+		ChainId:          chainID,
+		DidNamespace:     didNamespace,
+		CurrentVersionId: currentVersionID,
+		Payload:          payloadSignBytes,
+	}
+}
+
+// GetSignBytes returns the bytes SignInfo signatures are made over
+func (e *SigningEnvelope) GetSignBytes() []byte {
+	bytes, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// GetEnvelopeSignBytes wraps the payload sign bytes into the signing envelope and returns its sign bytes
+func GetEnvelopeSignBytes(chainID string, didNamespace string, currentVersionID string, payloadSignBytes []byte) []byte {
+	return NewSigningEnvelope(chainID, didNamespace, currentVersionID, payloadSignBytes).GetSignBytes()
+}
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/did/v2/signing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SigningEnvelope binds a DID or resource payload to the network and to the state it is applied to.
+// SignInfo signatures are made over the serialized envelope, so they can't be replayed
+// on another network or against another version of the DID Document.
+type SigningEnvelope struct {
+	// Chain id of the network the payload is submitted to
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// DID namespace of the network the payload is submitted to
+	// Example: mainnet, testnet, local
+	DidNamespace string `protobuf:"bytes,2,opt,name=did_namespace,json=didNamespace,proto3" json:"did_namespace,omitempty"`
+	// Latest version id of the DID Document the payload is applied to.
+	// For resources it's the latest version id of the collection DID Document.
+	// Empty for DID Document creation.
+	CurrentVersionId string `protobuf:"bytes,3,opt,name=current_version_id,json=currentVersionId,proto3" json:"current_version_id,omitempty"`
+	// Sign bytes of the payload
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *SigningEnvelope) Reset()         { *m = SigningEnvelope{} }
+func (m *SigningEnvelope) String() string { return proto.CompactTextString(m) }
+func (*SigningEnvelope) ProtoMessage()    {}
+func (*SigningEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30dba48524bdb0db, []int{0}
+}
+func (m *SigningEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningEnvelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningEnvelope.Merge(m, src)
+}
+func (m *SigningEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningEnvelope proto.InternalMessageInfo
+
+func (m *SigningEnvelope) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SigningEnvelope) GetDidNamespace() string {
+	if m != nil {
+		return m.DidNamespace
+	}
+	return ""
+}
+
+func (m *SigningEnvelope) GetCurrentVersionId() string {
+	if m != nil {
+		return m.CurrentVersionId
+	}
+	return ""
+}
+
+func (m *SigningEnvelope) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// SigningParams defines the parameters of payload signature verification
+type SigningParams struct {
+	// Accept signatures made over the bare payload sign bytes instead of the signing envelope.
+	// Allows clients to migrate to the signing envelope and will be disabled by governance afterwards.
+	//
+	// Default: true
+	AllowLegacySignatures bool `protobuf:"varint,1,opt,name=allow_legacy_signatures,json=allowLegacySignatures,proto3" json:"allow_legacy_signatures,omitempty"`
+	// Accept verification methods referenced by capabilityInvocation but not by authentication as signers
+	// of any change of the DID Document, as before capability invocation was enforced, instead of service updates only.
+	// Keeps DID Documents relying on such methods in control until their controllers reference them from authentication
+	// and will be disabled by governance afterwards.
+	//
+	// Default: true
+	AllowCapabilityInvocationFullUpdates bool `protobuf:"varint,2,opt,name=allow_capability_invocation_full_updates,json=allowCapabilityInvocationFullUpdates,proto3" json:"allow_capability_invocation_full_updates,omitempty"`
+}
+
+func (m *SigningParams) Reset()         { *m = SigningParams{} }
+func (m *SigningParams) String() string { return proto.CompactTextString(m) }
+func (*SigningParams) ProtoMessage()    {}
+func (*SigningParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30dba48524bdb0db, []int{1}
+}
+func (m *SigningParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningParams.Merge(m, src)
+}
+func (m *SigningParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningParams proto.InternalMessageInfo
+
+func (m *SigningParams) GetAllowLegacySignatures() bool {
+	if m != nil {
+		return m.AllowLegacySignatures
+	}
+	return false
+}
+
+func (m *SigningParams) GetAllowCapabilityInvocationFullUpdates() bool {
+	if m != nil {
+		return m.AllowCapabilityInvocationFullUpdates
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*SigningEnvelope)(nil), "cheqd.did.v2.SigningEnvelope")
+	proto.RegisterType((*SigningParams)(nil), "cheqd.did.v2.SigningParams")
+}
+
+func init() { proto.RegisterFile("cheqd/did/v2/signing.proto", fileDescriptor_30dba48524bdb0db) }
+
+var fileDescriptor_30dba48524bdb0db = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xcd, 0x6a, 0xe3, 0x30,
+	0x14, 0x85, 0xa3, 0x99, 0x61, 0x92, 0x11, 0x09, 0x33, 0x08, 0x86, 0xf1, 0x74, 0x61, 0x42, 0xda,
+	0x45, 0x28, 0x8d, 0x0d, 0x29, 0xf4, 0x01, 0xfa, 0x07, 0x81, 0x52, 0x8a, 0x43, 0xb3, 0xe8, 0xc6,
+	0xdc, 0xe8, 0xaa, 0x8e, 0x40, 0x91, 0x5c, 0x5b, 0x76, 0xea, 0xb7, 0xe8, 0xb6, 0xab, 0xbe, 0x4e,
+	0x97, 0x59, 0x76, 0x59, 0x92, 0x17, 0x29, 0x91, 0x93, 0x2c, 0xaf, 0xbe, 0x4f, 0xe2, 0x5c, 0x1d,
+	0x7a, 0xc0, 0x67, 0xe2, 0x09, 0x43, 0x94, 0x18, 0x96, 0xc3, 0x30, 0x97, 0x89, 0x96, 0x3a, 0x09,
+	0xd2, 0xcc, 0x58, 0xc3, 0xda, 0x8e, 0x05, 0x28, 0x31, 0x28, 0x87, 0xbd, 0x57, 0x42, 0x7f, 0x8f,
+	0x6b, 0x7e, 0xa5, 0x4b, 0xa1, 0x4c, 0x2a, 0xd8, 0x7f, 0xda, 0xe2, 0x33, 0x90, 0x3a, 0x96, 0xe8,
+	0x91, 0x2e, 0xe9, 0xff, 0x8a, 0x9a, 0x6e, 0x1e, 0x21, 0x3b, 0xa4, 0x1d, 0x94, 0x18, 0x6b, 0x98,
+	0x8b, 0x3c, 0x05, 0x2e, 0xbc, 0x6f, 0x8e, 0xb7, 0x51, 0xe2, 0xed, 0xee, 0x8c, 0x9d, 0x50, 0xc6,
+	0x8b, 0x2c, 0x13, 0xda, 0xc6, 0xa5, 0xc8, 0x72, 0x69, 0xdc, 0x4b, 0xdf, 0x9d, 0xf9, 0x67, 0x4b,
+	0x26, 0x35, 0x18, 0x21, 0xf3, 0x68, 0x33, 0x85, 0x4a, 0x19, 0x40, 0xef, 0x47, 0x97, 0xf4, 0xdb,
+	0xd1, 0x6e, 0xec, 0xbd, 0x11, 0xda, 0xd9, 0x66, 0xbb, 0x83, 0x0c, 0xe6, 0x39, 0x3b, 0xa3, 0xff,
+	0x40, 0x29, 0xb3, 0x88, 0x95, 0x48, 0x80, 0x57, 0xf1, 0x66, 0x33, 0xb0, 0x45, 0x26, 0x72, 0x17,
+	0xb4, 0x15, 0xfd, 0x75, 0xf8, 0xc6, 0xd1, 0xf1, 0x1e, 0xb2, 0x09, 0xed, 0xd7, 0xf7, 0x38, 0xa4,
+	0x30, 0x95, 0x4a, 0xda, 0x2a, 0x96, 0xba, 0x34, 0x1c, 0xec, 0x26, 0xdd, 0x63, 0xa1, 0x54, 0x5c,
+	0xa4, 0x08, 0x56, 0xe4, 0x6e, 0xa3, 0x56, 0x74, 0xe4, 0xfc, 0x8b, 0xbd, 0x3e, 0xda, 0xdb, 0xd7,
+	0x85, 0x52, 0xf7, 0xb5, 0x7b, 0x7e, 0xf9, 0xbe, 0xf2, 0xc9, 0x72, 0xe5, 0x93, 0xcf, 0x95, 0x4f,
+	0x5e, 0xd6, 0x7e, 0x63, 0xb9, 0xf6, 0x1b, 0x1f, 0x6b, 0xbf, 0xf1, 0x70, 0x9c, 0x48, 0x3b, 0x2b,
+	0xa6, 0x01, 0x37, 0xf3, 0x90, 0x83, 0x36, 0x8b, 0x01, 0x37, 0xa1, 0xfb, 0xf9, 0x81, 0x36, 0x28,
+	0xc2, 0x67, 0x57, 0x8e, 0xad, 0x52, 0x91, 0x4f, 0x7f, 0xba, 0x62, 0x4e, 0xbf, 0x06, 0x00, 0xc2,
+	0x0a, 0xc6, 0x76, 0xb6, 0x01, 0x00, 0x00,
+}
+
+func (m *SigningEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CurrentVersionId) > 0 {
+		i -= len(m.CurrentVersionId)
+		copy(dAtA[i:], m.CurrentVersionId)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.CurrentVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidNamespace) > 0 {
+		i -= len(m.DidNamespace)
+		copy(dAtA[i:], m.DidNamespace)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.DidNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowCapabilityInvocationFullUpdates {
+		i--
+		if m.AllowCapabilityInvocationFullUpdates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AllowLegacySignatures {
+		i--
+		if m.AllowLegacySignatures {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SigningEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.DidNamespace)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.CurrentVersionId)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	return n
+}
+
+func (m *SigningParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowLegacySignatures {
+		n += 2
+	}
+	if m.AllowCapabilityInvocationFullUpdates {
+		n += 2
+	}
+	return n
+}
+
+func sovSigning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigning(x uint64) (n int) {
+	return sovSigning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SigningEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLegacySignatures", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowLegacySignatures = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCapabilityInvocationFullUpdates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowCapabilityInvocationFullUpdates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigning = fmt.Errorf("proto: unexpected end of group")
+)
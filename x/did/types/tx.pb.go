@@ -195,19 +195,79 @@ func (m *MsgDeactivateDidDoc) GetSignatures() []*SignInfo {
 	return nil
 }
 
+// MsgPatchDidDoc defines the Msg/PatchDidDoc request type.
+// It describes the parameters of a request for partially updating an existing DID document.
+type MsgPatchDidDoc struct {
+	// Payload containing the patch to be applied to the DID Document
+	Payload *MsgPatchDidDocPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the DID Document's controller(s)
+	Signatures []*SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *MsgPatchDidDoc) Reset()         { *m = MsgPatchDidDoc{} }
+func (m *MsgPatchDidDoc) String() string { return proto.CompactTextString(m) }
+func (*MsgPatchDidDoc) ProtoMessage()    {}
+func (*MsgPatchDidDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{3}
+}
+func (m *MsgPatchDidDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPatchDidDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPatchDidDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPatchDidDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPatchDidDoc.Merge(m, src)
+}
+func (m *MsgPatchDidDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPatchDidDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPatchDidDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPatchDidDoc proto.InternalMessageInfo
+
+func (m *MsgPatchDidDoc) GetPayload() *MsgPatchDidDocPayload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MsgPatchDidDoc) GetSignatures() []*SignInfo {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 // SignInfo defines the structure of a DID Document controller's signature
 type SignInfo struct {
 	// Verification method ID of the DID Controller
 	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 	// Signature of the DID Document controller
 	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Version ID of the DID Document the verification method is taken from (optional).
+	// Tells the existing and the updated versions of a DID Document apart during update.
+	// If not set, the signature is checked against each version the signer is required for.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 }
 
 func (m *SignInfo) Reset()         { *m = SignInfo{} }
 func (m *SignInfo) String() string { return proto.CompactTextString(m) }
 func (*SignInfo) ProtoMessage()    {}
 func (*SignInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{3}
+	return fileDescriptor_0e353aae8dd04717, []int{4}
 }
 func (m *SignInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,6 +310,13 @@ func (m *SignInfo) GetSignature() []byte {
 	return nil
 }
 
+func (m *SignInfo) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 // MsgCreateDidDocPayload defines the structure of the payload for creating a new DID document
 type MsgCreateDidDocPayload struct {
 	// context is a list of URIs used to identify the context of the DID document.
@@ -259,6 +326,8 @@ type MsgCreateDidDocPayload struct {
 	// Format: did:canow:<namespace>:<unique-identifier>
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// controller is a list of DIDs that are allowed to control the DID document.
+	// Besides did:canow DIDs of the ledger, did:key and did:jwk DIDs are allowed. Their DID documents
+	// are derived from the DIDs and aren't written to the ledger.
 	Controller []string `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
 	// verificationMethod is a list of verification methods that can be used to
 	// verify a digital signature or cryptographic proof.
@@ -299,13 +368,21 @@ type MsgCreateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// controllerThreshold is the minimal number of controllers that have to sign changes of the DID Document. OPTIONAL.
+	// Default: 0, which means that all controllers have to sign.
+	ControllerThreshold uint32 `protobuf:"varint,13,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
+	// nextKeyCommitment is the pre-rotation commitment to the next authentication key set. OPTIONAL.
+	// Can be generated with `generate-next-key-commitment` command.
+	//
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	NextKeyCommitment string `protobuf:"bytes,14,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
 }
 
 func (m *MsgCreateDidDocPayload) Reset()         { *m = MsgCreateDidDocPayload{} }
 func (m *MsgCreateDidDocPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateDidDocPayload) ProtoMessage()    {}
 func (*MsgCreateDidDocPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{4}
+	return fileDescriptor_0e353aae8dd04717, []int{5}
 }
 func (m *MsgCreateDidDocPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,6 +495,20 @@ func (m *MsgCreateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (m *MsgCreateDidDocPayload) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
+func (m *MsgCreateDidDocPayload) GetNextKeyCommitment() string {
+	if m != nil {
+		return m.NextKeyCommitment
+	}
+	return ""
+}
+
 // MsgCreateDidDocResponse defines response type for Msg/CreateDidDoc.
 type MsgCreateDidDocResponse struct {
 	// Return the created DID Document with metadata
@@ -428,7 +519,7 @@ func (m *MsgCreateDidDocResponse) Reset()         { *m = MsgCreateDidDocResponse
 func (m *MsgCreateDidDocResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateDidDocResponse) ProtoMessage()    {}
 func (*MsgCreateDidDocResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{5}
+	return fileDescriptor_0e353aae8dd04717, []int{6}
 }
 func (m *MsgCreateDidDocResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,6 +564,8 @@ type MsgUpdateDidDocPayload struct {
 	// Format: did:canow:<namespace>:<unique-identifier>
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// controller is a list of DIDs that are allowed to control the DID document.
+	// Besides did:canow DIDs of the ledger, did:key and did:jwk DIDs are allowed. Their DID documents
+	// are derived from the DIDs and aren't written to the ledger.
 	Controller []string `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
 	// verificationMethod is a list of verification methods that can be used to
 	// verify a digital signature or cryptographic proof.
@@ -514,13 +607,29 @@ type MsgUpdateDidDocPayload struct {
 	//
 	// Format: <uuid>
 	VersionId string `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Version ID of the DID Document the update is based on. OPTIONAL.
+	// If set, the update is rejected unless it is the latest version of the DID Document.
+	//
+	// Format: <uuid>
+	PreviousVersionId string `protobuf:"bytes,13,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// controllerThreshold is the minimal number of controllers that have to sign changes of the DID Document. OPTIONAL.
+	// Default: 0, which means that all controllers have to sign.
+	//
+	// The update has to satisfy thresholds of both the existing and the updated versions.
+	ControllerThreshold uint32 `protobuf:"varint,14,opt,name=controller_threshold,json=controllerThreshold,proto3" json:"controller_threshold,omitempty"`
+	// nextKeyCommitment is the pre-rotation commitment to the next authentication key set. OPTIONAL.
+	// If the existing version has a commitment, it can be replaced only together with rotating the committed keys.
+	// Otherwise, the existing commitment is kept and this field has to be either empty or equal to it.
+	//
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	NextKeyCommitment string `protobuf:"bytes,15,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
 }
 
 func (m *MsgUpdateDidDocPayload) Reset()         { *m = MsgUpdateDidDocPayload{} }
 func (m *MsgUpdateDidDocPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDidDocPayload) ProtoMessage()    {}
 func (*MsgUpdateDidDocPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{6}
+	return fileDescriptor_0e353aae8dd04717, []int{7}
 }
 func (m *MsgUpdateDidDocPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,6 +742,27 @@ func (m *MsgUpdateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (m *MsgUpdateDidDocPayload) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
+func (m *MsgUpdateDidDocPayload) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
+func (m *MsgUpdateDidDocPayload) GetNextKeyCommitment() string {
+	if m != nil {
+		return m.NextKeyCommitment
+	}
+	return ""
+}
+
 type MsgUpdateDidDocResponse struct {
 	// Return the updated DID Document with metadata
 	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -642,7 +772,7 @@ func (m *MsgUpdateDidDocResponse) Reset()         { *m = MsgUpdateDidDocResponse
 func (m *MsgUpdateDidDocResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDidDocResponse) ProtoMessage()    {}
 func (*MsgUpdateDidDocResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{7}
+	return fileDescriptor_0e353aae8dd04717, []int{8}
 }
 func (m *MsgUpdateDidDocResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,13 +815,18 @@ type MsgDeactivateDidDocPayload struct {
 	// Version ID of the DID Document to be deactivated
 	// This is primarily used as a sanity check to ensure that the correct DID Document is being deactivated.
 	VersionId string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Version ID of the DID Document the deactivation is based on. OPTIONAL.
+	// If set, the deactivation is rejected unless it is the latest version of the DID Document.
+	//
+	// Format: <uuid>
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
 }
 
 func (m *MsgDeactivateDidDocPayload) Reset()         { *m = MsgDeactivateDidDocPayload{} }
 func (m *MsgDeactivateDidDocPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgDeactivateDidDocPayload) ProtoMessage()    {}
 func (*MsgDeactivateDidDocPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{8}
+	return fileDescriptor_0e353aae8dd04717, []int{9}
 }
 func (m *MsgDeactivateDidDocPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,6 +869,13 @@ func (m *MsgDeactivateDidDocPayload) GetVersionId() string {
 	return ""
 }
 
+func (m *MsgDeactivateDidDocPayload) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
 // MsgDeactivateDidDocResponse defines response type for Msg/DeactivateDidDoc.
 type MsgDeactivateDidDocResponse struct {
 	// Return the deactivated DID Document with metadata
@@ -744,7 +886,7 @@ func (m *MsgDeactivateDidDocResponse) Reset()         { *m = MsgDeactivateDidDoc
 func (m *MsgDeactivateDidDocResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeactivateDidDocResponse) ProtoMessage()    {}
 func (*MsgDeactivateDidDocResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e353aae8dd04717, []int{9}
+	return fileDescriptor_0e353aae8dd04717, []int{10}
 }
 func (m *MsgDeactivateDidDocResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,10 +922,257 @@ func (m *MsgDeactivateDidDocResponse) GetValue() *DidDocWithMetadata {
 	return nil
 }
 
+// MsgPatchDidDocPayload defines the structure of the payload for partially updating an existing DID document
+type MsgPatchDidDocPayload struct {
+	// id is the DID of the DID document.
+	// Format: did:canow:<namespace>:<unique-identifier>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// operations is the list of operations applied in order to the latest version of the DID Document
+	Operations []*PatchOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Version ID of the DID Document the patch is based on.
+	// The patch is rejected if it is not the latest version of the DID Document.
+	//
+	// Format: <uuid>
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	// Version ID of the patched DID Document.
+	//
+	// Format: <uuid>
+	VersionId string `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// nextKeyCommitment is the pre-rotation commitment to the next authentication key set. OPTIONAL.
+	// The same rules as for DID Document update apply.
+	//
+	// Format: multibase (base58btc) encoded sha2-256 multihash
+	NextKeyCommitment string `protobuf:"bytes,5,opt,name=next_key_commitment,json=nextKeyCommitment,proto3" json:"next_key_commitment,omitempty"`
+}
+
+func (m *MsgPatchDidDocPayload) Reset()         { *m = MsgPatchDidDocPayload{} }
+func (m *MsgPatchDidDocPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgPatchDidDocPayload) ProtoMessage()    {}
+func (*MsgPatchDidDocPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{11}
+}
+func (m *MsgPatchDidDocPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPatchDidDocPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPatchDidDocPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPatchDidDocPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPatchDidDocPayload.Merge(m, src)
+}
+func (m *MsgPatchDidDocPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPatchDidDocPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPatchDidDocPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPatchDidDocPayload proto.InternalMessageInfo
+
+func (m *MsgPatchDidDocPayload) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MsgPatchDidDocPayload) GetOperations() []*PatchOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *MsgPatchDidDocPayload) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
+func (m *MsgPatchDidDocPayload) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *MsgPatchDidDocPayload) GetNextKeyCommitment() string {
+	if m != nil {
+		return m.NextKeyCommitment
+	}
+	return ""
+}
+
+// PatchOperation defines an RFC 6902 style operation on a list property of a DID Document.
+// Exactly one value field matching the path is required for add and replace operations.
+type PatchOperation struct {
+	// op is the operation to apply: add, remove or replace
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// path is a JSON pointer to an item of a list property of the DID Document.
+	// Supported properties: verificationMethod, authentication, assertionMethod,
+	// capabilityInvocation, capabilityDelegation, keyAgreement, service, alsoKnownAs, context.
+	// The index "-" refers to the end of the list and can be used only with add.
+	//
+	// Examples:
+	// - /verificationMethod/-
+	// - /authentication/0
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// verification_method is the value for verificationMethod paths
+	VerificationMethod *VerificationMethod `protobuf:"bytes,3,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// verification_relationship is the value for authentication, assertionMethod,
+	// capabilityInvocation, capabilityDelegation and keyAgreement paths
+	VerificationRelationship *VerificationRelationship `protobuf:"bytes,4,opt,name=verification_relationship,json=verificationRelationship,proto3" json:"verification_relationship,omitempty"`
+	// service is the value for service paths
+	Service *Service `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	// also_known_as is the value for alsoKnownAs paths
+	AlsoKnownAs string `protobuf:"bytes,6,opt,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// context is the value for context paths
+	Context string `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (m *PatchOperation) Reset()         { *m = PatchOperation{} }
+func (m *PatchOperation) String() string { return proto.CompactTextString(m) }
+func (*PatchOperation) ProtoMessage()    {}
+func (*PatchOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{12}
+}
+func (m *PatchOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatchOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatchOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatchOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchOperation.Merge(m, src)
+}
+func (m *PatchOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatchOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchOperation proto.InternalMessageInfo
+
+func (m *PatchOperation) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *PatchOperation) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PatchOperation) GetVerificationMethod() *VerificationMethod {
+	if m != nil {
+		return m.VerificationMethod
+	}
+	return nil
+}
+
+func (m *PatchOperation) GetVerificationRelationship() *VerificationRelationship {
+	if m != nil {
+		return m.VerificationRelationship
+	}
+	return nil
+}
+
+func (m *PatchOperation) GetService() *Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *PatchOperation) GetAlsoKnownAs() string {
+	if m != nil {
+		return m.AlsoKnownAs
+	}
+	return ""
+}
+
+func (m *PatchOperation) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// MsgPatchDidDocResponse defines response type for Msg/PatchDidDoc.
+type MsgPatchDidDocResponse struct {
+	// Return the patched DID Document with metadata
+	Value *DidDocWithMetadata `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MsgPatchDidDocResponse) Reset()         { *m = MsgPatchDidDocResponse{} }
+func (m *MsgPatchDidDocResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPatchDidDocResponse) ProtoMessage()    {}
+func (*MsgPatchDidDocResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e353aae8dd04717, []int{13}
+}
+func (m *MsgPatchDidDocResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPatchDidDocResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPatchDidDocResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPatchDidDocResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPatchDidDocResponse.Merge(m, src)
+}
+func (m *MsgPatchDidDocResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPatchDidDocResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPatchDidDocResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPatchDidDocResponse proto.InternalMessageInfo
+
+func (m *MsgPatchDidDocResponse) GetValue() *DidDocWithMetadata {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateDidDoc)(nil), "cheqd.did.v2.MsgCreateDidDoc")
 	proto.RegisterType((*MsgUpdateDidDoc)(nil), "cheqd.did.v2.MsgUpdateDidDoc")
 	proto.RegisterType((*MsgDeactivateDidDoc)(nil), "cheqd.did.v2.MsgDeactivateDidDoc")
+	proto.RegisterType((*MsgPatchDidDoc)(nil), "cheqd.did.v2.MsgPatchDidDoc")
 	proto.RegisterType((*SignInfo)(nil), "cheqd.did.v2.SignInfo")
 	proto.RegisterType((*MsgCreateDidDocPayload)(nil), "cheqd.did.v2.MsgCreateDidDocPayload")
 	proto.RegisterType((*MsgCreateDidDocResponse)(nil), "cheqd.did.v2.MsgCreateDidDocResponse")
@@ -791,57 +1180,76 @@ func init() {
 	proto.RegisterType((*MsgUpdateDidDocResponse)(nil), "cheqd.did.v2.MsgUpdateDidDocResponse")
 	proto.RegisterType((*MsgDeactivateDidDocPayload)(nil), "cheqd.did.v2.MsgDeactivateDidDocPayload")
 	proto.RegisterType((*MsgDeactivateDidDocResponse)(nil), "cheqd.did.v2.MsgDeactivateDidDocResponse")
+	proto.RegisterType((*MsgPatchDidDocPayload)(nil), "cheqd.did.v2.MsgPatchDidDocPayload")
+	proto.RegisterType((*PatchOperation)(nil), "cheqd.did.v2.PatchOperation")
+	proto.RegisterType((*MsgPatchDidDocResponse)(nil), "cheqd.did.v2.MsgPatchDidDocResponse")
 }
 
 func init() { proto.RegisterFile("cheqd/did/v2/tx.proto", fileDescriptor_0e353aae8dd04717) }
 
 var fileDescriptor_0e353aae8dd04717 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x5d, 0x6f, 0x12, 0x4d,
-	0x14, 0xc7, 0xbb, 0xf0, 0xb4, 0x94, 0x03, 0x7d, 0xc9, 0xf4, 0xe5, 0xd9, 0x87, 0xc7, 0x12, 0x24,
-	0x6a, 0xd0, 0xa4, 0x90, 0xa0, 0xe9, 0xa5, 0x49, 0x2b, 0x37, 0xa4, 0xc1, 0xd8, 0xb5, 0xd5, 0x44,
-	0x13, 0x71, 0xba, 0x73, 0xba, 0x4c, 0xba, 0xdd, 0xc1, 0x9d, 0x61, 0x5b, 0x3e, 0x82, 0x77, 0x1a,
-	0xbf, 0x94, 0x97, 0x4d, 0xbc, 0xf1, 0xd2, 0xb4, 0x5f, 0xc4, 0xb0, 0xcb, 0xd2, 0x65, 0xa1, 0x6d,
-	0xb0, 0xbd, 0x31, 0xe9, 0x15, 0xe1, 0x9c, 0xff, 0xf9, 0xf1, 0xdf, 0x99, 0x73, 0xd8, 0x03, 0x2b,
-	0x66, 0x0b, 0x3f, 0xb1, 0x0a, 0xe3, 0xac, 0xe2, 0x55, 0x2b, 0xea, 0xa4, 0xdc, 0x76, 0x85, 0x12,
-	0x24, 0xeb, 0x87, 0xcb, 0x8c, 0xb3, 0xb2, 0x57, 0xcd, 0xfd, 0x37, 0x24, 0x62, 0x9c, 0x31, 0x61,
-	0x06, 0xc2, 0xe2, 0x67, 0x0d, 0x16, 0x1a, 0xd2, 0x7a, 0xe1, 0x22, 0x55, 0x58, 0xe3, 0xac, 0x26,
-	0x4c, 0xf2, 0x1c, 0x52, 0x6d, 0xda, 0xb5, 0x05, 0x65, 0xba, 0x56, 0xd0, 0x4a, 0x99, 0xea, 0x83,
-	0x72, 0x14, 0x57, 0x8e, 0xe9, 0x5f, 0x05, 0x5a, 0x23, 0x2c, 0x22, 0x1b, 0x00, 0x92, 0x5b, 0x0e,
-	0x55, 0x1d, 0x17, 0xa5, 0x9e, 0x28, 0x24, 0x4b, 0x99, 0xea, 0xea, 0x30, 0xe2, 0x35, 0xb7, 0x9c,
-	0xba, 0x73, 0x20, 0x8c, 0x88, 0x32, 0xf4, 0xb2, 0xd7, 0x66, 0x13, 0x79, 0x89, 0xea, 0x6f, 0xcd,
-	0xcb, 0x57, 0x0d, 0x96, 0x1a, 0xd2, 0xaa, 0x21, 0x35, 0x15, 0xf7, 0x2e, 0xfc, 0x6c, 0xc5, 0xfd,
-	0x94, 0x46, 0xfc, 0xc4, 0x6b, 0x6e, 0xcd, 0xd3, 0x07, 0x98, 0x0d, 0xe3, 0xe4, 0x19, 0xac, 0x7a,
-	0xe8, 0xf2, 0x03, 0x6e, 0x52, 0xc5, 0x85, 0xd3, 0x3c, 0x42, 0xd5, 0x12, 0xac, 0xc9, 0x03, 0x5b,
-	0x69, 0x63, 0x39, 0x9a, 0x6d, 0xf8, 0xc9, 0x3a, 0x23, 0xf7, 0x20, 0x3d, 0xe0, 0xe9, 0x89, 0x82,
-	0x56, 0xca, 0x1a, 0x17, 0x81, 0xe2, 0x8f, 0x69, 0x58, 0x1d, 0x7f, 0xb7, 0x44, 0x87, 0x94, 0x29,
-	0x1c, 0x85, 0x27, 0x4a, 0xd7, 0x0a, 0xc9, 0x52, 0xda, 0x08, 0xbf, 0x92, 0x79, 0x48, 0x70, 0xe6,
-	0xb3, 0xd2, 0x46, 0x82, 0x33, 0x92, 0x07, 0xe8, 0xa5, 0x5c, 0x61, 0xdb, 0xe8, 0xea, 0x49, 0x5f,
-	0x1c, 0x89, 0x90, 0x1d, 0x58, 0x1a, 0x63, 0x5c, 0xff, 0xc7, 0x3f, 0x85, 0xc2, 0xf0, 0x29, 0xbc,
-	0x19, 0x79, 0x06, 0x83, 0x8c, 0x3e, 0x17, 0x79, 0x09, 0xf3, 0xb4, 0xa3, 0x5a, 0xe8, 0xa8, 0x7e,
-	0x5c, 0x9f, 0xf6, 0x69, 0x8f, 0x2e, 0xa7, 0x19, 0x68, 0xfb, 0x9f, 0xb2, 0xc5, 0xdb, 0x46, 0xac,
-	0x9a, 0xec, 0xc0, 0x22, 0x95, 0x12, 0xdd, 0xa8, 0xbf, 0x99, 0x89, 0x88, 0x0b, 0x83, 0xfa, 0xbe,
-	0xc5, 0xf7, 0xb0, 0x62, 0xd2, 0x36, 0xdd, 0xe7, 0x36, 0x57, 0xdd, 0x26, 0x77, 0x3c, 0xd1, 0x77,
-	0x9a, 0x9a, 0x88, 0xbb, 0x7c, 0x01, 0xa9, 0x0f, 0x18, 0x31, 0x38, 0x43, 0x1b, 0xad, 0x00, 0x3e,
-	0xfb, 0xa7, 0xf0, 0xda, 0x80, 0x41, 0xb6, 0x61, 0xee, 0x10, 0xbb, 0x4d, 0x6a, 0xb9, 0x88, 0x47,
-	0xe8, 0x28, 0x3d, 0x3d, 0x11, 0x34, 0x7b, 0x88, 0xdd, 0xcd, 0xb0, 0x96, 0x54, 0x20, 0x25, 0xd1,
-	0xf5, 0xb8, 0x89, 0x3a, 0xf8, 0x98, 0x95, 0x58, 0xdb, 0x07, 0x49, 0x23, 0x54, 0x91, 0x22, 0xcc,
-	0x51, 0x5b, 0x8a, 0xe6, 0xa1, 0x23, 0x8e, 0x9d, 0x26, 0x95, 0x7a, 0xc6, 0x6f, 0xa8, 0x4c, 0x2f,
-	0xb8, 0xdd, 0x8b, 0x6d, 0x4a, 0xb2, 0x06, 0xe0, 0xa1, 0x2b, 0x7b, 0x97, 0xc5, 0x99, 0x9e, 0xf5,
-	0x3b, 0x31, 0xdd, 0x8f, 0xd4, 0x59, 0x71, 0x07, 0xfe, 0x8d, 0x35, 0xb5, 0x81, 0xb2, 0x2d, 0x1c,
-	0x89, 0x64, 0x03, 0xa6, 0x3d, 0x6a, 0x77, 0xb0, 0x3f, 0xca, 0xb1, 0xee, 0x0b, 0xc4, 0x6f, 0xb9,
-	0x6a, 0x35, 0x50, 0x51, 0x46, 0x15, 0x35, 0x02, 0x79, 0x38, 0x28, 0x63, 0xfe, 0x78, 0xee, 0x06,
-	0xe5, 0x6e, 0x50, 0xfe, 0xee, 0x41, 0x89, 0x36, 0xf5, 0x8d, 0x07, 0x65, 0x1b, 0x72, 0x97, 0xbf,
-	0x10, 0xfb, 0x13, 0xa1, 0x0d, 0x26, 0x62, 0xd8, 0x5f, 0x22, 0xee, 0x6f, 0x0f, 0xfe, 0x1f, 0x03,
-	0xbb, 0xa9, 0xc7, 0xea, 0xb7, 0x04, 0x24, 0x1b, 0xd2, 0x22, 0xbb, 0x90, 0x1d, 0xda, 0x82, 0xd6,
-	0xae, 0x5c, 0x7a, 0x72, 0x0f, 0xaf, 0x4c, 0x0f, 0x5c, 0xed, 0x42, 0x76, 0x68, 0x9f, 0x59, 0xbb,
-	0x72, 0x7d, 0x19, 0x43, 0x1d, 0x7b, 0x1f, 0x1f, 0x61, 0x71, 0x64, 0x33, 0xb9, 0x7f, 0xed, 0x22,
-	0x92, 0x7b, 0x7c, 0xad, 0x24, 0xfc, 0x85, 0xad, 0xda, 0xf7, 0xb3, 0xbc, 0x76, 0x7a, 0x96, 0xd7,
-	0x7e, 0x9d, 0xe5, 0xb5, 0x2f, 0xe7, 0xf9, 0xa9, 0xd3, 0xf3, 0xfc, 0xd4, 0xcf, 0xf3, 0xfc, 0xd4,
-	0xbb, 0x27, 0x16, 0x57, 0xad, 0xce, 0x7e, 0xd9, 0x14, 0x47, 0x15, 0x93, 0x3a, 0xe2, 0x78, 0xdd,
-	0x14, 0x15, 0x9f, 0xbb, 0xee, 0x08, 0x86, 0x95, 0x13, 0x7f, 0xcf, 0x54, 0xdd, 0x36, 0xca, 0xfd,
-	0x19, 0x7f, 0xc9, 0x7c, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x01, 0xab, 0xfb, 0xa6, 0x0a,
-	0x00, 0x00,
+	// 963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x49, 0x5c, 0x3f, 0xff, 0x48, 0x19, 0x27, 0x61, 0x6b, 0x12, 0xcb, 0x98, 0x16,
+	0x19, 0xa4, 0xda, 0xc2, 0xa0, 0x9e, 0x00, 0xa9, 0xad, 0x2f, 0x51, 0x64, 0x48, 0x96, 0xb4, 0x48,
+	0x70, 0x30, 0x93, 0x9d, 0xa9, 0x77, 0x14, 0x7b, 0x67, 0xd9, 0x19, 0x6f, 0x63, 0x21, 0xce, 0x88,
+	0x1b, 0xfc, 0x33, 0xfc, 0x0d, 0x1c, 0x7b, 0x44, 0xe2, 0x82, 0x12, 0xd4, 0xbf, 0x03, 0x79, 0xd6,
+	0xbb, 0xde, 0x5d, 0xff, 0x48, 0xdc, 0xe4, 0x82, 0xd4, 0x53, 0xe2, 0xf7, 0xbe, 0xf9, 0xf6, 0x7b,
+	0xcf, 0xef, 0x1b, 0xef, 0x83, 0x1d, 0xd3, 0xa2, 0x3f, 0x92, 0x26, 0x61, 0xa4, 0xe9, 0xb5, 0x9a,
+	0xf2, 0xbc, 0xe1, 0xb8, 0x5c, 0x72, 0x94, 0x57, 0xe1, 0x06, 0x61, 0xa4, 0xe1, 0xb5, 0xca, 0xf7,
+	0x62, 0x20, 0xc2, 0x08, 0xe1, 0xa6, 0x0f, 0xac, 0xfd, 0xaa, 0xc1, 0x56, 0x47, 0xf4, 0x9e, 0xba,
+	0x14, 0x4b, 0xda, 0x66, 0xa4, 0xcd, 0x4d, 0xf4, 0x25, 0x64, 0x1c, 0x3c, 0xea, 0x73, 0x4c, 0x74,
+	0xad, 0xaa, 0xd5, 0x73, 0xad, 0xfb, 0x8d, 0x28, 0x5d, 0x23, 0x81, 0x3f, 0xf2, 0xb1, 0x46, 0x70,
+	0x08, 0x3d, 0x02, 0x10, 0xac, 0x67, 0x63, 0x39, 0x74, 0xa9, 0xd0, 0x53, 0xd5, 0x74, 0x3d, 0xd7,
+	0xda, 0x8d, 0x53, 0x7c, 0xc3, 0x7a, 0xf6, 0x81, 0xfd, 0x82, 0x1b, 0x11, 0x64, 0xa0, 0xe5, 0x99,
+	0x43, 0x56, 0xd2, 0x12, 0xc5, 0xdf, 0x9a, 0x96, 0xdf, 0x35, 0x28, 0x75, 0x44, 0xaf, 0x4d, 0xb1,
+	0x29, 0x99, 0x37, 0xd5, 0xf3, 0x24, 0xa9, 0xa7, 0x3e, 0xa3, 0x27, 0x79, 0xe6, 0xd6, 0x34, 0xfd,
+	0xa2, 0x41, 0xb1, 0x23, 0x7a, 0x47, 0x58, 0x9a, 0xd6, 0x44, 0xce, 0x17, 0x49, 0x39, 0x1f, 0xcc,
+	0xc8, 0x89, 0xc0, 0x6f, 0x4d, 0xc9, 0xcf, 0x70, 0x27, 0x88, 0xa3, 0xcf, 0x60, 0xd7, 0xa3, 0x2e,
+	0x7b, 0xc1, 0x4c, 0x2c, 0x19, 0xb7, 0xbb, 0x03, 0x2a, 0x2d, 0x4e, 0xba, 0xcc, 0x57, 0x94, 0x35,
+	0xb6, 0xa3, 0xd9, 0x8e, 0x4a, 0x1e, 0x10, 0xb4, 0x07, 0xd9, 0x90, 0x4f, 0x4f, 0x55, 0xb5, 0x7a,
+	0xde, 0x98, 0x06, 0xd0, 0x3e, 0x80, 0x47, 0x5d, 0x31, 0xa6, 0x63, 0x44, 0x4f, 0x2b, 0x9e, 0xec,
+	0x24, 0x72, 0x40, 0x6a, 0x7f, 0x6c, 0xc2, 0xee, 0xfc, 0x21, 0x44, 0x3a, 0x64, 0x4c, 0x6e, 0x4b,
+	0x7a, 0x2e, 0x75, 0xad, 0x9a, 0xae, 0x67, 0x8d, 0xe0, 0x23, 0x2a, 0x42, 0x8a, 0x11, 0xf5, 0xa8,
+	0xac, 0x91, 0x62, 0x04, 0x55, 0x00, 0xc6, 0x29, 0x97, 0xf7, 0xfb, 0xd4, 0xd5, 0xd3, 0x0a, 0x1c,
+	0x89, 0xa0, 0x63, 0x28, 0xcd, 0xa9, 0x4b, 0x5f, 0x57, 0x4d, 0xaa, 0xc6, 0x9b, 0xf4, 0x7c, 0xa6,
+	0x44, 0x03, 0xcd, 0x96, 0x8d, 0xbe, 0x82, 0x22, 0x1e, 0x4a, 0x8b, 0xda, 0x72, 0x12, 0xd7, 0x37,
+	0x14, 0xdb, 0x87, 0x8b, 0xd9, 0x0c, 0xda, 0x57, 0x7f, 0x85, 0xc5, 0x1c, 0x23, 0x71, 0x1a, 0x1d,
+	0xc3, 0x5d, 0x2c, 0x04, 0x75, 0xa3, 0xfa, 0x36, 0x57, 0x62, 0xdc, 0x0a, 0xcf, 0x4f, 0x24, 0x7e,
+	0x0f, 0x3b, 0x26, 0x76, 0xf0, 0x29, 0xeb, 0x33, 0x39, 0xea, 0x32, 0xdb, 0xe3, 0x13, 0xa5, 0x99,
+	0x95, 0x78, 0xb7, 0xa7, 0x24, 0x07, 0x21, 0x47, 0x82, 0x9c, 0xd0, 0x3e, 0xed, 0xf9, 0xe4, 0x77,
+	0xde, 0x94, 0xbc, 0x1d, 0x72, 0xa0, 0x43, 0x28, 0x9c, 0xd1, 0x51, 0x17, 0xf7, 0x5c, 0x4a, 0x07,
+	0xd4, 0x96, 0x7a, 0x76, 0x25, 0xd2, 0xfc, 0x19, 0x1d, 0x3d, 0x0e, 0xce, 0xa2, 0x26, 0x64, 0x04,
+	0x75, 0x3d, 0x66, 0x52, 0x1d, 0x14, 0xcd, 0x4e, 0xc2, 0x15, 0x7e, 0xd2, 0x08, 0x50, 0xa8, 0x06,
+	0x05, 0xdc, 0x17, 0xbc, 0x7b, 0x66, 0xf3, 0x97, 0x76, 0x17, 0x0b, 0x3d, 0xa7, 0x06, 0x2a, 0x37,
+	0x0e, 0x1e, 0x8e, 0x63, 0x8f, 0x45, 0x62, 0xaa, 0xf3, 0x89, 0xa9, 0x46, 0x9f, 0xc0, 0xf6, 0x74,
+	0xfc, 0xba, 0xd2, 0x72, 0xa9, 0xb0, 0x78, 0x9f, 0xe8, 0x85, 0xaa, 0x56, 0x2f, 0x18, 0xa5, 0x69,
+	0xee, 0x24, 0x48, 0xa1, 0x06, 0x94, 0x6c, 0x7a, 0x2e, 0xbb, 0xe3, 0xc2, 0x4d, 0x3e, 0x18, 0x30,
+	0xa9, 0x2a, 0x2f, 0x2a, 0xea, 0x77, 0xc6, 0xa9, 0x43, 0x3a, 0x7a, 0x1a, 0x26, 0x6a, 0xc7, 0xf0,
+	0x6e, 0xc2, 0x37, 0x06, 0x15, 0x0e, 0xb7, 0x05, 0x45, 0x8f, 0x60, 0xc3, 0xc3, 0xfd, 0x21, 0x9d,
+	0xdc, 0x23, 0x89, 0x01, 0xf7, 0xc1, 0xdf, 0x32, 0x69, 0x75, 0xa8, 0xc4, 0x04, 0x4b, 0x6c, 0xf8,
+	0xf0, 0xda, 0x6b, 0xdf, 0x8b, 0x73, 0x2e, 0xe1, 0xb7, 0x5e, 0x7c, 0xeb, 0xc5, 0xff, 0xab, 0x17,
+	0x1b, 0x50, 0x72, 0x5c, 0xea, 0x31, 0x3e, 0x14, 0xdd, 0x08, 0xae, 0xe0, 0x1b, 0x2b, 0x48, 0x3d,
+	0xbf, 0xd2, 0xbb, 0xc5, 0x95, 0xbd, 0xbb, 0xb5, 0xdc, 0xbb, 0x51, 0x9f, 0xdd, 0xd8, 0xbb, 0x3f,
+	0x41, 0x79, 0xf1, 0xfb, 0xca, 0xc4, 0xa4, 0x5a, 0x68, 0xd2, 0x78, 0xcb, 0x52, 0xd7, 0x6c, 0x59,
+	0x7a, 0x41, 0xcb, 0x6a, 0xcf, 0xe0, 0xbd, 0x39, 0x0f, 0xbf, 0x71, 0x4d, 0xff, 0x6a, 0xb0, 0x33,
+	0xf7, 0xad, 0x67, 0xa6, 0x9e, 0xcf, 0x01, 0xb8, 0x43, 0x5d, 0x7f, 0xec, 0x26, 0x2f, 0x3f, 0x7b,
+	0xf1, 0xc7, 0x28, 0x96, 0xaf, 0x03, 0x90, 0x11, 0xc1, 0xaf, 0x5a, 0x6e, 0xa2, 0x7b, 0xeb, 0x73,
+	0xba, 0x37, 0x6f, 0x1a, 0x36, 0x16, 0x4d, 0xc3, 0xdf, 0x29, 0x28, 0xc6, 0xd5, 0x8d, 0xeb, 0xe3,
+	0x4e, 0x50, 0x1f, 0x77, 0x10, 0x82, 0x75, 0x07, 0x4b, 0x6b, 0xf2, 0x4d, 0xa9, 0xff, 0x17, 0x5d,
+	0xa4, 0xe9, 0x79, 0x3d, 0xbe, 0xe6, 0x45, 0x6a, 0xc2, 0xbd, 0x18, 0xa5, 0x1b, 0x71, 0xb2, 0xaa,
+	0xf3, 0xfa, 0xbe, 0xd7, 0xbd, 0x05, 0x99, 0xe8, 0x1d, 0xb0, 0x51, 0xd5, 0xde, 0xe4, 0x0e, 0xd8,
+	0xac, 0x6a, 0xc9, 0x3b, 0x20, 0xf2, 0xfb, 0x94, 0x51, 0xd9, 0xe0, 0x63, 0xed, 0x48, 0xfd, 0xa6,
+	0x45, 0x66, 0xe8, 0xa6, 0x63, 0xd9, 0x7a, 0x9d, 0x82, 0x74, 0x47, 0xf4, 0xd0, 0x09, 0xe4, 0x63,
+	0xbb, 0xd6, 0xfe, 0xd2, 0xd5, 0xaa, 0xfc, 0x60, 0x69, 0x3a, 0x54, 0x75, 0x02, 0xf9, 0xd8, 0xd6,
+	0xb4, 0xbf, 0x74, 0x49, 0x2a, 0x3f, 0x58, 0x9a, 0x0e, 0x59, 0x7f, 0x80, 0xbb, 0x33, 0xfb, 0xcf,
+	0xfb, 0x57, 0xae, 0x3b, 0xe5, 0x8f, 0xae, 0x84, 0x84, 0x4f, 0x38, 0x86, 0x5c, 0x74, 0x9b, 0xd9,
+	0x5b, 0xb6, 0xbc, 0x94, 0xef, 0x2f, 0xcb, 0x06, 0x94, 0x4f, 0xda, 0x7f, 0x5e, 0x54, 0xb4, 0x57,
+	0x17, 0x15, 0xed, 0x9f, 0x8b, 0x8a, 0xf6, 0xdb, 0x65, 0x65, 0xed, 0xd5, 0x65, 0x65, 0xed, 0xaf,
+	0xcb, 0xca, 0xda, 0x77, 0x1f, 0xf7, 0x98, 0xb4, 0x86, 0xa7, 0x0d, 0x93, 0x0f, 0x9a, 0x26, 0xb6,
+	0xf9, 0xcb, 0x87, 0x26, 0x6f, 0x2a, 0xca, 0x87, 0x36, 0x27, 0xb4, 0x79, 0xae, 0x16, 0x64, 0x39,
+	0x72, 0xa8, 0x38, 0xdd, 0x54, 0xdb, 0xf1, 0xa7, 0xff, 0x0d, 0x00, 0x90, 0xbb, 0xd5, 0xe9, 0x5f,
+	0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -862,6 +1270,8 @@ type MsgClient interface {
 	UpdateDidDoc(ctx context.Context, in *MsgUpdateDidDoc, opts ...grpc.CallOption) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDoc, opts ...grpc.CallOption) (*MsgDeactivateDidDocResponse, error)
+	// PatchDidDoc defines a method for partially updating an existing DID document
+	PatchDidDoc(ctx context.Context, in *MsgPatchDidDoc, opts ...grpc.CallOption) (*MsgPatchDidDocResponse, error)
 }
 
 type msgClient struct {
@@ -899,6 +1309,15 @@ func (c *msgClient) DeactivateDidDoc(ctx context.Context, in *MsgDeactivateDidDo
 	return out, nil
 }
 
+func (c *msgClient) PatchDidDoc(ctx context.Context, in *MsgPatchDidDoc, opts ...grpc.CallOption) (*MsgPatchDidDocResponse, error) {
+	out := new(MsgPatchDidDocResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.did.v2.Msg/PatchDidDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateDidDoc defines a method for creating a new DID document
@@ -907,6 +1326,8 @@ type MsgServer interface {
 	UpdateDidDoc(context.Context, *MsgUpdateDidDoc) (*MsgUpdateDidDocResponse, error)
 	// DeactivateDidDoc defines a method for deactivating an existing DID document
 	DeactivateDidDoc(context.Context, *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error)
+	// PatchDidDoc defines a method for partially updating an existing DID document
+	PatchDidDoc(context.Context, *MsgPatchDidDoc) (*MsgPatchDidDocResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -922,6 +1343,9 @@ func (*UnimplementedMsgServer) UpdateDidDoc(ctx context.Context, req *MsgUpdateD
 func (*UnimplementedMsgServer) DeactivateDidDoc(ctx context.Context, req *MsgDeactivateDidDoc) (*MsgDeactivateDidDocResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDidDoc not implemented")
 }
+func (*UnimplementedMsgServer) PatchDidDoc(ctx context.Context, req *MsgPatchDidDoc) (*MsgPatchDidDocResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchDidDoc not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -981,6 +1405,24 @@ func _Msg_DeactivateDidDoc_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PatchDidDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPatchDidDoc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PatchDidDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.did.v2.Msg/PatchDidDoc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PatchDidDoc(ctx, req.(*MsgPatchDidDoc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cheqd.did.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -997,6 +1439,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateDidDoc",
 			Handler:    _Msg_DeactivateDidDoc_Handler,
 		},
+		{
+			MethodName: "PatchDidDoc",
+			Handler:    _Msg_PatchDidDoc_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/did/v2/tx.proto",
@@ -1149,7 +1595,7 @@ func (m *MsgDeactivateDidDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SignInfo) Marshal() (dAtA []byte, err error) {
+func (m *MsgPatchDidDoc) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1159,7 +1605,56 @@ func (m *SignInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPatchDidDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPatchDidDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1169,6 +1664,13 @@ func (m *SignInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1206,6 +1708,18 @@ func (m *MsgCreateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextKeyCommitment) > 0 {
+		i -= len(m.NextKeyCommitment)
+		copy(dAtA[i:], m.NextKeyCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextKeyCommitment)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -1403,6 +1917,25 @@ func (m *MsgUpdateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextKeyCommitment) > 0 {
+		i -= len(m.NextKeyCommitment)
+		copy(dAtA[i:], m.NextKeyCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextKeyCommitment)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -1600,6 +2133,13 @@ func (m *MsgDeactivateDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -1652,6 +2192,193 @@ func (m *MsgDeactivateDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPatchDidDocPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPatchDidDocPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPatchDidDocPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKeyCommitment) > 0 {
+		i -= len(m.NextKeyCommitment)
+		copy(dAtA[i:], m.NextKeyCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextKeyCommitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatchOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatchOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatchOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		i -= len(m.AlsoKnownAs)
+		copy(dAtA[i:], m.AlsoKnownAs)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AlsoKnownAs)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VerificationRelationship != nil {
+		{
+			size, err := m.VerificationRelationship.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VerificationMethod != nil {
+		{
+			size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPatchDidDocResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPatchDidDocResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPatchDidDocResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1720,6 +2447,25 @@ func (m *MsgDeactivateDidDoc) Size() (n int) {
 	return n
 }
 
+func (m *MsgPatchDidDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SignInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1734,6 +2480,10 @@ func (m *SignInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1811,6 +2561,13 @@ func (m *MsgCreateDidDocPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.ControllerThreshold))
+	}
+	l = len(m.NextKeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1901,6 +2658,17 @@ func (m *MsgUpdateDidDocPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.PreviousVersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.ControllerThreshold))
+	}
+	l = len(m.NextKeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1931,6 +2699,10 @@ func (m *MsgDeactivateDidDocPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.PreviousVersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1947,21 +2719,102 @@ func (m *MsgDeactivateDidDocResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateDidDoc) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+func (m *MsgPatchDidDocPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PreviousVersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NextKeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PatchOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VerificationMethod != nil {
+		l = m.VerificationMethod.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VerificationRelationship != nil {
+		l = m.VerificationRelationship.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AlsoKnownAs)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPatchDidDocResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateDidDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -2313,6 +3166,126 @@ func (m *MsgDeactivateDidDoc) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPatchDidDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPatchDidDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPatchDidDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgPatchDidDocPayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SignInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2408,6 +3381,38 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2856,25 +3861,76 @@ func (m *MsgCreateDidDocPayload) Unmarshal(dAtA []byte) error {
 			}
 			m.VersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *MsgCreateDidDocResponse) Unmarshal(dAtA []byte) error {
@@ -3390,6 +4446,89 @@ func (m *MsgUpdateDidDocPayload) Unmarshal(dAtA []byte) error {
 			}
 			m.VersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+			}
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3590,6 +4729,38 @@ func (m *MsgDeactivateDidDocPayload) Unmarshal(dAtA []byte) error {
 			}
 			m.VersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3697,6 +4868,590 @@ func (m *MsgDeactivateDidDocResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPatchDidDocPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPatchDidDocPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPatchDidDocPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &PatchOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKeyCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatchOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatchOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatchOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerificationMethod == nil {
+				m.VerificationMethod = &VerificationMethod{}
+			}
+			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationRelationship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerificationRelationship == nil {
+				m.VerificationRelationship = &VerificationRelationship{}
+			}
+			if err := m.VerificationRelationship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPatchDidDocResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPatchDidDocResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPatchDidDocResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DidDocWithMetadata{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
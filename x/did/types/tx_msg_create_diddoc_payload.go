@@ -30,19 +30,24 @@ func (msg *MsgCreateDidDocPayload) ToDidDoc() DidDoc {
 		KeyAgreement:         msg.KeyAgreement,
 		AlsoKnownAs:          msg.AlsoKnownAs,
 		Service:              msg.Service,
+		ControllerThreshold:  msg.ControllerThreshold,
 	}
 }
 
 // Validation
 
 func (msg MsgCreateDidDocPayload) Validate(allowedNamespaces []string) error {
-	err := msg.ToDidDoc().Validate(allowedNamespaces)
+	didDoc := msg.ToDidDoc()
+
+	err := didDoc.Validate(allowedNamespaces)
 	if err != nil {
 		return err
 	}
 
 	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Context, HasRequiredContextsRule(&didDoc, nil)),
 		validation.Field(&msg.VersionId, validation.Required),
+		validation.Field(&msg.NextKeyCommitment, validation.When(msg.NextKeyCommitment != "", IsNextKeyCommitment())),
 	)
 }
 
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgPatchDidDoc{}
+
+func NewMsgPatchDid(payload *MsgPatchDidDocPayload, signatures []*SignInfo) *MsgPatchDidDoc {
+	return &MsgPatchDidDoc{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgPatchDidDoc) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPatchDidDoc) Type() string {
+	return "MsgPatchDidDoc"
+}
+
+func (msg *MsgPatchDidDoc) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgPatchDidDoc) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPatchDidDoc) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgPatchDidDoc) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgPatchDidPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, IsUniqueSignInfoListRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgPatchDidDoc) Normalize() {
+	msg.Payload.Normalize()
+	NormalizeSignInfoList(msg.Signatures)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgPatchDidDocPayload{}
+
+func (msg *MsgPatchDidDocPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// Validation
+
+func (msg MsgPatchDidDocPayload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&msg.Operations, validation.Required, validation.Each(ValidPatchOperationRule())),
+		validation.Field(&msg.PreviousVersionId, validation.Required, IsUUID()),
+		validation.Field(&msg.VersionId, validation.Required, IsUUID()),
+		validation.Field(&msg.NextKeyCommitment, validation.When(msg.NextKeyCommitment != "", IsNextKeyCommitment())),
+	)
+}
+
+func ValidMsgPatchDidPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgPatchDidDocPayload)
+		if !ok {
+			panic("ValidMsgPatchDidPayloadRule must be only applied on MsgPatchDidPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
+
+func ValidPatchOperationRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(PatchOperation)
+		if !ok {
+			panic("ValidPatchOperationRule must be only applied on PatchOperation properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+// Normalize
+
+func (msg *MsgPatchDidDocPayload) Normalize() {
+	msg.Id = utils.NormalizeDID(msg.Id)
+
+	for _, op := range msg.Operations {
+		if op.VerificationMethod != nil {
+			NormalizeVerificationMethod(op.VerificationMethod)
+		}
+
+		if op.VerificationRelationship != nil {
+			NormalizeVerificationRelationshipList([]*VerificationRelationship{op.VerificationRelationship})
+		}
+
+		if op.Service != nil {
+			NormalizeService(op.Service)
+		}
+	}
+
+	msg.PreviousVersionId = utils.NormalizeUUID(msg.PreviousVersionId)
+	msg.VersionId = utils.NormalizeUUID(msg.VersionId)
+}
@@ -29,6 +29,7 @@ func (msg *MsgUpdateDidDocPayload) ToDidDoc() DidDoc {
 		KeyAgreement:         msg.KeyAgreement,
 		AlsoKnownAs:          msg.AlsoKnownAs,
 		Service:              msg.Service,
+		ControllerThreshold:  msg.ControllerThreshold,
 	}
 }
 
@@ -42,6 +43,8 @@ func (msg MsgUpdateDidDocPayload) Validate(allowedNamespaces []string) error {
 
 	return validation.ValidateStruct(&msg,
 		validation.Field(&msg.VersionId, validation.Required),
+		validation.Field(&msg.PreviousVersionId, validation.When(msg.PreviousVersionId != "", IsUUID())),
+		validation.Field(&msg.NextKeyCommitment, validation.When(msg.NextKeyCommitment != "", IsNextKeyCommitment())),
 	)
 }
 
@@ -75,4 +78,5 @@ func (msg *MsgUpdateDidDocPayload) Normalize() {
 		NormalizeService(s)
 	}
 	msg.VersionId = utils.NormalizeUUID(msg.VersionId)
+	msg.PreviousVersionId = utils.NormalizeUUID(msg.PreviousVersionId)
 }
@@ -26,7 +26,7 @@ func GetSignInfoIds(infos []*SignInfo) []string {
 
 func IsUniqueSignInfoList(infos []*SignInfo) bool {
 	hash := func(si *SignInfo) string {
-		return si.VerificationMethodId + ":" + base58.Encode(si.Signature)
+		return si.VerificationMethodId + ":" + si.VersionId + ":" + base58.Encode(si.Signature)
 	}
 
 	tmp := map[string]bool{}
@@ -73,7 +73,7 @@ func FindSignInfoBySigner(infos []*SignInfo, signer string) (info SignInfo, foun
 
 func (si SignInfo) Validate(allowedNamespaces []string) error {
 	return validation.ValidateStruct(&si,
-		validation.Field(&si.VerificationMethodId, validation.Required, IsSpecificDIDUrl(allowedNamespaces, Empty, Empty, Required)),
+		validation.Field(&si.VerificationMethodId, validation.Required, IsSignerDIDUrl(allowedNamespaces)),
 		validation.Field(&si.Signature, validation.Required),
 	)
 }
@@ -124,6 +124,7 @@ func IsUniqueSignInfoListRule() *CustomErrorRule {
 
 func (si *SignInfo) Normalize() {
 	si.VerificationMethodId = utils.NormalizeDIDUrl(si.VerificationMethodId)
+	si.VersionId = utils.NormalizeUUID(si.VersionId)
 }
 
 func NormalizeSignInfoList(signatures []*SignInfo) {
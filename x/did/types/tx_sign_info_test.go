@@ -94,6 +94,24 @@ var _ = Describe("Full SignInfo duplicates tests", func() {
 				isValid: true,
 			}),
 
+		Entry(
+			"Signatures are the same but versions are different",
+			TestCaseSignInfosStruct{
+				signInfos: []*SignInfo{
+					{
+						VerificationMethodId: "did:canow:zABCDEFG123456789abcd#method1",
+						Signature:            []byte("aaa="),
+						VersionId:            "9a7b5e34-61f8-4a2c-9e0a-4b1d3c2f5e61",
+					},
+					{
+						VerificationMethodId: "did:canow:zABCDEFG123456789abcd#method1",
+						Signature:            []byte("aaa="),
+						VersionId:            "0c2d4f6a-8b1e-4d3c-a5f7-6e9b8a7c1d20",
+					},
+				},
+				isValid: true,
+			}),
+
 		Entry(
 			"All fields are the same",
 			TestCaseSignInfosStruct{
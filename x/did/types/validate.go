@@ -61,6 +61,30 @@ func IsDID(allowedNamespaces []string) *CustomErrorRule {
 	})
 }
 
+// IsControllerDID accepts DIDs of the ledger and DIDs which DID Documents are derived in-process, e.g. did:key
+func IsControllerDID(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsControllerDID must be only applied on string properties")
+		}
+
+		return ValidateControllerDID(casted, allowedNamespaces)
+	})
+}
+
+// IsSignerDIDUrl accepts verification method ids of DIDs of the ledger and of DIDs which DID Documents are derived in-process
+func IsSignerDIDUrl(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsSignerDIDUrl must be only applied on string properties")
+		}
+
+		return ValidateSignerDIDUrl(casted, allowedNamespaces)
+	})
+}
+
 func IsSpecificDIDUrl(allowedNamespaces []string, pathRule, queryRule, fragmentRule ValidationType) *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(string)
@@ -116,6 +140,17 @@ func IsMultibase() *CustomErrorRule {
 	})
 }
 
+func IsNextKeyCommitment() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsNextKeyCommitment must be only applied on string properties")
+		}
+
+		return utils.ValidateMultibaseSha256Multihash(casted)
+	})
+}
+
 func IsMultibaseEd25519VerificationKey2020() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(string)
@@ -181,6 +216,50 @@ func IsMultibaseMulticodecBls12381G2PubKey() *CustomErrorRule {
 	})
 }
 
+func IsSecp256k1VerificationMaterial() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsSecp256k1VerificationMaterial must be only applied on string properties")
+		}
+
+		return utils.ValidateSecp256k1VerificationMaterial(casted)
+	})
+}
+
+func IsMultikey() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsMultikey must be only applied on string properties")
+		}
+
+		return utils.ValidateMultikey(casted)
+	})
+}
+
+func IsBase58X25519KeyAgreementKey2019() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsBase58X25519KeyAgreementKey2019 must be only applied on string properties")
+		}
+
+		return utils.ValidateBase58X25519KeyAgreementKey2019(casted)
+	})
+}
+
+func IsMultibaseX25519KeyAgreementKey2020() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsMultibaseX25519KeyAgreementKey2020 must be only applied on string properties")
+		}
+
+		return utils.ValidateMultibaseX25519KeyAgreementKey2020(casted)
+	})
+}
+
 func IsJWK() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(string)
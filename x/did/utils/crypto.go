@@ -17,6 +17,11 @@ import (
 )
 
 func ValidateJWK(jwkString string) error {
+	if IsSecp256k1JWK(jwkString) {
+		_, err := ParseSecp256k1JWK(jwkString)
+		return err
+	}
+
 	key, err := jwk.ParseKey([]byte(jwkString))
 	if err != nil {
 		return fmt.Errorf("can't parse jwk: %s", err.Error())
@@ -45,6 +50,12 @@ func ValidateJWK(jwkString string) error {
 				return err
 			}
 
+		case jwa.X25519:
+			err := ValidateX25519PubKey(okpPubKey.X())
+			if err != nil {
+				return err
+			}
+
 		case bls12381g2.Bls12381G2:
 			bls12381G2PubKey := bls12381g2.PublicKey(okpPubKey.X())
 			err := ValidateBls12381G2PubKey(bls12381G2PubKey)
@@ -53,7 +64,7 @@ func ValidateJWK(jwkString string) error {
 			}
 
 		default:
-			return fmt.Errorf("unsupported jwk cryptographic curve: %s. supported curves are: Ed25519, Bls12381G2", okpPubKey.Crv())
+			return fmt.Errorf("unsupported jwk cryptographic curve: %s. supported curves are: Ed25519, X25519, Bls12381G2", okpPubKey.Crv())
 		}
 	default:
 		return fmt.Errorf("unsupported jwk key type: %s. supported key types are: RSA/pub, EC/pub, OKP/pub", key.KeyType())
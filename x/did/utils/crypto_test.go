@@ -69,6 +69,21 @@ var _ = Describe("Crypto", func() {
 			})
 		})
 
+		Context("Positive secp256k1", func() {
+			It("should return no error", func() {
+				err := ValidateJWK("{\"crv\":\"secp256k1\",\"kty\":\"EC\",\"x\":\"8N6tUNfjWXByrJzcyJR3AYJL5r939SnMMXKkPbeOpKo\",\"y\":\"P0K1UnXC8rt0--RaUroYgRDEPL22puEEb_Oj7_Y2d7M\"}")
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Context("Negative secp256k1: point is not on the curve", func() {
+			It("should return error", func() {
+				err := ValidateJWK("{\"crv\":\"secp256k1\",\"kty\":\"EC\",\"x\":\"8N6tUNfjWXByrJzcyJR3AYJL5r939SnMMXKkPbeOpKo\",\"y\":\"P0K1UnXC8rt0--RaUroYgRDEPL22puEEb_Oj7_Y2d7A\"}")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("secp256k1: invalid public key"))
+			})
+		})
+
 		Context("Positive rsa", func() {
 			It("should return no error", func() {
 				err := ValidateJWK("{\"e\":\"AQAB\",\"kty\":\"RSA\",\"n\":\"skKXRn44WN2DpXDwm4Ip25kIAGRA8y3iXlaoAhPmFiuSDkx97lXcJYrjxX0wSfehgCiSoZOBv6mFzgSVv0_pXQ6zI35xi2dsbexrc87m7Q24q2chpG33ttnVwQkoXrrm0zDzSX32EVxYQyTu9aWp-zxUdAWcrWUarT24RmgjU78v8JmUzkLmwbzsEImnIZ8Hce2ruisAmuAQBVVA4bWwQm_x1KPoQW-TP5_UR3gGugvf0XrQfMJaVpcxcJ9tduMUw6ffZOsqgbvAiZYnrezxSIjnd5lFTFBIEYdGR6ZgjYZoWvQB7U72o_TJoka-zfSODOUbxNBvxvFhA3uhoo3ZKw\"}")
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// CanonicalizeJSONObject returns the canonical form of the JSON object: keys are sorted,
+// insignificant whitespace is removed and numbers are kept as they are written.
+func CanonicalizeJSONObject(data string) (string, error) {
+	var object map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&object)
+	if err != nil {
+		return "", err
+	}
+
+	if object == nil {
+		return "", errors.New("must be a JSON object")
+	}
+
+	if decoder.More() {
+		return "", errors.New("unexpected data after the JSON object")
+	}
+
+	return EncodeCanonicalJSON(object)
+}
+
+// EncodeCanonicalJSON serializes the value with sorted object keys and without HTML escaping
+func EncodeCanonicalJSON(value interface{}) (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSuffix(buffer.Bytes(), []byte("\n"))), nil
+}
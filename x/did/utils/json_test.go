@@ -0,0 +1,30 @@
+package utils_test
+
+import (
+	. "github.com/canow-co/cheqd-node/x/did/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSON utils functionality", func() {
+	DescribeTable("CanonicalizeJSONObject function",
+
+		func(data string, expected string, isValid bool) {
+			actual, err := CanonicalizeJSONObject(data)
+			if isValid {
+				Expect(err).To(BeNil())
+				Expect(actual).To(Equal(expected))
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("Keys are sorted", `{"b": 1, "a": {"d": [], "c": null}}`, `{"a":{"c":null,"d":[]},"b":1}`, true),
+		Entry("Numbers are kept as written", `{"a": 1.50, "b": 12345678901234567890}`, `{"a":1.50,"b":12345678901234567890}`, true),
+		Entry("HTML characters are not escaped", `{"uri": "https://example.com/?a=1&b=<2>"}`, `{"uri":"https://example.com/?a=1&b=<2>"}`, true),
+		Entry("Array is not an object", `["a"]`, "", false),
+		Entry("Null is not an object", `null`, "", false),
+		Entry("Trailing data", `{"a": 1} {"b": 2}`, "", false),
+		Entry("Invalid JSON", `{"a": }`, "", false),
+	)
+})
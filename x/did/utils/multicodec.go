@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Multicodec codes of public keys.
+// Documentation: https://github.com/multiformats/multicodec/blob/master/table.csv
+const (
+	Ed25519PubCode   uint64 = 0xed
+	X25519PubCode    uint64 = 0xec
+	Secp256k1PubCode uint64 = 0xe7
+	P256PubCode      uint64 = 0x1200
+	P384PubCode      uint64 = 0x1201
+	P521PubCode      uint64 = 0x1202
+	RSAPubCode       uint64 = 0x1205
+)
+
+// AddMulticodecPrefix prepends the varint encoded multicodec code to the key bytes
+func AddMulticodecPrefix(code uint64, keyBytes []byte) []byte {
+	prefix := make([]byte, binary.MaxVarintLen64)
+	prefixLength := binary.PutUvarint(prefix, code)
+
+	return append(prefix[:prefixLength], keyBytes...)
+}
+
+// SplitMulticodecPrefix returns the multicodec code and the key bytes following it
+func SplitMulticodecPrefix(multicodec []byte) (uint64, []byte, error) {
+	code, prefixLength := binary.Uvarint(multicodec)
+	if prefixLength <= 0 {
+		return 0, nil, errors.New("invalid multicodec value")
+	}
+
+	return code, multicodec[prefixLength:], nil
+}
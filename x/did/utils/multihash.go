@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/multiformats/go-multibase"
+)
+
+// Multihash codes of hash functions.
+// Documentation: https://github.com/multiformats/multicodec/blob/master/table.csv
+const (
+	Sha256MultihashCode uint64 = 0x12
+)
+
+// Sha256Multihash returns sha2-256 multihash of the data: multihash code and digest length prefixed digest
+func Sha256Multihash(data []byte) []byte {
+	digest := sha256.Sum256(data)
+	prefix := AddMulticodecPrefix(Sha256MultihashCode, AddMulticodecPrefix(sha256.Size, nil))
+
+	return append(prefix, digest[:]...)
+}
+
+// ValidateMultibaseSha256Multihash checks that the data is multibase (base58btc) encoded sha2-256 multihash
+func ValidateMultibaseSha256Multihash(data string) error {
+	encoding, multihash, err := multibase.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	if encoding != multibase.Base58BTC {
+		return errors.New("only base58btc multibase encoding is supported")
+	}
+
+	expectedPrefix := Sha256Multihash(nil)[:2]
+	if !bytes.HasPrefix(multihash, expectedPrefix) {
+		return errors.New("not a sha2-256 multihash")
+	}
+
+	if len(multihash) != len(expectedPrefix)+sha256.Size {
+		return fmt.Errorf("sha2-256 multihash: bad digest length: %d", len(multihash)-len(expectedPrefix))
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"fmt"
+
+	"github.com/canow-co/cheqd-node/x/did/utils/bls12381g2"
+	"github.com/multiformats/go-multibase"
+)
+
+const compressedSecp256k1PubKeySize = 33
+
+// ParseMultikey decodes publicKeyMultibase of Multikey verification method:
+// base58btc encoded multicodec prefixed public key. EC keys must be compressed.
+// Documentation: https://www.w3.org/TR/controller-document/#multikey
+func ParseMultikey(data string) (uint64, []byte, error) {
+	encoding, multicodec, err := multibase.Decode(data)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if encoding != multibase.Base58BTC {
+		return 0, nil, fmt.Errorf("invalid encoding for Multikey. expected: %s actual: %s",
+			multibase.EncodingToStr[multibase.Base58BTC], multibase.EncodingToStr[encoding])
+	}
+
+	code, keyBytes, err := SplitMulticodecPrefix(multicodec)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	switch code {
+	case Ed25519PubCode:
+		err = ValidateEd25519PubKey(keyBytes)
+	case X25519PubCode:
+		err = ValidateX25519PubKey(keyBytes)
+	case Secp256k1PubCode:
+		if len(keyBytes) != compressedSecp256k1PubKeySize {
+			return 0, nil, fmt.Errorf("secp256k1: bad compressed public key length: %d", len(keyBytes))
+		}
+		_, err = ParseSecp256k1PubKey(keyBytes)
+	case P256PubCode, P384PubCode:
+		_, err = unmarshalCompressedECDSAPubKey(code, keyBytes)
+	case bls12381g2.Bls12381G2PubCode:
+		err = ValidateBls12381G2PubKey(keyBytes)
+	default:
+		return 0, nil, fmt.Errorf("unsupported Multikey multicodec: 0x%x", code)
+	}
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return code, keyBytes, nil
+}
+
+// IsX25519Multikey checks whether publicKeyMultibase of Multikey verification method is a X25519 key
+func IsX25519Multikey(data string) bool {
+	code, _, err := ParseMultikey(data)
+	return err == nil && code == X25519PubCode
+}
+
+func ValidateMultikey(data string) error {
+	_, _, err := ParseMultikey(data)
+	return err
+}
+
+// VerifyMultikeySignature verifies the signature with the public key of Multikey verification method.
+// The signature format is the same as for other representations of the key type:
+// ed25519 and BBS+ signatures as is, ES256K for secp256k1, ASN1 encoded ECDSA with SHA256 digest for NIST curves.
+func VerifyMultikeySignature(data string, message []byte, signature []byte) error {
+	code, keyBytes, err := ParseMultikey(data)
+	if err != nil {
+		return err
+	}
+
+	switch code {
+	case Ed25519PubCode:
+		return VerifyED25519Signature(keyBytes, message, signature)
+	case X25519PubCode:
+		return ErrKeyAgreementOnlyKey
+	case Secp256k1PubCode:
+		pubKey, err := ParseSecp256k1PubKey(keyBytes)
+		if err != nil {
+			return err
+		}
+		return VerifySecp256k1Signature(pubKey, message, signature)
+	case P256PubCode, P384PubCode:
+		pubKey, err := unmarshalCompressedECDSAPubKey(code, keyBytes)
+		if err != nil {
+			return err
+		}
+		return VerifyECDSASignature(*pubKey, message, signature)
+	case bls12381g2.Bls12381G2PubCode:
+		return VerifyBLS12381G2Signature(keyBytes, message, signature)
+	default:
+		return fmt.Errorf("unsupported Multikey multicodec: 0x%x", code)
+	}
+}
+
+func unmarshalCompressedECDSAPubKey(code uint64, keyBytes []byte) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch code {
+	case P256PubCode:
+		curve = elliptic.P256()
+	case P384PubCode:
+		curve = elliptic.P384()
+	default:
+		return nil, fmt.Errorf("unsupported ecdsa multicodec: 0x%x", code)
+	}
+
+	x, y := elliptic.UnmarshalCompressed(curve, keyBytes)
+	if x == nil {
+		return nil, fmt.Errorf("%s: invalid compressed public key", curve.Params().Name)
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"crypto"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/multiformats/go-multibase"
+)
+
+const (
+	// Secp256k1JWKCurve is the JWK "crv" value of secp256k1 keys (RFC 8812)
+	Secp256k1JWKCurve = "secp256k1"
+
+	// Secp256k1SignatureSize is the size of ES256K signatures: 32 bytes of R followed by 32 bytes of S (RFC 8812)
+	Secp256k1SignatureSize = 64
+
+	secp256k1CoordinateSize = 32
+)
+
+// ecJWK holds the members of EC and OKP jwks needed to detect the curve without jwx
+type ecJWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// IsJSONObject checks whether the verification material is a JSON object, i.e. a JWK rather than a multibase string
+func IsJSONObject(data string) bool {
+	return strings.HasPrefix(strings.TrimSpace(data), "{")
+}
+
+// IsSecp256k1JWK checks whether the jwk is an EC key on the secp256k1 curve.
+// Such keys are parsed by ParseSecp256k1JWK because jwx supports the curve only when built with jwx_es256k tag.
+func IsSecp256k1JWK(jwkString string) bool {
+	var key ecJWK
+	err := json.Unmarshal([]byte(jwkString), &key)
+	if err != nil {
+		return false
+	}
+
+	return key.Kty == "EC" && key.Crv == Secp256k1JWKCurve
+}
+
+// ParseSecp256k1JWK parses a jwk with kty="EC" and crv="secp256k1" and checks that the point is on the curve
+func ParseSecp256k1JWK(jwkString string) (*secp256k1.PublicKey, error) {
+	var key ecJWK
+	err := json.Unmarshal([]byte(jwkString), &key)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse jwk: %s", err.Error())
+	}
+
+	if key.Kty != "EC" || key.Crv != Secp256k1JWKCurve {
+		return nil, fmt.Errorf("not a secp256k1 jwk: kty=%s, crv=%s", key.Kty, key.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil {
+		return nil, fmt.Errorf("secp256k1: can't decode x coordinate: %s", err.Error())
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(key.Y)
+	if err != nil {
+		return nil, fmt.Errorf("secp256k1: can't decode y coordinate: %s", err.Error())
+	}
+
+	if len(x) != secp256k1CoordinateSize || len(y) != secp256k1CoordinateSize {
+		return nil, fmt.Errorf("secp256k1: bad coordinate length: x=%d, y=%d", len(x), len(y))
+	}
+
+	uncompressed := append([]byte{0x04}, x...)
+	uncompressed = append(uncompressed, y...)
+
+	return ParseSecp256k1PubKey(uncompressed)
+}
+
+// ParseSecp256k1PubKey parses compressed (33 bytes) or uncompressed (65 bytes) secp256k1 public key
+func ParseSecp256k1PubKey(keyBytes []byte) (*secp256k1.PublicKey, error) {
+	pubKey, err := secp256k1.ParsePubKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("secp256k1: %s", err.Error())
+	}
+
+	return pubKey, nil
+}
+
+// ParseMultibaseSecp256k1PubKey parses multibase encoded secp256k1-pub multicodec (0xe7) prefixed public key
+func ParseMultibaseSecp256k1PubKey(data string) (*secp256k1.PublicKey, error) {
+	_, multicodec, err := multibase.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	code, codePrefixLength := binary.Uvarint(multicodec)
+	if codePrefixLength <= 0 {
+		return nil, errors.New("invalid multicodec value")
+	}
+	if code != Secp256k1PubCode {
+		return nil, errors.New("not a secp256k1 public key")
+	}
+
+	return ParseSecp256k1PubKey(multicodec[codePrefixLength:])
+}
+
+// ParseSecp256k1VerificationMaterial parses secp256k1 public key represented either as a JWK or as a multibase string
+func ParseSecp256k1VerificationMaterial(data string) (*secp256k1.PublicKey, error) {
+	if IsJSONObject(data) {
+		return ParseSecp256k1JWK(data)
+	}
+
+	return ParseMultibaseSecp256k1PubKey(data)
+}
+
+func ValidateSecp256k1VerificationMaterial(data string) error {
+	_, err := ParseSecp256k1VerificationMaterial(data)
+	return err
+}
+
+// VerifySecp256k1Signature verifies ES256K signature: SHA256 message digest, R || S signature encoding.
+// Signatures with high S are rejected to prevent malleability, the same as for Cosmos SDK account keys.
+func VerifySecp256k1Signature(pubKey *secp256k1.PublicKey, message []byte, signature []byte) error {
+	if len(signature) != Secp256k1SignatureSize {
+		return fmt.Errorf("secp256k1: bad signature length: %d", len(signature))
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow || r.IsZero() {
+		return errors.New("secp256k1: invalid signature R value")
+	}
+	if overflow := s.SetByteSlice(signature[32:]); overflow || s.IsZero() {
+		return errors.New("secp256k1: invalid signature S value")
+	}
+	if s.IsOverHalfOrder() {
+		return errors.New("secp256k1: signature S value is not in lower half of the order")
+	}
+
+	hasher := crypto.SHA256.New()
+	hasher.Write(message)
+	digest := hasher.Sum(nil)
+
+	if !ecdsa.NewSignature(&r, &s).Verify(digest, pubKey) {
+		return errors.New("invalid secp256k1 signature")
+	}
+
+	return nil
+}
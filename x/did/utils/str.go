@@ -63,6 +63,23 @@ func Unique(array []string) []string {
 	return result
 }
 
+// UniqueOrdered returns a copy of the passed array with duplicates removed, keeping the first occurrences in order
+func UniqueOrdered(array []string) []string {
+	m := map[string]bool{}
+	result := make([]string, 0, len(array))
+
+	for _, v := range array {
+		if m[v] {
+			continue
+		}
+
+		m[v] = true
+		result = append(result, v)
+	}
+
+	return result
+}
+
 func IsUnique(list []string) bool {
 	set := map[string]bool{}
 
@@ -73,6 +73,16 @@ var _ = Describe("Str utils functionality", func() {
 		Entry("General case. Expeceted array with unique elements", []string{"4", "1", "6", "2", "3", "1", "3", "1"}, []string{"1", "2", "3", "4", "6"}),
 	)
 
+	DescribeTable("UniqueOrdered function",
+
+		func(array []string, expected []string) {
+			Expect(UniqueOrdered(array)).To(Equal(expected))
+		},
+		Entry("nil as array. Expected empty list", nil, []string{}),
+		Entry("Unique array with length 3. Expected the same array", []string{"1", "3", "2"}, []string{"1", "3", "2"}),
+		Entry("General case. Expected first occurrences in order", []string{"4", "1", "6", "2", "3", "1", "3", "1"}, []string{"4", "1", "6", "2", "3"}),
+	)
+
 	DescribeTable("ReplaceInList function",
 
 		func(list []string, oldVal string, newVal string, expected []string) {
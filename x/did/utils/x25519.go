@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+	"github.com/multiformats/go-multibase"
+	"golang.org/x/crypto/curve25519"
+)
+
+// X25519JWKCurve is the JWK "crv" value of X25519 keys (RFC 8037)
+const X25519JWKCurve = "X25519"
+
+// ErrKeyAgreementOnlyKey is returned on an attempt to verify a signature with a key which can be used for key agreement only
+var ErrKeyAgreementOnlyKey = errors.New("key agreement keys can't be used to verify signatures")
+
+// Any scalar works here: multiplication of a low order point by a clamped scalar always gives all-zero output
+var x25519CheckScalar = bytes.Repeat([]byte{0x01}, curve25519.ScalarSize)
+
+// ValidateX25519PubKey checks the length of the key and rejects low order points
+func ValidateX25519PubKey(keyBytes []byte) error {
+	if l := len(keyBytes); l != curve25519.PointSize {
+		return fmt.Errorf("x25519: bad public key length: %d", l)
+	}
+
+	_, err := curve25519.X25519(x25519CheckScalar, keyBytes)
+	if err != nil {
+		return errors.New("x25519: low order public key")
+	}
+
+	return nil
+}
+
+func ValidateBase58X25519KeyAgreementKey2019(data string) error {
+	keyBytes, err := base58.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	return ValidateX25519PubKey(keyBytes)
+}
+
+func ValidateMultibaseX25519KeyAgreementKey2020(data string) error {
+	encoding, multicodec, err := multibase.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	if encoding != multibase.Base58BTC {
+		return fmt.Errorf("invalid encoding for X25519KeyAgreementKey2020. expected: %s actual: %s",
+			multibase.EncodingToStr[multibase.Base58BTC], multibase.EncodingToStr[encoding])
+	}
+
+	code, keyBytes, err := SplitMulticodecPrefix(multicodec)
+	if err != nil {
+		return err
+	}
+	if code != X25519PubCode {
+		return errors.New("not a X25519 public key")
+	}
+
+	return ValidateX25519PubKey(keyBytes)
+}
+
+// IsX25519JWK checks whether the jwk is an OKP key on the X25519 curve
+func IsX25519JWK(jwkString string) bool {
+	var key ecJWK
+	err := json.Unmarshal([]byte(jwkString), &key)
+	if err != nil {
+		return false
+	}
+
+	return key.Kty == "OKP" && key.Crv == X25519JWKCurve
+}
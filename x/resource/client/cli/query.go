@@ -21,7 +21,10 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdGetResource(),
 		CmdGetResourceMetadata(),
-		CmdGetCollectionResources())
+		CmdGetCollectionResources(),
+		CmdGetLatestResource(),
+		CmdGetResourceVersions(),
+		CmdDereference())
 
 	return cmd
 }
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const FlagAccept = "accept"
+
+func CmdDereference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dereference [did-url]",
+		Short: "Dereference a DID URL",
+		Long: `Dereference a DID URL according to the W3C DID Resolution specification.
+
+		Supported DID URLs:
+		- DID Document fragments: did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j#key-1
+		- Service endpoints: did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j?service=agent&relativeRef=/path
+		- Resources by ID: did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j/resources/6e8bc430-9c3a-11d9-9669-0800200c9a66
+		- Resources by name and type: did:canow:testnet:wGHEXrZvJxR8vw5P3UWH1j?resourceName=Schema&resourceType=JsonSchema&versionTime=2023-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			accept, err := cmd.Flags().GetString(FlagAccept)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDereferenceRequest{
+				DidUrl: args[0],
+				Accept: accept,
+			}
+
+			resp, err := queryClient.Dereference(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagAccept, "", "Requested media type of the dereferenced content")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetLatestResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-resource [collection-id] [name] [resource-type]",
+		Short: "Query the latest version of a resource",
+		Long: `Query the latest version of a resource by Collection ID, Resource Name and Resource Type.
+
+		Collection ID is the UNIQUE IDENTIFIER part of the DID the resource is linked to.
+		Example: c82f2b02-bdab-4dd7-b833-3e143745d612, wGHEXrZvJxR8vw5P3UWH1j, etc.
+
+		Resource Name and Resource Type are shared by all versions of the resource.
+		Example: PassportSchema AnonCredsSchema, EducationTrustRegistry StatusList2021, etc.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryLatestResourceRequest{
+				CollectionId: args[0],
+				Name:         args[1],
+				ResourceType: args[2],
+			}
+
+			resp, err := queryClient.LatestResource(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
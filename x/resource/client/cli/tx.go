@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
+	didcli "github.com/canow-co/cheqd-node/x/did/client/cli"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
 	"github.com/canow-co/cheqd-node/x/resource/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,6 +14,8 @@ import (
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 )
 
+const FlagCollectionVersionID = "collection-version-id"
+
 // AddTxFlagsToCmd adds common flags to a module tx command.
 func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json)")
@@ -51,7 +57,66 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdCreateResource())
+	cmd.AddCommand(CmdCreateResource(),
+		CmdBeginResourceUpload(),
+		CmdAppendResourceChunk(),
+		CmdFinalizeResource(),
+	)
 
 	return cmd
 }
+
+// GetCollectionDid returns the DID of the resource collection.
+// The payload contains just the collection id, so the namespace is taken from the sign inputs of the DID subject.
+func GetCollectionDid(collectionID string, signInputs []didcli.SignInput) (string, error) {
+	if len(signInputs) == 0 {
+		return "", errors.New("sign inputs are required")
+	}
+
+	did, _, _, _, err := didutils.TrySplitDIDUrl(signInputs[0].VerificationMethodID)
+	if err != nil {
+		return "", err
+	}
+
+	_, namespace, _, err := didutils.TrySplitDID(did)
+	if err != nil {
+		return "", err
+	}
+
+	return didutils.JoinDID(didtypes.DidMethod, namespace, collectionID), nil
+}
+
+// GetCollectionVersionID returns the latest version of the collection DID Document: the value of the collection-version-id flag
+// or the version queried from the ledger.
+func GetCollectionVersionID(cmd *cobra.Command, clientCtx client.Context, did string) (string, error) {
+	versionID, err := cmd.Flags().GetString(FlagCollectionVersionID)
+	if err != nil {
+		return "", err
+	}
+
+	if versionID != "" || clientCtx.Offline {
+		return versionID, nil
+	}
+
+	return didcli.QueryLatestVersionID(cmd.Context(), clientCtx, did)
+}
+
+// SignCollectionPayload signs the payload for the latest version of the collection DID Document with the sign inputs
+func SignCollectionPayload(cmd *cobra.Command, clientCtx client.Context, collectionID string, payloadSignBytes []byte, signInputs []didcli.SignInput) ([]*didtypes.SignInfo, error) {
+	did, err := GetCollectionDid(collectionID, signInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionVersionID, err := GetCollectionVersionID(cmd, clientCtx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := didcli.GetEnvelopeSignBytes(clientCtx, did, collectionVersionID, payloadSignBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return didcli.SignWithSignInputs(signBytes, signInputs), nil
+}
@@ -93,9 +93,11 @@ Example payload file:
 				payload.Id = uuid.NewString()
 			}
 
-			// Build identity message
-			signBytes := payload.GetSignBytes()
-			identitySignatures := didcli.SignWithSignInputs(signBytes, signInputs)
+			// Sign the payload for the latest version of the collection DID Document
+			identitySignatures, err := SignCollectionPayload(cmd, clientCtx, payload.CollectionId, payload.GetSignBytes(), signInputs)
+			if err != nil {
+				return err
+			}
 
 			msg := types.MsgCreateResource{
 				Payload:    &payload,
@@ -116,6 +118,7 @@ Example payload file:
 	AddTxFlagsToCmd(cmd)
 
 	// add custom / override flags
+	cmd.Flags().String(FlagCollectionVersionID, "", "Latest version ID of the collection DID Document. Required to sign the payload in offline mode")
 	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultCreateResourceImageFee)).String(), "Fixed fee for Resource creation, e.g., 10000000000"+types.BaseMinimalDenom+". Please check what the current fees by running 'cheqd-noded query params subspace resource feeparams'")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFees)
@@ -0,0 +1,270 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+
+	didcli "github.com/canow-co/cheqd-node/x/did/client/cli"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+const FlagChunkSize = "chunk-size"
+
+func CmdBeginResourceUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-upload [payload-file] [resource-data-file]",
+		Short: "Start a chunked upload of a Resource.",
+		Long: `Start a chunked upload of a Resource which data exceeds the size limit of 'create'.
+[payload-file] is JSON encoded MsgBeginResourceUploadPayload alongside with sign inputs.
+[resource-data-file] is a path to the Resource data file. Its size and SHA-256 checksum are declared in the payload.
+
+The upload is staged until it is finalized with 'finalize-upload' or expires. Chunks are staged with 'append-chunk'.
+Resources up to 200KB can't be uploaded in chunks, they are created with 'create'.
+Payload file has the same format as for 'create'.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgBeginResourceUploadPayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			// Declare size and checksum of the data file
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			checksum := sha256.Sum256(data)
+			payload.TotalSize = uint64(len(data))
+			payload.Checksum = hex.EncodeToString(checksum[:])
+
+			// Populate resource id if not set
+			if payload.Id == "" {
+				payload.Id = uuid.NewString()
+			}
+
+			identitySignatures, err := SignCollectionPayload(cmd, clientCtx, payload.CollectionId, payload.GetSignBytes(), signInputs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgBeginResourceUpload{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(FlagCollectionVersionID, "", "Latest version ID of the collection DID Document. Required to sign the payload in offline mode")
+
+	return cmd
+}
+
+func CmdAppendResourceChunk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "append-chunk [payload-file] [resource-data-file] [chunk-index]",
+		Short: "Stage a chunk of a Resource upload.",
+		Long: `Stage the chunk of the Resource data file with the given zero-based index.
+[payload-file] is JSON encoded MsgAppendResourceChunkPayload alongside with sign inputs.
+[resource-data-file] is a path to the Resource data file declared in 'begin-upload'.
+[chunk-index] is the index of the chunk. Chunks must be staged in order.
+
+NOTES:
+1. Fee used for the transaction will ALWAYS take the fixed fee per chunk, REGARDLESS of what value is passed in '--fees' flag.
+2. Chunk size must not exceed the limit of the upload params. Please check it by running 'cheqd-noded query params subspace resource uploadparams'.
+
+Example payload file:
+{
+    "payload": {
+        "collectionId": "<did-unique-identifier>",
+        "id": "<uuid>"
+    },
+    "signInputs": [
+        {
+            "verificationMethodId": "did:canow:<namespace>:<unique-identifier>#<key-id>",
+            "privKey": "<private-key-bytes-encoded-to-base64>"
+        }
+    ]
+}
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgAppendResourceChunkPayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			chunkIndex, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chunkSize, err := cmd.Flags().GetUint64(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+
+			// Cut the chunk out of the data file
+			if chunkSize == 0 || chunkIndex >= (uint64(len(data))+chunkSize-1)/chunkSize {
+				return fmt.Errorf("chunk %d is out of the data file", chunkIndex)
+			}
+
+			start := chunkIndex * chunkSize
+			end := start + chunkSize
+			if end > uint64(len(data)) {
+				end = uint64(len(data))
+			}
+
+			payload.ChunkIndex = chunkIndex
+			payload.Data = data[start:end]
+
+			identitySignatures, err := SignCollectionPayload(cmd, clientCtx, payload.CollectionId, payload.GetSignBytes(), signInputs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAppendResourceChunk{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(FlagCollectionVersionID, "", "Latest version ID of the collection DID Document. Required to sign the payload in offline mode")
+	cmd.Flags().Uint64(FlagChunkSize, types.DefaultUploadMaxChunkSize, "Size of the chunks the Resource data file is split into in bytes")
+	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultUploadChunkFee)).String(), "Fixed fee per chunk, e.g., 2500000000"+types.BaseMinimalDenom+". Please check what the current fees by running 'cheqd-noded query params subspace resource uploadparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
+
+func CmdFinalizeResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-upload [payload-file]",
+		Short: "Finalize a chunked upload of a Resource.",
+		Long: `Verify the staged chunks against the size and SHA-256 checksum declared in 'begin-upload' and publish the Resource.
+[payload-file] is JSON encoded MsgFinalizeResourcePayload alongside with sign inputs.
+Payload file has the same format as for 'append-chunk'.
+
+NOTES:
+1. Fee used for the transaction will ALWAYS take the fixed fee for Resource creation, REGARDLESS of what value is passed in '--fees' flag.
+2. Fixed fees for Resource creation are the same as for 'create': they are defined based on the IANA media type of the staged Resource data. Fees per chunk are charged on top of them.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJSON, signInputs, err := didcli.ReadPayloadWithSignInputsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgFinalizeResourcePayload
+			err = clientCtx.Codec.UnmarshalJSON(payloadJSON, &payload)
+			if err != nil {
+				return err
+			}
+
+			identitySignatures, err := SignCollectionPayload(cmd, clientCtx, payload.CollectionId, payload.GetSignBytes(), signInputs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizeResource{
+				Payload:    &payload,
+				Signatures: identitySignatures,
+			}
+
+			// Set fee-payer if not set
+			err = didcli.SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	// add standard tx flags
+	AddTxFlagsToCmd(cmd)
+
+	// add custom / override flags
+	cmd.Flags().String(FlagCollectionVersionID, "", "Latest version ID of the collection DID Document. Required to sign the payload in offline mode")
+	cmd.Flags().String(flags.FlagFees, sdk.NewCoin(types.BaseMinimalDenom, sdk.NewInt(types.DefaultCreateResourceImageFee)).String(), "Fixed fee for Resource creation, e.g., 10000000000"+types.BaseMinimalDenom+". Please check what the current fees by running 'cheqd-noded query params subspace resource feeparams'")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFees)
+	_ = cmd.MarkFlagRequired(flags.FlagGas)
+	_ = cmd.MarkFlagRequired(flags.FlagGasAdjustment)
+
+	return cmd
+}
@@ -17,8 +17,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 		}
 	}
 
+	// Build the latest version index from the version links
+	k.IndexAllLatestResourceVersions(&ctx)
+
 	// set fee params
 	k.SetParams(ctx, *genState.FeeParams)
+
+	// set upload params. Genesis files created before they were introduced use the defaults
+	uploadParams := genState.UploadParams
+	if uploadParams == nil {
+		uploadParams = types.DefaultUploadParams()
+	}
+	k.SetUploadParams(ctx, *uploadParams)
 }
 
 // ExportGenesis returns the cheqd module's exported genesis.
@@ -31,9 +41,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	// get fee params
 	feeParams := k.GetParams(ctx)
+	uploadParams := k.GetUploadParams(ctx)
 
 	return &types.GenesisState{
-		Resources: resourceList,
-		FeeParams: &feeParams,
+		Resources:    resourceList,
+		FeeParams:    &feeParams,
+		UploadParams: &uploadParams,
 	}
 }
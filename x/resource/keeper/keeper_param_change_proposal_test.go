@@ -158,4 +158,48 @@ var _ = DescribeTable("Proposal Handler", func(testcase TestCaseKeeperProposal)
 			true,
 			"",
 		}),
+	Entry("upload params",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: resourcetypes.ModuleName,
+				Key:      string(resourcetypes.ParamStoreKeyUploadParams),
+				Value:    `{"chunk": {"denom": "zarx", "amount": "1000000000"}, "max_chunk_size": "102400", "max_resource_size": "1048576", "expiry_blocks": "100"}`,
+			}),
+			func(handlerSuite *HandlerTestSuite) {
+				expectedUploadParams := resourcetypes.UploadParams{
+					Chunk:           sdk.Coin{Denom: resourcetypes.BaseMinimalDenom, Amount: sdk.NewInt(1000000000)},
+					MaxChunkSize:    102400,
+					MaxResourceSize: 1048576,
+					ExpiryBlocks:    100,
+				}
+
+				uploadParams := handlerSuite.app.ResourceKeeper.GetUploadParams(handlerSuite.ctx)
+
+				Expect(expectedUploadParams).To(Equal(uploadParams))
+			},
+			false,
+			"",
+		}),
+	Entry("invalid upload params: max resource size less than max chunk size",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: resourcetypes.ModuleName,
+				Key:      string(resourcetypes.ParamStoreKeyUploadParams),
+				Value:    `{"chunk": {"denom": "zarx", "amount": "1000000000"}, "max_chunk_size": "102400", "max_resource_size": "1024", "expiry_blocks": "100"}`,
+			}),
+			func(*HandlerTestSuite) {},
+			true,
+			"",
+		}),
+	Entry("invalid upload params: expiry blocks 0",
+		TestCaseKeeperProposal{
+			testProposal(proposal.ParamChange{
+				Subspace: resourcetypes.ModuleName,
+				Key:      string(resourcetypes.ParamStoreKeyUploadParams),
+				Value:    `{"chunk": {"denom": "zarx", "amount": "1000000000"}, "max_chunk_size": "102400", "max_resource_size": "1048576", "expiry_blocks": "0"}`,
+			}),
+			func(*HandlerTestSuite) {},
+			true,
+			"",
+		}),
 )
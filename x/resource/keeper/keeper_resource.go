@@ -57,7 +57,12 @@ func (k Keeper) AddNewResourceVersion(ctx *sdk.Context, resource *types.Resource
 
 	// Set new version
 	err := k.SetResource(ctx, resource)
-	return err
+	if err != nil {
+		return err
+	}
+
+	k.SetLatestResourceVersion(ctx, resource.Metadata)
+	return nil
 }
 
 // SetResource create or update a specific resource in the store
@@ -144,21 +149,19 @@ func (k Keeper) GetResourceCollection(ctx *sdk.Context, collectionID string) []*
 	return resources
 }
 
+// GetLastResourceVersionMetadata returns the metadata of the latest version of the resource with the name and type
 func (k Keeper) GetLastResourceVersionMetadata(ctx *sdk.Context, collectionID, name, resourceType string) (types.Metadata, bool) {
-	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetResourceMetadataCollectionPrefix(collectionID))
-
-	defer closeIteratorOrPanic(iterator)
-
-	for ; iterator.Valid(); iterator.Next() {
-		var metadata types.Metadata
-		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+	id, found := k.GetLatestResourceVersionID(ctx, collectionID, name, resourceType)
+	if !found {
+		return types.Metadata{}, false
+	}
 
-		if metadata.Name == name && metadata.ResourceType == resourceType && metadata.NextVersionId == "" {
-			return metadata, true
-		}
+	metadata, err := k.GetResourceMetadata(ctx, collectionID, id)
+	if err != nil {
+		return types.Metadata{}, false
 	}
 
-	return types.Metadata{}, false
+	return metadata, true
 }
 
 // UpdateResourceMetadata update the metadata of a resource. Returns an error if the resource doesn't exist
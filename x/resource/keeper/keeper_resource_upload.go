@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	"github.com/canow-co/cheqd-node/x/resource/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetResourceUpload create or update a staged resource upload. The expiry index refers to the upload key
+func (k Keeper) SetResourceUpload(ctx *sdk.Context, upload *types.ResourceUpload) {
+	store := ctx.KVStore(k.storeKey)
+
+	uploadBytes := k.cdc.MustMarshal(upload)
+	uploadKey := types.GetResourceUploadKey(upload.CollectionId, upload.Id)
+	store.Set(uploadKey, uploadBytes)
+	store.Set(types.GetResourceUploadExpiryKey(upload.ExpiryHeight, upload.CollectionId, upload.Id), uploadKey)
+}
+
+// GetResourceUpload returns a staged resource upload from its id
+func (k Keeper) GetResourceUpload(ctx *sdk.Context, collectionID string, id string) (types.ResourceUpload, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	uploadBytes := store.Get(types.GetResourceUploadKey(collectionID, id))
+	if uploadBytes == nil {
+		return types.ResourceUpload{}, types.ErrResourceUploadNotFound.Wrap(collectionID + ":" + id)
+	}
+
+	var upload types.ResourceUpload
+	if err := k.cdc.Unmarshal(uploadBytes, &upload); err != nil {
+		return types.ResourceUpload{}, sdkerrors.ErrInvalidType.Wrap(err.Error())
+	}
+
+	return upload, nil
+}
+
+// HasResourceUpload checks if the staged resource upload exists in the store
+func (k Keeper) HasResourceUpload(ctx *sdk.Context, collectionID string, id string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetResourceUploadKey(collectionID, id))
+}
+
+// AppendResourceUploadChunk stages the next chunk of the resource upload and updates the upload progress.
+// The media type is detected once the last chunk is staged, a part of the data isn't enough to detect e.g. JSON.
+func (k Keeper) AppendResourceUploadChunk(ctx *sdk.Context, upload *types.ResourceUpload, data []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetResourceUploadChunkKey(upload.CollectionId, upload.Id, upload.ChunkCount), data)
+
+	upload.ChunkCount++
+	upload.ReceivedSize += uint64(len(data))
+
+	if upload.ReceivedSize == upload.TotalSize {
+		upload.MediaType = utils.DetectMediaType(k.GetResourceUploadData(ctx, upload))
+	}
+
+	k.SetResourceUpload(ctx, upload)
+}
+
+// GetResourceUploadData returns the staged chunks of the resource upload concatenated in order
+func (k Keeper) GetResourceUploadData(ctx *sdk.Context, upload *types.ResourceUpload) []byte {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetResourceUploadChunkPrefix(upload.CollectionId, upload.Id))
+	defer closeIteratorOrPanic(iterator)
+
+	data := make([]byte, 0, upload.ReceivedSize)
+	for ; iterator.Valid(); iterator.Next() {
+		data = append(data, iterator.Value()...)
+	}
+
+	return data
+}
+
+// GetUploadedResourceMediaType returns the media type of the resource uploaded in chunks: the one of the published resource
+// after the upload is finalized, the one stored in the upload with the last chunk before that. Empty if neither exists.
+// The staged data isn't read, so the fee of the finalization is cheap to compute.
+func (k Keeper) GetUploadedResourceMediaType(ctx sdk.Context, collectionID string, id string) string {
+	if metadata, err := k.GetResourceMetadata(&ctx, collectionID, id); err == nil {
+		return metadata.MediaType
+	}
+
+	upload, err := k.GetResourceUpload(&ctx, collectionID, id)
+	if err != nil {
+		return ""
+	}
+
+	return upload.MediaType
+}
+
+// DeleteResourceUpload removes the staged resource upload with all its chunks
+func (k Keeper) DeleteResourceUpload(ctx *sdk.Context, upload *types.ResourceUpload) {
+	store := ctx.KVStore(k.storeKey)
+
+	for index := uint64(0); index < upload.ChunkCount; index++ {
+		store.Delete(types.GetResourceUploadChunkKey(upload.CollectionId, upload.Id, index))
+	}
+
+	store.Delete(types.GetResourceUploadExpiryKey(upload.ExpiryHeight, upload.CollectionId, upload.Id))
+	store.Delete(types.GetResourceUploadKey(upload.CollectionId, upload.Id))
+}
+
+// DeleteExpiredResourceUploads removes the staged resource uploads which expire at the given height or before it
+func (k Keeper) DeleteExpiredResourceUploads(ctx *sdk.Context, height int64) ([]types.ResourceUpload, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the expired uploads first, the store can't be modified while iterating over it
+	var expired []types.ResourceUpload
+
+	iterator := store.Iterator(didutils.StrBytes(types.ResourceUploadExpiryKey), types.GetResourceUploadExpiryPrefix(height+1))
+	for ; iterator.Valid(); iterator.Next() {
+		var upload types.ResourceUpload
+		if err := k.cdc.Unmarshal(store.Get(iterator.Value()), &upload); err != nil {
+			closeIteratorOrPanic(iterator)
+			return nil, sdkerrors.ErrInvalidType.Wrap(err.Error())
+		}
+
+		expired = append(expired, upload)
+	}
+	closeIteratorOrPanic(iterator)
+
+	for i := range expired {
+		k.DeleteResourceUpload(ctx, &expired[i])
+	}
+
+	return expired, nil
+}
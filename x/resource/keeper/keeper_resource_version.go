@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetLatestResourceVersion points the latest version index of the resource name and type to the resource
+func (k Keeper) SetLatestResourceVersion(ctx *sdk.Context, metadata *types.Metadata) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetResourceLatestVersionKey(metadata.CollectionId, metadata.Name, metadata.ResourceType)
+	store.Set(key, []byte(metadata.Id))
+}
+
+// GetLatestResourceVersionID returns the id of the latest version of the resource with the name and type
+func (k Keeper) GetLatestResourceVersionID(ctx *sdk.Context, collectionID, name, resourceType string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	id := store.Get(types.GetResourceLatestVersionKey(collectionID, name, resourceType))
+	if id == nil {
+		return "", false
+	}
+
+	return string(id), true
+}
+
+// GetResourceVersions returns the metadata of all versions of the resource with the name and type,
+// from the latest to the first one
+func (k Keeper) GetResourceVersions(ctx *sdk.Context, collectionID, name, resourceType string) ([]*types.Metadata, error) {
+	id, found := k.GetLatestResourceVersionID(ctx, collectionID, name, resourceType)
+	if !found {
+		return []*types.Metadata{}, nil
+	}
+
+	var versions []*types.Metadata
+	for id != "" {
+		metadata, err := k.GetResourceMetadata(ctx, collectionID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, &metadata)
+		id = metadata.PreviousVersionId
+	}
+
+	return versions, nil
+}
+
+// IndexAllLatestResourceVersions builds the latest version index from the version links of all resources in the store
+func (k Keeper) IndexAllLatestResourceVersions(ctx *sdk.Context) {
+	// Collect the latest versions first, the store can't be modified while iterating over it
+	var latestVersions []types.Metadata
+
+	k.IterateAllResourceMetadatas(ctx, func(metadata types.Metadata) bool {
+		if metadata.NextVersionId == "" {
+			latestVersions = append(latestVersions, metadata)
+		}
+
+		return true
+	})
+
+	for i := range latestVersions {
+		k.SetLatestResourceVersion(ctx, &latestVersions[i])
+	}
+}
@@ -24,20 +24,18 @@ func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateRes
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Remember bytes before modifying payload
-	signBytes := msg.Payload.GetSignBytes()
+	payloadSignBytes := msg.Payload.GetSignBytes()
 
 	msg.Normalize()
 
-	// Validate corresponding DIDDoc exists
-	namespace := k.didKeeper.GetDidNamespace(&ctx)
-	did := didutils.JoinDID(didtypes.DidMethod, namespace, msg.Payload.CollectionId)
-	didDoc, err := k.didKeeper.GetLatestDidDoc(&ctx, did)
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	did, didDoc, err := k.getCollectionDidDoc(&ctx, msg.Payload.CollectionId)
 	if err != nil {
 		return nil, err
 	}
 
 	// Validate namespaces
-	err = msg.Validate([]string{namespace})
+	err = msg.Validate(k.didKeeper.GetAllowedNamespaces(&ctx))
 	if err != nil {
 		return nil, didtypes.ErrNamespaceValidation.Wrap(err.Error())
 	}
@@ -53,15 +51,55 @@ func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateRes
 	}
 
 	// The DID subject is the only needed signer
-	signers := []string{did}
-	err = didkeeper.VerifyAllSignersHaveAllValidSignatures(&k.didKeeper, &ctx, map[string]didtypes.DidDocWithMetadata{},
-		signBytes, signers, msg.Signatures)
+	err = k.verifyCollectionSignatures(&ctx, did, didDoc, payloadSignBytes, msg.Signatures)
 	if err != nil {
 		return nil, err
 	}
 
-	// Build Resource
+	// Build and persist Resource
 	resource := msg.Payload.ToResource()
+	err = k.publishResource(&ctx, did, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgCreateResourceResponse{
+		Resource: resource.Metadata,
+	}, nil
+}
+
+// getCollectionDidDoc returns the DID Document of the resource collection from one of the allowed namespaces
+func (k msgServer) getCollectionDidDoc(ctx *sdk.Context, collectionID string) (string, didtypes.DidDocWithMetadata, error) {
+	did, found := k.didKeeper.FindDidByUniqueID(ctx, collectionID)
+	if !found {
+		did = didutils.JoinDID(didtypes.DidMethod, k.didKeeper.GetDidNamespace(ctx), collectionID)
+	} else if _, namespace, _ := didutils.MustSplitDID(did); !k.didKeeper.IsAllowedNamespace(ctx, namespace) {
+		// Resources can't be added to DIDs in namespaces which aren't allowed anymore
+		return "", didtypes.DidDocWithMetadata{}, didtypes.ErrDidDocNotFound.Wrapf("%s is in namespace %s which is not allowed", did, namespace)
+	}
+
+	didDoc, err := k.didKeeper.GetLatestDidDoc(ctx, did)
+	if err != nil {
+		return "", didtypes.DidDocWithMetadata{}, err
+	}
+
+	return did, didDoc, nil
+}
+
+// verifyCollectionSignatures checks that the DID subject of the resource collection signed the payload
+func (k msgServer) verifyCollectionSignatures(ctx *sdk.Context, did string, didDoc didtypes.DidDocWithMetadata, payloadSignBytes []byte, signatures []*didtypes.SignInfo) error {
+	signers := []string{did}
+	signBytes := didkeeper.GetAcceptedSignBytes(&k.didKeeper, ctx, did, payloadSignBytes, didDoc.Metadata.VersionId)
+
+	return didkeeper.VerifyAllSignersHaveAllValidSignatures(&k.didKeeper, ctx, map[string]didtypes.DidDocWithMetadata{},
+		signBytes, signers, signatures)
+}
+
+// publishResource fills the metadata computed by the ledger, persists the resource and emits the events
+func (k msgServer) publishResource(ctx *sdk.Context, did string, resource *types.ResourceWithMetadata) error {
+	_, namespace, _ := didutils.MustSplitDID(did)
+
 	checksum := sha256.Sum256(resource.Resource.Data)
 	resource.Metadata.Checksum = hex.EncodeToString(checksum[:])
 	resource.Metadata.Created = ctx.BlockTime()
@@ -69,19 +107,53 @@ func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateRes
 
 	// Add default resource alternative url
 	defaultAlternativeURL := types.AlternativeUri{
-		Uri:         fmt.Sprintf(DefaultAlternativeURITemplate, namespace, msg.Payload.CollectionId, msg.Payload.Id),
+		Uri:         fmt.Sprintf(DefaultAlternativeURITemplate, namespace, resource.Metadata.CollectionId, resource.Metadata.Id),
 		Description: DefaultAlternaticeURIDescription,
 	}
 	resource.Metadata.AlsoKnownAs = append(resource.Metadata.AlsoKnownAs, &defaultAlternativeURL)
 
 	// Persist resource
-	err = k.AddNewResourceVersion(&ctx, &resource)
+	err := k.AddNewResourceVersion(ctx, resource)
 	if err != nil {
-		return nil, types.ErrInternal.Wrapf(err.Error())
+		return types.ErrInternal.Wrapf(err.Error())
 	}
 
-	// Build and return response
-	return &types.MsgCreateResourceResponse{
-		Resource: resource.Metadata,
-	}, nil
+	// Emit events
+	err = emitResourceCreatedEvents(ctx, resource.Metadata)
+	if err != nil {
+		return types.ErrInternal.Wrapf(err.Error())
+	}
+
+	return nil
+}
+
+// emitResourceCreatedEvents emits EventResourceCreated and, if the resource was linked to a previous version,
+// EventResourceVersionLinked
+func emitResourceCreatedEvents(ctx *sdk.Context, metadata *types.Metadata) error {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventResourceCreated{
+		CollectionId:      metadata.CollectionId,
+		Id:                metadata.Id,
+		Name:              metadata.Name,
+		ResourceType:      metadata.ResourceType,
+		MediaType:         metadata.MediaType,
+		Checksum:          metadata.Checksum,
+		PreviousVersionId: metadata.PreviousVersionId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if metadata.PreviousVersionId == "" {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventResourceVersionLinked{
+		CollectionId:      metadata.CollectionId,
+		Id:                metadata.Id,
+		Name:              metadata.Name,
+		ResourceType:      metadata.ResourceType,
+		MediaType:         metadata.MediaType,
+		Checksum:          metadata.Checksum,
+		PreviousVersionId: metadata.PreviousVersionId,
+	})
 }
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) BeginResourceUpload(goCtx context.Context, msg *types.MsgBeginResourceUpload) (*types.MsgBeginResourceUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	payloadSignBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	did, didDoc, err := k.getCollectionDidDoc(&ctx, msg.Payload.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	err = msg.Validate(k.didKeeper.GetAllowedNamespaces(&ctx))
+	if err != nil {
+		return nil, didtypes.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Validate DID is not deactivated
+	if didDoc.Metadata.Deactivated {
+		return nil, didtypes.ErrDIDDocDeactivated.Wrap(did)
+	}
+
+	// Validate neither Resource nor its upload exist
+	if k.HasResource(&ctx, msg.Payload.CollectionId, msg.Payload.Id) {
+		return nil, types.ErrResourceExists.Wrap(msg.Payload.Id)
+	}
+
+	if k.HasResourceUpload(&ctx, msg.Payload.CollectionId, msg.Payload.Id) {
+		return nil, types.ErrResourceExists.Wrapf("upload of %s is in progress", msg.Payload.Id)
+	}
+
+	// Validate declared size
+	params := k.GetUploadParams(ctx)
+	if msg.Payload.TotalSize > params.MaxResourceSize {
+		return nil, types.ErrBadRequest.Wrapf("resource size %d exceeds the limit of %d bytes", msg.Payload.TotalSize, params.MaxResourceSize)
+	}
+
+	// The DID subject is the only needed signer
+	err = k.verifyCollectionSignatures(&ctx, did, didDoc, payloadSignBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stage the upload until it expires
+	upload := msg.Payload.ToResourceUpload(ctx.BlockHeight() + params.ExpiryBlocks)
+	k.SetResourceUpload(&ctx, &upload)
+
+	return &types.MsgBeginResourceUploadResponse{
+		Upload: &upload,
+	}, nil
+}
+
+func (k msgServer) AppendResourceChunk(goCtx context.Context, msg *types.MsgAppendResourceChunk) (*types.MsgAppendResourceChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	payloadSignBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	did, didDoc, err := k.getCollectionDidDoc(&ctx, msg.Payload.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	err = msg.Validate(k.didKeeper.GetAllowedNamespaces(&ctx))
+	if err != nil {
+		return nil, didtypes.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Validate DID is not deactivated
+	if didDoc.Metadata.Deactivated {
+		return nil, didtypes.ErrDIDDocDeactivated.Wrap(did)
+	}
+
+	upload, err := k.GetResourceUpload(&ctx, msg.Payload.CollectionId, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the chunk is the next one and fits the declared size
+	if msg.Payload.ChunkIndex != upload.ChunkCount {
+		return nil, types.ErrInvalidResourceChunk.Wrapf("expected chunk %d, got %d", upload.ChunkCount, msg.Payload.ChunkIndex)
+	}
+
+	params := k.GetUploadParams(ctx)
+	chunkSize := uint64(len(msg.Payload.Data))
+	if chunkSize > params.MaxChunkSize {
+		return nil, types.ErrInvalidResourceChunk.Wrapf("chunk size %d exceeds the limit of %d bytes", chunkSize, params.MaxChunkSize)
+	}
+
+	if upload.ReceivedSize+chunkSize > upload.TotalSize {
+		return nil, types.ErrInvalidResourceChunk.Wrapf("chunk exceeds the declared resource size of %d bytes", upload.TotalSize)
+	}
+
+	// The DID subject is the only needed signer
+	err = k.verifyCollectionSignatures(&ctx, did, didDoc, payloadSignBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	k.AppendResourceUploadChunk(&ctx, &upload, msg.Payload.Data)
+
+	return &types.MsgAppendResourceChunkResponse{
+		Upload: &upload,
+	}, nil
+}
+
+func (k msgServer) FinalizeResource(goCtx context.Context, msg *types.MsgFinalizeResource) (*types.MsgFinalizeResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Remember bytes before modifying payload
+	payloadSignBytes := msg.Payload.GetSignBytes()
+
+	msg.Normalize()
+
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	did, didDoc, err := k.getCollectionDidDoc(&ctx, msg.Payload.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	err = msg.Validate(k.didKeeper.GetAllowedNamespaces(&ctx))
+	if err != nil {
+		return nil, didtypes.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Validate DID is not deactivated
+	if didDoc.Metadata.Deactivated {
+		return nil, didtypes.ErrDIDDocDeactivated.Wrap(did)
+	}
+
+	upload, err := k.GetResourceUpload(&ctx, msg.Payload.CollectionId, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate Resource hasn't been created in the meantime
+	if k.HasResource(&ctx, msg.Payload.CollectionId, msg.Payload.Id) {
+		return nil, types.ErrResourceExists.Wrap(msg.Payload.Id)
+	}
+
+	// The DID subject is the only needed signer
+	err = k.verifyCollectionSignatures(&ctx, did, didDoc, payloadSignBytes, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the staged data against the declaration
+	if upload.ReceivedSize != upload.TotalSize {
+		return nil, types.ErrInvalidResourceChunk.Wrapf("received %d of %d bytes", upload.ReceivedSize, upload.TotalSize)
+	}
+
+	data := k.GetResourceUploadData(&ctx, &upload)
+	checksum := sha256.Sum256(data)
+	if hex.EncodeToString(checksum[:]) != upload.Checksum {
+		return nil, types.ErrResourceChecksumMismatch.Wrapf("declared %s, got %s", upload.Checksum, hex.EncodeToString(checksum[:]))
+	}
+
+	// Build and persist Resource
+	resource := upload.ToResource(data)
+	err = k.publishResource(&ctx, did, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteResourceUpload(&ctx, &upload)
+
+	return &types.MsgFinalizeResourceResponse{
+		Resource: resource.Metadata,
+	}, nil
+}
@@ -13,3 +13,17 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.FeeParams) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeParams, &params)
 	return params
 }
+
+func (k Keeper) SetUploadParams(ctx sdk.Context, params types.UploadParams) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyUploadParams, &params)
+}
+
+// GetUploadParams returns the chunked upload params or the defaults if they haven't been set yet, e.g. before an upgrade
+func (k Keeper) GetUploadParams(ctx sdk.Context) (params types.UploadParams) {
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyUploadParams) {
+		return *types.DefaultUploadParams()
+	}
+
+	k.paramSpace.Get(ctx, types.ParamStoreKeyUploadParams, &params)
+	return params
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	didkeeper "github.com/canow-co/cheqd-node/x/did/keeper"
 	"github.com/canow-co/cheqd-node/x/resource/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +26,13 @@ func NewQuerier(k Keeper, cheqdKeeper didkeeper.Keeper, legacyQuerierCdc *codec.
 			return resourceMetadata(ctx, k, cheqdKeeper, legacyQuerierCdc, path[1], path[2])
 		case types.QueryGetCollectionResources:
 			return collectionResources(ctx, k, cheqdKeeper, legacyQuerierCdc, path[1])
+		case types.QueryGetLatestResource:
+			return latestResource(ctx, k, cheqdKeeper, legacyQuerierCdc, path[1], path[2], path[3])
+		case types.QueryGetResourceVersions:
+			return resourceVersions(ctx, k, cheqdKeeper, legacyQuerierCdc, path[1], path[2], path[3])
+		case types.QueryDereference:
+			// DID URL path segments are split along with the query path
+			return dereference(ctx, k, cheqdKeeper, legacyQuerierCdc, strings.Join(path[1:], "/"))
 
 		default:
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
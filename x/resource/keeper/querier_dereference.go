@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	didkeeper "github.com/canow-co/cheqd-node/x/did/keeper"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func dereference(ctx sdk.Context, keeper Keeper, cheqdKeeper didkeeper.Keeper, legacyQuerierCdc *codec.LegacyAmino, didURL string) ([]byte, error) {
+	queryServer := NewQueryServer(keeper, cheqdKeeper)
+
+	resp, err := queryServer.Dereference(sdk.WrapSDKContext(ctx), &types.QueryDereferenceRequest{DidUrl: didURL})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -20,10 +20,9 @@ func (q queryServer) CollectionResources(c context.Context, req *types.QueryColl
 
 	req.Normalize()
 
-	// Validate corresponding DIDDoc exists
-	namespace := q.didKeeper.GetDidNamespace(&ctx)
-	did := didutils.JoinDID(didtypes.DidMethod, namespace, req.CollectionId)
-	if !q.didKeeper.HasDidDoc(&ctx, did) {
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	if _, found := q.didKeeper.FindDidByUniqueID(&ctx, req.CollectionId); !found {
+		did := didutils.JoinDID(didtypes.DidMethod, q.didKeeper.GetDidNamespace(&ctx), req.CollectionId)
 		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
 	}
 
@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/canow-co/cheqd-node/x/resource/types"
+)
+
+// Dereference dereferences a DID URL according to the W3C DID Resolution specification.
+// Dereferencing errors are reported in the dereferencing metadata instead of being returned as query errors.
+func (q queryServer) Dereference(c context.Context, req *types.QueryDereferenceRequest) (*types.QueryDereferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retrieved := ctx.BlockTime()
+
+	// Validate DID URL before normalization because normalization expects a well-formed DID URL
+	did, _, _, _, err := didutils.TrySplitDIDUrl(req.DidUrl)
+	if err != nil {
+		return newDereferenceErrorResponse("", types.DereferencingErrorInvalidDidURL, retrieved), nil
+	}
+
+	method, _, _, err := didutils.TrySplitDID(did)
+	if err != nil {
+		return newDereferenceErrorResponse(did, types.DereferencingErrorInvalidDidURL, retrieved), nil
+	}
+
+	if method != didtypes.DidMethod {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorMethodNotSupported, retrieved), nil
+	}
+
+	if !didutils.IsValidDIDUrl(req.DidUrl, didtypes.DidMethod, nil) {
+		return newDereferenceErrorResponse(did, types.DereferencingErrorInvalidDidURL, retrieved), nil
+	}
+
+	req.Normalize()
+
+	did, path, query, fragment := didutils.MustSplitDIDUrl(req.DidUrl)
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return newDereferenceErrorResponse(did, types.DereferencingErrorInvalidDidURL, retrieved), nil
+	}
+
+	// DIDs from other networks can't be found on this ledger. DIDs in namespaces which aren't allowed anymore still can.
+	if !q.didKeeper.HasDidDoc(&ctx, did) {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved), nil
+	}
+
+	switch {
+	case path != "":
+		return q.dereferenceResourcePath(&ctx, did, path, req.Accept), nil
+	case params.Get(types.ResourceNameParam) != "" || params.Get(types.ResourceTypeParam) != "":
+		return q.dereferenceResourceQuery(&ctx, did, params, req.Accept), nil
+	case params.Get(types.ServiceParam) != "":
+		return q.dereferenceService(&ctx, did, params, fragment, req.Accept), nil
+	default:
+		return q.dereferenceDidDoc(&ctx, did, params, fragment, req.Accept), nil
+	}
+}
+
+// dereferenceDidDoc returns the DID Document or its verification method or service addressed by the fragment
+func (q queryServer) dereferenceDidDoc(ctx *sdk.Context, did string, params url.Values, fragment string, accept string) *types.QueryDereferenceResponse {
+	retrieved := ctx.BlockTime()
+
+	contentType, supported := didtypes.NormalizeDIDContentType(accept)
+	if !supported {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorRepresentationNotSupported, retrieved)
+	}
+
+	didDoc, errorCode := q.getDidDocVersion(ctx, did, params)
+	if errorCode != "" {
+		return newDereferenceErrorResponse(did, errorCode, retrieved)
+	}
+
+	var content []byte
+	var err error
+
+	if fragment == "" {
+		content, err = didDoc.DidDoc.MarshalW3CJSON(contentType)
+	} else {
+		var found bool
+		content, found, err = marshalDidDocFragment(didDoc.DidDoc, didutils.JoinDIDUrl(did, "", "", fragment))
+		if !found {
+			return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved)
+		}
+	}
+
+	if err != nil {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorInternalError, retrieved)
+	}
+
+	metadata := types.NewDereferencingMetadata(did, contentType, retrieved)
+
+	return &types.QueryDereferenceResponse{
+		DereferencingMetadata: &metadata,
+		ContentStream:         content,
+		DidDocumentMetadata:   didDoc.Metadata,
+	}
+}
+
+// dereferenceService returns the service endpoints selected by the service and relativeRef parameters
+func (q queryServer) dereferenceService(ctx *sdk.Context, did string, params url.Values, fragment string, accept string) *types.QueryDereferenceResponse {
+	retrieved := ctx.BlockTime()
+
+	if !isAcceptable(accept, types.ServiceEndpointContentType) {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorRepresentationNotSupported, retrieved)
+	}
+
+	didDoc, errorCode := q.getDidDocVersion(ctx, did, params)
+	if errorCode != "" {
+		return newDereferenceErrorResponse(did, errorCode, retrieved)
+	}
+
+	serviceID := didutils.JoinDIDUrl(did, "", "", params.Get(types.ServiceParam))
+
+	var service *didtypes.Service
+	for _, s := range didDoc.DidDoc.Service {
+		if s.Id == serviceID {
+			service = s
+			break
+		}
+	}
+
+	if service == nil {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved)
+	}
+
+	uris := service.EndpointURIs()
+
+	endpoints := make([]string, 0, len(uris))
+	for _, endpoint := range uris {
+		endpointURL, err := buildServiceEndpointURL(endpoint, params.Get(types.RelativeRefParam), fragment)
+		if err != nil {
+			return newDereferenceErrorResponse(did, didtypes.ResolutionErrorInvalidDidURL, retrieved)
+		}
+
+		endpoints = append(endpoints, endpointURL)
+	}
+
+	metadata := types.NewDereferencingMetadata(did, types.ServiceEndpointContentType, retrieved)
+
+	return &types.QueryDereferenceResponse{
+		DereferencingMetadata: &metadata,
+		ContentStream:         []byte(strings.Join(endpoints, "\r\n")),
+		DidDocumentMetadata:   didDoc.Metadata,
+	}
+}
+
+// dereferenceResourcePath returns the resource addressed by the <did>/resources/<resource-id> path
+func (q queryServer) dereferenceResourcePath(ctx *sdk.Context, did string, path string, accept string) *types.QueryDereferenceResponse {
+	retrieved := ctx.BlockTime()
+
+	if !strings.HasPrefix(path, types.ResourcesPathPrefix) {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved)
+	}
+
+	resourceID := strings.TrimPrefix(path, types.ResourcesPathPrefix)
+	if !didutils.IsValidUUID(resourceID) {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved)
+	}
+
+	_, _, collectionID := didutils.MustSplitDID(did)
+	resourceID = didutils.NormalizeUUID(resourceID)
+
+	if !q.HasResource(ctx, collectionID, resourceID) {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved)
+	}
+
+	return q.newResourceResponse(ctx, did, collectionID, resourceID, accept)
+}
+
+// dereferenceResourceQuery returns the resource selected by the resourceName, resourceType and versionTime parameters.
+// The latest version created not after versionTime is selected.
+func (q queryServer) dereferenceResourceQuery(ctx *sdk.Context, did string, params url.Values, accept string) *types.QueryDereferenceResponse {
+	retrieved := ctx.BlockTime()
+
+	versionTime := retrieved
+	if params.Get(types.VersionTimeParam) != "" {
+		var err error
+		versionTime, err = time.Parse(time.RFC3339Nano, params.Get(types.VersionTimeParam))
+		if err != nil {
+			return newDereferenceErrorResponse(did, didtypes.ResolutionErrorInvalidOptions, retrieved)
+		}
+	}
+
+	name := params.Get(types.ResourceNameParam)
+	resourceType := params.Get(types.ResourceTypeParam)
+	_, _, collectionID := didutils.MustSplitDID(did)
+
+	// Versions of every matching resource, from the latest to the first one
+	var versionChains [][]*types.Metadata
+	if name != "" && resourceType != "" {
+		versions, err := q.GetResourceVersions(ctx, collectionID, name, resourceType)
+		if err != nil {
+			return newDereferenceErrorResponse(did, didtypes.ResolutionErrorInternalError, retrieved)
+		}
+
+		versionChains = append(versionChains, versions)
+	} else {
+		visited := map[string]bool{}
+		for _, metadata := range q.GetResourceCollection(ctx, collectionID) {
+			if name != "" && metadata.Name != name {
+				continue
+			}
+
+			if resourceType != "" && metadata.ResourceType != resourceType {
+				continue
+			}
+
+			key := string(types.GetResourceLatestVersionKey(collectionID, metadata.Name, metadata.ResourceType))
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			versions, err := q.GetResourceVersions(ctx, collectionID, metadata.Name, metadata.ResourceType)
+			if err != nil {
+				return newDereferenceErrorResponse(did, didtypes.ResolutionErrorInternalError, retrieved)
+			}
+
+			versionChains = append(versionChains, versions)
+		}
+	}
+
+	// Versions created in the same block share the timestamp, so they are ordered by the version chain
+	var selected *types.Metadata
+	for _, versions := range versionChains {
+		for _, metadata := range versions {
+			if metadata.Created.After(versionTime) {
+				continue
+			}
+
+			if selected == nil || metadata.Created.After(selected.Created) {
+				selected = metadata
+			}
+
+			break
+		}
+	}
+
+	if selected == nil {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorNotFound, retrieved)
+	}
+
+	return q.newResourceResponse(ctx, did, collectionID, selected.Id, accept)
+}
+
+func (q queryServer) newResourceResponse(ctx *sdk.Context, did, collectionID, resourceID, accept string) *types.QueryDereferenceResponse {
+	retrieved := ctx.BlockTime()
+
+	resource, err := q.GetResource(ctx, collectionID, resourceID)
+	if err != nil {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorInternalError, retrieved)
+	}
+
+	if !isAcceptable(accept, resource.Metadata.MediaType) {
+		return newDereferenceErrorResponse(did, didtypes.ResolutionErrorRepresentationNotSupported, retrieved)
+	}
+
+	metadata := types.NewDereferencingMetadata(did, resource.Metadata.MediaType, retrieved)
+
+	return &types.QueryDereferenceResponse{
+		DereferencingMetadata: &metadata,
+		ContentStream:         resource.Resource.Data,
+		ResourceMetadata:      resource.Metadata,
+	}
+}
+
+func (q queryServer) getDidDocVersion(ctx *sdk.Context, did string, params url.Values) (didtypes.DidDocWithMetadata, string) {
+	versionID := params.Get(types.VersionIDParam)
+	if versionID != "" {
+		if !didutils.IsValidUUID(versionID) {
+			return didtypes.DidDocWithMetadata{}, didtypes.ResolutionErrorInvalidOptions
+		}
+
+		versionID = didutils.NormalizeUUID(versionID)
+	}
+
+	didDoc, found, errorCode := q.didKeeper.ResolveDidDocVersion(ctx, did, versionID, params.Get(types.VersionTimeParam))
+	if errorCode != "" {
+		return didtypes.DidDocWithMetadata{}, errorCode
+	}
+
+	if !found {
+		return didtypes.DidDocWithMetadata{}, didtypes.ResolutionErrorNotFound
+	}
+
+	return didDoc, ""
+}
+
+// marshalDidDocFragment looks for a verification method or service with the given id, including
+// verification methods embedded into verification relationships
+func marshalDidDocFragment(didDoc *didtypes.DidDoc, id string) ([]byte, bool, error) {
+	if vm, found := didtypes.FindVerificationMethod(didDoc.AllVerificationMethods(), id); found {
+		bz, err := vm.MarshalW3CJSON()
+		return bz, true, err
+	}
+
+	for _, service := range didDoc.Service {
+		if service.Id == id {
+			bz, err := service.MarshalW3CJSON()
+			return bz, true, err
+		}
+	}
+
+	return nil, false, nil
+}
+
+// buildServiceEndpointURL resolves the relative reference of the input DID URL against the service endpoint
+// as defined by RFC 3986 and applies the fragment to the result.
+// Documentation: https://www.w3.org/TR/did-core/#relative-did-urls
+func buildServiceEndpointURL(endpoint, relativeRef, fragment string) (string, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := url.Parse(relativeRef)
+	if err != nil {
+		return "", err
+	}
+
+	result := base.ResolveReference(ref)
+	if fragment != "" {
+		result.Fragment = fragment
+	}
+
+	return result.String(), nil
+}
+
+func isAcceptable(accept string, contentType string) bool {
+	mediaType := strings.TrimSpace(strings.Split(accept, ";")[0])
+
+	return mediaType == "" || mediaType == didtypes.AnyContentType || mediaType == contentType
+}
+
+func newDereferenceErrorResponse(did string, errorCode string, retrieved time.Time) *types.QueryDereferenceResponse {
+	metadata := types.NewDereferencingMetadata(did, "", retrieved)
+	metadata.Error = errorCode
+
+	return &types.QueryDereferenceResponse{
+		DereferencingMetadata: &metadata,
+	}
+}
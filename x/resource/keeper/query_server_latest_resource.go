@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/canow-co/cheqd-node/x/resource/types"
+)
+
+func (q queryServer) LatestResource(c context.Context, req *types.QueryLatestResourceRequest) (*types.QueryLatestResourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	if _, found := q.didKeeper.FindDidByUniqueID(&ctx, req.CollectionId); !found {
+		did := didutils.JoinDID(didtypes.DidMethod, q.didKeeper.GetDidNamespace(&ctx), req.CollectionId)
+		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
+	}
+
+	id, found := q.GetLatestResourceVersionID(&ctx, req.CollectionId, req.Name, req.ResourceType)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("resource %s:%s of type %s", req.CollectionId, req.Name, req.ResourceType)
+	}
+
+	resource, err := q.GetResource(&ctx, req.CollectionId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLatestResourceResponse{
+		Resource: &resource,
+	}, nil
+}
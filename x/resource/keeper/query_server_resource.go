@@ -21,10 +21,9 @@ func (q queryServer) Resource(c context.Context, req *types.QueryResourceRequest
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	// Validate corresponding DIDDoc exists
-	namespace := q.didKeeper.GetDidNamespace(&ctx)
-	did := didutils.JoinDID(didtypes.DidMethod, namespace, req.CollectionId)
-	if !q.didKeeper.HasDidDoc(&ctx, did) {
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	if _, found := q.didKeeper.FindDidByUniqueID(&ctx, req.CollectionId); !found {
+		did := didutils.JoinDID(didtypes.DidMethod, q.didKeeper.GetDidNamespace(&ctx), req.CollectionId)
 		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
 	}
 
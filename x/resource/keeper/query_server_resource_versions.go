@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/canow-co/cheqd-node/x/resource/types"
+)
+
+func (q queryServer) ResourceVersions(c context.Context, req *types.QueryResourceVersionsRequest) (*types.QueryResourceVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	req.Normalize()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Validate corresponding DIDDoc exists in one of the allowed namespaces
+	if _, found := q.didKeeper.FindDidByUniqueID(&ctx, req.CollectionId); !found {
+		did := didutils.JoinDID(didtypes.DidMethod, q.didKeeper.GetDidNamespace(&ctx), req.CollectionId)
+		return nil, didtypes.ErrDidDocNotFound.Wrap(did)
+	}
+
+	versions, err := q.GetResourceVersions(&ctx, req.CollectionId, req.Name, req.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResourceVersionsResponse{
+		Resources: versions,
+	}, nil
+}
@@ -172,8 +172,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock executes all ABCI BeginBlock logic respective to the resource module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock executes all ABCI EndBlock logic respective to the resource module. It
+// EndBlock executes all ABCI EndBlock logic respective to the resource module:
+// staged resource uploads which expired without being finalized are removed. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	expired, err := am.keeper.DeleteExpiredResourceUploads(&ctx, ctx.BlockHeight())
+	if err != nil {
+		panic(fmt.Sprintf("Cannot delete expired resource uploads: %s", err.Error()))
+	}
+
+	for _, upload := range expired {
+		am.keeper.Logger(ctx).Info("expired resource upload removed", "collection_id", upload.CollectionId, "id", upload.Id)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -108,6 +108,20 @@ var _ = Describe("Create Resource Tests", func() {
 			_, err := setup.CreateResource(msg, []didsetup.SignInput{bob.SignInput})
 			Expect(err.Error()).To(ContainSubstring("not found"))
 		})
+
+		It("Can't be created with signature made for another chain", func() {
+			bobDidDoc, err := setup.QueryDidDoc(bob.Did)
+			Expect(err).To(BeNil())
+
+			signBytes := didtypes.GetEnvelopeSignBytes("other-chain", didsetup.DidNamespace, bobDidDoc.Value.Metadata.VersionId, msg.GetSignBytes())
+
+			_, err = setup.ResourceMsgServer.CreateResource(setup.StdCtx, &resourcetypes.MsgCreateResource{
+				Payload:    msg,
+				Signatures: didsetup.Sign(signBytes, []didsetup.SignInput{bob.SignInput}),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(didtypes.ErrInvalidSignature.Error()))
+		})
 	})
 
 	Describe("New version", func() {
@@ -201,4 +215,40 @@ var _ = Describe("Create Resource Tests", func() {
 			Expect(created.Resource.Metadata.Id).To(Equal(strings.ToLower(UUIDString)))
 		})
 	})
+
+	Describe("Resource of a DID in an additional namespace", func() {
+		var carol didsetup.CreatedDidDocInfo
+
+		BeforeEach(func() {
+			setup.Keeper.SetNamespaceParams(setup.SdkCtx, didtypes.NamespaceParams{
+				Namespaces: []*didtypes.NamespaceConfig{{Namespace: "partners"}},
+			})
+
+			carol = setup.CreateCustomDidDoc(setup.BuildDidDocWithCustomDID(didutils.JoinDID(didtypes.DidMethod, "partners", uuid.NewString())))
+		})
+
+		It("Can be created and refers to the DID namespace", func() {
+			resource := setup.BuildSimpleResource(carol.CollectionID, SchemaData, "Test Resource Name", CLSchemaType)
+
+			_, err := setup.CreateResource(&resource, []didsetup.SignInput{carol.SignInput})
+			Expect(err).To(BeNil())
+
+			created, err := setup.QueryResource(carol.CollectionID, resource.Id)
+			Expect(err).To(BeNil())
+			Expect(created.Resource.Metadata.AlsoKnownAs).To(ContainElement(&resourcetypes.AlternativeUri{
+				Uri:         carol.Did + "/resources/" + resource.Id,
+				Description: "did-url",
+			}))
+		})
+
+		It("Can't be created after the namespace is disallowed", func() {
+			setup.Keeper.SetNamespaceParams(setup.SdkCtx, *didtypes.DefaultNamespaceParams())
+
+			resource := setup.BuildSimpleResource(carol.CollectionID, SchemaData, "Test Resource Name", CLSchemaType)
+
+			_, err := setup.CreateResource(&resource, []didsetup.SignInput{carol.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+	})
 })
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/resource/tests/setup"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	didsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	resourcetypes "github.com/canow-co/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource events", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+	})
+
+	// typedEventsSince parses typed events emitted after the given number of events
+	typedEventsSince := func(offset int) []proto.Message {
+		var result []proto.Message
+
+		for _, event := range setup.SdkCtx.EventManager().ABCIEvents()[offset:] {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			result = append(result, typedEvent)
+		}
+
+		return result
+	}
+
+	It("Emits EventResourceCreated for the first version", func() {
+		offset := len(setup.SdkCtx.EventManager().ABCIEvents())
+		res := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		events := typedEventsSince(offset)
+		Expect(events).To(HaveLen(1))
+
+		event, ok := events[0].(*resourcetypes.EventResourceCreated)
+		Expect(ok).To(BeTrue())
+		Expect(event).To(Equal(&resourcetypes.EventResourceCreated{
+			CollectionId: alice.CollectionID,
+			Id:           res.Resource.Id,
+			Name:         "Resource",
+			ResourceType: CLSchemaType,
+			MediaType:    res.Resource.MediaType,
+			Checksum:     res.Resource.Checksum,
+		}))
+	})
+
+	It("Emits EventResourceVersionLinked for the next version", func() {
+		first := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		offset := len(setup.SdkCtx.EventManager().ABCIEvents())
+		second := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		events := typedEventsSince(offset)
+		Expect(events).To(HaveLen(2))
+
+		created, ok := events[0].(*resourcetypes.EventResourceCreated)
+		Expect(ok).To(BeTrue())
+		Expect(created.Id).To(Equal(second.Resource.Id))
+		Expect(created.PreviousVersionId).To(Equal(first.Resource.Id))
+
+		linked, ok := events[1].(*resourcetypes.EventResourceVersionLinked)
+		Expect(ok).To(BeTrue())
+		Expect(linked).To(Equal(&resourcetypes.EventResourceVersionLinked{
+			CollectionId:      alice.CollectionID,
+			Id:                second.Resource.Id,
+			Name:              "Resource",
+			ResourceType:      CLSchemaType,
+			MediaType:         second.Resource.MediaType,
+			Checksum:          second.Resource.Checksum,
+			PreviousVersionId: first.Resource.Id,
+		}))
+	})
+})
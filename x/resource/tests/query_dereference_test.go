@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"time"
+
+	. "github.com/canow-co/cheqd-node/x/resource/tests/setup"
+	"github.com/google/uuid"
+
+	didsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DID URL dereferencing", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+
+	BeforeEach(func() {
+		setup = Setup()
+
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Service = []*didtypes.Service{
+			{
+				Id:              didDoc.Did + "#agent",
+				ServiceType:     "DIDCommMessaging",
+				ServiceEndpoint: []string{"https://agent.example.com", "https://backup.example.com"},
+			},
+			{
+				Id:              didDoc.Did + "#api",
+				ServiceType:     "LinkedDomains",
+				ServiceEndpoint: []string{"https://api.example.com/", "https://api.example.com/v1/messages"},
+			},
+		}
+		alice = setup.CreateCustomDidDoc(didDoc)
+	})
+
+	It("Dereferences the DID Document", func() {
+		res, err := setup.Dereference(alice.Did, "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(res.DereferencingMetadata.ContentType).To(Equal(didtypes.DIDJSONLDContentType))
+		Expect(res.DereferencingMetadata.Did.DidString).To(Equal(alice.Did))
+		Expect(res.DidDocumentMetadata.VersionId).To(Equal(alice.VersionID))
+
+		didDoc, err := didsetup.ParseJSONToMap(string(res.ContentStream))
+		Expect(err).To(BeNil())
+		Expect(didDoc["id"]).To(Equal(alice.Did))
+	})
+
+	It("Dereferences a verification method by fragment", func() {
+		res, err := setup.Dereference(alice.KeyID, didtypes.DIDJSONContentType)
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(res.DereferencingMetadata.ContentType).To(Equal(didtypes.DIDJSONContentType))
+
+		vm, err := didsetup.ParseJSONToMap(string(res.ContentStream))
+		Expect(err).To(BeNil())
+		Expect(vm).To(Equal(map[string]any{
+			"id":                 alice.KeyID,
+			"type":               didtypes.Ed25519VerificationKey2020Type,
+			"controller":         alice.Did,
+			"publicKeyMultibase": didsetup.GenerateEd25519VerificationKey2020VerificationMaterial(alice.KeyPair.Public),
+		}))
+	})
+
+	It("Dereferences a service by fragment", func() {
+		res, err := setup.Dereference(alice.Did+"#agent", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+
+		service, err := didsetup.ParseJSONToMap(string(res.ContentStream))
+		Expect(err).To(BeNil())
+		Expect(service["id"]).To(Equal(alice.Did + "#agent"))
+		Expect(service["type"]).To(Equal("DIDCommMessaging"))
+	})
+
+	It("Selects service endpoints with the relative reference applied", func() {
+		res, err := setup.Dereference(alice.Did+"?service=agent&relativeRef=%2Finbox%3Fid%3D1#top", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(res.DereferencingMetadata.ContentType).To(Equal(types.ServiceEndpointContentType))
+		Expect(string(res.ContentStream)).To(Equal("https://agent.example.com/inbox?id=1#top\r\nhttps://backup.example.com/inbox?id=1#top"))
+	})
+
+	It("Resolves the relative reference against endpoints with a path", func() {
+		res, err := setup.Dereference(alice.Did+"?service=api&relativeRef=%2Finbox", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(string(res.ContentStream)).To(Equal("https://api.example.com/inbox\r\nhttps://api.example.com/inbox"))
+
+		res, err = setup.Dereference(alice.Did+"?service=api&relativeRef=inbox", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(string(res.ContentStream)).To(Equal("https://api.example.com/inbox\r\nhttps://api.example.com/v1/inbox"))
+
+		res, err = setup.Dereference(alice.Did+"?service=agent&relativeRef=inbox", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(string(res.ContentStream)).To(Equal("https://agent.example.com/inbox\r\nhttps://backup.example.com/inbox"))
+	})
+
+	It("Selects URIs of DIDComm v2 endpoint maps", func() {
+		didDoc := setup.BuildSimpleDidDoc()
+		didDoc.Msg.Service = []*didtypes.Service{
+			{
+				Id:          didDoc.Did + "#didcomm",
+				ServiceType: didtypes.DIDCommMessagingServiceType,
+				Endpoint:    didtypes.NewMapServiceEndpoint(`{"accept":["didcomm/v2"],"uri":"https://mediator.example.com"}`),
+			},
+		}
+		bob := setup.CreateCustomDidDoc(didDoc)
+
+		res, err := setup.Dereference(bob.Did+"?service=didcomm", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(string(res.ContentStream)).To(Equal("https://mediator.example.com"))
+
+		res, err = setup.Dereference(bob.Did+"#didcomm", "")
+		Expect(err).To(BeNil())
+		Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+		Expect(res.ContentStream).To(MatchJSON(`{"id":"` + bob.Did + `#didcomm","type":"DIDCommMessaging","serviceEndpoint":{"accept":["didcomm/v2"],"uri":"https://mediator.example.com"}}`))
+	})
+
+	Describe("Resources", func() {
+		var first *types.MsgCreateResourceResponse
+		var second *types.MsgCreateResourceResponse
+		var created time.Time
+
+		BeforeEach(func() {
+			created = setup.SdkCtx.BlockTime()
+			first = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Schema", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+			setup.SetBlockTime(created.Add(time.Hour))
+			second = setup.CreateSimpleResource(alice.CollectionID, `{"attr":["name"]}`, "Schema", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		})
+
+		It("Dereferences a resource by path", func() {
+			res, err := setup.Dereference(alice.Did+"/resources/"+first.Resource.Id, "")
+			Expect(err).To(BeNil())
+			Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+			Expect(res.DereferencingMetadata.ContentType).To(Equal(JSONResourceType))
+			Expect(string(res.ContentStream)).To(Equal(SchemaData))
+			Expect(res.ResourceMetadata.Id).To(Equal(first.Resource.Id))
+		})
+
+		It("Selects the latest resource by name and type", func() {
+			res, err := setup.Dereference(alice.Did+"?resourceName=Schema&resourceType="+CLSchemaType, "")
+			Expect(err).To(BeNil())
+			Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+			Expect(res.ResourceMetadata.Id).To(Equal(second.Resource.Id))
+		})
+
+		It("Selects the resource version active at versionTime", func() {
+			versionTime := created.Add(time.Minute).Format(time.RFC3339)
+
+			res, err := setup.Dereference(alice.Did+"?resourceName=Schema&versionTime="+versionTime, "")
+			Expect(err).To(BeNil())
+			Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+			Expect(res.ResourceMetadata.Id).To(Equal(first.Resource.Id))
+		})
+
+		Describe("Versions created in the same block", func() {
+			// Ids are chosen so that the collection is iterated in the A, C, B order for the A <- B <- C chain
+			ids := []string{
+				"10000000-0000-4000-8000-000000000000",
+				"30000000-0000-4000-8000-000000000000",
+				"20000000-0000-4000-8000-000000000000",
+			}
+
+			BeforeEach(func() {
+				setup.SetBlockTime(created.Add(2 * time.Hour))
+
+				for _, id := range ids {
+					resource := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Logo", CLSchemaType)
+					resource.Id = id
+
+					_, err := setup.CreateResource(&resource, []didsetup.SignInput{alice.SignInput})
+					Expect(err).To(BeNil())
+				}
+			})
+
+			DescribeTable("Selects the latest version of the chain",
+				func(query string) {
+					res, err := setup.Dereference(alice.Did+query, "")
+					Expect(err).To(BeNil())
+					Expect(res.DereferencingMetadata.Error).To(BeEmpty())
+					Expect(res.ResourceMetadata.Id).To(Equal(ids[2]))
+				},
+
+				Entry("by name and type", "?resourceName=Logo&resourceType="+CLSchemaType),
+				Entry("by name", "?resourceName=Logo"),
+			)
+		})
+
+		It("Reports an unacceptable resource representation", func() {
+			res, err := setup.Dereference(alice.Did+"/resources/"+first.Resource.Id, "image/png")
+			Expect(err).To(BeNil())
+			Expect(res.DereferencingMetadata.Error).To(Equal(didtypes.ResolutionErrorRepresentationNotSupported))
+			Expect(res.ContentStream).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("Reports dereferencing errors",
+		func(didURL func() string, expectedError string) {
+			res, err := setup.Dereference(didURL(), "")
+			Expect(err).To(BeNil())
+			Expect(res.DereferencingMetadata.Error).To(Equal(expectedError))
+			Expect(res.ContentStream).To(BeEmpty())
+		},
+		Entry("Invalid DID URL", func() string { return "not-a-did" }, types.DereferencingErrorInvalidDidURL),
+		Entry("Unsupported method", func() string { return "did:example:123#key-1" }, didtypes.ResolutionErrorMethodNotSupported),
+		Entry("Other namespace", func() string { return "did:canow:mainnet:" + alice.CollectionID + "#key-1" }, didtypes.ResolutionErrorNotFound),
+		Entry("Unknown DID", func() string { return didsetup.GenerateDID(didsetup.Base58_16bytes) + "#key-1" }, didtypes.ResolutionErrorNotFound),
+		Entry("Unknown fragment", func() string { return alice.Did + "#unknown" }, didtypes.ResolutionErrorNotFound),
+		Entry("Unknown service", func() string { return alice.Did + "?service=unknown" }, didtypes.ResolutionErrorNotFound),
+		Entry("Unknown path", func() string { return alice.Did + "/unknown" }, didtypes.ResolutionErrorNotFound),
+		Entry("Unknown resource", func() string { return alice.Did + "/resources/" + uuid.NewString() }, didtypes.ResolutionErrorNotFound),
+		Entry("Invalid versionTime", func() string { return alice.Did + "?resourceName=Schema&versionTime=yesterday" }, didtypes.ResolutionErrorInvalidOptions),
+		Entry("Invalid versionId", func() string { return alice.Did + "?versionId=1" }, didtypes.ResolutionErrorInvalidOptions),
+	)
+})
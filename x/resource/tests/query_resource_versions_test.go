@@ -0,0 +1,95 @@
+package tests
+
+import (
+	. "github.com/canow-co/cheqd-node/x/resource/tests/setup"
+
+	didsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query Latest Resource and Resource Versions", func() {
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+
+	var res1v1 *types.MsgCreateResourceResponse
+	var res1v2 *types.MsgCreateResourceResponse
+	var res1v3 *types.MsgCreateResourceResponse
+	var res2v1 *types.MsgCreateResourceResponse
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		res1v1 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		res1v2 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+		res2v1 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", "Another Type", []didsetup.SignInput{alice.SignInput})
+		res1v3 = setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Resource 1", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+	})
+
+	It("Returns the latest version", func() {
+		latest, err := setup.QueryLatestResource(alice.CollectionID, "Resource 1", CLSchemaType)
+		Expect(err).To(BeNil())
+		Expect(latest.Resource.Metadata).To(Equal(res1v3.Resource))
+		Expect(latest.Resource.Resource.Data).To(Equal([]byte(SchemaData)))
+
+		latest, err = setup.QueryLatestResource(alice.CollectionID, "Resource 1", "Another Type")
+		Expect(err).To(BeNil())
+		Expect(latest.Resource.Metadata.Id).To(Equal(res2v1.Resource.Id))
+	})
+
+	It("Returns all versions from the latest to the first one", func() {
+		versions, err := setup.QueryResourceVersions(alice.CollectionID, "Resource 1", CLSchemaType)
+		Expect(err).To(BeNil())
+
+		ids := []string{}
+		for _, version := range versions.Resources {
+			ids = append(ids, version.Id)
+		}
+
+		Expect(ids).To(Equal([]string{res1v3.Resource.Id, res1v2.Resource.Id, res1v1.Resource.Id}))
+	})
+
+	It("Doesn't mix up names and types containing the separator", func() {
+		resA := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "a:b", "c", []didsetup.SignInput{alice.SignInput})
+		resB := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "a", "b:c", []didsetup.SignInput{alice.SignInput})
+
+		Expect(resA.Resource.PreviousVersionId).To(BeEmpty())
+		Expect(resB.Resource.PreviousVersionId).To(BeEmpty())
+
+		latest, err := setup.QueryLatestResource(alice.CollectionID, "a:b", "c")
+		Expect(err).To(BeNil())
+		Expect(latest.Resource.Metadata.Id).To(Equal(resA.Resource.Id))
+	})
+
+	It("Returns not found for an unknown name and type", func() {
+		_, err := setup.QueryLatestResource(alice.CollectionID, "Unknown", CLSchemaType)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+
+		versions, err := setup.QueryResourceVersions(alice.CollectionID, "Unknown", CLSchemaType)
+		Expect(err).To(BeNil())
+		Expect(versions.Resources).To(BeEmpty())
+	})
+
+	It("Returns an error for an unknown collection", func() {
+		_, err := setup.QueryLatestResource(didsetup.GenerateDID(didsetup.Base58_16bytes), "Resource 1", CLSchemaType)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(didtypes.ErrDidDocNotFound.Error()))
+	})
+
+	It("Rebuilds the index from the version links", func() {
+		setup.ResourceKeeper.IndexAllLatestResourceVersions(&setup.SdkCtx)
+
+		id, found := setup.ResourceKeeper.GetLatestResourceVersionID(&setup.SdkCtx, alice.CollectionID, "Resource 1", CLSchemaType)
+		Expect(found).To(BeTrue())
+		Expect(id).To(Equal(res1v3.Resource.Id))
+
+		id, found = setup.ResourceKeeper.GetLatestResourceVersionID(&setup.SdkCtx, alice.CollectionID, "Resource 1", "Another Type")
+		Expect(found).To(BeTrue())
+		Expect(id).To(Equal(res2v1.Resource.Id))
+	})
+})
@@ -0,0 +1,314 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/canow-co/cheqd-node/x/resource"
+	. "github.com/canow-co/cheqd-node/x/resource/tests/setup"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	didsetup "github.com/canow-co/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	resourcetypes "github.com/canow-co/cheqd-node/x/resource/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chunked resource upload", func() {
+	const chunkSize = resourcetypes.DefaultUploadMaxChunkSize
+
+	var setup TestSetup
+	var alice didsetup.CreatedDidDocInfo
+	var data []byte
+	var payload *resourcetypes.MsgBeginResourceUploadPayload
+
+	BeforeEach(func() {
+		setup = Setup()
+		alice = setup.CreateSimpleDid()
+
+		// A status list larger than a single transaction can carry
+		data = []byte(`{"encodedList":"` + strings.Repeat("H4sIAAAAAAAAA", 40*1024) + `"}`)
+		checksum := sha256.Sum256(data)
+
+		payload = &resourcetypes.MsgBeginResourceUploadPayload{
+			CollectionId: alice.CollectionID,
+			Id:           uuid.NewString(),
+			Name:         "Revocation Status List",
+			ResourceType: "StatusList2021",
+			TotalSize:    uint64(len(data)),
+			Checksum:     hex.EncodeToString(checksum[:]),
+		}
+	})
+
+	setBlockHeight := func(height int64) {
+		setup.SdkCtx = setup.SdkCtx.WithBlockHeight(height)
+		setup.StdCtx = sdk.WrapSDKContext(setup.SdkCtx)
+	}
+
+	It("Publishes a resource larger than the single transaction limit", func() {
+		Expect(len(data)).To(BeNumerically(">", chunkSize))
+
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		err = setup.UploadResourceInChunks(alice.CollectionID, payload.Id, data, chunkSize, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		res, err := setup.FinalizeResource(&resourcetypes.MsgFinalizeResourcePayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Resource.Checksum).To(Equal(payload.Checksum))
+		Expect(res.Resource.MediaType).To(Equal("application/json"))
+
+		created, err := setup.QueryResource(alice.CollectionID, payload.Id)
+		Expect(err).To(BeNil())
+		Expect(created.Resource.Resource.Data).To(Equal(data))
+		Expect(created.Resource.Metadata.Name).To(Equal(payload.Name))
+
+		// The staged upload is removed
+		Expect(setup.ResourceKeeper.HasResourceUpload(&setup.SdkCtx, alice.CollectionID, payload.Id)).To(BeFalse())
+	})
+
+	It("Stores the media type detected from the complete data with the last chunk", func() {
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		Expect(setup.UploadResourceInChunks(alice.CollectionID, payload.Id, data[:2*chunkSize], chunkSize, []didsetup.SignInput{alice.SignInput})).To(Succeed())
+		Expect(setup.ResourceKeeper.GetUploadedResourceMediaType(setup.SdkCtx, alice.CollectionID, payload.Id)).To(BeEmpty())
+
+		_, err = setup.AppendResourceChunk(&resourcetypes.MsgAppendResourceChunkPayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+			ChunkIndex:   2,
+			Data:         data[2*chunkSize:],
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(setup.ResourceKeeper.GetUploadedResourceMediaType(setup.SdkCtx, alice.CollectionID, payload.Id)).To(Equal("application/json"))
+	})
+
+	It("Links the uploaded resource as a new version", func() {
+		existing := setup.CreateSimpleResource(alice.CollectionID, SchemaData, payload.Name, payload.ResourceType, []didsetup.SignInput{alice.SignInput})
+
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(setup.UploadResourceInChunks(alice.CollectionID, payload.Id, data, chunkSize, []didsetup.SignInput{alice.SignInput})).To(Succeed())
+
+		res, err := setup.FinalizeResource(&resourcetypes.MsgFinalizeResourcePayload{
+			CollectionId: alice.CollectionID,
+			Id:           payload.Id,
+		}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(res.Resource.PreviousVersionId).To(Equal(existing.Resource.Id))
+	})
+
+	It("Can't be started for an existing resource or twice", func() {
+		existing := setup.CreateSimpleResource(alice.CollectionID, SchemaData, "Schema", CLSchemaType, []didsetup.SignInput{alice.SignInput})
+
+		payload.Id = existing.Resource.Id
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(resourcetypes.ErrResourceExists.Error()))
+
+		payload.Id = uuid.NewString()
+		_, err = setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("upload of " + payload.Id + " is in progress"))
+	})
+
+	It("Can't be started for a resource larger than the limit", func() {
+		payload.TotalSize = resourcetypes.DefaultUploadMaxResourceSize + 1
+
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("exceeds the limit"))
+	})
+
+	It("Can't be started for a resource small enough to be created in a single transaction", func() {
+		// A small image is created with MsgCreateResource and charged the image fee
+		image := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 1024)...)
+		checksum := sha256.Sum256(image)
+		payload.TotalSize = uint64(len(image))
+		payload.Checksum = hex.EncodeToString(checksum[:])
+
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must be created with MsgCreateResource"))
+	})
+
+	It("Can't be started without the DID subject signature", func() {
+		bob := setup.CreateSimpleDid()
+
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{bob.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+	})
+
+	Describe("Chunks", func() {
+		BeforeEach(func() {
+			_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+		})
+
+		appendChunk := func(index uint64, chunk []byte, signInputs []didsetup.SignInput) error {
+			_, err := setup.AppendResourceChunk(&resourcetypes.MsgAppendResourceChunkPayload{
+				CollectionId: alice.CollectionID,
+				Id:           payload.Id,
+				ChunkIndex:   index,
+				Data:         chunk,
+			}, signInputs)
+
+			return err
+		}
+
+		It("Must be appended in order", func() {
+			err := appendChunk(1, data[:chunkSize], []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected chunk 0, got 1"))
+
+			Expect(appendChunk(0, data[:chunkSize], []didsetup.SignInput{alice.SignInput})).To(Succeed())
+
+			// A replayed chunk is rejected
+			err = appendChunk(0, data[:chunkSize], []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(resourcetypes.ErrInvalidResourceChunk.Error()))
+		})
+
+		It("Can't exceed the chunk size limit", func() {
+			err := appendChunk(0, data[:chunkSize+1], []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exceeds the limit"))
+		})
+
+		It("Can't exceed the declared size", func() {
+			payload.TotalSize = chunkSize + 1
+			payload.Id = uuid.NewString()
+			_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			err = appendChunk(0, data[:chunkSize], []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			err = appendChunk(1, data[chunkSize:chunkSize+2], []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("chunk exceeds the declared resource size"))
+		})
+
+		It("Can't be appended to an unknown upload", func() {
+			payload.Id = uuid.NewString()
+
+			err := appendChunk(0, data[:chunkSize], []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(resourcetypes.ErrResourceUploadNotFound.Error()))
+		})
+
+		It("Can't be appended without the DID subject signature", func() {
+			err := appendChunk(0, data[:chunkSize], []didsetup.SignInput{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("signature is required but not found"))
+		})
+	})
+
+	Describe("Finalization", func() {
+		finalize := func() error {
+			_, err := setup.FinalizeResource(&resourcetypes.MsgFinalizeResourcePayload{
+				CollectionId: alice.CollectionID,
+				Id:           payload.Id,
+			}, []didsetup.SignInput{alice.SignInput})
+
+			return err
+		}
+
+		It("Requires all the declared data", func() {
+			_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+			Expect(setup.UploadResourceInChunks(alice.CollectionID, payload.Id, data[:chunkSize], chunkSize, []didsetup.SignInput{alice.SignInput})).To(Succeed())
+
+			err = finalize()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("received 204800 of"))
+		})
+
+		It("Verifies the declared checksum", func() {
+			checksum := sha256.Sum256([]byte("other data"))
+			payload.Checksum = strings.ToUpper(hex.EncodeToString(checksum[:]))
+
+			_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+			Expect(setup.UploadResourceInChunks(alice.CollectionID, payload.Id, data, chunkSize, []didsetup.SignInput{alice.SignInput})).To(Succeed())
+
+			err = finalize()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(resourcetypes.ErrResourceChecksumMismatch.Error()))
+
+			_, err = setup.QueryResource(alice.CollectionID, payload.Id)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Can't publish a resource created in the meantime", func() {
+			_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			resourcePayload := setup.BuildSimpleResource(alice.CollectionID, SchemaData, "Schema", CLSchemaType)
+			resourcePayload.Id = payload.Id
+			_, err = setup.CreateResource(&resourcePayload, []didsetup.SignInput{alice.SignInput})
+			Expect(err).To(BeNil())
+
+			err = finalize()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(resourcetypes.ErrResourceExists.Error()))
+		})
+	})
+
+	It("Removes expired uploads in EndBlock", func() {
+		module := resource.NewAppModule(setup.Cdc, setup.ResourceKeeper, setup.Keeper)
+
+		setBlockHeight(10)
+		_, err := setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+		Expect(setup.UploadResourceInChunks(alice.CollectionID, payload.Id, data[:2*chunkSize], chunkSize, []didsetup.SignInput{alice.SignInput})).To(Succeed())
+
+		other := *payload
+		other.Id = uuid.NewString()
+		setBlockHeight(11)
+		_, err = setup.BeginResourceUpload(&other, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		expiryHeight := 10 + int64(resourcetypes.DefaultUploadExpiryBlocks)
+
+		setBlockHeight(expiryHeight - 1)
+		module.EndBlock(setup.SdkCtx, abci.RequestEndBlock{})
+		Expect(setup.ResourceKeeper.HasResourceUpload(&setup.SdkCtx, alice.CollectionID, payload.Id)).To(BeTrue())
+
+		setBlockHeight(expiryHeight)
+		module.EndBlock(setup.SdkCtx, abci.RequestEndBlock{})
+		Expect(setup.ResourceKeeper.HasResourceUpload(&setup.SdkCtx, alice.CollectionID, payload.Id)).To(BeFalse())
+		Expect(setup.ResourceKeeper.HasResourceUpload(&setup.SdkCtx, alice.CollectionID, other.Id)).To(BeTrue())
+
+		// The chunks are removed along with the upload
+		upload := resourcetypes.ResourceUpload{CollectionId: alice.CollectionID, Id: payload.Id, ReceivedSize: 2 * chunkSize}
+		Expect(setup.ResourceKeeper.GetResourceUploadData(&setup.SdkCtx, &upload)).To(BeEmpty())
+
+		// The same resource can be uploaded again
+		_, err = setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+	})
+
+	It("Rejects the DID of a deactivated collection", func() {
+		_, err := setup.DeactivateDidDoc(&didtypes.MsgDeactivateDidDocPayload{Id: alice.Did, VersionId: uuid.NewString()}, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(BeNil())
+
+		_, err = setup.BeginResourceUpload(payload, []didsetup.SignInput{alice.SignInput})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(didtypes.ErrDIDDocDeactivated.Error()))
+	})
+})
@@ -50,12 +50,15 @@ func Setup() TestSetup {
 	dbStore.MountStoreWithDB(didStoreKey, storetypes.StoreTypeIAVL, nil)
 	dbStore.MountStoreWithDB(resourceStoreKey, storetypes.StoreTypeIAVL, nil)
 
-	_ = dbStore.LoadLatestVersion()
-
 	// Init ParamsKeeper KVStore
 	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
+	dbStore.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
+
+	_ = dbStore.LoadLatestVersion()
+
 	// Init Keepers
 	paramsKeeper := initParamsKeeper(cdc, aminoCdc, paramsStoreKey, paramsTStoreKey)
 	didKeeper := didkeeper.NewKeeper(cdc, didStoreKey, getSubspace(didtypes.ModuleName, paramsKeeper))
@@ -104,8 +107,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
 
 	// set params subspaces
-	paramsKeeper.Subspace(didtypes.ModuleName)
-	paramsKeeper.Subspace(types.ModuleName)
+	paramsKeeper.Subspace(didtypes.ModuleName).WithKeyTable(didtypes.ParamKeyTable())
+	paramsKeeper.Subspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())
 
 	return paramsKeeper
 }
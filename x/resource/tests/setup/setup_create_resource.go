@@ -1,30 +1,17 @@
 package setup
 
 import (
-	"crypto/ed25519"
-
 	"github.com/canow-co/cheqd-node/x/did/tests/setup"
-	didtypes "github.com/canow-co/cheqd-node/x/did/types"
 	"github.com/canow-co/cheqd-node/x/resource/types"
 	"github.com/google/uuid"
 )
 
 func (s *TestSetup) CreateResource(payload *types.MsgCreateResourcePayload, signInputs []setup.SignInput) (*types.MsgCreateResourceResponse, error) {
-	signBytes := payload.GetSignBytes()
-	signatures := make([]*didtypes.SignInfo, 0, len(signInputs))
-
-	for _, input := range signInputs {
-		signature := ed25519.Sign(input.Key, signBytes)
-
-		signatures = append(signatures, &didtypes.SignInfo{
-			VerificationMethodId: input.VerificationMethodID,
-			Signature:            signature,
-		})
-	}
+	signBytes := s.GetEnvelopeSignBytes(s.getCollectionDid(payload.CollectionId), payload.GetSignBytes())
 
 	msg := &types.MsgCreateResource{
 		Payload:    payload,
-		Signatures: signatures,
+		Signatures: setup.Sign(signBytes, signInputs),
 	}
 
 	return s.ResourceMsgServer.CreateResource(s.StdCtx, msg)
@@ -0,0 +1,12 @@
+package setup
+
+import "github.com/canow-co/cheqd-node/x/resource/types"
+
+func (s *TestSetup) Dereference(didURL, accept string) (*types.QueryDereferenceResponse, error) {
+	req := &types.QueryDereferenceRequest{
+		DidUrl: didURL,
+		Accept: accept,
+	}
+
+	return s.ResourceQueryServer.Dereference(s.StdCtx, req)
+}
@@ -0,0 +1,23 @@
+package setup
+
+import "github.com/canow-co/cheqd-node/x/resource/types"
+
+func (s *TestSetup) QueryLatestResource(collectionID, name, resourceType string) (*types.QueryLatestResourceResponse, error) {
+	req := &types.QueryLatestResourceRequest{
+		CollectionId: collectionID,
+		Name:         name,
+		ResourceType: resourceType,
+	}
+
+	return s.ResourceQueryServer.LatestResource(s.StdCtx, req)
+}
+
+func (s *TestSetup) QueryResourceVersions(collectionID, name, resourceType string) (*types.QueryResourceVersionsResponse, error) {
+	req := &types.QueryResourceVersionsRequest{
+		CollectionId: collectionID,
+		Name:         name,
+		ResourceType: resourceType,
+	}
+
+	return s.ResourceQueryServer.ResourceVersions(s.StdCtx, req)
+}
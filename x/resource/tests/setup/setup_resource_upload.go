@@ -0,0 +1,72 @@
+package setup
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/tests/setup"
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	"github.com/canow-co/cheqd-node/x/resource/types"
+)
+
+func (s *TestSetup) getCollectionDid(collectionID string) string {
+	did, found := s.Keeper.FindDidByUniqueID(&s.SdkCtx, didutils.NormalizeID(collectionID))
+	if !found {
+		did = didutils.JoinDID(didtypes.DidMethod, s.Keeper.GetDidNamespace(&s.SdkCtx), didutils.NormalizeID(collectionID))
+	}
+
+	return did
+}
+
+func (s *TestSetup) BeginResourceUpload(payload *types.MsgBeginResourceUploadPayload, signInputs []setup.SignInput) (*types.MsgBeginResourceUploadResponse, error) {
+	signBytes := s.GetEnvelopeSignBytes(s.getCollectionDid(payload.CollectionId), payload.GetSignBytes())
+
+	msg := &types.MsgBeginResourceUpload{
+		Payload:    payload,
+		Signatures: setup.Sign(signBytes, signInputs),
+	}
+
+	return s.ResourceMsgServer.BeginResourceUpload(s.StdCtx, msg)
+}
+
+func (s *TestSetup) AppendResourceChunk(payload *types.MsgAppendResourceChunkPayload, signInputs []setup.SignInput) (*types.MsgAppendResourceChunkResponse, error) {
+	signBytes := s.GetEnvelopeSignBytes(s.getCollectionDid(payload.CollectionId), payload.GetSignBytes())
+
+	msg := &types.MsgAppendResourceChunk{
+		Payload:    payload,
+		Signatures: setup.Sign(signBytes, signInputs),
+	}
+
+	return s.ResourceMsgServer.AppendResourceChunk(s.StdCtx, msg)
+}
+
+func (s *TestSetup) FinalizeResource(payload *types.MsgFinalizeResourcePayload, signInputs []setup.SignInput) (*types.MsgFinalizeResourceResponse, error) {
+	signBytes := s.GetEnvelopeSignBytes(s.getCollectionDid(payload.CollectionId), payload.GetSignBytes())
+
+	msg := &types.MsgFinalizeResource{
+		Payload:    payload,
+		Signatures: setup.Sign(signBytes, signInputs),
+	}
+
+	return s.ResourceMsgServer.FinalizeResource(s.StdCtx, msg)
+}
+
+// UploadResourceInChunks stages the data in chunks of the given size after the upload has begun
+func (s *TestSetup) UploadResourceInChunks(collectionID, id string, data []byte, chunkSize int, signInputs []setup.SignInput) error {
+	for index := 0; index*chunkSize < len(data); index++ {
+		end := (index + 1) * chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		_, err := s.AppendResourceChunk(&types.MsgAppendResourceChunkPayload{
+			CollectionId: collectionID,
+			Id:           id,
+			ChunkIndex:   uint64(index),
+			Data:         data[index*chunkSize : end],
+		}, signInputs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
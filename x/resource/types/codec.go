@@ -10,12 +10,18 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	// Sdk messages
 	cdc.RegisterConcrete(&MsgCreateResource{}, "resource/CreateResource", nil)
+	cdc.RegisterConcrete(&MsgBeginResourceUpload{}, "resource/BeginResourceUpload", nil)
+	cdc.RegisterConcrete(&MsgAppendResourceChunk{}, "resource/AppendResourceChunk", nil)
+	cdc.RegisterConcrete(&MsgFinalizeResource{}, "resource/FinalizeResource", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Sdk messages
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateResource{},
+		&MsgBeginResourceUpload{},
+		&MsgAppendResourceChunk{},
+		&MsgFinalizeResource{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
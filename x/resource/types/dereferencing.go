@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+)
+
+const (
+	// Error code of the DID URL dereferencing process in addition to the DID resolution ones.
+	// Documentation: https://www.w3.org/TR/did-spec-registries/#error
+	DereferencingErrorInvalidDidURL = "invalidDidUrl"
+
+	// Media type of the selected service endpoints
+	ServiceEndpointContentType = "text/uri-list"
+)
+
+// DID URL parameters supported by dereferencing
+const (
+	ServiceParam      = "service"
+	RelativeRefParam  = "relativeRef"
+	VersionIDParam    = "versionId"
+	VersionTimeParam  = "versionTime"
+	ResourceNameParam = "resourceName"
+	ResourceTypeParam = "resourceType"
+)
+
+// ResourcesPathPrefix is the DID URL path prefix of DID-Linked Resources.
+// Format: <did>/resources/<resource-id>
+const ResourcesPathPrefix = "/resources/"
+
+func NewDereferencingMetadata(did string, contentType string, retrieved time.Time) DereferencingMetadata {
+	resolutionMetadata := didtypes.NewDidResolutionMetadata(did, contentType, retrieved)
+
+	return DereferencingMetadata{
+		ContentType: contentType,
+		Retrieved:   retrieved,
+		Did:         resolutionMetadata.Did,
+	}
+}
@@ -8,8 +8,11 @@ import (
 
 // x/resource module sentinel errors
 var (
-	ErrBadRequest      = sdkerrors.Register(ModuleName, 2000, "bad request")
-	ErrResourceExists  = sdkerrors.Register(ModuleName, 2200, "Resource exists")
-	ErrBasicValidation = sdkerrors.Register(ModuleName, 2205, "basic validation failed")
-	ErrInternal        = sdkerrors.Register(ModuleName, 2500, "internal error")
+	ErrBadRequest               = sdkerrors.Register(ModuleName, 2000, "bad request")
+	ErrResourceExists           = sdkerrors.Register(ModuleName, 2200, "Resource exists")
+	ErrResourceUploadNotFound   = sdkerrors.Register(ModuleName, 2201, "Resource upload not found")
+	ErrBasicValidation          = sdkerrors.Register(ModuleName, 2205, "basic validation failed")
+	ErrInvalidResourceChunk     = sdkerrors.Register(ModuleName, 2206, "invalid resource chunk")
+	ErrResourceChecksumMismatch = sdkerrors.Register(ModuleName, 2207, "resource checksum mismatch")
+	ErrInternal                 = sdkerrors.Register(ModuleName, 2500, "internal error")
 )
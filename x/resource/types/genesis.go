@@ -9,13 +9,19 @@ const (
 	DefaultCreateResourceJSONFee    = 25e8                   // 2.5 ARX or 2500000000 zarx
 	DefaultCreateResourceDefaultFee = 5e9                    // 5 ARX or 5000000000 zarx
 	DefaultBurnFactor               = "0.500000000000000000" // 0.5 or 50%
+
+	DefaultUploadChunkFee        = 25e8       // 2.5 ARX or 2500000000 zarx
+	DefaultUploadMaxChunkSize    = 200 * 1024 // 200KB
+	DefaultUploadMaxResourceSize = 10 << 20   // 10MB
+	DefaultUploadExpiryBlocks    = 14400      // about 24 hours with 6 seconds blocks
 )
 
 // DefaultGenesis returns the default `resource` genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Resources: []*ResourceWithMetadata{},
-		FeeParams: DefaultFeeParams(),
+		Resources:    []*ResourceWithMetadata{},
+		FeeParams:    DefaultFeeParams(),
+		UploadParams: DefaultUploadParams(),
 	}
 }
 
@@ -30,6 +36,12 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if gs.UploadParams != nil {
+		if err := gs.UploadParams.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
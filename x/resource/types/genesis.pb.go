@@ -29,6 +29,9 @@ type GenesisState struct {
 	// Fee parameters for the Resource module
 	// Defines fixed fees and burn percentage for resources
 	FeeParams *FeeParams `protobuf:"bytes,2,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// Chunked upload parameters for the Resource module
+	// Genesis files created before they were introduced use the defaults
+	UploadParams *UploadParams `protobuf:"bytes,3,opt,name=upload_params,json=uploadParams,proto3" json:"upload_params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetFeeParams() *FeeParams {
 	return nil
 }
 
+func (m *GenesisState) GetUploadParams() *UploadParams {
+	if m != nil {
+		return m.UploadParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cheqd.resource.v2.GenesisState")
 }
@@ -85,22 +95,24 @@ func init() {
 func init() { proto.RegisterFile("cheqd/resource/v2/genesis.proto", fileDescriptor_dd2827e54652b885) }
 
 var fileDescriptor_dd2827e54652b885 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
+	// 273 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x48, 0x2d,
 	0x4c, 0xd1, 0x2f, 0x4a, 0x2d, 0xce, 0x2f, 0x2d, 0x4a, 0x4e, 0xd5, 0x2f, 0x33, 0xd2, 0x4f, 0x4f,
 	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x2b, 0xd0,
 	0x83, 0x29, 0xd0, 0x2b, 0x33, 0x92, 0x92, 0xc6, 0xd4, 0x93, 0x96, 0x9a, 0x0a, 0x51, 0x2f, 0xa5,
-	0x80, 0x29, 0x09, 0xd7, 0x0b, 0x56, 0xa1, 0x34, 0x89, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70,
-	0x49, 0x62, 0x49, 0xaa, 0x90, 0x2b, 0x17, 0x27, 0x4c, 0x49, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06,
-	0xb7, 0x91, 0xba, 0x1e, 0x86, 0xb5, 0x7a, 0x41, 0x50, 0x76, 0x78, 0x66, 0x49, 0x86, 0x6f, 0x6a,
-	0x49, 0x62, 0x4a, 0x62, 0x49, 0x62, 0x10, 0x42, 0xa7, 0x90, 0x35, 0x17, 0x57, 0x5a, 0x6a, 0x6a,
-	0x7c, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x0c, 0x16,
-	0x73, 0xdc, 0x52, 0x53, 0x03, 0xc0, 0x6a, 0x82, 0x38, 0xd3, 0x60, 0x4c, 0x27, 0xcf, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0x4e, 0xcc, 0xcb, 0x2f, 0xd7, 0x4d, 0xce, 0xd7, 0x07, 0x9b, 0xaa,
-	0x9b, 0x97, 0x9f, 0x92, 0xaa, 0x5f, 0x81, 0xf0, 0x6b, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x9b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x0b, 0xa7, 0x90, 0x5b, 0x01, 0x00,
+	0x80, 0x29, 0x09, 0xd7, 0x0b, 0x51, 0x21, 0x87, 0xa9, 0xa2, 0xb4, 0x20, 0x27, 0x3f, 0x31, 0x05,
+	0x22, 0xaf, 0x74, 0x83, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0x86, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21,
+	0x57, 0x2e, 0x4e, 0x98, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x75, 0x3d, 0x0c,
+	0x67, 0xe9, 0x05, 0x41, 0xd9, 0xe1, 0x99, 0x25, 0x19, 0xbe, 0xa9, 0x25, 0x89, 0x29, 0x89, 0x25,
+	0x89, 0x41, 0x08, 0x9d, 0x42, 0xd6, 0x5c, 0x5c, 0x69, 0xa9, 0xa9, 0xf1, 0x05, 0x89, 0x45, 0x89,
+	0xb9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x32, 0x58, 0xcc, 0x71, 0x4b, 0x4d, 0x0d,
+	0x00, 0xab, 0x09, 0xe2, 0x4c, 0x83, 0x31, 0x85, 0x5c, 0xb8, 0x78, 0x21, 0x8e, 0x84, 0xe9, 0x67,
+	0x06, 0xeb, 0x97, 0xc7, 0xa2, 0x3f, 0x14, 0xac, 0x0e, 0x6a, 0x04, 0x4f, 0x29, 0x12, 0xcf, 0xc9,
+	0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
+	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33, 0x4b, 0x32,
+	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x93, 0x13, 0xf3, 0xf2, 0xcb, 0x75, 0x93, 0xf3, 0xf5,
+	0xc1, 0x66, 0xeb, 0xe6, 0xe5, 0xa7, 0xa4, 0xea, 0x57, 0x20, 0xc2, 0xab, 0xa4, 0xb2, 0x20, 0xb5,
+	0x38, 0x89, 0x0d, 0x1c, 0x58, 0xc6, 0x80, 0x01, 0x00, 0xa2, 0x65, 0xfc, 0x96, 0xc1, 0x01, 0x00,
 	0x00,
 }
 
@@ -124,6 +136,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UploadParams != nil {
+		{
+			size, err := m.UploadParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.FeeParams != nil {
 		{
 			size, err := m.FeeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -180,6 +204,10 @@ func (m *GenesisState) Size() (n int) {
 		l = m.FeeParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.UploadParams != nil {
+		l = m.UploadParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -288,6 +316,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadParams == nil {
+				m.UploadParams = &UploadParams{}
+			}
+			if err := m.UploadParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
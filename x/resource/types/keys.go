@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "resource"
@@ -18,6 +20,8 @@ const (
 	ResourceMetadataKey = "resource-metadata:"
 	ResourceDataKey     = "resource-data:"
 	ResourceCountKey    = "resource-count:"
+
+	ResourceLatestVersionKey = "resource-latest-version:"
 )
 
 // GetResourceDataKey returns the byte representation of resource key
@@ -34,3 +38,42 @@ func GetResourceMetadataKey(collectionID string, id string) []byte {
 func GetResourceMetadataCollectionPrefix(collectionID string) []byte {
 	return []byte(ResourceMetadataKey + collectionID + ":")
 }
+
+// GetResourceLatestVersionKey returns the byte representation of the key of the latest resource version index.
+// The name is length-prefixed, so names and types containing the separator can't collide.
+func GetResourceLatestVersionKey(collectionID string, name string, resourceType string) []byte {
+	return []byte(fmt.Sprintf("%s%s:%d:%s:%s", ResourceLatestVersionKey, collectionID, len(name), name, resourceType))
+}
+
+const (
+	ResourceUploadKey       = "resource-upload:"
+	ResourceUploadChunkKey  = "resource-upload-chunk:"
+	ResourceUploadExpiryKey = "resource-upload-expiry:"
+)
+
+// GetResourceUploadKey returns the byte representation of staged resource upload key
+func GetResourceUploadKey(collectionID string, id string) []byte {
+	return []byte(ResourceUploadKey + collectionID + ":" + id)
+}
+
+// GetResourceUploadChunkKey returns the byte representation of staged resource upload chunk key.
+// Chunk indexes are zero-padded, so chunks are iterated in order.
+func GetResourceUploadChunkKey(collectionID string, id string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s:%s:%020d", ResourceUploadChunkKey, collectionID, id, index))
+}
+
+// GetResourceUploadChunkPrefix used to iterate over all staged chunks of a resource upload
+func GetResourceUploadChunkPrefix(collectionID string, id string) []byte {
+	return []byte(ResourceUploadChunkKey + collectionID + ":" + id + ":")
+}
+
+// GetResourceUploadExpiryKey returns the byte representation of the expiry index key of a staged resource upload.
+// Heights are zero-padded, so uploads are iterated in the order of expiry.
+func GetResourceUploadExpiryKey(expiryHeight int64, collectionID string, id string) []byte {
+	return append(GetResourceUploadExpiryPrefix(expiryHeight), []byte(collectionID+":"+id)...)
+}
+
+// GetResourceUploadExpiryPrefix used to iterate over staged resource uploads which expire at the height
+func GetResourceUploadExpiryPrefix(expiryHeight int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d:", ResourceUploadExpiryKey, expiryHeight))
+}
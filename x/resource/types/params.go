@@ -7,12 +7,16 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-var ParamStoreKeyFeeParams = []byte("feeparams")
+var (
+	ParamStoreKeyFeeParams    = []byte("feeparams")
+	ParamStoreKeyUploadParams = []byte("uploadparams")
+)
 
 // ParamKeyTable returns the key declaration for parameters
 func ParamKeyTable() paramstypes.KeyTable {
 	return paramstypes.NewKeyTable(
 		paramstypes.NewParamSetPair(ParamStoreKeyFeeParams, FeeParams{}, validateFeeParams),
+		paramstypes.NewParamSetPair(ParamStoreKeyUploadParams, UploadParams{}, validateUploadParams),
 	)
 }
 
@@ -143,3 +147,43 @@ func validateFeeParams(i interface{}) error {
 
 	return v.ValidateBasic()
 }
+
+// DefaultUploadParams returns default chunked resource upload parameters
+func DefaultUploadParams() *UploadParams {
+	return &UploadParams{
+		Chunk:           sdk.NewCoin(BaseMinimalDenom, sdk.NewInt(DefaultUploadChunkFee)),
+		MaxChunkSize:    DefaultUploadMaxChunkSize,
+		MaxResourceSize: DefaultUploadMaxResourceSize,
+		ExpiryBlocks:    DefaultUploadExpiryBlocks,
+	}
+}
+
+// ValidateBasic performs basic validation of chunked resource upload parameters
+func (up *UploadParams) ValidateBasic() error {
+	if up.Chunk.IsNil() || !up.Chunk.IsPositive() || up.Chunk.Denom != BaseMinimalDenom {
+		return fmt.Errorf("invalid resource chunk tx fee: %s", up.Chunk)
+	}
+
+	if up.MaxChunkSize == 0 {
+		return fmt.Errorf("max chunk size must be positive")
+	}
+
+	if up.MaxResourceSize < up.MaxChunkSize {
+		return fmt.Errorf("max resource size must not be less than max chunk size: %d", up.MaxResourceSize)
+	}
+
+	if up.ExpiryBlocks <= 0 {
+		return fmt.Errorf("expiry blocks must be positive: %d", up.ExpiryBlocks)
+	}
+
+	return nil
+}
+
+func validateUploadParams(i interface{}) error {
+	v, ok := i.(UploadParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.ValidateBasic()
+}
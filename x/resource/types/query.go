@@ -4,4 +4,7 @@ const (
 	QueryGetResource            = "get-resource"
 	QueryGetResourceMetadata    = "get-resource-metadata"
 	QueryGetCollectionResources = "get-collection-resources"
+	QueryGetLatestResource      = "get-latest-resource"
+	QueryGetResourceVersions    = "get-resource-versions"
+	QueryDereference            = "dereference"
 )
@@ -6,10 +6,13 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/canow-co/cheqd-node/x/did/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	_ "github.com/cosmos/gogoproto/types"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +20,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -361,6 +366,449 @@ func (m *QueryCollectionResourcesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryLatestResourceRequest is the request type for the Query/LatestResource RPC method
+type QueryLatestResourceRequest struct {
+	// collection_id is an identifier of the DidDocument the resource belongs to.
+	// Format: <unique-identifier>
+	//
+	// Examples:
+	// - c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - wGHEXrZvJxR8vw5P3UWH1j
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// name is the human-readable name of the resource.
+	// Example: PassportSchema, EducationTrustRegistry
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// resource_type is the type of the resource.
+	// Example: AnonCredsSchema, StatusList2021
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+}
+
+func (m *QueryLatestResourceRequest) Reset()         { *m = QueryLatestResourceRequest{} }
+func (m *QueryLatestResourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestResourceRequest) ProtoMessage()    {}
+func (*QueryLatestResourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{6}
+}
+func (m *QueryLatestResourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestResourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestResourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestResourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestResourceRequest.Merge(m, src)
+}
+func (m *QueryLatestResourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestResourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestResourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestResourceRequest proto.InternalMessageInfo
+
+func (m *QueryLatestResourceRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *QueryLatestResourceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryLatestResourceRequest) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+// QueryLatestResourceResponse is the response type for the Query/LatestResource RPC method
+type QueryLatestResourceResponse struct {
+	// resource is the latest version of the resource with its metadata
+	Resource *ResourceWithMetadata `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+}
+
+func (m *QueryLatestResourceResponse) Reset()         { *m = QueryLatestResourceResponse{} }
+func (m *QueryLatestResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestResourceResponse) ProtoMessage()    {}
+func (*QueryLatestResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{7}
+}
+func (m *QueryLatestResourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestResourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestResourceResponse.Merge(m, src)
+}
+func (m *QueryLatestResourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestResourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestResourceResponse proto.InternalMessageInfo
+
+func (m *QueryLatestResourceResponse) GetResource() *ResourceWithMetadata {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+// QueryResourceVersionsRequest is the request type for the Query/ResourceVersions RPC method
+type QueryResourceVersionsRequest struct {
+	// collection_id is an identifier of the DidDocument the resource belongs to.
+	// Format: <unique-identifier>
+	//
+	// Examples:
+	// - c82f2b02-bdab-4dd7-b833-3e143745d612
+	// - wGHEXrZvJxR8vw5P3UWH1j
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// name is the human-readable name of the resource.
+	// Example: PassportSchema, EducationTrustRegistry
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// resource_type is the type of the resource.
+	// Example: AnonCredsSchema, StatusList2021
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+}
+
+func (m *QueryResourceVersionsRequest) Reset()         { *m = QueryResourceVersionsRequest{} }
+func (m *QueryResourceVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceVersionsRequest) ProtoMessage()    {}
+func (*QueryResourceVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{8}
+}
+func (m *QueryResourceVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceVersionsRequest.Merge(m, src)
+}
+func (m *QueryResourceVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryResourceVersionsRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *QueryResourceVersionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryResourceVersionsRequest) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+// QueryResourceVersionsResponse is the response type for the Query/ResourceVersions RPC method
+type QueryResourceVersionsResponse struct {
+	// resources is the metadata of the resource versions, from the latest to the first one
+	Resources []*Metadata `protobuf:"bytes,1,rep,name=resources,proto3" json:"linkedResourceMetadata"`
+}
+
+func (m *QueryResourceVersionsResponse) Reset()         { *m = QueryResourceVersionsResponse{} }
+func (m *QueryResourceVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceVersionsResponse) ProtoMessage()    {}
+func (*QueryResourceVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{9}
+}
+func (m *QueryResourceVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceVersionsResponse.Merge(m, src)
+}
+func (m *QueryResourceVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryResourceVersionsResponse) GetResources() []*Metadata {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// QueryDereferenceRequest is the request type for the Query/Dereference RPC method
+type QueryDereferenceRequest struct {
+	// did_url is the DID URL to dereference.
+	//
+	// Examples:
+	// - did:canow:testnet:c82f2b02-bdab-4dd7-b833-3e143745d612#key-1
+	// - did:canow:testnet:c82f2b02-bdab-4dd7-b833-3e143745d612?service=agent&relativeRef=/path
+	// - did:canow:testnet:c82f2b02-bdab-4dd7-b833-3e143745d612/resources/6e8bc430-9c3a-11d9-9669-0800200c9a66
+	// - did:canow:testnet:c82f2b02-bdab-4dd7-b833-3e143745d612?resourceName=Schema&resourceType=JsonSchema
+	DidUrl string `protobuf:"bytes,1,opt,name=did_url,json=didUrl,proto3" json:"did_url,omitempty"`
+	// accept is the requested media type of the content stream. OPTIONAL.
+	// Defaults to application/did+ld+json for DID Document content.
+	Accept string `protobuf:"bytes,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (m *QueryDereferenceRequest) Reset()         { *m = QueryDereferenceRequest{} }
+func (m *QueryDereferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDereferenceRequest) ProtoMessage()    {}
+func (*QueryDereferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{10}
+}
+func (m *QueryDereferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDereferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDereferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDereferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDereferenceRequest.Merge(m, src)
+}
+func (m *QueryDereferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDereferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDereferenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDereferenceRequest proto.InternalMessageInfo
+
+func (m *QueryDereferenceRequest) GetDidUrl() string {
+	if m != nil {
+		return m.DidUrl
+	}
+	return ""
+}
+
+func (m *QueryDereferenceRequest) GetAccept() string {
+	if m != nil {
+		return m.Accept
+	}
+	return ""
+}
+
+// QueryDereferenceResponse is the response type for the Query/Dereference RPC method
+type QueryDereferenceResponse struct {
+	// dereferencing_metadata contains information about the dereferencing process
+	DereferencingMetadata *DereferencingMetadata `protobuf:"bytes,1,opt,name=dereferencing_metadata,json=dereferencingMetadata,proto3" json:"dereferencingMetadata"`
+	// content_stream is the dereferenced resource in the representation described by the content type
+	ContentStream []byte `protobuf:"bytes,2,opt,name=content_stream,json=contentStream,proto3" json:"contentStream"`
+	// did_document_metadata is populated when the content is a DID Document or its part
+	DidDocumentMetadata *types.Metadata `protobuf:"bytes,3,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"didDocumentMetadata,omitempty"`
+	// resource_metadata is populated when the content is a DID-Linked Resource
+	ResourceMetadata *Metadata `protobuf:"bytes,4,opt,name=resource_metadata,json=resourceMetadata,proto3" json:"linkedResourceMetadata,omitempty"`
+}
+
+func (m *QueryDereferenceResponse) Reset()         { *m = QueryDereferenceResponse{} }
+func (m *QueryDereferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDereferenceResponse) ProtoMessage()    {}
+func (*QueryDereferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{11}
+}
+func (m *QueryDereferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDereferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDereferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDereferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDereferenceResponse.Merge(m, src)
+}
+func (m *QueryDereferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDereferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDereferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDereferenceResponse proto.InternalMessageInfo
+
+func (m *QueryDereferenceResponse) GetDereferencingMetadata() *DereferencingMetadata {
+	if m != nil {
+		return m.DereferencingMetadata
+	}
+	return nil
+}
+
+func (m *QueryDereferenceResponse) GetContentStream() []byte {
+	if m != nil {
+		return m.ContentStream
+	}
+	return nil
+}
+
+func (m *QueryDereferenceResponse) GetDidDocumentMetadata() *types.Metadata {
+	if m != nil {
+		return m.DidDocumentMetadata
+	}
+	return nil
+}
+
+func (m *QueryDereferenceResponse) GetResourceMetadata() *Metadata {
+	if m != nil {
+		return m.ResourceMetadata
+	}
+	return nil
+}
+
+// DereferencingMetadata contains information about the DID URL dereferencing process
+type DereferencingMetadata struct {
+	// content_type is the media type of the content stream
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"contentType,omitempty"`
+	// error is the error code of the dereferencing process, if any.
+	// Examples: invalidDidUrl, notFound, representationNotSupported
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// retrieved is the time of dereferencing
+	Retrieved time.Time `protobuf:"bytes,3,opt,name=retrieved,proto3,stdtime" json:"retrieved"`
+	// did contains properties of the DID the URL is based on
+	Did *types.DidProperties `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *DereferencingMetadata) Reset()         { *m = DereferencingMetadata{} }
+func (m *DereferencingMetadata) String() string { return proto.CompactTextString(m) }
+func (*DereferencingMetadata) ProtoMessage()    {}
+func (*DereferencingMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14284472e64722d9, []int{12}
+}
+func (m *DereferencingMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DereferencingMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DereferencingMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DereferencingMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DereferencingMetadata.Merge(m, src)
+}
+func (m *DereferencingMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DereferencingMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DereferencingMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DereferencingMetadata proto.InternalMessageInfo
+
+func (m *DereferencingMetadata) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *DereferencingMetadata) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DereferencingMetadata) GetRetrieved() time.Time {
+	if m != nil {
+		return m.Retrieved
+	}
+	return time.Time{}
+}
+
+func (m *DereferencingMetadata) GetDid() *types.DidProperties {
+	if m != nil {
+		return m.Did
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryResourceRequest)(nil), "cheqd.resource.v2.QueryResourceRequest")
 	proto.RegisterType((*QueryResourceResponse)(nil), "cheqd.resource.v2.QueryResourceResponse")
@@ -368,47 +816,84 @@ func init() {
 	proto.RegisterType((*QueryResourceMetadataResponse)(nil), "cheqd.resource.v2.QueryResourceMetadataResponse")
 	proto.RegisterType((*QueryCollectionResourcesRequest)(nil), "cheqd.resource.v2.QueryCollectionResourcesRequest")
 	proto.RegisterType((*QueryCollectionResourcesResponse)(nil), "cheqd.resource.v2.QueryCollectionResourcesResponse")
+	proto.RegisterType((*QueryLatestResourceRequest)(nil), "cheqd.resource.v2.QueryLatestResourceRequest")
+	proto.RegisterType((*QueryLatestResourceResponse)(nil), "cheqd.resource.v2.QueryLatestResourceResponse")
+	proto.RegisterType((*QueryResourceVersionsRequest)(nil), "cheqd.resource.v2.QueryResourceVersionsRequest")
+	proto.RegisterType((*QueryResourceVersionsResponse)(nil), "cheqd.resource.v2.QueryResourceVersionsResponse")
+	proto.RegisterType((*QueryDereferenceRequest)(nil), "cheqd.resource.v2.QueryDereferenceRequest")
+	proto.RegisterType((*QueryDereferenceResponse)(nil), "cheqd.resource.v2.QueryDereferenceResponse")
+	proto.RegisterType((*DereferencingMetadata)(nil), "cheqd.resource.v2.DereferencingMetadata")
 }
 
 func init() { proto.RegisterFile("cheqd/resource/v2/query.proto", fileDescriptor_14284472e64722d9) }
 
 var fileDescriptor_14284472e64722d9 = []byte{
-	// 553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x18, 0xcd, 0xc4, 0x1f, 0xb4, 0x53, 0x15, 0x1d, 0xab, 0x84, 0xb5, 0xdd, 0x86, 0x08, 0xb6, 0x28,
-	0x99, 0x31, 0x9b, 0x93, 0xe2, 0x29, 0x05, 0xa5, 0x88, 0xa0, 0x29, 0x22, 0x88, 0x20, 0x93, 0xdd,
-	0x61, 0x33, 0x98, 0xec, 0x6c, 0x32, 0x93, 0x68, 0x29, 0xbd, 0xf8, 0x07, 0x88, 0xa0, 0x07, 0xff,
-	0x0f, 0x4f, 0x5e, 0x3d, 0x79, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0x78, 0xf2, 0xaf, 0x90, 0xcc, 0xee,
-	0xec, 0xb6, 0xd9, 0x0d, 0x69, 0xe8, 0x6d, 0x98, 0xef, 0x7b, 0xef, 0x7b, 0xef, 0x9b, 0xb7, 0x0b,
-	0xd7, 0xdd, 0x36, 0xeb, 0x79, 0xa4, 0xcf, 0xa4, 0x18, 0xf4, 0x5d, 0x46, 0x86, 0x0e, 0xe9, 0x0d,
-	0x58, 0x7f, 0x0f, 0x87, 0x7d, 0xa1, 0x04, 0xba, 0xa2, 0xcb, 0xd8, 0x94, 0xf1, 0xd0, 0xb1, 0xca,
-	0x59, 0x44, 0x52, 0xd6, 0x20, 0xeb, 0xb6, 0x2b, 0x64, 0x57, 0x48, 0xd2, 0xa2, 0x92, 0x45, 0x6c,
-	0x64, 0x58, 0x6b, 0x31, 0x45, 0x6b, 0x24, 0xa4, 0x3e, 0x0f, 0xa8, 0xe2, 0x22, 0x88, 0x7b, 0x57,
-	0x7d, 0xe1, 0x0b, 0x7d, 0x24, 0x93, 0x53, 0x7c, 0xbb, 0xe6, 0x0b, 0xe1, 0x77, 0x18, 0xa1, 0x21,
-	0x27, 0x34, 0x08, 0x84, 0xd2, 0x10, 0x19, 0x55, 0x2b, 0x8f, 0xe1, 0xea, 0xb3, 0x09, 0x6b, 0x33,
-	0x1e, 0xdb, 0x64, 0xbd, 0x01, 0x93, 0x0a, 0xdd, 0x84, 0x17, 0x5d, 0xd1, 0xe9, 0x30, 0x77, 0xd2,
-	0xfc, 0x9a, 0x7b, 0x25, 0x50, 0x06, 0x5b, 0xcb, 0xcd, 0x0b, 0xe9, 0xe5, 0x8e, 0x87, 0x2e, 0xc1,
-	0x22, 0xf7, 0x4a, 0x45, 0x5d, 0x29, 0x72, 0xaf, 0xf2, 0x0a, 0x5e, 0x9b, 0x22, 0x93, 0xa1, 0x08,
-	0x24, 0x43, 0xdb, 0x70, 0xc9, 0xf8, 0xd2, 0x44, 0x2b, 0xce, 0x26, 0xce, 0x6c, 0x03, 0x1b, 0xd8,
-	0x0b, 0xae, 0xda, 0x4f, 0x98, 0xa2, 0x1e, 0x55, 0xb4, 0x99, 0x00, 0x2b, 0xbb, 0x70, 0xed, 0x18,
-	0x7b, 0xd2, 0x72, 0x1a, 0xc9, 0x0a, 0xae, 0xcf, 0x20, 0x8d, 0xa5, 0xef, 0x66, 0xa4, 0xdf, 0xc8,
-	0x91, 0x6e, 0x60, 0x0d, 0xeb, 0xdf, 0xef, 0x8d, 0xeb, 0x1d, 0x1e, 0xbc, 0x61, 0x5e, 0x86, 0x32,
-	0xb5, 0xf2, 0x01, 0xc0, 0x0d, 0x3d, 0x76, 0x3b, 0xd1, 0x66, 0xba, 0xe5, 0x42, 0x76, 0x1e, 0x42,
-	0x98, 0xc6, 0x40, 0xdb, 0x5a, 0x71, 0x6e, 0xe1, 0x28, 0x33, 0x78, 0x92, 0x19, 0x1c, 0x25, 0x30,
-	0xce, 0x0c, 0x7e, 0x4a, 0x7d, 0xf3, 0xc4, 0xcd, 0x23, 0xc8, 0xca, 0x77, 0x00, 0xcb, 0xb3, 0x05,
-	0xc5, 0xab, 0x78, 0x0e, 0x97, 0x8d, 0x03, 0x59, 0x02, 0xe5, 0x33, 0xa7, 0xd9, 0x45, 0xca, 0x84,
-	0x1e, 0xe5, 0x78, 0xd8, 0x9c, 0xeb, 0x21, 0xd2, 0x74, 0xd4, 0x84, 0xf3, 0xf9, 0x2c, 0x3c, 0xa7,
-	0x4d, 0xa0, 0x2f, 0x00, 0x2e, 0x99, 0x91, 0x28, 0x2f, 0x6a, 0x79, 0x99, 0xb7, 0xb6, 0xe6, 0x37,
-	0x46, 0x53, 0x2b, 0xf7, 0xde, 0xff, 0xfc, 0xfb, 0xa9, 0x58, 0x47, 0x35, 0x92, 0xfd, 0x80, 0xf7,
-	0x8f, 0xbd, 0xda, 0x41, 0x52, 0x93, 0x64, 0x9f, 0x7b, 0x07, 0xe8, 0x1b, 0x80, 0x97, 0xa7, 0xb7,
-	0x81, 0xc8, 0xbc, 0xc9, 0x53, 0x59, 0xb7, 0xee, 0x9e, 0x1c, 0x10, 0x4b, 0x6e, 0x68, 0xc9, 0x0f,
-	0xd0, 0xfd, 0x85, 0x25, 0x93, 0xae, 0x91, 0xf9, 0x15, 0xc0, 0xab, 0x39, 0x01, 0x41, 0xce, 0x2c,
-	0x35, 0xb3, 0xe3, 0x6d, 0xd5, 0x17, 0xc2, 0xc4, 0x26, 0xea, 0xda, 0x44, 0x15, 0xdd, 0x39, 0x81,
-	0x09, 0xa3, 0xba, 0xb1, 0xf3, 0x63, 0x64, 0x83, 0xc3, 0x91, 0x0d, 0xfe, 0x8c, 0x6c, 0xf0, 0x71,
-	0x6c, 0x17, 0x0e, 0xc7, 0x76, 0xe1, 0xd7, 0xd8, 0x2e, 0xbc, 0x24, 0x3e, 0x57, 0xed, 0x41, 0x0b,
-	0xbb, 0xa2, 0x4b, 0x5c, 0x1a, 0x88, 0xb7, 0x55, 0x57, 0x44, 0xcc, 0xd5, 0x40, 0x78, 0x8c, 0xbc,
-	0x4b, 0x07, 0xa8, 0xbd, 0x90, 0xc9, 0xd6, 0x79, 0xfd, 0xd3, 0xac, 0xff, 0x0f, 0x00, 0x00, 0xff,
-	0xff, 0x66, 0x2c, 0xc6, 0x36, 0xea, 0x05, 0x00, 0x00,
+	// 1040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0xec, 0x36, 0x4d, 0x5f, 0xd2, 0xd0, 0x6c, 0x9a, 0xd4, 0x55, 0x1b, 0xcb, 0x08, 0xa6,
+	0x4d, 0x1b, 0x22, 0x35, 0xce, 0x05, 0x98, 0x1e, 0x18, 0x37, 0x03, 0x53, 0x7e, 0xcc, 0x14, 0xa5,
+	0x85, 0x19, 0x86, 0x19, 0x8f, 0xac, 0xdd, 0x3a, 0x3b, 0x58, 0x5a, 0x45, 0x5a, 0x1b, 0xdc, 0xd2,
+	0x0b, 0x07, 0x8e, 0x9d, 0xce, 0x70, 0xe1, 0xcc, 0x91, 0x0b, 0x07, 0x4e, 0x5c, 0x39, 0xf5, 0xd8,
+	0x19, 0x2e, 0x9c, 0x0c, 0x93, 0x70, 0xf2, 0x3f, 0xc0, 0x95, 0xd1, 0x6a, 0x25, 0xcb, 0xb6, 0x3c,
+	0x76, 0x5a, 0xe0, 0x66, 0xed, 0x7b, 0xdf, 0x7b, 0xdf, 0xf7, 0xf6, 0x93, 0x9e, 0x61, 0xc3, 0x39,
+	0x20, 0x87, 0xd8, 0x0c, 0x48, 0xc8, 0xda, 0x81, 0x43, 0xcc, 0x4e, 0xd5, 0x3c, 0x6c, 0x93, 0xa0,
+	0x6b, 0xf8, 0x01, 0xe3, 0x0c, 0xad, 0x88, 0xb0, 0x91, 0x84, 0x8d, 0x4e, 0x55, 0xbd, 0x14, 0x23,
+	0x30, 0xc5, 0x51, 0x32, 0xa6, 0x18, 0x33, 0x27, 0xce, 0x56, 0x4b, 0x43, 0xa1, 0x4c, 0x1d, 0xb5,
+	0x32, 0xde, 0x26, 0xad, 0x19, 0x67, 0xdc, 0x70, 0x58, 0xe8, 0xb2, 0xd0, 0x6c, 0xd8, 0x21, 0x89,
+	0xa1, 0x66, 0x67, 0xa7, 0x41, 0xb8, 0xbd, 0x63, 0xfa, 0x76, 0x93, 0x7a, 0x36, 0xa7, 0xcc, 0x93,
+	0xb9, 0x17, 0x9a, 0xac, 0xc9, 0xc4, 0x4f, 0x33, 0xfa, 0x25, 0x4f, 0xaf, 0x34, 0x19, 0x6b, 0xb6,
+	0x88, 0x69, 0xfb, 0xd4, 0xb4, 0x3d, 0x8f, 0x71, 0x01, 0x09, 0x65, 0x54, 0x93, 0x51, 0xf1, 0xd4,
+	0x68, 0x3f, 0x30, 0x39, 0x75, 0x49, 0xc8, 0x6d, 0xd7, 0x8f, 0x13, 0xf4, 0x0f, 0xe0, 0xc2, 0xc7,
+	0x51, 0x5b, 0x4b, 0xf2, 0xb2, 0xc8, 0x61, 0x9b, 0x84, 0x1c, 0xbd, 0x06, 0xe7, 0x1c, 0xd6, 0x6a,
+	0x11, 0x27, 0xaa, 0x56, 0xa7, 0xb8, 0xa4, 0x54, 0x94, 0xcd, 0xb3, 0xd6, 0xd2, 0xe0, 0xf0, 0x0e,
+	0x46, 0xcb, 0x50, 0xa0, 0xb8, 0x54, 0x10, 0x91, 0x02, 0xc5, 0xfa, 0xe7, 0xb0, 0x36, 0x52, 0x2c,
+	0xf4, 0x99, 0x17, 0x12, 0x74, 0x1b, 0x16, 0x12, 0xe1, 0xa2, 0xd0, 0x62, 0xf5, 0x9a, 0x31, 0x36,
+	0x63, 0x23, 0x81, 0x7d, 0x4a, 0xf9, 0xc1, 0x47, 0x84, 0xdb, 0xd8, 0xe6, 0xb6, 0x95, 0x02, 0xf5,
+	0x7d, 0xb8, 0x32, 0x54, 0x3d, 0x4d, 0x79, 0x19, 0xca, 0x1c, 0x36, 0x26, 0x14, 0x95, 0xd4, 0xf7,
+	0xc7, 0xa8, 0x5f, 0xce, 0xa1, 0x9e, 0xc0, 0x6a, 0x6a, 0xbf, 0xa7, 0xad, 0xb7, 0xa8, 0xf7, 0x05,
+	0xc1, 0x63, 0x25, 0x07, 0x52, 0x9e, 0x28, 0xa0, 0x89, 0xb6, 0xb7, 0x53, 0x6e, 0x49, 0x76, 0x78,
+	0x22, 0x39, 0xef, 0x02, 0x0c, 0x7c, 0x22, 0x64, 0x2d, 0x56, 0xaf, 0x1a, 0xb1, 0xa9, 0x8c, 0xc8,
+	0x54, 0x46, 0xec, 0x47, 0x69, 0x2a, 0xe3, 0xae, 0xdd, 0x4c, 0xae, 0xd8, 0xca, 0x20, 0xf5, 0x5f,
+	0x15, 0xa8, 0x4c, 0x26, 0x24, 0x47, 0x71, 0x1f, 0xce, 0x26, 0x0a, 0xc2, 0x92, 0x52, 0x29, 0xbe,
+	0xcc, 0x2c, 0x06, 0x95, 0xd0, 0x7b, 0x39, 0x1a, 0xae, 0x4d, 0xd5, 0x10, 0x73, 0x1a, 0x12, 0xf1,
+	0x10, 0x54, 0xa1, 0xe1, 0x43, 0x9b, 0x47, 0xfa, 0x5e, 0xc4, 0xd1, 0x08, 0x4e, 0x79, 0xb6, 0x4b,
+	0xa4, 0x41, 0xc4, 0xef, 0x08, 0x98, 0x90, 0xad, 0xf3, 0xae, 0x4f, 0x4a, 0xc5, 0x18, 0x98, 0x1c,
+	0xde, 0xeb, 0xfa, 0x44, 0x6f, 0xc0, 0xe5, 0xdc, 0xde, 0xff, 0xe6, 0x0b, 0xf0, 0xf5, 0xc8, 0x0b,
+	0xf0, 0x09, 0x09, 0xc2, 0xe8, 0x5d, 0xff, 0x7f, 0x14, 0x76, 0x60, 0x63, 0x42, 0xf7, 0xff, 0xd4,
+	0x1e, 0xfa, 0xfb, 0x70, 0x51, 0xf4, 0xdd, 0x23, 0x01, 0x79, 0x40, 0x02, 0xe2, 0x0d, 0xae, 0xf4,
+	0x22, 0x9c, 0xc1, 0x14, 0xd7, 0xdb, 0x41, 0x4b, 0x4a, 0x9d, 0xc7, 0x14, 0xdf, 0x0f, 0x5a, 0x68,
+	0x1d, 0xe6, 0x6d, 0xc7, 0x21, 0x3e, 0x97, 0x32, 0xe5, 0x93, 0xfe, 0x53, 0x11, 0x4a, 0xe3, 0xc5,
+	0x24, 0xff, 0x87, 0xb0, 0x8e, 0xd3, 0x63, 0xea, 0x35, 0xeb, 0xae, 0x64, 0x23, 0x6f, 0x6c, 0x33,
+	0x47, 0xcc, 0x5e, 0x16, 0x90, 0x2a, 0xbb, 0xd4, 0xef, 0x69, 0x6b, 0x38, 0x2f, 0x64, 0xe5, 0x1f,
+	0xa3, 0x37, 0x61, 0xd9, 0x61, 0x1e, 0x27, 0x1e, 0xaf, 0x87, 0x3c, 0x20, 0xb6, 0x2b, 0x88, 0x2f,
+	0xd5, 0x56, 0xfa, 0x3d, 0xed, 0x9c, 0x8c, 0xec, 0x8b, 0x80, 0x35, 0xfc, 0x88, 0x5c, 0x58, 0x8b,
+	0x66, 0x80, 0x99, 0xd3, 0x76, 0x23, 0x78, 0x4a, 0xba, 0x28, 0x48, 0xaf, 0x4b, 0xd2, 0x98, 0xe2,
+	0xa1, 0xe1, 0xbf, 0xda, 0xef, 0x69, 0x1b, 0x98, 0xe2, 0x3d, 0x89, 0x4b, 0x02, 0x6f, 0x30, 0x97,
+	0x72, 0xe2, 0xfa, 0xbc, 0x6b, 0xad, 0xe6, 0x84, 0x91, 0x07, 0x2b, 0xa9, 0x55, 0xd2, 0x56, 0xa7,
+	0xa6, 0x7f, 0x17, 0x5f, 0xef, 0xf7, 0xb4, 0x4a, 0xfe, 0x65, 0x67, 0x5a, 0x9e, 0x0f, 0x46, 0x62,
+	0xfa, 0xb7, 0x05, 0x58, 0xcb, 0x1d, 0x32, 0xba, 0x05, 0x4b, 0xc9, 0xc8, 0x84, 0x67, 0x85, 0x03,
+	0xe2, 0xd1, 0xcb, 0xf3, 0xc8, 0xb6, 0x99, 0xe2, 0x8b, 0x99, 0x63, 0x74, 0x1d, 0x4e, 0x93, 0x20,
+	0x60, 0x41, 0x6c, 0x90, 0xda, 0x6a, 0xbf, 0xa7, 0xbd, 0x22, 0x0e, 0x32, 0x80, 0x38, 0x03, 0xd5,
+	0x22, 0x5f, 0xf3, 0x80, 0x92, 0x0e, 0xc1, 0x72, 0xaa, 0xaa, 0x11, 0xef, 0x55, 0x23, 0xd9, 0xab,
+	0xc6, 0xbd, 0x64, 0xaf, 0xd6, 0x16, 0x9e, 0xf5, 0xb4, 0xb9, 0xa7, 0x7f, 0x68, 0x8a, 0x35, 0x80,
+	0xa1, 0x77, 0xa0, 0x88, 0x29, 0x1e, 0x19, 0x94, 0xbc, 0x93, 0x3d, 0x8a, 0xef, 0x06, 0xcc, 0x27,
+	0x01, 0xa7, 0x24, 0x8c, 0x6f, 0x1c, 0x53, 0x9c, 0xe1, 0x11, 0x41, 0xab, 0x7f, 0x9f, 0x81, 0xd3,
+	0xc2, 0xba, 0xe8, 0x7b, 0x05, 0x16, 0x92, 0x19, 0xa2, 0xbc, 0xcf, 0x48, 0xde, 0x42, 0x57, 0x37,
+	0xa7, 0x27, 0xc6, 0xef, 0x81, 0xfe, 0xd6, 0x37, 0xbf, 0xfd, 0xf5, 0x5d, 0x61, 0x17, 0xed, 0x98,
+	0xe3, 0x7f, 0x5f, 0x1e, 0x0d, 0x7d, 0x60, 0x1e, 0xa7, 0xb1, 0xd0, 0x7c, 0x44, 0xf1, 0x63, 0xf4,
+	0x8b, 0x02, 0xe7, 0x47, 0xaf, 0x17, 0x99, 0xd3, 0x3a, 0x8f, 0x2c, 0x72, 0xf5, 0xe6, 0xec, 0x00,
+	0x49, 0xb9, 0x26, 0x28, 0xdf, 0x42, 0x6f, 0x9f, 0x98, 0xb2, 0x99, 0x98, 0x18, 0xfd, 0xac, 0xc0,
+	0x6a, 0xce, 0xf6, 0x43, 0xd5, 0x49, 0x6c, 0x26, 0xef, 0x6e, 0x75, 0xf7, 0x44, 0x18, 0x29, 0x62,
+	0x57, 0x88, 0xd8, 0x46, 0x5b, 0x33, 0x88, 0x48, 0x59, 0xff, 0xa0, 0xc0, 0xf2, 0xf0, 0xce, 0x41,
+	0xdb, 0x93, 0x9a, 0xe7, 0xee, 0x45, 0xd5, 0x98, 0x35, 0x5d, 0xd2, 0xdc, 0x11, 0x34, 0xb7, 0xd0,
+	0xf5, 0x19, 0x68, 0xb6, 0x44, 0x09, 0xf4, 0x63, 0xc6, 0x16, 0xc9, 0xda, 0x98, 0x6e, 0x8b, 0x91,
+	0xf5, 0xa6, 0xde, 0x9c, 0x1d, 0xf0, 0x02, 0x13, 0xed, 0x24, 0xbc, 0x9e, 0x28, 0xb0, 0x98, 0x59,
+	0x0f, 0xe8, 0xc6, 0xa4, 0xb6, 0xe3, 0x0b, 0x49, 0xdd, 0x9a, 0x29, 0x57, 0xb2, 0xbb, 0x2a, 0xd8,
+	0x55, 0x50, 0x39, 0x87, 0xdd, 0x60, 0x4b, 0x90, 0xda, 0x9d, 0x67, 0x47, 0x65, 0xe5, 0xf9, 0x51,
+	0x59, 0xf9, 0xf3, 0xa8, 0xac, 0x3c, 0x3d, 0x2e, 0xcf, 0x3d, 0x3f, 0x2e, 0xcf, 0xfd, 0x7e, 0x5c,
+	0x9e, 0xfb, 0xcc, 0x6c, 0x52, 0x7e, 0xd0, 0x6e, 0x18, 0x0e, 0x73, 0x4d, 0xc7, 0xf6, 0xd8, 0x97,
+	0xdb, 0x0e, 0x8b, 0x8b, 0x6d, 0x7b, 0x0c, 0x13, 0xf3, 0xab, 0x41, 0xcd, 0xe8, 0x13, 0x19, 0x36,
+	0xe6, 0xc5, 0xf7, 0x6a, 0xf7, 0x9f, 0x01, 0x00, 0xe5, 0x0e, 0xcb, 0x45, 0x00, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -429,6 +914,13 @@ type QueryClient interface {
 	ResourceMetadata(ctx context.Context, in *QueryResourceMetadataRequest, opts ...grpc.CallOption) (*QueryResourceMetadataResponse, error)
 	// Fetch metadata for all resources in a collection
 	CollectionResources(ctx context.Context, in *QueryCollectionResourcesRequest, opts ...grpc.CallOption) (*QueryCollectionResourcesResponse, error)
+	// Fetch the latest version of a resource with the given name and type in a collection
+	LatestResource(ctx context.Context, in *QueryLatestResourceRequest, opts ...grpc.CallOption) (*QueryLatestResourceResponse, error)
+	// Fetch metadata for all versions of a resource with the given name and type in a collection
+	ResourceVersions(ctx context.Context, in *QueryResourceVersionsRequest, opts ...grpc.CallOption) (*QueryResourceVersionsResponse, error)
+	// Dereference a DID URL according to the W3C DID Resolution specification.
+	// Supports DID Document fragments, service endpoint selection and DID-Linked Resources.
+	Dereference(ctx context.Context, in *QueryDereferenceRequest, opts ...grpc.CallOption) (*QueryDereferenceResponse, error)
 }
 
 type queryClient struct {
@@ -466,7 +958,34 @@ func (c *queryClient) CollectionResources(ctx context.Context, in *QueryCollecti
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
+func (c *queryClient) LatestResource(ctx context.Context, in *QueryLatestResourceRequest, opts ...grpc.CallOption) (*QueryLatestResourceResponse, error) {
+	out := new(QueryLatestResourceResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Query/LatestResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceVersions(ctx context.Context, in *QueryResourceVersionsRequest, opts ...grpc.CallOption) (*QueryResourceVersionsResponse, error) {
+	out := new(QueryResourceVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Query/ResourceVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Dereference(ctx context.Context, in *QueryDereferenceRequest, opts ...grpc.CallOption) (*QueryDereferenceResponse, error) {
+	out := new(QueryDereferenceResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Query/Dereference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Fetch data/payload for a specific resource (without metadata)
 	Resource(context.Context, *QueryResourceRequest) (*QueryResourceResponse, error)
@@ -474,6 +993,13 @@ type QueryServer interface {
 	ResourceMetadata(context.Context, *QueryResourceMetadataRequest) (*QueryResourceMetadataResponse, error)
 	// Fetch metadata for all resources in a collection
 	CollectionResources(context.Context, *QueryCollectionResourcesRequest) (*QueryCollectionResourcesResponse, error)
+	// Fetch the latest version of a resource with the given name and type in a collection
+	LatestResource(context.Context, *QueryLatestResourceRequest) (*QueryLatestResourceResponse, error)
+	// Fetch metadata for all versions of a resource with the given name and type in a collection
+	ResourceVersions(context.Context, *QueryResourceVersionsRequest) (*QueryResourceVersionsResponse, error)
+	// Dereference a DID URL according to the W3C DID Resolution specification.
+	// Supports DID Document fragments, service endpoint selection and DID-Linked Resources.
+	Dereference(context.Context, *QueryDereferenceRequest) (*QueryDereferenceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -489,6 +1015,15 @@ func (*UnimplementedQueryServer) ResourceMetadata(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) CollectionResources(ctx context.Context, req *QueryCollectionResourcesRequest) (*QueryCollectionResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectionResources not implemented")
 }
+func (*UnimplementedQueryServer) LatestResource(ctx context.Context, req *QueryLatestResourceRequest) (*QueryLatestResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestResource not implemented")
+}
+func (*UnimplementedQueryServer) ResourceVersions(ctx context.Context, req *QueryResourceVersionsRequest) (*QueryResourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceVersions not implemented")
+}
+func (*UnimplementedQueryServer) Dereference(ctx context.Context, req *QueryDereferenceRequest) (*QueryDereferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dereference not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -548,6 +1083,60 @@ func _Query_CollectionResources_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LatestResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Query/LatestResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestResource(ctx, req.(*QueryLatestResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Query/ResourceVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceVersions(ctx, req.(*QueryResourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dereference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDereferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dereference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Query/Dereference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dereference(ctx, req.(*QueryDereferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cheqd.resource.v2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -564,6 +1153,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollectionResources",
 			Handler:    _Query_CollectionResources_Handler,
 		},
+		{
+			MethodName: "LatestResource",
+			Handler:    _Query_LatestResource_Handler,
+		},
+		{
+			MethodName: "ResourceVersions",
+			Handler:    _Query_ResourceVersions_Handler,
+		},
+		{
+			MethodName: "Dereference",
+			Handler:    _Query_Dereference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/resource/v2/query.proto",
@@ -804,120 +1405,1445 @@ func (m *QueryCollectionResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLatestResourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryResourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryLatestResourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLatestResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResourceMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResourceVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResourceMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResourceVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCollectionResourcesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDereferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDereferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDereferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DidUrl) > 0 {
+		i -= len(m.DidUrl)
+		copy(dAtA[i:], m.DidUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCollectionResourcesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDereferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryDereferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDereferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceMetadata != nil {
+		{
+			size, err := m.ResourceMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentStream) > 0 {
+		i -= len(m.ContentStream)
+		copy(dAtA[i:], m.ContentStream)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentStream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DereferencingMetadata != nil {
+		{
+			size, err := m.DereferencingMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DereferencingMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DereferencingMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DereferencingMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Did != nil {
+		{
+			size, err := m.Did.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Retrieved, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Retrieved):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryResourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollectionResourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollectionResourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestResourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDereferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDereferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DereferencingMetadata != nil {
+		l = m.DereferencingMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContentStream)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResourceMetadata != nil {
+		l = m.ResourceMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DereferencingMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Retrieved)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Did != nil {
+		l = m.Did.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryResourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &ResourceWithMetadata{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Metadata{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectionResourcesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectionResourcesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectionResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectionResourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectionResourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectionResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &Metadata{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestResourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestResourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestResourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &ResourceWithMetadata{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryResourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,10 +2866,10 @@ func (m *QueryResourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -980,7 +2906,7 @@ func (m *QueryResourceRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1008,7 +2934,39 @@ func (m *QueryResourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1031,7 +2989,7 @@ func (m *QueryResourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,15 +3012,15 @@ func (m *QueryResourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1089,10 +3047,8 @@ func (m *QueryResourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &ResourceWithMetadata{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, &Metadata{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1117,7 +3073,7 @@ func (m *QueryResourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResourceMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDereferenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1140,15 +3096,15 @@ func (m *QueryResourceMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResourceMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDereferenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResourceMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDereferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1176,11 +3132,11 @@ func (m *QueryResourceMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			m.DidUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1208,7 +3164,7 @@ func (m *QueryResourceMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Accept = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1231,7 +3187,7 @@ func (m *QueryResourceMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResourceMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDereferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1254,15 +3210,15 @@ func (m *QueryResourceMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResourceMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDereferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResourceMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDereferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DereferencingMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1289,68 +3245,52 @@ func (m *QueryResourceMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Metadata{}
+			if m.DereferencingMetadata == nil {
+				m.DereferencingMetadata = &DereferencingMetadata{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DereferencingMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentStream", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCollectionResourcesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ContentStream = append(m.ContentStream[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentStream == nil {
+				m.ContentStream = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollectionResourcesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollectionResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1360,27 +3300,31 @@ func (m *QueryCollectionResourcesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &types.Metadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1407,10 +3351,10 @@ func (m *QueryCollectionResourcesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.ResourceMetadata == nil {
+				m.ResourceMetadata = &Metadata{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1435,7 +3379,7 @@ func (m *QueryCollectionResourcesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollectionResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *DereferencingMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,15 +3402,79 @@ func (m *QueryCollectionResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollectionResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DereferencingMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollectionResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DereferencingMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrieved", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1493,14 +3501,13 @@ func (m *QueryCollectionResourcesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &Metadata{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Retrieved, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1527,10 +3534,10 @@ func (m *QueryCollectionResourcesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Did == nil {
+				m.Did = &types.DidProperties{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Did.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,7 @@
+package types
+
+import "github.com/canow-co/cheqd-node/x/did/utils"
+
+func (query *QueryDereferenceRequest) Normalize() {
+	query.DidUrl = utils.NormalizeDIDUrl(query.DidUrl)
+}
@@ -0,0 +1,9 @@
+package types
+
+import (
+	"github.com/canow-co/cheqd-node/x/did/utils"
+)
+
+func (query *QueryLatestResourceRequest) Normalize() {
+	query.CollectionId = utils.NormalizeID(query.CollectionId)
+}
@@ -0,0 +1,18 @@
+package types
+
+// ToResource builds the resource from the staged upload and its data
+func (upload *ResourceUpload) ToResource(data []byte) ResourceWithMetadata {
+	return ResourceWithMetadata{
+		Metadata: &Metadata{
+			CollectionId: upload.CollectionId,
+			Id:           upload.Id,
+			Name:         upload.Name,
+			Version:      upload.Version,
+			ResourceType: upload.ResourceType,
+			AlsoKnownAs:  upload.AlsoKnownAs,
+		},
+		Resource: &Resource{
+			Data: data,
+		},
+	}
+}
@@ -258,385 +258,2955 @@ func (m *MsgCreateResourceResponse) GetResource() *Metadata {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgCreateResource)(nil), "cheqd.resource.v2.MsgCreateResource")
-	proto.RegisterType((*MsgCreateResourcePayload)(nil), "cheqd.resource.v2.MsgCreateResourcePayload")
-	proto.RegisterType((*MsgCreateResourceResponse)(nil), "cheqd.resource.v2.MsgCreateResourceResponse")
+// MsgBeginResourceUpload defines the Msg/BeginResourceUpload request type.
+// It declares the resource metadata, the total size and the checksum of the data uploaded in chunks.
+type MsgBeginResourceUpload struct {
+	// Payload containing the declaration of the resource to be uploaded.
+	Payload *MsgBeginResourceUploadPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the corresponding DID Document's controller(s).
+	Signatures []*types.SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func init() { proto.RegisterFile("cheqd/resource/v2/tx.proto", fileDescriptor_1d13b428c5ed4ca4) }
-
-var fileDescriptor_1d13b428c5ed4ca4 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0x12, 0x41,
-	0x14, 0xc7, 0x59, 0xc0, 0xa2, 0x03, 0x54, 0x3b, 0xa9, 0x75, 0xc5, 0x64, 0x41, 0xd2, 0x03, 0x89,
-	0x76, 0x37, 0x62, 0xf4, 0xe6, 0xa1, 0x34, 0x1e, 0x88, 0xa9, 0x31, 0x53, 0xf5, 0xe0, 0x85, 0x4c,
-	0x77, 0x9e, 0xdb, 0x09, 0xcb, 0xcc, 0xba, 0x33, 0xd0, 0x72, 0xf7, 0x03, 0x18, 0x3f, 0x55, 0x8f,
-	0x3d, 0x7a, 0x22, 0x06, 0x6e, 0x7c, 0x0a, 0xb3, 0x03, 0x83, 0x54, 0x6a, 0xd2, 0xdb, 0x1b, 0xfe,
-	0xbf, 0xff, 0x7f, 0x1e, 0xfb, 0xde, 0xa0, 0x5a, 0x78, 0x06, 0xdf, 0x58, 0x90, 0x82, 0x92, 0xc3,
-	0x34, 0x84, 0x60, 0xd4, 0x0e, 0xf4, 0x85, 0x9f, 0xa4, 0x52, 0x4b, 0xbc, 0x63, 0x34, 0xdf, 0x6a,
-	0xfe, 0xa8, 0x5d, 0x7b, 0xb8, 0xc0, 0x19, 0x67, 0xeb, 0x64, 0xad, 0xb1, 0x99, 0xb2, 0x72, 0x2d,
-	0x88, 0xdd, 0x48, 0x46, 0xd2, 0x94, 0x41, 0x56, 0x2d, 0x7e, 0x6d, 0xfe, 0x74, 0xd0, 0xce, 0xb1,
-	0x8a, 0x8e, 0x52, 0xa0, 0x1a, 0xc8, 0xd2, 0x81, 0xdf, 0xa2, 0x52, 0x42, 0xc7, 0xb1, 0xa4, 0xcc,
-	0x75, 0x1a, 0x4e, 0xab, 0xdc, 0x7e, 0xe6, 0x6f, 0x74, 0xe2, 0x6f, 0xd8, 0x3e, 0x2c, 0x2c, 0xc4,
-	0x7a, 0xf1, 0x6b, 0x84, 0x14, 0x8f, 0x04, 0xd5, 0xc3, 0x14, 0x94, 0x9b, 0x6f, 0x14, 0x5a, 0xe5,
-	0xf6, 0xde, 0x32, 0x89, 0x71, 0x96, 0x85, 0x9c, 0xf0, 0x48, 0x74, 0xc5, 0x57, 0x49, 0xd6, 0xc8,
-	0xe6, 0xf7, 0x02, 0x72, 0xff, 0x97, 0x8e, 0x31, 0x2a, 0x32, 0xaa, 0xa9, 0x69, 0xac, 0x42, 0x4c,
-	0x8d, 0xdf, 0xa0, 0x6a, 0x28, 0xe3, 0x18, 0x42, 0xcd, 0xa5, 0xe8, 0x71, 0xe6, 0xe6, 0x1b, 0x4e,
-	0xeb, 0x5e, 0xc7, 0x9d, 0x4f, 0xea, 0xbb, 0xb6, 0xe5, 0xa3, 0x15, 0xd0, 0x65, 0xa4, 0x12, 0xae,
-	0x9d, 0xb0, 0x87, 0xf2, 0x9c, 0xb9, 0x05, 0xe3, 0xd9, 0x9e, 0x4f, 0xea, 0xc8, 0x7a, 0xba, 0x8c,
-	0xe4, 0x39, 0xc3, 0xfb, 0xa8, 0x28, 0xe8, 0x00, 0xdc, 0xa2, 0x21, 0x1e, 0xcc, 0x27, 0xf5, 0x8a,
-	0x25, 0xde, 0xd3, 0x01, 0x10, 0xa3, 0xe2, 0x17, 0xa8, 0x34, 0x82, 0x54, 0x71, 0x29, 0xdc, 0x3b,
-	0x06, 0x7c, 0x74, 0x39, 0xa9, 0x3b, 0xf3, 0x49, 0xfd, 0xbe, 0x85, 0x3f, 0x2f, 0x64, 0x62, 0x39,
-	0xfc, 0x0a, 0x55, 0xad, 0xd6, 0xd3, 0xe3, 0x04, 0xdc, 0xad, 0xcd, 0x1b, 0x3e, 0x8e, 0x13, 0x20,
-	0xd7, 0x4e, 0x18, 0x50, 0x95, 0xc6, 0x4a, 0xf6, 0xfa, 0x42, 0x9e, 0x8b, 0x1e, 0x55, 0x6e, 0xc9,
-	0x7c, 0xda, 0xa7, 0x37, 0x0c, 0xe9, 0x30, 0xd6, 0x90, 0x0a, 0xaa, 0xf9, 0x08, 0x3e, 0xa5, 0xbc,
-	0xe3, 0x2d, 0x5b, 0xda, 0xb3, 0xcc, 0x75, 0x9d, 0x94, 0xb3, 0xdc, 0x77, 0x59, 0xec, 0xa1, 0x6a,
-	0x26, 0xe8, 0xf1, 0xc6, 0x14, 0x08, 0xa8, 0x44, 0x0a, 0x05, 0xf8, 0x04, 0xdd, 0xb5, 0x19, 0xcb,
-	0x1d, 0x79, 0x72, 0xd3, 0x8e, 0x80, 0xa6, 0xd9, 0x84, 0x3a, 0xb5, 0xec, 0xd2, 0x98, 0x8b, 0x3e,
-	0x30, 0x1b, 0x65, 0x35, 0xb2, 0x0a, 0x6a, 0xf7, 0x51, 0xe1, 0x58, 0x45, 0x98, 0xa1, 0xed, 0x7f,
-	0x16, 0x72, 0xff, 0x36, 0xfb, 0x57, 0x7b, 0x7e, 0x1b, 0xca, 0xfe, 0x83, 0x4e, 0xf7, 0x72, 0xea,
-	0x39, 0x57, 0x53, 0xcf, 0xf9, 0x3d, 0xf5, 0x9c, 0x1f, 0x33, 0x2f, 0x77, 0x35, 0xf3, 0x72, 0xbf,
-	0x66, 0x5e, 0xee, 0x4b, 0x10, 0x71, 0x7d, 0x36, 0x3c, 0xf5, 0x43, 0x39, 0x08, 0x42, 0x2a, 0xe4,
-	0xf9, 0x41, 0x28, 0x03, 0x13, 0x7d, 0x20, 0x24, 0x83, 0xe0, 0xe2, 0xef, 0x3b, 0xcb, 0xa6, 0xa6,
-	0x4e, 0xb7, 0xcc, 0x63, 0x7a, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0x66, 0x67, 0x0b, 0x1f, 0xcc,
-	0x03, 0x00, 0x00,
+func (m *MsgBeginResourceUpload) Reset()         { *m = MsgBeginResourceUpload{} }
+func (m *MsgBeginResourceUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginResourceUpload) ProtoMessage()    {}
+func (*MsgBeginResourceUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{3}
+}
+func (m *MsgBeginResourceUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginResourceUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginResourceUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginResourceUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginResourceUpload.Merge(m, src)
+}
+func (m *MsgBeginResourceUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginResourceUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginResourceUpload.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgBeginResourceUpload proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateResource defines a method for creating a resource.
-	CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error)
+func (m *MsgBeginResourceUpload) GetPayload() *MsgBeginResourceUploadPayload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgBeginResourceUpload) GetSignatures() []*types.SignInfo {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgBeginResourceUploadPayload defines the structure of the payload for starting a chunked resource upload.
+//
+// The upload is staged until it is finalized with MsgFinalizeResource or expires.
+// If a resource or a staged upload with the given id, collection_id already exists, an error code 2200 will be returned.
+type MsgBeginResourceUploadPayload struct {
+	// collection_id is an identifier of the DidDocument the resource belongs to.
+	// Format: <unique-identifier>
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"resourceCollectionId"`
+	// id is a unique id of the resource.
+	// Format: <uuid>
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"resourceId"`
+	// name is a human-readable name of the resource.
+	// Format: <string>
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"resourceName"`
+	// version is a version of the resource.
+	// Format: <string>
+	// Stored as a string. OPTIONAL.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"resourceVersion"`
+	// resource_type is a type of the resource.
+	// Format: <string>
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resourceType"`
+	// also_known_as is a list of URIs that can be used to get the resource.
+	AlsoKnownAs []*AlternativeUri `protobuf:"bytes,6,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"resourceAlternativeUri"`
+	// total_size is the total size of the resource data in bytes.
+	// It must exceed the data size limit of MsgCreateResource (200KB), smaller resources are created in a single transaction.
+	TotalSize uint64 `protobuf:"varint,7,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// checksum is the hex encoded SHA-256 checksum of the resource data
+	Checksum string `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
-func (c *msgClient) CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error) {
-	out := new(MsgCreateResourceResponse)
-	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Msg/CreateResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBeginResourceUploadPayload) Reset()         { *m = MsgBeginResourceUploadPayload{} }
+func (m *MsgBeginResourceUploadPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginResourceUploadPayload) ProtoMessage()    {}
+func (*MsgBeginResourceUploadPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{4}
+}
+func (m *MsgBeginResourceUploadPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginResourceUploadPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginResourceUploadPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgBeginResourceUploadPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginResourceUploadPayload.Merge(m, src)
+}
+func (m *MsgBeginResourceUploadPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginResourceUploadPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginResourceUploadPayload.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateResource defines a method for creating a resource.
-	CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error)
+var xxx_messageInfo_MsgBeginResourceUploadPayload proto.InternalMessageInfo
+
+func (m *MsgBeginResourceUploadPayload) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgBeginResourceUploadPayload) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) CreateResource(ctx context.Context, req *MsgCreateResource) (*MsgCreateResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateResource not implemented")
+func (m *MsgBeginResourceUploadPayload) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgBeginResourceUploadPayload) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func _Msg_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateResource)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgBeginResourceUploadPayload) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateResource(ctx, in)
+	return ""
+}
+
+func (m *MsgBeginResourceUploadPayload) GetAlsoKnownAs() []*AlternativeUri {
+	if m != nil {
+		return m.AlsoKnownAs
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cheqd.resource.v2.Msg/CreateResource",
+	return nil
+}
+
+func (m *MsgBeginResourceUploadPayload) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateResource(ctx, req.(*MsgCreateResource))
+	return 0
+}
+
+func (m *MsgBeginResourceUploadPayload) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cheqd.resource.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateResource",
-			Handler:    _Msg_CreateResource_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cheqd/resource/v2/tx.proto",
+type MsgBeginResourceUploadResponse struct {
+	// Return the staged upload.
+	Upload *ResourceUpload `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
 }
 
-func (m *MsgCreateResource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBeginResourceUploadResponse) Reset()         { *m = MsgBeginResourceUploadResponse{} }
+func (m *MsgBeginResourceUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginResourceUploadResponse) ProtoMessage()    {}
+func (*MsgBeginResourceUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{5}
+}
+func (m *MsgBeginResourceUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginResourceUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginResourceUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBeginResourceUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginResourceUploadResponse.Merge(m, src)
+}
+func (m *MsgBeginResourceUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginResourceUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginResourceUploadResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateResource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBeginResourceUploadResponse proto.InternalMessageInfo
+
+func (m *MsgBeginResourceUploadResponse) GetUpload() *ResourceUpload {
+	if m != nil {
+		return m.Upload
+	}
+	return nil
 }
 
-func (m *MsgCreateResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+// MsgAppendResourceChunk defines the Msg/AppendResourceChunk request type.
+type MsgAppendResourceChunk struct {
+	// Payload containing the chunk of the resource data.
+	Payload *MsgAppendResourceChunkPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the corresponding DID Document's controller(s).
+	Signatures []*types.SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *MsgAppendResourceChunk) Reset()         { *m = MsgAppendResourceChunk{} }
+func (m *MsgAppendResourceChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendResourceChunk) ProtoMessage()    {}
+func (*MsgAppendResourceChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{6}
+}
+func (m *MsgAppendResourceChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppendResourceChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendResourceChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Payload != nil {
-		{
-			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAppendResourceChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendResourceChunk.Merge(m, src)
+}
+func (m *MsgAppendResourceChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendResourceChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendResourceChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppendResourceChunk proto.InternalMessageInfo
+
+func (m *MsgAppendResourceChunk) GetPayload() *MsgAppendResourceChunkPayload {
+	if m != nil {
+		return m.Payload
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateResourcePayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAppendResourceChunk) GetSignatures() []*types.SignInfo {
+	if m != nil {
+		return m.Signatures
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateResourcePayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAppendResourceChunkPayload defines the structure of the payload for staging a chunk of a resource upload.
+// Chunks are appended in order: chunk_index must be the number of chunks staged so far.
+type MsgAppendResourceChunkPayload struct {
+	// collection_id is an identifier of the DidDocument the resource belongs to.
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"resourceCollectionId"`
+	// id is a unique id of the resource.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"resourceId"`
+	// chunk_index is the zero-based position of the chunk in the resource data
+	ChunkIndex uint64 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	// data is the chunk of the resource data
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *MsgCreateResourcePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AlsoKnownAs) > 0 {
-		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AlsoKnownAs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+func (m *MsgAppendResourceChunkPayload) Reset()         { *m = MsgAppendResourceChunkPayload{} }
+func (m *MsgAppendResourceChunkPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendResourceChunkPayload) ProtoMessage()    {}
+func (*MsgAppendResourceChunkPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{7}
+}
+func (m *MsgAppendResourceChunkPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppendResourceChunkPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendResourceChunkPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ResourceType) > 0 {
-		i -= len(m.ResourceType)
-		copy(dAtA[i:], m.ResourceType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResourceType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgAppendResourceChunkPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendResourceChunkPayload.Merge(m, src)
+}
+func (m *MsgAppendResourceChunkPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendResourceChunkPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendResourceChunkPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppendResourceChunkPayload proto.InternalMessageInfo
+
+func (m *MsgAppendResourceChunkPayload) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
 	}
-	if len(m.CollectionId) > 0 {
-		i -= len(m.CollectionId)
-		copy(dAtA[i:], m.CollectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollectionId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgAppendResourceChunkPayload) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAppendResourceChunkPayload) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateResourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAppendResourceChunkPayload) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateResourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAppendResourceChunkResponse struct {
+	// Return the staged upload.
+	Upload *ResourceUpload `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
 }
 
-func (m *MsgCreateResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgAppendResourceChunkResponse) Reset()         { *m = MsgAppendResourceChunkResponse{} }
+func (m *MsgAppendResourceChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendResourceChunkResponse) ProtoMessage()    {}
+func (*MsgAppendResourceChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{8}
+}
+func (m *MsgAppendResourceChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppendResourceChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendResourceChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAppendResourceChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendResourceChunkResponse.Merge(m, src)
+}
+func (m *MsgAppendResourceChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendResourceChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendResourceChunkResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgAppendResourceChunkResponse proto.InternalMessageInfo
+
+func (m *MsgAppendResourceChunkResponse) GetUpload() *ResourceUpload {
+	if m != nil {
+		return m.Upload
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgCreateResource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+
+// MsgFinalizeResource defines the Msg/FinalizeResource request type.
+type MsgFinalizeResource struct {
+	// Payload identifying the staged upload.
+	Payload *MsgFinalizeResourcePayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures of the corresponding DID Document's controller(s).
+	Signatures []*types.SignInfo `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *MsgFinalizeResource) Reset()         { *m = MsgFinalizeResource{} }
+func (m *MsgFinalizeResource) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeResource) ProtoMessage()    {}
+func (*MsgFinalizeResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{9}
+}
+func (m *MsgFinalizeResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgFinalizeResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeResource.Merge(m, src)
+}
+func (m *MsgFinalizeResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeResource.DiscardUnknown(m)
 }
 
-func (m *MsgCreateResourcePayload) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgFinalizeResource proto.InternalMessageInfo
+
+func (m *MsgFinalizeResource) GetPayload() *MsgFinalizeResourcePayload {
+	if m != nil {
+		return m.Payload
 	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgFinalizeResource) GetSignatures() []*types.SignInfo {
+	if m != nil {
+		return m.Signatures
 	}
-	l = len(m.CollectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgFinalizeResourcePayload defines the structure of the payload for finalizing a chunked resource upload.
+//
+// The staged data must have the declared size and SHA-256 checksum.
+// The resource is created the same way as with MsgCreateResource and the staged upload is removed.
+type MsgFinalizeResourcePayload struct {
+	// collection_id is an identifier of the DidDocument the resource belongs to.
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"resourceCollectionId"`
+	// id is a unique id of the resource.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"resourceId"`
+}
+
+func (m *MsgFinalizeResourcePayload) Reset()         { *m = MsgFinalizeResourcePayload{} }
+func (m *MsgFinalizeResourcePayload) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeResourcePayload) ProtoMessage()    {}
+func (*MsgFinalizeResourcePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{10}
+}
+func (m *MsgFinalizeResourcePayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeResourcePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeResourcePayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFinalizeResourcePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeResourcePayload.Merge(m, src)
+}
+func (m *MsgFinalizeResourcePayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeResourcePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeResourcePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeResourcePayload proto.InternalMessageInfo
+
+func (m *MsgFinalizeResourcePayload) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgFinalizeResourcePayload) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgFinalizeResourceResponse struct {
+	// Return the created resource metadata.
+	Resource *Metadata `protobuf:"bytes,1,opt,name=resource,proto3" json:"linkedResourceMetadata"`
+}
+
+func (m *MsgFinalizeResourceResponse) Reset()         { *m = MsgFinalizeResourceResponse{} }
+func (m *MsgFinalizeResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeResourceResponse) ProtoMessage()    {}
+func (*MsgFinalizeResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d13b428c5ed4ca4, []int{11}
+}
+func (m *MsgFinalizeResourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeResourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ResourceType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFinalizeResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeResourceResponse.Merge(m, src)
+}
+func (m *MsgFinalizeResourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeResourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeResourceResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizeResourceResponse) GetResource() *Metadata {
+	if m != nil {
+		return m.Resource
 	}
-	if len(m.AlsoKnownAs) > 0 {
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateResource)(nil), "cheqd.resource.v2.MsgCreateResource")
+	proto.RegisterType((*MsgCreateResourcePayload)(nil), "cheqd.resource.v2.MsgCreateResourcePayload")
+	proto.RegisterType((*MsgCreateResourceResponse)(nil), "cheqd.resource.v2.MsgCreateResourceResponse")
+	proto.RegisterType((*MsgBeginResourceUpload)(nil), "cheqd.resource.v2.MsgBeginResourceUpload")
+	proto.RegisterType((*MsgBeginResourceUploadPayload)(nil), "cheqd.resource.v2.MsgBeginResourceUploadPayload")
+	proto.RegisterType((*MsgBeginResourceUploadResponse)(nil), "cheqd.resource.v2.MsgBeginResourceUploadResponse")
+	proto.RegisterType((*MsgAppendResourceChunk)(nil), "cheqd.resource.v2.MsgAppendResourceChunk")
+	proto.RegisterType((*MsgAppendResourceChunkPayload)(nil), "cheqd.resource.v2.MsgAppendResourceChunkPayload")
+	proto.RegisterType((*MsgAppendResourceChunkResponse)(nil), "cheqd.resource.v2.MsgAppendResourceChunkResponse")
+	proto.RegisterType((*MsgFinalizeResource)(nil), "cheqd.resource.v2.MsgFinalizeResource")
+	proto.RegisterType((*MsgFinalizeResourcePayload)(nil), "cheqd.resource.v2.MsgFinalizeResourcePayload")
+	proto.RegisterType((*MsgFinalizeResourceResponse)(nil), "cheqd.resource.v2.MsgFinalizeResourceResponse")
+}
+
+func init() { proto.RegisterFile("cheqd/resource/v2/tx.proto", fileDescriptor_1d13b428c5ed4ca4) }
+
+var fileDescriptor_1d13b428c5ed4ca4 = []byte{
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6e, 0xeb, 0x44,
+	0x14, 0xc6, 0xeb, 0x24, 0x37, 0xe9, 0x3d, 0x69, 0x2f, 0xf7, 0x4e, 0x4b, 0x31, 0xae, 0xea, 0x84,
+	0xa8, 0x42, 0xa9, 0xa0, 0x31, 0x0d, 0x02, 0x89, 0x05, 0x8b, 0xa6, 0x02, 0x14, 0x50, 0x10, 0x72,
+	0x29, 0x0b, 0x58, 0x44, 0xae, 0x67, 0x70, 0x86, 0x38, 0x33, 0xc6, 0xe3, 0xa4, 0x7f, 0xd8, 0xf2,
+	0x00, 0x88, 0x05, 0x2b, 0x1e, 0x02, 0x89, 0x27, 0x60, 0xd7, 0x65, 0x97, 0xac, 0x22, 0xd4, 0xee,
+	0xf2, 0x14, 0xc8, 0x8e, 0xed, 0xa6, 0xb1, 0xdb, 0x6b, 0x55, 0xc9, 0xce, 0x33, 0xe7, 0xfb, 0xce,
+	0x39, 0xc9, 0xf9, 0x69, 0x66, 0x40, 0x31, 0x7b, 0xe4, 0x67, 0xac, 0xb9, 0x44, 0xf0, 0xa1, 0x6b,
+	0x12, 0x6d, 0xd4, 0xd4, 0xbc, 0xf3, 0x86, 0xe3, 0x72, 0x8f, 0xa3, 0x57, 0x41, 0xac, 0x11, 0xc5,
+	0x1a, 0xa3, 0xa6, 0xf2, 0xe6, 0x54, 0x8e, 0x29, 0x9e, 0x55, 0x2a, 0xd5, 0x64, 0x96, 0xd8, 0x35,
+	0x55, 0xa8, 0x49, 0xc5, 0xd0, 0xb1, 0xb9, 0x81, 0xc3, 0xf8, 0xa6, 0xc5, 0x2d, 0x1e, 0x7c, 0x6a,
+	0xfe, 0xd7, 0x74, 0xb7, 0xf6, 0xbb, 0x04, 0xaf, 0x3a, 0xc2, 0x3a, 0x72, 0x89, 0xe1, 0x11, 0x3d,
+	0xf4, 0xa2, 0xcf, 0xa0, 0xe4, 0x18, 0x17, 0xbe, 0x59, 0x96, 0xaa, 0x52, 0xbd, 0xdc, 0x7c, 0xaf,
+	0x91, 0xe8, 0xb4, 0x91, 0xb0, 0x7d, 0x33, 0xb5, 0xe8, 0x91, 0x17, 0x7d, 0x0c, 0x20, 0xa8, 0xc5,
+	0x0c, 0x6f, 0xe8, 0x12, 0x21, 0xe7, 0xaa, 0xf9, 0x7a, 0xb9, 0xb9, 0x15, 0x66, 0xc2, 0x14, 0xfb,
+	0x49, 0x8e, 0xa9, 0xc5, 0xda, 0xec, 0x47, 0xae, 0xcf, 0x28, 0x6b, 0xbf, 0xe6, 0x41, 0x7e, 0x28,
+	0x3b, 0x42, 0x50, 0xc0, 0x86, 0x67, 0x04, 0x8d, 0xad, 0xe9, 0xc1, 0x37, 0xfa, 0x14, 0xd6, 0x4d,
+	0x6e, 0xdb, 0xc4, 0xf4, 0x28, 0x67, 0x5d, 0x8a, 0xe5, 0x5c, 0x55, 0xaa, 0x3f, 0x6f, 0xc9, 0x93,
+	0x71, 0x65, 0x33, 0x6a, 0xf9, 0x28, 0x16, 0xb4, 0xb1, 0xbe, 0x66, 0xce, 0xac, 0x90, 0x0a, 0x39,
+	0x8a, 0xe5, 0x7c, 0xe0, 0x79, 0x31, 0x19, 0x57, 0x20, 0xf2, 0xb4, 0xb1, 0x9e, 0xa3, 0x18, 0xed,
+	0x42, 0x81, 0x19, 0x03, 0x22, 0x17, 0x02, 0xc5, 0xcb, 0xc9, 0xb8, 0xb2, 0x16, 0x29, 0xbe, 0x36,
+	0x06, 0x44, 0x0f, 0xa2, 0xe8, 0x00, 0x4a, 0x23, 0xe2, 0x0a, 0xca, 0x99, 0xfc, 0x2c, 0x10, 0xbe,
+	0x75, 0x35, 0xae, 0x48, 0x93, 0x71, 0xe5, 0x8d, 0x48, 0xfc, 0xdd, 0x34, 0xac, 0x47, 0x3a, 0xf4,
+	0x11, 0xac, 0x47, 0xb1, 0xae, 0x77, 0xe1, 0x10, 0xb9, 0x98, 0xac, 0xf0, 0xed, 0x85, 0x43, 0xf4,
+	0x7b, 0x2b, 0x44, 0x60, 0xdd, 0xb0, 0x05, 0xef, 0xf6, 0x19, 0x3f, 0x63, 0x5d, 0x43, 0xc8, 0xa5,
+	0xe0, 0xaf, 0x7d, 0x27, 0x65, 0x48, 0x87, 0xb6, 0x47, 0x5c, 0x66, 0x78, 0x74, 0x44, 0x4e, 0x5c,
+	0xda, 0x52, 0xc3, 0x96, 0xb6, 0x22, 0xcd, 0xfd, 0xb8, 0x5e, 0xf6, 0xf3, 0x7e, 0xe5, 0xa7, 0x3d,
+	0x14, 0x35, 0x07, 0xde, 0x4e, 0x4c, 0x41, 0x27, 0xc2, 0xe1, 0x4c, 0x10, 0x74, 0x0c, 0xab, 0x51,
+	0x8e, 0x90, 0x91, 0xed, 0x34, 0x46, 0x88, 0x67, 0xf8, 0x13, 0x6a, 0x29, 0x7e, 0x51, 0x9b, 0xb2,
+	0x3e, 0xc1, 0x51, 0xaa, 0x28, 0xa6, 0xc7, 0x89, 0x6a, 0x7f, 0x4a, 0xb0, 0xd5, 0x11, 0x56, 0x8b,
+	0x58, 0x94, 0x45, 0xb2, 0x93, 0x00, 0x62, 0xf4, 0xe5, 0x3c, 0x92, 0x1f, 0xa4, 0x23, 0x99, 0xe2,
+	0x5d, 0x18, 0x97, 0x7f, 0xe5, 0x61, 0xe7, 0xd1, 0x12, 0x49, 0x10, 0xa5, 0x27, 0x80, 0x98, 0x7b,
+	0x2d, 0x88, 0xf9, 0xac, 0x20, 0x16, 0x9e, 0x0a, 0xe2, 0xb3, 0xa7, 0x81, 0x58, 0x5c, 0x06, 0x88,
+	0x68, 0x07, 0xc0, 0xe3, 0x9e, 0x61, 0x77, 0x05, 0xbd, 0x24, 0x72, 0xa9, 0x2a, 0xd5, 0x0b, 0xfa,
+	0xf3, 0x60, 0xe7, 0x98, 0x5e, 0x12, 0xa4, 0xc0, 0xaa, 0xd9, 0x23, 0x66, 0x5f, 0x0c, 0x07, 0xf2,
+	0xaa, 0xdf, 0xb7, 0x1e, 0xaf, 0x6b, 0x3f, 0x80, 0x9a, 0x3e, 0xb1, 0x18, 0xe4, 0x4f, 0xa0, 0x38,
+	0x3d, 0x27, 0x43, 0xae, 0xd2, 0x9a, 0x9f, 0xb3, 0x86, 0x86, 0x08, 0xd7, 0x43, 0xc7, 0x21, 0x2c,
+	0xc6, 0xfa, 0xa8, 0x37, 0x64, 0xfd, 0xcc, 0xb8, 0xa6, 0x78, 0x17, 0x86, 0xeb, 0xdf, 0x12, 0xec,
+	0x3c, 0x5a, 0x62, 0xd9, 0xb8, 0x56, 0xa0, 0x6c, 0xfa, 0xe5, 0xba, 0x94, 0x61, 0x72, 0x1e, 0x50,
+	0x5b, 0xd0, 0x21, 0xd8, 0x6a, 0xfb, 0x3b, 0xf1, 0x59, 0x5e, 0xb8, 0x3b, 0xcb, 0xc3, 0x89, 0xa5,
+	0x34, 0xbd, 0x88, 0x89, 0xfd, 0x21, 0xc1, 0x46, 0x47, 0x58, 0x9f, 0x53, 0x66, 0xd8, 0xf4, 0xf2,
+	0xee, 0xc2, 0xfb, 0x62, 0x7e, 0x5c, 0xfb, 0xe9, 0xe3, 0x9a, 0x37, 0x2e, 0x6c, 0x56, 0xbf, 0x80,
+	0xf2, 0x70, 0xfa, 0x25, 0xcf, 0xa9, 0xe6, 0xc2, 0x76, 0x4a, 0xf1, 0xa5, 0x1e, 0xf5, 0xcd, 0x7f,
+	0xf2, 0x90, 0xef, 0x08, 0x0b, 0x61, 0x78, 0x31, 0xf7, 0xf8, 0xd8, 0xcd, 0xf2, 0xd6, 0x50, 0xde,
+	0xcf, 0xa2, 0x8a, 0x7f, 0x82, 0x80, 0x8d, 0xb4, 0x4b, 0x65, 0x2f, 0xf3, 0x1d, 0xa2, 0x1c, 0x64,
+	0x96, 0xce, 0x16, 0x4d, 0x3b, 0x1a, 0xf6, 0x32, 0x9f, 0x04, 0xca, 0x41, 0x66, 0x69, 0x5c, 0xf4,
+	0x27, 0x78, 0x99, 0xa0, 0xfb, 0xdd, 0x6c, 0x30, 0x2b, 0x8d, 0x6c, 0xba, 0xa8, 0x56, 0xab, 0x7d,
+	0x75, 0xa3, 0x4a, 0xd7, 0x37, 0xaa, 0xf4, 0xdf, 0x8d, 0x2a, 0xfd, 0x76, 0xab, 0xae, 0x5c, 0xdf,
+	0xaa, 0x2b, 0xff, 0xde, 0xaa, 0x2b, 0xdf, 0x6b, 0x16, 0xf5, 0x7a, 0xc3, 0xd3, 0x86, 0xc9, 0x07,
+	0x9a, 0x69, 0x30, 0x7e, 0xb6, 0x6f, 0x72, 0x2d, 0x48, 0xbe, 0xcf, 0x38, 0x26, 0xda, 0xf9, 0xdd,
+	0x3b, 0xd5, 0xbf, 0x6e, 0xc4, 0x69, 0x31, 0x78, 0x8e, 0x7e, 0xf8, 0xff, 0x00, 0xdc, 0xb9, 0xa9,
+	0x87, 0x2e, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateResource defines a method for creating a resource.
+	CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error)
+	// BeginResourceUpload defines a method for starting a chunked upload of a resource
+	// which data exceeds the size limit of a single transaction.
+	BeginResourceUpload(ctx context.Context, in *MsgBeginResourceUpload, opts ...grpc.CallOption) (*MsgBeginResourceUploadResponse, error)
+	// AppendResourceChunk defines a method for staging the next chunk of a chunked resource upload.
+	AppendResourceChunk(ctx context.Context, in *MsgAppendResourceChunk, opts ...grpc.CallOption) (*MsgAppendResourceChunkResponse, error)
+	// FinalizeResource defines a method for verifying the staged data of a chunked resource upload
+	// and publishing the resource.
+	FinalizeResource(ctx context.Context, in *MsgFinalizeResource, opts ...grpc.CallOption) (*MsgFinalizeResourceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error) {
+	out := new(MsgCreateResourceResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Msg/CreateResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginResourceUpload(ctx context.Context, in *MsgBeginResourceUpload, opts ...grpc.CallOption) (*MsgBeginResourceUploadResponse, error) {
+	out := new(MsgBeginResourceUploadResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Msg/BeginResourceUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppendResourceChunk(ctx context.Context, in *MsgAppendResourceChunk, opts ...grpc.CallOption) (*MsgAppendResourceChunkResponse, error) {
+	out := new(MsgAppendResourceChunkResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Msg/AppendResourceChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeResource(ctx context.Context, in *MsgFinalizeResource, opts ...grpc.CallOption) (*MsgFinalizeResourceResponse, error) {
+	out := new(MsgFinalizeResourceResponse)
+	err := c.cc.Invoke(ctx, "/cheqd.resource.v2.Msg/FinalizeResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateResource defines a method for creating a resource.
+	CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error)
+	// BeginResourceUpload defines a method for starting a chunked upload of a resource
+	// which data exceeds the size limit of a single transaction.
+	BeginResourceUpload(context.Context, *MsgBeginResourceUpload) (*MsgBeginResourceUploadResponse, error)
+	// AppendResourceChunk defines a method for staging the next chunk of a chunked resource upload.
+	AppendResourceChunk(context.Context, *MsgAppendResourceChunk) (*MsgAppendResourceChunkResponse, error)
+	// FinalizeResource defines a method for verifying the staged data of a chunked resource upload
+	// and publishing the resource.
+	FinalizeResource(context.Context, *MsgFinalizeResource) (*MsgFinalizeResourceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateResource(ctx context.Context, req *MsgCreateResource) (*MsgCreateResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResource not implemented")
+}
+func (*UnimplementedMsgServer) BeginResourceUpload(ctx context.Context, req *MsgBeginResourceUpload) (*MsgBeginResourceUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginResourceUpload not implemented")
+}
+func (*UnimplementedMsgServer) AppendResourceChunk(ctx context.Context, req *MsgAppendResourceChunk) (*MsgAppendResourceChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendResourceChunk not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeResource(ctx context.Context, req *MsgFinalizeResource) (*MsgFinalizeResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeResource not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Msg/CreateResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateResource(ctx, req.(*MsgCreateResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginResourceUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginResourceUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginResourceUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Msg/BeginResourceUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginResourceUpload(ctx, req.(*MsgBeginResourceUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendResourceChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendResourceChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendResourceChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Msg/AppendResourceChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendResourceChunk(ctx, req.(*MsgAppendResourceChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqd.resource.v2.Msg/FinalizeResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeResource(ctx, req.(*MsgFinalizeResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cheqd.resource.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateResource",
+			Handler:    _Msg_CreateResource_Handler,
+		},
+		{
+			MethodName: "BeginResourceUpload",
+			Handler:    _Msg_BeginResourceUpload_Handler,
+		},
+		{
+			MethodName: "AppendResourceChunk",
+			Handler:    _Msg_AppendResourceChunk_Handler,
+		},
+		{
+			MethodName: "FinalizeResource",
+			Handler:    _Msg_FinalizeResource_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cheqd/resource/v2/tx.proto",
+}
+
+func (m *MsgCreateResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateResourcePayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResourcePayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResourcePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AlsoKnownAs) > 0 {
+		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlsoKnownAs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginResourceUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginResourceUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginResourceUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginResourceUploadPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginResourceUploadPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginResourceUploadPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlsoKnownAs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginResourceUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginResourceUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginResourceUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Upload != nil {
+		{
+			size, err := m.Upload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendResourceChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendResourceChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendResourceChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendResourceChunkPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendResourceChunkPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendResourceChunkPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendResourceChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendResourceChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendResourceChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Upload != nil {
+		{
+			size, err := m.Upload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeResourcePayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeResourcePayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeResourcePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateResourcePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for _, e := range m.AlsoKnownAs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBeginResourceUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBeginResourceUploadPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AlsoKnownAs) > 0 {
 		for _, e := range m.AlsoKnownAs {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	if m.TotalSize != 0 {
+		n += 1 + sovTx(uint64(m.TotalSize))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBeginResourceUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upload != nil {
+		l = m.Upload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppendResourceChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAppendResourceChunkPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovTx(uint64(m.ChunkIndex))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppendResourceChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upload != nil {
+		l = m.Upload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFinalizeResourcePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgCreateResourcePayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &types.SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResourcePayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResourcePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, &AlternativeUri{})
+			if err := m.AlsoKnownAs[len(m.AlsoKnownAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Metadata{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginResourceUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginResourceUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginResourceUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgBeginResourceUploadPayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &types.SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginResourceUploadPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginResourceUploadPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginResourceUploadPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, &AlternativeUri{})
+			if err := m.AlsoKnownAs[len(m.AlsoKnownAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginResourceUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginResourceUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginResourceUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upload == nil {
+				m.Upload = &ResourceUpload{}
+			}
+			if err := m.Upload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendResourceChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendResourceChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendResourceChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgAppendResourceChunkPayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &types.SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendResourceChunkPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendResourceChunkPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendResourceChunkPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCreateResourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateResource) Unmarshal(dAtA []byte) error {
+func (m *MsgAppendResourceChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -659,15 +3229,15 @@ func (m *MsgCreateResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppendResourceChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppendResourceChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -694,44 +3264,10 @@ func (m *MsgCreateResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &MsgCreateResourcePayload{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Upload == nil {
+				m.Upload = &ResourceUpload{}
 			}
-			m.Signatures = append(m.Signatures, &types.SignInfo{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Upload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -756,7 +3292,7 @@ func (m *MsgCreateResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizeResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -779,17 +3315,17 @@ func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResourcePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizeResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResourcePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizeResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -799,31 +3335,33 @@ func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Payload == nil {
+				m.Payload = &MsgFinalizeResourcePayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -833,91 +3371,79 @@ func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Signatures = append(m.Signatures, &types.SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeResourcePayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeResourcePayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeResourcePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -945,11 +3471,11 @@ func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -977,41 +3503,7 @@ func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AlsoKnownAs = append(m.AlsoKnownAs, &AlternativeUri{})
-			if err := m.AlsoKnownAs[len(m.AlsoKnownAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1034,7 +3526,7 @@ func (m *MsgCreateResourcePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizeResourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,10 +3549,10 @@ func (m *MsgCreateResourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizeResourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizeResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
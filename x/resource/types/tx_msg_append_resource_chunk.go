@@ -0,0 +1,58 @@
+package types
+
+import (
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgAppendResourceChunk{}
+
+func NewMsgAppendResourceChunk(payload *MsgAppendResourceChunkPayload, signatures []*didtypes.SignInfo) *MsgAppendResourceChunk {
+	return &MsgAppendResourceChunk{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgAppendResourceChunk) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAppendResourceChunk) Type() string {
+	return "MsgAppendResourceChunk"
+}
+
+func (msg *MsgAppendResourceChunk) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgAppendResourceChunk) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAppendResourceChunk) ValidateBasic() error {
+	err := msg.Validate([]string{})
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgAppendResourceChunk) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgAppendResourceChunkPayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgAppendResourceChunk) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ didtypes.IdentityMsg = &MsgAppendResourceChunkPayload{}
+
+func (msg *MsgAppendResourceChunkPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// Validation
+
+func (msg MsgAppendResourceChunkPayload) Validate() error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.CollectionId, validation.Required, didtypes.IsID()),
+		validation.Field(&msg.Id, validation.Required, didtypes.IsUUID()),
+		validation.Field(&msg.Data, validation.Required),
+	)
+}
+
+func ValidMsgAppendResourceChunkPayload() *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgAppendResourceChunkPayload)
+		if !ok {
+			panic("ValidMsgAppendResourceChunkPayload must be only applied on MsgAppendResourceChunkPayload properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+// Normalize
+
+func (msg *MsgAppendResourceChunkPayload) Normalize() {
+	msg.CollectionId = didutils.NormalizeID(msg.CollectionId)
+	msg.Id = didutils.NormalizeUUID(msg.Id)
+}
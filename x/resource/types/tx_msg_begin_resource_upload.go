@@ -0,0 +1,58 @@
+package types
+
+import (
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgBeginResourceUpload{}
+
+func NewMsgBeginResourceUpload(payload *MsgBeginResourceUploadPayload, signatures []*didtypes.SignInfo) *MsgBeginResourceUpload {
+	return &MsgBeginResourceUpload{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgBeginResourceUpload) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBeginResourceUpload) Type() string {
+	return "MsgBeginResourceUpload"
+}
+
+func (msg *MsgBeginResourceUpload) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgBeginResourceUpload) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBeginResourceUpload) ValidateBasic() error {
+	err := msg.Validate([]string{})
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgBeginResourceUpload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgBeginResourceUploadPayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgBeginResourceUpload) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}
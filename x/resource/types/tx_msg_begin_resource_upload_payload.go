@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ didtypes.IdentityMsg = &MsgBeginResourceUploadPayload{}
+
+// ChecksumRegexp matches hex encoded SHA-256 checksums
+var ChecksumRegexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+func (msg *MsgBeginResourceUploadPayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// ToResourceUpload builds a staged upload without chunks which expires at the given height
+func (msg *MsgBeginResourceUploadPayload) ToResourceUpload(expiryHeight int64) ResourceUpload {
+	return ResourceUpload{
+		CollectionId: msg.CollectionId,
+		Id:           msg.Id,
+		Name:         msg.Name,
+		Version:      msg.Version,
+		ResourceType: msg.ResourceType,
+		AlsoKnownAs:  msg.AlsoKnownAs,
+		TotalSize:    msg.TotalSize,
+		Checksum:     msg.Checksum,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// Validation
+
+func (msg MsgBeginResourceUploadPayload) Validate() error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.CollectionId, validation.Required, didtypes.IsID()),
+		validation.Field(&msg.Id, validation.Required, didtypes.IsUUID()),
+		validation.Field(&msg.Name, validation.Required, validation.Length(1, 64)),
+		validation.Field(&msg.Version, validation.Length(1, 64)),
+		validation.Field(&msg.ResourceType, validation.Required, validation.Length(1, 64)),
+		validation.Field(&msg.AlsoKnownAs, validation.Each(ValidAlternativeURI())),
+		validation.Field(&msg.TotalSize, validation.Required,
+			validation.Min(uint64(MaxCreateResourceDataSize+1)).Error(fmt.Sprintf("resources up to %d bytes must be created with MsgCreateResource", MaxCreateResourceDataSize))),
+		validation.Field(&msg.Checksum, validation.Required, validation.Match(ChecksumRegexp).Error("must be a hex encoded SHA-256 checksum")),
+	)
+}
+
+func ValidMsgBeginResourceUploadPayload() *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgBeginResourceUploadPayload)
+		if !ok {
+			panic("ValidMsgBeginResourceUploadPayload must be only applied on MsgBeginResourceUploadPayload properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+// Normalize
+
+func (msg *MsgBeginResourceUploadPayload) Normalize() {
+	msg.CollectionId = didutils.NormalizeID(msg.CollectionId)
+	msg.Id = didutils.NormalizeUUID(msg.Id)
+	msg.Checksum = strings.ToLower(msg.Checksum)
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	resourcetypes "github.com/canow-co/cheqd-node/x/resource/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TxMsgBeginResourceUploadPayload", func() {
+	validPayload := func() resourcetypes.MsgBeginResourceUploadPayload {
+		return resourcetypes.MsgBeginResourceUploadPayload{
+			CollectionId: "zABCDEFG123456789abcd",
+			Id:           "ba62c728-cb15-498b-8e9e-9259cc242186",
+			Name:         "Test Resource",
+			ResourceType: "StatusList2021",
+			TotalSize:    1024 * 1024,
+			Checksum:     "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+		}
+	}
+
+	It("should return nil if the message is valid", func() {
+		msg := validPayload()
+		Expect(msg.Validate()).To(BeNil())
+
+		msg.Normalize()
+		Expect(msg.Checksum).To(Equal("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+	})
+
+	DescribeTable("Invalid payloads", func(modify func(*resourcetypes.MsgBeginResourceUploadPayload), errorMsg string) {
+		msg := validPayload()
+		modify(&msg)
+		Expect(msg.Validate().Error()).To(Equal(errorMsg))
+	},
+		Entry("no size", func(msg *resourcetypes.MsgBeginResourceUploadPayload) { msg.TotalSize = 0 }, "total_size: cannot be blank."),
+		Entry("size allowed for MsgCreateResource", func(msg *resourcetypes.MsgBeginResourceUploadPayload) {
+			msg.TotalSize = resourcetypes.MaxCreateResourceDataSize
+		}, "total_size: resources up to 204800 bytes must be created with MsgCreateResource."),
+		Entry("no checksum", func(msg *resourcetypes.MsgBeginResourceUploadPayload) { msg.Checksum = "" }, "checksum: cannot be blank."),
+		Entry("not a SHA-256 checksum", func(msg *resourcetypes.MsgBeginResourceUploadPayload) { msg.Checksum = "e3b0c442" }, "checksum: must be a hex encoded SHA-256 checksum."),
+	)
+})
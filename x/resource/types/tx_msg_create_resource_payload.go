@@ -35,6 +35,10 @@ func (msg *MsgCreateResourcePayload) ToResource() ResourceWithMetadata {
 
 // Validation
 
+// MaxCreateResourceDataSize is the size limit of the resource data created by a single MsgCreateResource.
+// Larger resources are uploaded in chunks.
+const MaxCreateResourceDataSize = 200 * 1024 // 200KB
+
 func (msg MsgCreateResourcePayload) Validate() error {
 	return validation.ValidateStruct(&msg,
 		validation.Field(&msg.CollectionId, validation.Required, didtypes.IsID()),
@@ -43,7 +47,7 @@ func (msg MsgCreateResourcePayload) Validate() error {
 		validation.Field(&msg.Version, validation.Length(1, 64)),
 		validation.Field(&msg.ResourceType, validation.Required, validation.Length(1, 64)),
 		validation.Field(&msg.AlsoKnownAs, validation.Each(ValidAlternativeURI())),
-		validation.Field(&msg.Data, validation.Required, validation.Length(1, 200*1024)), // 200KB
+		validation.Field(&msg.Data, validation.Required, validation.Length(1, MaxCreateResourceDataSize)),
 	)
 }
 
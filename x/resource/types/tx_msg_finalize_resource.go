@@ -0,0 +1,58 @@
+package types
+
+import (
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgFinalizeResource{}
+
+func NewMsgFinalizeResource(payload *MsgFinalizeResourcePayload, signatures []*didtypes.SignInfo) *MsgFinalizeResource {
+	return &MsgFinalizeResource{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgFinalizeResource) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFinalizeResource) Type() string {
+	return "MsgFinalizeResource"
+}
+
+func (msg *MsgFinalizeResource) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgFinalizeResource) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFinalizeResource) ValidateBasic() error {
+	err := msg.Validate([]string{})
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgFinalizeResource) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgFinalizeResourcePayload()),
+		validation.Field(&msg.Signatures, didtypes.IsUniqueSignInfoListRule(), validation.Each(didtypes.ValidSignInfoRule(allowedNamespaces))),
+	)
+}
+
+// Normalize
+
+func (msg *MsgFinalizeResource) Normalize() {
+	msg.Payload.Normalize()
+	didtypes.NormalizeSignInfoList(msg.Signatures)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	didtypes "github.com/canow-co/cheqd-node/x/did/types"
+	didutils "github.com/canow-co/cheqd-node/x/did/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ didtypes.IdentityMsg = &MsgFinalizeResourcePayload{}
+
+func (msg *MsgFinalizeResourcePayload) GetSignBytes() []byte {
+	bytes, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bytes
+}
+
+// Validation
+
+func (msg MsgFinalizeResourcePayload) Validate() error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.CollectionId, validation.Required, didtypes.IsID()),
+		validation.Field(&msg.Id, validation.Required, didtypes.IsUUID()),
+	)
+}
+
+func ValidMsgFinalizeResourcePayload() *didtypes.CustomErrorRule {
+	return didtypes.NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgFinalizeResourcePayload)
+		if !ok {
+			panic("ValidMsgFinalizeResourcePayload must be only applied on MsgFinalizeResourcePayload properties")
+		}
+
+		return casted.Validate()
+	})
+}
+
+// Normalize
+
+func (msg *MsgFinalizeResourcePayload) Normalize() {
+	msg.CollectionId = didutils.NormalizeID(msg.CollectionId)
+	msg.Id = didutils.NormalizeUUID(msg.Id)
+}
@@ -0,0 +1,1155 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/resource/v2/upload.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ResourceUpload is a chunked resource upload staged until it is finalized or expires.
+// The chunks are stored separately.
+type ResourceUpload struct {
+	// collection_id is the unique identifier part of the DID the resource is linked to
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// id is the unique identifier of the resource.
+	// Format: UUID
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human-readable name of the resource
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the human-readable version of the resource. OPTIONAL.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// resource_type is the type of the resource
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// also_known_as is a list of URIs that can be used to get the resource
+	AlsoKnownAs []*AlternativeUri `protobuf:"bytes,6,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// total_size is the declared total size of the resource data in bytes
+	TotalSize uint64 `protobuf:"varint,7,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// checksum is the declared hex encoded SHA-256 checksum of the resource data
+	Checksum string `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// chunk_count is the number of chunks staged so far
+	ChunkCount uint64 `protobuf:"varint,9,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// received_size is the total size of the chunks staged so far in bytes
+	ReceivedSize uint64 `protobuf:"varint,10,opt,name=received_size,json=receivedSize,proto3" json:"received_size,omitempty"`
+	// expiry_height is the block height at the end of which the upload is removed if it isn't finalized
+	ExpiryHeight int64 `protobuf:"varint,11,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// media_type is the media type detected from the resource data once all the chunks are staged.
+	// Empty until then.
+	MediaType string `protobuf:"bytes,12,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+}
+
+func (m *ResourceUpload) Reset()         { *m = ResourceUpload{} }
+func (m *ResourceUpload) String() string { return proto.CompactTextString(m) }
+func (*ResourceUpload) ProtoMessage()    {}
+func (*ResourceUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f1471cc921862fc, []int{0}
+}
+func (m *ResourceUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUpload.Merge(m, src)
+}
+func (m *ResourceUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceUpload proto.InternalMessageInfo
+
+func (m *ResourceUpload) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetAlsoKnownAs() []*AlternativeUri {
+	if m != nil {
+		return m.AlsoKnownAs
+	}
+	return nil
+}
+
+func (m *ResourceUpload) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *ResourceUpload) GetChunkCount() uint64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetReceivedSize() uint64 {
+	if m != nil {
+		return m.ReceivedSize
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *ResourceUpload) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+// UploadParams defines the parameters of chunked resource uploads
+type UploadParams struct {
+	// Fixed fee for staging a chunk of a resource upload.
+	// The burn factor of the resource fee params applies.
+	//
+	// Default: 2.5 ARX or 2500000000zarx
+	Chunk types.Coin `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk"`
+	// Maximum size of a chunk in bytes
+	//
+	// Default: 204800 (200KB)
+	MaxChunkSize uint64 `protobuf:"varint,2,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"`
+	// Maximum total size of a resource uploaded in chunks in bytes
+	//
+	// Default: 10485760 (10MB)
+	MaxResourceSize uint64 `protobuf:"varint,3,opt,name=max_resource_size,json=maxResourceSize,proto3" json:"max_resource_size,omitempty"`
+	// Number of blocks a staged upload is kept for before it is removed unless finalized
+	//
+	// Default: 14400 (about 24 hours with 6 seconds blocks)
+	ExpiryBlocks int64 `protobuf:"varint,4,opt,name=expiry_blocks,json=expiryBlocks,proto3" json:"expiry_blocks,omitempty"`
+}
+
+func (m *UploadParams) Reset()         { *m = UploadParams{} }
+func (m *UploadParams) String() string { return proto.CompactTextString(m) }
+func (*UploadParams) ProtoMessage()    {}
+func (*UploadParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f1471cc921862fc, []int{1}
+}
+func (m *UploadParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadParams.Merge(m, src)
+}
+func (m *UploadParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadParams proto.InternalMessageInfo
+
+func (m *UploadParams) GetChunk() types.Coin {
+	if m != nil {
+		return m.Chunk
+	}
+	return types.Coin{}
+}
+
+func (m *UploadParams) GetMaxChunkSize() uint64 {
+	if m != nil {
+		return m.MaxChunkSize
+	}
+	return 0
+}
+
+func (m *UploadParams) GetMaxResourceSize() uint64 {
+	if m != nil {
+		return m.MaxResourceSize
+	}
+	return 0
+}
+
+func (m *UploadParams) GetExpiryBlocks() int64 {
+	if m != nil {
+		return m.ExpiryBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ResourceUpload)(nil), "cheqd.resource.v2.ResourceUpload")
+	proto.RegisterType((*UploadParams)(nil), "cheqd.resource.v2.UploadParams")
+}
+
+func init() { proto.RegisterFile("cheqd/resource/v2/upload.proto", fileDescriptor_8f1471cc921862fc) }
+
+var fileDescriptor_8f1471cc921862fc = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x24, 0xfd, 0x93, 0x4d, 0x5a, 0xd4, 0x15, 0x87, 0x25, 0x12, 0x6e, 0x68, 0x39,
+	0x44, 0x48, 0xb5, 0xd5, 0x20, 0x1e, 0xa0, 0x89, 0x90, 0xa8, 0xb8, 0x20, 0x43, 0x2f, 0x5c, 0xac,
+	0xcd, 0x7a, 0x15, 0xaf, 0x62, 0xef, 0x04, 0xaf, 0xed, 0x26, 0x7d, 0x0a, 0x1e, 0x82, 0xc7, 0xe0,
+	0x01, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x8b, 0x20, 0xcf, 0xc6, 0x29, 0xa2, 0xb7, 0xdd, 0xdf,
+	0xcc, 0xec, 0x7e, 0x33, 0xdf, 0x10, 0x57, 0xc4, 0xf2, 0x5b, 0xe4, 0x67, 0xd2, 0x40, 0x91, 0x09,
+	0xe9, 0x97, 0x23, 0xbf, 0x58, 0x24, 0xc0, 0x23, 0x6f, 0x91, 0x41, 0x0e, 0xf4, 0x04, 0xe3, 0x5e,
+	0x1d, 0xf7, 0xca, 0x51, 0x7f, 0xf0, 0xb4, 0x64, 0x17, 0xc6, 0xa2, 0xbe, 0x2b, 0xc0, 0xa4, 0x60,
+	0xfc, 0x29, 0x37, 0xd2, 0x2f, 0x2f, 0xa7, 0x32, 0xe7, 0x97, 0xbe, 0x00, 0xa5, 0xb7, 0xf1, 0xe7,
+	0x33, 0x98, 0x01, 0x1e, 0xfd, 0xea, 0x64, 0xe9, 0xd9, 0x8f, 0x16, 0x39, 0x0e, 0xb6, 0x0f, 0xdd,
+	0xa0, 0x06, 0x7a, 0x4e, 0x8e, 0x04, 0x24, 0x89, 0x14, 0xb9, 0x02, 0x1d, 0xaa, 0x88, 0x39, 0x03,
+	0x67, 0xd8, 0x09, 0x7a, 0x8f, 0xf0, 0x3a, 0xa2, 0xc7, 0xa4, 0xa9, 0x22, 0xd6, 0xc4, 0x48, 0x53,
+	0x45, 0x94, 0x92, 0xb6, 0xe6, 0xa9, 0x64, 0x2d, 0x24, 0x78, 0xa6, 0x8c, 0x1c, 0x94, 0x32, 0x33,
+	0x0a, 0x34, 0x6b, 0x23, 0xae, 0xaf, 0xd5, 0x17, 0xb5, 0xfa, 0x30, 0x5f, 0x2d, 0x24, 0xdb, 0xb3,
+	0x5f, 0xd4, 0xf0, 0xcb, 0x6a, 0x21, 0xe9, 0x7b, 0x72, 0xc4, 0x13, 0x03, 0xe1, 0x5c, 0xc3, 0xad,
+	0x0e, 0xb9, 0x61, 0xfb, 0x83, 0xd6, 0xb0, 0x3b, 0x7a, 0xe5, 0x3d, 0x99, 0x8e, 0x77, 0x95, 0xe4,
+	0x32, 0xd3, 0x3c, 0x57, 0xa5, 0xbc, 0xc9, 0x54, 0xd0, 0xad, 0xea, 0x3e, 0x56, 0x65, 0x57, 0x86,
+	0xbe, 0x24, 0x24, 0x87, 0x9c, 0x27, 0xa1, 0x51, 0x77, 0x92, 0x1d, 0x0c, 0x9c, 0x61, 0x3b, 0xe8,
+	0x20, 0xf9, 0xac, 0xee, 0x24, 0xed, 0x93, 0x43, 0x11, 0x4b, 0x31, 0x37, 0x45, 0xca, 0x0e, 0x51,
+	0xc5, 0xee, 0x4e, 0x4f, 0x49, 0x57, 0xc4, 0x85, 0x9e, 0x87, 0x02, 0x0a, 0x9d, 0xb3, 0x0e, 0xd6,
+	0x12, 0x44, 0x93, 0x8a, 0xd8, 0x3e, 0x84, 0x54, 0xa5, 0x8c, 0xec, 0xf3, 0x04, 0x53, 0x7a, 0x35,
+	0xc4, 0x1f, 0xce, 0xc9, 0x91, 0x5c, 0x2e, 0x54, 0xb6, 0x0a, 0x63, 0xa9, 0x66, 0x71, 0xce, 0xba,
+	0x03, 0x67, 0xd8, 0x0a, 0x7a, 0x16, 0x7e, 0x40, 0x56, 0xa9, 0x4c, 0x65, 0xa4, 0xb8, 0x1d, 0x47,
+	0x0f, 0x85, 0x74, 0x90, 0x54, 0xb3, 0x38, 0xfb, 0xe9, 0x90, 0x9e, 0xb5, 0xe7, 0x13, 0xcf, 0x78,
+	0x6a, 0xe8, 0x3b, 0xb2, 0x87, 0x3a, 0xd0, 0x9c, 0xee, 0xe8, 0x85, 0x67, 0xdd, 0xf7, 0x2a, 0xf7,
+	0xbd, 0xad, 0xfb, 0xde, 0x04, 0x94, 0x1e, 0xb7, 0xef, 0x7f, 0x9f, 0x36, 0x02, 0x9b, 0x4d, 0x5f,
+	0x93, 0xe3, 0x94, 0x2f, 0x43, 0xdb, 0x15, 0x2a, 0x6e, 0x5a, 0xc5, 0x29, 0x5f, 0x4e, 0x2a, 0x88,
+	0x8a, 0xdf, 0x90, 0x93, 0x2a, 0x6b, 0x67, 0x11, 0x26, 0xb6, 0x30, 0xf1, 0x59, 0xca, 0x97, 0xf5,
+	0xbe, 0xfc, 0xd7, 0xdd, 0x34, 0x01, 0x31, 0x37, 0xac, 0xfd, 0x6f, 0x77, 0x63, 0x64, 0xe3, 0xeb,
+	0xfb, 0xb5, 0xeb, 0x3c, 0xac, 0x5d, 0xe7, 0xcf, 0xda, 0x75, 0xbe, 0x6f, 0xdc, 0xc6, 0xc3, 0xc6,
+	0x6d, 0xfc, 0xda, 0xb8, 0x8d, 0xaf, 0xfe, 0x4c, 0xe5, 0x71, 0x31, 0xf5, 0x04, 0xa4, 0xbe, 0xe0,
+	0x1a, 0x6e, 0x2f, 0x04, 0xf8, 0x68, 0xf0, 0x85, 0x86, 0x48, 0xfa, 0xcb, 0xc7, 0x95, 0xaf, 0x26,
+	0x63, 0xa6, 0xfb, 0xb8, 0xb7, 0x6f, 0xff, 0x0e, 0x00, 0xc5, 0xf4, 0x38, 0xb1, 0x44, 0x03, 0x00,
+	0x00,
+}
+
+func (m *ResourceUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ReceivedSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlsoKnownAs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryBlocks != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ExpiryBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxResourceSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.MaxResourceSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxChunkSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.MaxChunkSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpload(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ResourceUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for _, e := range m.AlsoKnownAs {
+			l = e.Size()
+			n += 1 + l + sovUpload(uint64(l))
+		}
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovUpload(uint64(m.TotalSize))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovUpload(uint64(m.ChunkCount))
+	}
+	if m.ReceivedSize != 0 {
+		n += 1 + sovUpload(uint64(m.ReceivedSize))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovUpload(uint64(m.ExpiryHeight))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chunk.Size()
+	n += 1 + l + sovUpload(uint64(l))
+	if m.MaxChunkSize != 0 {
+		n += 1 + sovUpload(uint64(m.MaxChunkSize))
+	}
+	if m.MaxResourceSize != 0 {
+		n += 1 + sovUpload(uint64(m.MaxResourceSize))
+	}
+	if m.ExpiryBlocks != 0 {
+		n += 1 + sovUpload(uint64(m.ExpiryBlocks))
+	}
+	return n
+}
+
+func sovUpload(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpload(x uint64) (n int) {
+	return sovUpload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ResourceUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, &AlternativeUri{})
+			if err := m.AlsoKnownAs[len(m.AlsoKnownAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChunkSize", wireType)
+			}
+			m.MaxChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChunkSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourceSize", wireType)
+			}
+			m.MaxResourceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResourceSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlocks", wireType)
+			}
+			m.ExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpload(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpload
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpload
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpload
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpload        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpload          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpload = fmt.Errorf("proto: unexpected end of group")
+)